@@ -0,0 +1,168 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package algorithms provides generic algorithms, which work on the iterators of all containers.
+//
+// Like ds.Seq, algorithms operate on the elements after the passed iterator's current position,
+// so passing a container's Begin() iterator operates on all of its elements.
+//
+// Algorithms choose their strategy based on the capabilities of the passed iterator.
+// Random access iterators, which implement ds.ConstantTimeAccessIterator, are read and written by index, without moving them.
+// Algorithms rearranging elements through other bidirectional iterators
+// buffer the elements and write them back while moving the iterator back to its original position.
+// Indices of iterators implementing ds.ReversedIterator are visited in descending order.
+package algorithms
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+)
+
+// randomAccessReader is the part of ds.RandomAccessReadableIterator, which does not depend on the iterator's key type.
+type randomAccessReader[T any] interface {
+	ds.SizedIterator
+
+	Index() (int, bool)
+	GetAt(i int) (value T, found bool)
+}
+
+// randomAccessWriter is the part of ds.RandomAccessWriteableIterator, which does not depend on the iterator's key type.
+type randomAccessWriter[T any] interface {
+	randomAccessReader[T]
+
+	SetAt(i int, value T) bool
+}
+
+// span is the range of indices [first, last) of the elements after an iterator's current position.
+// Reversed iterators visit these indices from last-1 down to first.
+type span struct {
+	first    int
+	last     int
+	reversed bool
+}
+
+// spanOf returns the span of the elements after it's current position.
+func spanOf(it interface {
+	ds.SizedIterator
+	Index() (int, bool)
+}) span {
+	s := span{last: it.Size(), reversed: ds.IsReversed(it)}
+
+	switch {
+	case it.IsBegin():
+		return s
+	case it.IsEnd():
+		return span{first: s.last, last: s.last, reversed: s.reversed}
+	}
+
+	index, _ := it.Index()
+	if s.reversed {
+		s.last = index
+	} else {
+		s.first = index + 1
+	}
+
+	return s
+}
+
+func (s span) Len() int {
+	return s.last - s.first
+}
+
+// index returns the index of the i-th element in the span in iteration order.
+// Passing Len() returns the index one past the last element, which an iterator is moved to if no element was found.
+func (s span) index(i int) int {
+	if s.reversed {
+		return s.last - 1 - i
+	}
+
+	return s.first + i
+}
+
+// hasConstantTimeAccess checks if it's elements can be accessed by index in O(1).
+func hasConstantTimeAccess(it ds.Iterator) bool {
+	constantTime, ok := it.(ds.ConstantTimeAccessIterator)
+
+	return ok && constantTime.HasConstantTimeAccess()
+}
+
+// sequence is a mutable view of the elements, which an algorithm rearranges.
+type sequence[T any] interface {
+	Len() int
+	Get(i int) T
+	Set(i int, value T)
+}
+
+type sliceSequence[T any] []T
+
+func (s sliceSequence[T]) Len() int {
+	return len(s)
+}
+
+func (s sliceSequence[T]) Get(i int) T {
+	return s[i]
+}
+
+func (s sliceSequence[T]) Set(i int, value T) {
+	s[i] = value
+}
+
+type randomAccessSequence[T any] struct {
+	it randomAccessWriter[T]
+	span
+}
+
+func (s randomAccessSequence[T]) Get(i int) T {
+	value, _ := s.it.GetAt(s.index(i))
+
+	return value
+}
+
+func (s randomAccessSequence[T]) Set(i int, value T) {
+	s.it.SetAt(s.index(i), value)
+}
+
+func swap[T any](s sequence[T], i int, j int) {
+	first, second := s.Get(i), s.Get(j)
+	s.Set(i, second)
+	s.Set(j, first)
+}
+
+func reverse[T any](s sequence[T], first int, last int) {
+	for last--; first < last; first, last = first+1, last-1 {
+		swap(s, first, last)
+	}
+}
+
+// rearrange calls f with a view of the elements after it's current position.
+//
+// Random access iterators, which access their elements in O(1), are viewed directly and are not moved.
+// Otherwise the elements are buffered and written back after f returns, leaving it at its original position.
+func rearrange[T any](it ds.ReadWriteBidIterator[T], f func(s sequence[T])) {
+	if random, ok := it.(randomAccessWriter[T]); ok && hasConstantTimeAccess(it) {
+		f(randomAccessSequence[T]{it: random, span: spanOf(random)})
+
+		return
+	}
+
+	if it.IsEnd() {
+		f(sliceSequence[T](nil))
+
+		return
+	}
+
+	values := []T{}
+	for it.Next() {
+		value, _ := it.Get()
+		values = append(values, value)
+	}
+
+	f(sliceSequence[T](values))
+
+	for i := len(values) - 1; i >= 0; i-- {
+		it.Previous()
+		it.Set(values[i])
+	}
+
+	it.Previous()
+}
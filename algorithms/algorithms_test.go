@@ -0,0 +1,136 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package algorithms
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	"github.com/JonasMuehlmann/datastructures.go/lists/doublylinkedlist"
+	"github.com/JonasMuehlmann/datastructures.go/queues/circularbuffer"
+	"github.com/stretchr/testify/assert"
+)
+
+// bidirectionalIterator hides the random access capabilities of the wrapped iterator.
+type bidirectionalIterator[T any] struct {
+	ds.ReadWriteBidIterator[T]
+}
+
+// strategies returns iterators over values, which use every strategy of the algorithms, and a function to read back their values.
+func strategies[T any](values []T) map[string]func() (ds.ReadWriteBidIterator[T], func() []T) {
+	return map[string]func() (ds.ReadWriteBidIterator[T], func() []T){
+		"random access": func() (ds.ReadWriteBidIterator[T], func() []T) {
+			list := arraylist.NewFromSlice(append([]T{}, values...))
+
+			return list.Begin(), list.GetValues
+		},
+		"bidirectional": func() (ds.ReadWriteBidIterator[T], func() []T) {
+			list := doublylinkedlist.NewFromSlice(append([]T{}, values...))
+
+			return bidirectionalIterator[T]{list.Begin()}, list.GetValues
+		},
+		"linear access": func() (ds.ReadWriteBidIterator[T], func() []T) {
+			list := doublylinkedlist.NewFromSlice(append([]T{}, values...))
+
+			return list.Begin(), list.GetValues
+		},
+		"reversed random access": func() (ds.ReadWriteBidIterator[T], func() []T) {
+			list := arraylist.NewFromSlice(reversed(values))
+
+			return list.ReverseBegin(), func() []T { return reversed(list.GetValues()) }
+		},
+		"reversed wrapped random access": func() (ds.ReadWriteBidIterator[T], func() []T) {
+			queue := circularbuffer.NewFromSlice(len(values)+1, reversed(values))
+
+			return queue.ReverseBegin(), func() []T { return reversed(queue.GetValues()) }
+		},
+		"reversed bidirectional": func() (ds.ReadWriteBidIterator[T], func() []T) {
+			list := doublylinkedlist.NewFromSlice(reversed(values))
+
+			return list.ReverseBegin(), func() []T { return reversed(list.GetValues()) }
+		},
+	}
+}
+
+// reversed returns a reversed copy of values.
+func reversed[T any](values []T) []T {
+	result := slices.Clone(values)
+	slices.Reverse(result)
+
+	return result
+}
+
+// countingIterator counts the random accesses to the wrapped iterator.
+type countingIterator[T any] struct {
+	*doublylinkedlist.Iterator[T]
+	accesses int
+}
+
+func (it *countingIterator[T]) GetAt(i int) (value T, found bool) {
+	it.accesses++
+
+	return it.Iterator.GetAt(i)
+}
+
+func (it *countingIterator[T]) SetAt(i int, value T) bool {
+	it.accesses++
+
+	return it.Iterator.SetAt(i, value)
+}
+
+func TestRearrangeBuffersLinearAccess(t *testing.T) {
+	list := doublylinkedlist.New(1, 2, 3, 4)
+	it := &countingIterator[int]{Iterator: list.NewIterator(-1, list.Size())}
+
+	Rotate[int](it, 1)
+
+	assert.Equal(t, []int{2, 3, 4, 1}, list.GetValues())
+	assert.Equal(t, 0, it.accesses)
+	assert.True(t, it.IsBegin())
+}
+
+func TestRearrangeReversed(t *testing.T) {
+	list := arraylist.New(1, 2, 3, 4)
+
+	Rotate(list.ReverseBegin(), 1)
+	assert.Equal(t, []int{4, 1, 2, 3}, list.GetValues())
+
+	list = arraylist.New(1, 2, 3, 4, 5)
+
+	// Elements are partitioned in the order of the reversed iterator
+	assert.Equal(t, 2, StablePartition(list.ReverseBegin(), isEven))
+	assert.Equal(t, []int{1, 3, 5, 2, 4}, list.GetValues())
+}
+
+func TestRearrangeKeepsPosition(t *testing.T) {
+	for name, strategy := range strategies([]int{1, 2, 3, 4}) {
+		it, values := strategy()
+
+		assert.Truef(t, it.Next(), name)
+		Reverse(it)
+
+		assert.Equalf(t, []int{1, 4, 3, 2}, values(), name)
+
+		value, found := it.Get()
+		assert.Truef(t, found, name)
+		assert.Equalf(t, 1, value, name)
+	}
+}
+
+func TestRearrangeAtEnd(t *testing.T) {
+	for name, strategy := range strategies([]int{1, 2}) {
+		it, values := strategy()
+
+		assert.Falsef(t, it.NextN(3), name)
+		assert.Truef(t, it.IsEnd(), name)
+
+		Reverse(it)
+
+		assert.Equalf(t, []int{1, 2}, values(), name)
+		assert.Truef(t, it.IsEnd(), name)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package algorithms
+
+import (
+	"iter"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Merge returns a sequence over the elements of first and second in ascending order according to comparator.
+// Both inputs must be sorted according to comparator, equal elements of first precede those of second.
+//
+// The iterators are advanced while the sequence is consumed, so the sequence can only be ranged over once.
+func Merge[T any](first ds.ReadForIterator[T], second ds.ReadForIterator[T], comparator utils.Comparator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		hasFirst, hasSecond := first.Next(), second.Next()
+
+		for hasFirst && hasSecond {
+			firstValue, _ := first.Get()
+			secondValue, _ := second.Get()
+
+			if comparator(secondValue, firstValue) < 0 {
+				if !yield(secondValue) {
+					return
+				}
+
+				hasSecond = second.Next()
+			} else {
+				if !yield(firstValue) {
+					return
+				}
+
+				hasFirst = first.Next()
+			}
+		}
+
+		remaining, hasRemaining := first, hasFirst
+		if hasSecond {
+			remaining, hasRemaining = second, true
+		}
+
+		for ; hasRemaining; hasRemaining = remaining.Next() {
+			value, _ := remaining.Get()
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package algorithms
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  []int
+		second []int
+		result []int
+	}{
+		{
+			name:   "both empty",
+			first:  []int{},
+			second: []int{},
+			result: nil,
+		},
+		{
+			name:   "first empty",
+			first:  []int{},
+			second: []int{1, 2},
+			result: []int{1, 2},
+		},
+		{
+			name:   "second empty",
+			first:  []int{1, 2},
+			second: []int{},
+			result: []int{1, 2},
+		},
+		{
+			name:   "interleaved",
+			first:  []int{1, 3, 5, 7},
+			second: []int{2, 3, 4},
+			result: []int{1, 2, 3, 3, 4, 5, 7},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			merged := Merge(arraylist.NewFromSlice(test.first).Begin(), arraylist.NewFromSlice(test.second).Begin(), utils.BasicComparator[int])
+
+			assert.Equalf(t, test.result, slices.Collect(merged), test.name)
+		})
+	}
+}
+
+func TestMergeIsStable(t *testing.T) {
+	type entry struct {
+		key    int
+		origin string
+	}
+
+	comparator := func(a, b entry) int { return utils.BasicComparator(a.key, b.key) }
+
+	first := arraylist.New(entry{1, "first"}, entry{2, "first"})
+	second := arraylist.New(entry{1, "second"}, entry{2, "second"})
+
+	assert.Equal(t, []entry{{1, "first"}, {1, "second"}, {2, "first"}, {2, "second"}}, slices.Collect(Merge(first.Begin(), second.Begin(), comparator)))
+}
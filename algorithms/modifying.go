@@ -0,0 +1,151 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package algorithms
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Reverse reverses the order of the elements.
+func Reverse[T any](it ds.ReadWriteBidIterator[T]) {
+	rearrange(it, func(s sequence[T]) {
+		reverse(s, 0, s.Len())
+	})
+}
+
+// Rotate rotates the elements to the left by n positions, so that the n-th element becomes the first one.
+// Negative values of n rotate to the right.
+func Rotate[T any](it ds.ReadWriteBidIterator[T], n int) {
+	rearrange(it, func(s sequence[T]) {
+		length := s.Len()
+		if length == 0 {
+			return
+		}
+
+		n = ((n % length) + length) % length
+
+		reverse(s, 0, n)
+		reverse(s, n, length)
+		reverse(s, 0, length)
+	})
+}
+
+// Partition reorders the elements, so that all elements satisfying predicate precede the others
+// and returns the number of elements satisfying predicate.
+// The relative order of the elements is not preserved.
+func Partition[T any](it ds.ReadWriteBidIterator[T], predicate func(value T) bool) (count int) {
+	rearrange(it, func(s sequence[T]) {
+		first, last := 0, s.Len()
+
+		for {
+			for first < last && predicate(s.Get(first)) {
+				first++
+			}
+
+			for first < last && !predicate(s.Get(last-1)) {
+				last--
+			}
+
+			if first >= last {
+				break
+			}
+
+			swap(s, first, last-1)
+			first++
+			last--
+		}
+
+		count = first
+	})
+
+	return
+}
+
+// StablePartition reorders the elements, so that all elements satisfying predicate precede the others
+// and returns the number of elements satisfying predicate.
+// The relative order of the elements is preserved.
+func StablePartition[T any](it ds.ReadWriteBidIterator[T], predicate func(value T) bool) (count int) {
+	rearrange(it, func(s sequence[T]) {
+		rejected := make([]T, 0, s.Len())
+
+		for i := 0; i < s.Len(); i++ {
+			value := s.Get(i)
+			if predicate(value) {
+				s.Set(count, value)
+				count++
+			} else {
+				rejected = append(rejected, value)
+			}
+		}
+
+		for i, value := range rejected {
+			s.Set(count+i, value)
+		}
+	})
+
+	return
+}
+
+// Unique moves the first element of every consecutive run of equal elements to the front
+// and returns the number of these elements.
+// The elements after them are left in an unspecified state.
+func Unique[T any](it ds.ReadWriteBidIterator[T], comparator utils.Comparator[T]) (count int) {
+	rearrange(it, func(s sequence[T]) {
+		if s.Len() == 0 {
+			return
+		}
+
+		last := 0
+		for i := 1; i < s.Len(); i++ {
+			value := s.Get(i)
+			if comparator(s.Get(last), value) != 0 {
+				last++
+				if last != i {
+					s.Set(last, value)
+				}
+			}
+		}
+
+		count = last + 1
+	})
+
+	return
+}
+
+// NextPermutation rearranges the elements into the lexicographically next larger permutation according to comparator
+// and returns true if such a permutation exists.
+// Otherwise the elements are rearranged into the smallest permutation, which is sorted in ascending order.
+func NextPermutation[T any](it ds.ReadWriteBidIterator[T], comparator utils.Comparator[T]) (hasNext bool) {
+	rearrange(it, func(s sequence[T]) {
+		length := s.Len()
+		if length < 2 {
+			return
+		}
+
+		pivot := length - 2
+		for pivot >= 0 && comparator(s.Get(pivot), s.Get(pivot+1)) >= 0 {
+			pivot--
+		}
+
+		if pivot < 0 {
+			reverse(s, 0, length)
+
+			return
+		}
+
+		successor := length - 1
+		for comparator(s.Get(successor), s.Get(pivot)) <= 0 {
+			successor--
+		}
+
+		swap(s, pivot, successor)
+		reverse(s, pivot+1, length)
+
+		hasNext = true
+	})
+
+	return
+}
@@ -0,0 +1,329 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package algorithms
+
+import (
+	"testing"
+
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		result []int
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			result: []int{},
+		},
+		{
+			name:   "odd length",
+			values: []int{1, 2, 3},
+			result: []int{3, 2, 1},
+		},
+		{
+			name:   "even length",
+			values: []int{1, 2, 3, 4},
+			result: []int{4, 3, 2, 1},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		for strategyName, strategy := range strategies(test.values) {
+			name := test.name + ", " + strategyName
+			it, values := strategy()
+
+			t.Run(name, func(t *testing.T) {
+				defer testCommon.HandlePanic(t, name)
+
+				Reverse(it)
+
+				assert.Equalf(t, test.result, append([]int{}, values()...), name)
+				assert.Truef(t, it.IsBegin(), name)
+			})
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		n      int
+		result []int
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			n:      2,
+			result: []int{},
+		},
+		{
+			name:   "zero",
+			values: []int{1, 2, 3},
+			n:      0,
+			result: []int{1, 2, 3},
+		},
+		{
+			name:   "left",
+			values: []int{1, 2, 3, 4, 5},
+			n:      2,
+			result: []int{3, 4, 5, 1, 2},
+		},
+		{
+			name:   "right",
+			values: []int{1, 2, 3, 4, 5},
+			n:      -1,
+			result: []int{5, 1, 2, 3, 4},
+		},
+		{
+			name:   "more than length",
+			values: []int{1, 2, 3},
+			n:      4,
+			result: []int{2, 3, 1},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		for strategyName, strategy := range strategies(test.values) {
+			name := test.name + ", " + strategyName
+			it, values := strategy()
+
+			t.Run(name, func(t *testing.T) {
+				defer testCommon.HandlePanic(t, name)
+
+				Rotate(it, test.n)
+
+				assert.Equalf(t, test.result, append([]int{}, values()...), name)
+			})
+		}
+	}
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		count  int
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			count:  0,
+		},
+		{
+			name:   "none",
+			values: []int{1, 3, 5},
+			count:  0,
+		},
+		{
+			name:   "all",
+			values: []int{2, 4, 6},
+			count:  3,
+		},
+		{
+			name:   "mixed",
+			values: []int{1, 2, 3, 4, 5, 6, 8},
+			count:  4,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		for strategyName, strategy := range strategies(test.values) {
+			name := test.name + ", " + strategyName
+			it, values := strategy()
+
+			t.Run(name, func(t *testing.T) {
+				defer testCommon.HandlePanic(t, name)
+
+				count := Partition(it, isEven)
+				result := values()
+
+				assert.Equalf(t, test.count, count, name)
+				assert.ElementsMatchf(t, test.values, result, name)
+
+				for i, value := range result {
+					assert.Equalf(t, i < count, isEven(value), name)
+				}
+			})
+		}
+	}
+}
+
+func TestStablePartition(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		count  int
+		result []int
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			count:  0,
+			result: []int{},
+		},
+		{
+			name:   "none",
+			values: []int{1, 3, 5},
+			count:  0,
+			result: []int{1, 3, 5},
+		},
+		{
+			name:   "mixed",
+			values: []int{1, 2, 3, 4, 5, 6, 8},
+			count:  4,
+			result: []int{2, 4, 6, 8, 1, 3, 5},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		for strategyName, strategy := range strategies(test.values) {
+			name := test.name + ", " + strategyName
+			it, values := strategy()
+
+			t.Run(name, func(t *testing.T) {
+				defer testCommon.HandlePanic(t, name)
+
+				assert.Equalf(t, test.count, StablePartition(it, isEven), name)
+				assert.Equalf(t, test.result, append([]int{}, values()...), name)
+			})
+		}
+	}
+}
+
+func TestUnique(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		result []int
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			result: []int{},
+		},
+		{
+			name:   "no duplicates",
+			values: []int{1, 2, 3},
+			result: []int{1, 2, 3},
+		},
+		{
+			name:   "consecutive duplicates",
+			values: []int{1, 1, 2, 2, 2, 1, 3, 3},
+			result: []int{1, 2, 1, 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		for strategyName, strategy := range strategies(test.values) {
+			name := test.name + ", " + strategyName
+			it, values := strategy()
+
+			t.Run(name, func(t *testing.T) {
+				defer testCommon.HandlePanic(t, name)
+
+				count := Unique(it, utils.BasicComparator[int])
+
+				assert.Equalf(t, len(test.result), count, name)
+				assert.Equalf(t, test.result, append([]int{}, values()[:count]...), name)
+			})
+		}
+	}
+}
+
+func TestNextPermutation(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []int
+		result  []int
+		hasNext bool
+	}{
+		{
+			name:    "empty",
+			values:  []int{},
+			result:  []int{},
+			hasNext: false,
+		},
+		{
+			name:    "single",
+			values:  []int{1},
+			result:  []int{1},
+			hasNext: false,
+		},
+		{
+			name:    "first permutation",
+			values:  []int{1, 2, 3},
+			result:  []int{1, 3, 2},
+			hasNext: true,
+		},
+		{
+			name:    "middle permutation",
+			values:  []int{1, 3, 2},
+			result:  []int{2, 1, 3},
+			hasNext: true,
+		},
+		{
+			name:    "duplicates",
+			values:  []int{1, 2, 2},
+			result:  []int{2, 1, 2},
+			hasNext: true,
+		},
+		{
+			name:    "last permutation",
+			values:  []int{3, 2, 1},
+			result:  []int{1, 2, 3},
+			hasNext: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		for strategyName, strategy := range strategies(test.values) {
+			name := test.name + ", " + strategyName
+			it, values := strategy()
+
+			t.Run(name, func(t *testing.T) {
+				defer testCommon.HandlePanic(t, name)
+
+				assert.Equalf(t, test.hasNext, NextPermutation(it, utils.BasicComparator[int]), name)
+				assert.Equalf(t, test.result, append([]int{}, values()...), name)
+			})
+		}
+	}
+}
+
+func TestNextPermutationCount(t *testing.T) {
+	for name, strategy := range strategies([]int{1, 2, 3, 4}) {
+		it, _ := strategy()
+
+		permutations := 1
+		for NextPermutation(it, utils.BasicComparator[int]) {
+			permutations++
+		}
+
+		assert.Equalf(t, 24, permutations, name)
+	}
+}
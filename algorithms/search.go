@@ -0,0 +1,177 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package algorithms
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Find moves begin to the next element equal to value and returns true if such an element was found.
+// Otherwise begin is moved to its end.
+func Find[T comparable](begin ds.ReadForIterator[T], value T) bool {
+	return FindIf(begin, func(element T) bool { return element == value })
+}
+
+// FindIf moves begin to the next element satisfying predicate and returns true if such an element was found.
+// Otherwise begin is moved to its end.
+func FindIf[T any](begin ds.ReadForIterator[T], predicate func(value T) bool) bool {
+	for begin.Next() {
+		value, _ := begin.Get()
+		if predicate(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Count returns the number of elements equal to value, begin is moved to its end.
+func Count[T comparable](begin ds.ReadForIterator[T], value T) int {
+	count := 0
+	for FindIf(begin, func(element T) bool { return element == value }) {
+		count++
+	}
+
+	return count
+}
+
+// MinMaxElement returns the smallest and largest element, found is false if there are no elements.
+// If there are multiple smallest elements, the first one is returned, if there are multiple largest elements, the last one is returned.
+//
+// Elements are compared in pairs, using at most 3n/2 comparisons.
+func MinMaxElement[T any](begin ds.ReadForIterator[T], comparator utils.Comparator[T]) (smallest T, largest T, found bool) {
+	if !begin.Next() {
+		return
+	}
+
+	smallest, _ = begin.Get()
+	largest = smallest
+
+	for begin.Next() {
+		first, _ := begin.Get()
+
+		if !begin.Next() {
+			if comparator(first, smallest) < 0 {
+				smallest = first
+			} else if comparator(first, largest) >= 0 {
+				largest = first
+			}
+
+			break
+		}
+
+		second, _ := begin.Get()
+
+		smaller, larger := first, second
+		if comparator(second, first) < 0 {
+			smaller, larger = second, first
+		}
+
+		if comparator(smaller, smallest) < 0 {
+			smallest = smaller
+		}
+
+		if comparator(larger, largest) >= 0 {
+			largest = larger
+		}
+	}
+
+	return smallest, largest, true
+}
+
+// IsSorted returns true if the elements are sorted in ascending order according to comparator.
+// begin is moved to the first element, which is smaller than its predecessor, or to its end.
+func IsSorted[T any](begin ds.ReadForIterator[T], comparator utils.Comparator[T]) bool {
+	if !begin.Next() {
+		return true
+	}
+
+	previous, _ := begin.Get()
+
+	for begin.Next() {
+		value, _ := begin.Get()
+		if comparator(value, previous) < 0 {
+			return false
+		}
+
+		previous = value
+	}
+
+	return true
+}
+
+// LowerBound moves it to the first element, which is not smaller than value and returns true if such an element was found.
+// Otherwise it is moved to the index Size(), or -1 for reversed iterators, which is its end for most iterators.
+//
+// The elements must be sorted according to comparator, they are binary searched in O(log n) calls to GetAt.
+func LowerBound[TKey any, TValue any](it ds.RandomAccessReadableIterator[TKey, TValue], value TValue, comparator utils.Comparator[TValue]) bool {
+	return moveToPartitionPoint(it, func(element TValue) bool { return comparator(element, value) < 0 })
+}
+
+// UpperBound moves it to the first element, which is larger than value and returns true if such an element was found.
+// Otherwise it is moved to the index Size(), or -1 for reversed iterators, which is its end for most iterators.
+//
+// The elements must be sorted according to comparator, they are binary searched in O(log n) calls to GetAt.
+func UpperBound[TKey any, TValue any](it ds.RandomAccessReadableIterator[TKey, TValue], value TValue, comparator utils.Comparator[TValue]) bool {
+	return moveToPartitionPoint(it, func(element TValue) bool { return comparator(element, value) <= 0 })
+}
+
+// EqualRange moves it to the first element, which is not smaller than value and returns the number of elements equal to value,
+// which follow from that position on.
+//
+// The elements must be sorted according to comparator, they are binary searched in O(log n) calls to GetAt.
+func EqualRange[TKey any, TValue any](it ds.RandomAccessReadableIterator[TKey, TValue], value TValue, comparator utils.Comparator[TValue]) int {
+	s := spanOf(it)
+	lower := partitionPoint[TValue](it, s, 0, func(element TValue) bool { return comparator(element, value) < 0 })
+	upper := partitionPoint[TValue](it, s, lower, func(element TValue) bool { return comparator(element, value) <= 0 })
+
+	it.MoveTo(s.index(lower))
+
+	return upper - lower
+}
+
+// BinarySearch moves it to the first element equal to value and returns true if such an element was found.
+// Otherwise it is moved to the position, where value would be inserted, like with LowerBound.
+//
+// The elements must be sorted according to comparator, they are binary searched in O(log n) calls to GetAt.
+func BinarySearch[TKey any, TValue any](it ds.RandomAccessReadableIterator[TKey, TValue], value TValue, comparator utils.Comparator[TValue]) bool {
+	if !LowerBound(it, value, comparator) {
+		return false
+	}
+
+	element, _ := it.Get()
+
+	return comparator(element, value) == 0
+}
+
+// moveToPartitionPoint moves it to the first element, which does not satisfy predicate and returns true if such an element was found.
+func moveToPartitionPoint[TKey any, TValue any](it ds.RandomAccessReadableIterator[TKey, TValue], predicate func(value TValue) bool) bool {
+	s := spanOf(it)
+	i := partitionPoint[TValue](it, s, 0, predicate)
+
+	it.MoveTo(s.index(i))
+
+	return i < s.Len()
+}
+
+// partitionPoint returns the position of the first element in s, starting from first, which does not satisfy predicate.
+// The elements satisfying predicate must precede all others.
+func partitionPoint[T any](it randomAccessReader[T], s span, first int, predicate func(value T) bool) int {
+	last := s.Len()
+
+	for first < last {
+		middle := int(uint(first+last) >> 1)
+
+		value, _ := it.GetAt(s.index(middle))
+		if predicate(value) {
+			first = middle + 1
+		} else {
+			last = middle
+		}
+	}
+
+	return first
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	"github.com/JonasMuehlmann/datastructures.go/maps/treemap"
+	"github.com/JonasMuehlmann/datastructures.go/sets/treeset"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	it := arraylist.New(1, 2, 3, 2).Begin()
+
+	assert.True(t, Find(it, 2))
+
+	index, _ := it.Index()
+	assert.Equal(t, 1, index)
+
+	// Searching again continues after the found element
+	assert.True(t, Find(it, 2))
+
+	index, _ = it.Index()
+	assert.Equal(t, 3, index)
+
+	assert.False(t, Find(it, 2))
+	assert.True(t, it.IsEnd())
+}
+
+func TestFindIf(t *testing.T) {
+	it := arraylist.New(1, 3, 4, 5).Begin()
+
+	assert.True(t, FindIf(it, isEven))
+
+	value, found := it.Get()
+	assert.True(t, found)
+	assert.Equal(t, 4, value)
+
+	assert.False(t, FindIf(it, isEven))
+	assert.True(t, it.IsEnd())
+}
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		value  int
+		count  int
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			value:  1,
+			count:  0,
+		},
+		{
+			name:   "none",
+			values: []int{2, 3},
+			value:  1,
+			count:  0,
+		},
+		{
+			name:   "multiple",
+			values: []int{1, 2, 1, 1},
+			value:  1,
+			count:  3,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.count, Count(arraylist.NewFromSlice(test.values).Begin(), test.value), test.name)
+		})
+	}
+}
+
+func TestMinMaxElement(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		smallest int
+		largest  int
+		found    bool
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			found:  false,
+		},
+		{
+			name:     "single",
+			values:   []int{1},
+			smallest: 1,
+			largest:  1,
+			found:    true,
+		},
+		{
+			name:     "even length",
+			values:   []int{3, 1, 4, 2},
+			smallest: 1,
+			largest:  4,
+			found:    true,
+		},
+		{
+			name:     "odd length",
+			values:   []int{3, 5, 4, 2, 0},
+			smallest: 0,
+			largest:  5,
+			found:    true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			smallest, largest, found := MinMaxElement(arraylist.NewFromSlice(test.values).Begin(), utils.BasicComparator[int])
+
+			assert.Equalf(t, test.smallest, smallest, test.name)
+			assert.Equalf(t, test.largest, largest, test.name)
+			assert.Equalf(t, test.found, found, test.name)
+		})
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		isSorted bool
+	}{
+		{
+			name:     "empty",
+			values:   []int{},
+			isSorted: true,
+		},
+		{
+			name:     "sorted with duplicates",
+			values:   []int{1, 2, 2, 3},
+			isSorted: true,
+		},
+		{
+			name:     "unsorted",
+			values:   []int{1, 3, 2},
+			isSorted: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.isSorted, IsSorted(arraylist.NewFromSlice(test.values).Begin(), utils.BasicComparator[int]), test.name)
+		})
+	}
+}
+
+func TestBounds(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      []int
+		value       int
+		lowerBound  int
+		upperBound  int
+		equalCount  int
+		isContained bool
+	}{
+		{
+			name:        "empty",
+			values:      []int{},
+			value:       1,
+			lowerBound:  0,
+			upperBound:  0,
+			equalCount:  0,
+			isContained: false,
+		},
+		{
+			name:        "before all",
+			values:      []int{2, 4, 6},
+			value:       1,
+			lowerBound:  0,
+			upperBound:  0,
+			equalCount:  0,
+			isContained: false,
+		},
+		{
+			name:        "after all",
+			values:      []int{2, 4, 6},
+			value:       7,
+			lowerBound:  3,
+			upperBound:  3,
+			equalCount:  0,
+			isContained: false,
+		},
+		{
+			name:        "between",
+			values:      []int{2, 4, 6},
+			value:       5,
+			lowerBound:  2,
+			upperBound:  2,
+			equalCount:  0,
+			isContained: false,
+		},
+		{
+			name:        "duplicates",
+			values:      []int{1, 2, 2, 2, 3},
+			value:       2,
+			lowerBound:  1,
+			upperBound:  4,
+			equalCount:  3,
+			isContained: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			list := arraylist.NewFromSlice(test.values)
+
+			it := list.Begin()
+			assert.Equalf(t, test.lowerBound < len(test.values), LowerBound(it, test.value, utils.BasicComparator[int]), test.name)
+			index, _ := it.Index()
+			assert.Equalf(t, test.lowerBound, index, test.name)
+
+			it = list.Begin()
+			assert.Equalf(t, test.upperBound < len(test.values), UpperBound(it, test.value, utils.BasicComparator[int]), test.name)
+			index, _ = it.Index()
+			assert.Equalf(t, test.upperBound, index, test.name)
+
+			it = list.Begin()
+			assert.Equalf(t, test.equalCount, EqualRange(it, test.value, utils.BasicComparator[int]), test.name)
+			index, _ = it.Index()
+			assert.Equalf(t, test.lowerBound, index, test.name)
+
+			it = list.Begin()
+			assert.Equalf(t, test.isContained, BinarySearch(it, test.value, utils.BasicComparator[int]), test.name)
+		})
+	}
+}
+
+func TestBoundsReversed(t *testing.T) {
+	descending := func(a, b int) int { return utils.BasicComparator(b, a) }
+
+	m := treemap.NewFromMap(utils.BasicComparator[string], map[string]int{"a": 10, "b": 20, "c": 30})
+
+	it := m.ReverseBegin(utils.BasicComparator[string])
+	assert.True(t, LowerBound(it, 20, descending))
+
+	value, _ := it.Get()
+	assert.Equal(t, 20, value)
+
+	it = m.ReverseBegin(utils.BasicComparator[string])
+	assert.True(t, UpperBound(it, 20, descending))
+
+	value, _ = it.Get()
+	assert.Equal(t, 10, value)
+
+	it = m.ReverseBegin(utils.BasicComparator[string])
+	assert.False(t, LowerBound(it, 5, descending))
+	assert.True(t, it.IsEnd())
+
+	list := arraylist.New(1, 2, 2, 3, 4)
+	listIt := list.ReverseBegin()
+	assert.True(t, listIt.Next())
+
+	// Only the elements after the current position are searched
+	assert.Equal(t, 2, EqualRange(listIt, 2, descending))
+
+	index, _ := listIt.Index()
+	assert.Equal(t, 2, index)
+
+	assert.False(t, BinarySearch(listIt, 0, descending))
+	assert.True(t, listIt.IsEnd())
+}
+
+func TestBinarySearchSortedContainer(t *testing.T) {
+	set := treeset.New(utils.BasicComparator[int], 10, 30, 20, 40)
+	it := set.OrderedBegin(utils.BasicComparator[int])
+
+	assert.True(t, BinarySearch(it, 30, utils.BasicComparator[int]))
+
+	value, found := it.Get()
+	assert.True(t, found)
+	assert.Equal(t, 30, value)
+
+	assert.False(t, BinarySearch(it, 25, utils.BasicComparator[int]))
+}
+
+func TestBinarySearchAfterPosition(t *testing.T) {
+	it := arraylist.New(1, 2, 3, 4).Begin()
+	it.NextN(2)
+
+	// Only the elements after the current position are searched
+	assert.False(t, BinarySearch(it, 1, utils.BasicComparator[int]))
+
+	index, _ := it.Index()
+	assert.Equal(t, 2, index)
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package caches provides maps with a limited capacity, which evict entries according to a replacement policy.
+//
+// LRU evicts the least recently used entry, LFU evicts the least frequently used entry.
+// The capacity limits the number of entries or, if a weigher is configured, their total weight.
+// Entries can optionally expire after a time to live, which is measured using an injectable clock.
+//
+// Both caches implement maps.Map, Get counts as an access to the entry, Peek does not.
+// Since Get modifies the cache and reading its keys or size drops expired entries, both caches implement maps.ModifyingReader,
+// so that concurrent.Map guards all of their accesses with its exclusive lock.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Cache_replacement_policies
+package caches
+
+import (
+	"time"
+)
+
+// Options configures a cache.
+type Options[TKey comparable, TValue any] struct {
+	// Capacity is the maximum total weight of the cache's entries, must be at least 1.
+	Capacity int
+	// Weigher returns the weight of an entry, every entry weighs 1 if it is nil.
+	Weigher func(key TKey, value TValue) int
+	// OnEvict is called for every entry, which is evicted because of the capacity or its expiration.
+	// It is not called for entries, which are removed or overwritten explicitly.
+	OnEvict func(key TKey, value TValue)
+	// TTL is the default time to live of entries, entries do not expire if it is zero.
+	TTL time.Duration
+	// Clock returns the current time, time.Now is used if it is nil.
+	Clock func() time.Time
+}
+
+// Stats holds the access statistics of a cache.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRate returns the ratio of hits to all accesses or 0 if the cache was not accessed yet.
+func (stats Stats) HitRate() float64 {
+	if stats.Hits+stats.Misses == 0 {
+		return 0
+	}
+
+	return float64(stats.Hits) / float64(stats.Hits+stats.Misses)
+}
+
+type entry[TValue any] struct {
+	value      TValue
+	weight     int
+	expiration time.Time
+}
+
+// base holds the state shared by all caches.
+type base[TKey comparable, TValue any] struct {
+	options Options[TKey, TValue]
+	stats   Stats
+	// weight is the total weight of all entries.
+	weight int
+	// expiring is the number of entries, which have an expiration time.
+	expiring int
+}
+
+func newBase[TKey comparable, TValue any](options Options[TKey, TValue]) base[TKey, TValue] {
+	if options.Capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+
+	return base[TKey, TValue]{options: options}
+}
+
+func (b *base[TKey, TValue]) now() time.Time {
+	if b.options.Clock == nil {
+		return time.Now()
+	}
+
+	return b.options.Clock()
+}
+
+func (b *base[TKey, TValue]) newEntry(key TKey, value TValue, ttl time.Duration) *entry[TValue] {
+	e := &entry[TValue]{value: value, weight: 1}
+
+	if b.options.Weigher != nil {
+		e.weight = b.options.Weigher(key, value)
+	}
+
+	if ttl > 0 {
+		e.expiration = b.now().Add(ttl)
+	}
+
+	return e
+}
+
+func (b *base[TKey, TValue]) isExpired(e *entry[TValue]) bool {
+	return !e.expiration.IsZero() && !b.now().Before(e.expiration)
+}
+
+// track accounts for an entry added to the cache.
+func (b *base[TKey, TValue]) track(e *entry[TValue]) {
+	b.weight += e.weight
+	if !e.expiration.IsZero() {
+		b.expiring++
+	}
+}
+
+// untrack accounts for an entry removed from the cache and notifies OnEvict if it was evicted.
+func (b *base[TKey, TValue]) untrack(key TKey, e *entry[TValue], evicted bool) {
+	b.weight -= e.weight
+	if !e.expiration.IsZero() {
+		b.expiring--
+	}
+
+	if evicted {
+		b.evicted(key, e)
+	}
+}
+
+// evicted records the eviction of an entry and notifies OnEvict.
+func (b *base[TKey, TValue]) evicted(key TKey, e *entry[TValue]) {
+	b.stats.Evictions++
+
+	if b.options.OnEvict != nil {
+		b.options.OnEvict(key, e.value)
+	}
+}
+
+// fits returns true if an entry of the given weight fits into the cache without evicting other entries.
+func (b *base[TKey, TValue]) fits(weight int) bool {
+	return b.weight+weight <= b.options.Capacity
+}
+
+// Stats returns the access statistics of the cache.
+func (b *base[TKey, TValue]) Stats() Stats {
+	return b.stats
+}
+
+// ResetStats resets the access statistics of the cache.
+func (b *base[TKey, TValue]) ResetStats() {
+	b.stats = Stats{}
+}
+
+// Capacity returns the maximum total weight of the cache's entries.
+func (b *base[TKey, TValue]) Capacity() int {
+	return b.options.Capacity
+}
+
+// Weight returns the total weight of the cache's entries.
+func (b *base[TKey, TValue]) Weight() int {
+	return b.weight
+}
+
+// ReadsModify returns true, since reading a cache records accesses and drops expired entries.
+func (b *base[TKey, TValue]) ReadsModify() bool {
+	return true
+}
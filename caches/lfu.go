@@ -0,0 +1,373 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package caches
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/maps"
+	"github.com/JonasMuehlmann/datastructures.go/maps/linkedhashmap"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Map implementation
+var _ maps.Map[string, any] = (*LFU[string, any])(nil)
+
+// Assert Cloneable implementation
+var _ ds.Cloneable[*LFU[string, any]] = (*LFU[string, any])(nil)
+
+// Assert ModifyingReader implementation
+var _ maps.ModifyingReader = (*LFU[string, any])(nil)
+
+type lfuEntry[TValue any] struct {
+	*entry[TValue]
+	frequency int
+}
+
+// LFU is a cache, which evicts the least frequently used entry first.
+// Ties between entries with equal frequency are broken by evicting the least recently used one.
+type LFU[TKey comparable, TValue any] struct {
+	base[TKey, TValue]
+	entries map[TKey]*lfuEntry[TValue]
+	// frequencies maps every frequency to its entries' keys, which are ordered from the least to the most recently used entry.
+	frequencies map[int]*linkedhashmap.Map[TKey, struct{}]
+	// minFrequency is a lower bound of the frequencies in use.
+	minFrequency int
+}
+
+// NewLFU instantiates a new LFU cache, which holds at most capacity entries.
+func NewLFU[TKey comparable, TValue any](capacity int) *LFU[TKey, TValue] {
+	return NewLFUWithOptions(Options[TKey, TValue]{Capacity: capacity})
+}
+
+// NewLFUWithOptions instantiates a new LFU cache configured by options.
+func NewLFUWithOptions[TKey comparable, TValue any](options Options[TKey, TValue]) *LFU[TKey, TValue] {
+	return &LFU[TKey, TValue]{
+		base:        newBase(options),
+		entries:     make(map[TKey]*lfuEntry[TValue]),
+		frequencies: make(map[int]*linkedhashmap.Map[TKey, struct{}]),
+	}
+}
+
+// Put inserts key-value pair into the cache, using the default time to live.
+// Overwriting an entry keeps its frequency, a new entry starts with a frequency of 1.
+// Evicts the least frequently used entries until the new entry fits.
+func (cache *LFU[TKey, TValue]) Put(key TKey, value TValue) {
+	cache.PutWithTTL(key, value, cache.options.TTL)
+}
+
+// PutWithTTL inserts key-value pair into the cache, which expires after ttl.
+// The entry does not expire if ttl is zero.
+// Overwriting an entry keeps its frequency, a new entry starts with a frequency of 1.
+// Evicts the least frequently used entries until the new entry fits.
+// If the entry is heavier than the capacity, it replaces the entry of key, but is evicted immediately.
+func (cache *LFU[TKey, TValue]) PutWithTTL(key TKey, value TValue, ttl time.Duration) {
+	e := &lfuEntry[TValue]{entry: cache.newEntry(key, value, ttl), frequency: 1}
+
+	if old, found := cache.entries[key]; found {
+		e.frequency = old.frequency
+		cache.remove(key, old, false)
+	}
+
+	if e.weight > cache.options.Capacity {
+		cache.evicted(key, e.entry)
+
+		return
+	}
+
+	for !cache.fits(e.weight) && len(cache.entries) > 0 {
+		cache.evictLeastFrequent()
+	}
+
+	cache.entries[key] = e
+	cache.track(e.entry)
+	cache.addToFrequency(key, e.frequency)
+}
+
+// Get searches the entry in the cache by key and returns its value, incrementing its frequency.
+// Second return parameter is true if key was found and has not expired, otherwise false.
+func (cache *LFU[TKey, TValue]) Get(key TKey) (value TValue, found bool) {
+	e, found := cache.lookup(key)
+	if !found {
+		cache.stats.Misses++
+
+		return
+	}
+
+	cache.stats.Hits++
+
+	cache.removeFromFrequency(key, e.frequency)
+	if _, found := cache.frequencies[e.frequency]; !found && e.frequency == cache.minFrequency {
+		cache.minFrequency++
+	}
+
+	e.frequency++
+	cache.addToFrequency(key, e.frequency)
+
+	return e.value, true
+}
+
+// Peek searches the entry in the cache by key and returns its value without incrementing its frequency.
+// Second return parameter is true if key was found and has not expired, otherwise false.
+// Does not affect the access statistics.
+func (cache *LFU[TKey, TValue]) Peek(key TKey) (value TValue, found bool) {
+	e, found := cache.entries[key]
+	if !found || cache.isExpired(e.entry) {
+		return value, false
+	}
+
+	return e.value, true
+}
+
+// Frequency returns the number of accesses to the entry of key, including its insertion.
+// Returns 0 if key was not found or has expired.
+func (cache *LFU[TKey, TValue]) Frequency(key TKey) int {
+	e, found := cache.entries[key]
+	if !found || cache.isExpired(e.entry) {
+		return 0
+	}
+
+	return e.frequency
+}
+
+// Remove removes the entry from the cache by key.
+func (cache *LFU[TKey, TValue]) Remove(comparator utils.Comparator[TKey], key TKey) {
+	if e, found := cache.entries[key]; found {
+		cache.remove(key, e, false)
+	}
+}
+
+// GetKeys returns all keys ordered from the least to the most frequently used entry.
+func (cache *LFU[TKey, TValue]) GetKeys() []TKey {
+	cache.purgeExpired()
+
+	keys := make([]TKey, 0, len(cache.entries))
+	for _, frequency := range cache.sortedFrequencies() {
+		keys = append(keys, cache.frequencies[frequency].GetKeys()...)
+	}
+
+	return keys
+}
+
+// GetValues returns all values ordered from the least to the most frequently used entry.
+func (cache *LFU[TKey, TValue]) GetValues() []TValue {
+	keys := cache.GetKeys()
+
+	values := make([]TValue, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, cache.entries[key].value)
+	}
+
+	return values
+}
+
+// MergeWith inserts all elements of other into the cache, which may evict entries.
+// If a key is contained in both the cache and other, the cache is left unchanged and false is returned.
+func (cache *LFU[TKey, TValue]) MergeWith(other *maps.Map[TKey, TValue]) bool {
+	keys := (*other).GetKeys()
+
+	for _, key := range keys {
+		if _, found := cache.Peek(key); found {
+			return false
+		}
+	}
+
+	for _, key := range keys {
+		value, _ := (*other).Get(key)
+		cache.Put(key, value)
+	}
+
+	return true
+}
+
+// MergeWithSafe inserts all elements of other into the cache, which may evict entries.
+// If a key is contained in both the cache and other, other's value is used if overwriteOriginal is true, otherwise the original value is kept.
+func (cache *LFU[TKey, TValue]) MergeWithSafe(other *maps.Map[TKey, TValue], overwriteOriginal bool) {
+	for _, key := range (*other).GetKeys() {
+		if _, found := cache.Peek(key); found && !overwriteOriginal {
+			continue
+		}
+
+		value, _ := (*other).Get(key)
+		cache.Put(key, value)
+	}
+}
+
+// Empty returns true if the cache does not contain any unexpired entries.
+func (cache *LFU[TKey, TValue]) IsEmpty() bool {
+	return cache.Size() == 0
+}
+
+// Size returns the number of unexpired entries in the cache.
+func (cache *LFU[TKey, TValue]) Size() int {
+	cache.purgeExpired()
+
+	return len(cache.entries)
+}
+
+// Clear removes all entries from the cache without evicting them.
+// The access statistics are kept.
+func (cache *LFU[TKey, TValue]) Clear() {
+	cache.entries = make(map[TKey]*lfuEntry[TValue])
+	cache.frequencies = make(map[int]*linkedhashmap.Map[TKey, struct{}])
+	cache.minFrequency = 0
+	cache.weight = 0
+	cache.expiring = 0
+}
+
+// Clone returns a copy of the cache with the same options, access statistics and frequencies.
+func (cache *LFU[TKey, TValue]) Clone() *LFU[TKey, TValue] {
+	clone := &LFU[TKey, TValue]{
+		base:         cache.base,
+		entries:      make(map[TKey]*lfuEntry[TValue], len(cache.entries)),
+		frequencies:  make(map[int]*linkedhashmap.Map[TKey, struct{}], len(cache.frequencies)),
+		minFrequency: cache.minFrequency,
+	}
+
+	for key, e := range cache.entries {
+		copied := *e.entry
+		clone.entries[key] = &lfuEntry[TValue]{entry: &copied, frequency: e.frequency}
+	}
+
+	for frequency, keys := range cache.frequencies {
+		clone.frequencies[frequency] = keys.Clone()
+	}
+
+	return clone
+}
+
+// String returns a string representation of container
+func (cache *LFU[TKey, TValue]) ToString() string {
+	str := "LFUCache\nmap["
+	for _, key := range cache.GetKeys() {
+		str += fmt.Sprintf("%v:%v ", key, cache.entries[key].value)
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// lookup returns the entry of key, removing it if it has expired.
+func (cache *LFU[TKey, TValue]) lookup(key TKey) (*lfuEntry[TValue], bool) {
+	e, found := cache.entries[key]
+	if !found {
+		return nil, false
+	}
+
+	if cache.isExpired(e.entry) {
+		cache.remove(key, e, true)
+
+		return nil, false
+	}
+
+	return e, true
+}
+
+func (cache *LFU[TKey, TValue]) remove(key TKey, e *lfuEntry[TValue], evicted bool) {
+	delete(cache.entries, key)
+	cache.removeFromFrequency(key, e.frequency)
+	cache.untrack(key, e.entry, evicted)
+}
+
+func (cache *LFU[TKey, TValue]) addToFrequency(key TKey, frequency int) {
+	keys, found := cache.frequencies[frequency]
+	if !found {
+		keys = linkedhashmap.New[TKey, struct{}]()
+		cache.frequencies[frequency] = keys
+	}
+
+	keys.Put(key, struct{}{})
+
+	if frequency < cache.minFrequency || cache.minFrequency == 0 {
+		cache.minFrequency = frequency
+	}
+}
+
+func (cache *LFU[TKey, TValue]) removeFromFrequency(key TKey, frequency int) {
+	keys := cache.frequencies[frequency]
+	keys.Remove(nil, key)
+
+	if keys.IsEmpty() {
+		delete(cache.frequencies, frequency)
+	}
+}
+
+func (cache *LFU[TKey, TValue]) evictLeastFrequent() {
+	keys, found := cache.frequencies[cache.minFrequency]
+	if !found {
+		cache.minFrequency = cache.sortedFrequencies()[0]
+		keys = cache.frequencies[cache.minFrequency]
+	}
+
+	it := keys.First()
+	key, _ := it.GetKey()
+
+	cache.remove(key, cache.entries[key], true)
+}
+
+// sortedFrequencies returns all frequencies in use in ascending order.
+func (cache *LFU[TKey, TValue]) sortedFrequencies() []int {
+	frequencies := make([]int, 0, len(cache.frequencies))
+	for frequency := range cache.frequencies {
+		frequencies = append(frequencies, frequency)
+	}
+
+	utils.Sort(frequencies, utils.BasicComparator[int])
+
+	return frequencies
+}
+
+// purgeExpired evicts all expired entries.
+func (cache *LFU[TKey, TValue]) purgeExpired() {
+	if cache.expiring == 0 {
+		return
+	}
+
+	for key := range cache.entries {
+		cache.lookup(key)
+	}
+}
+
+// All returns a sequence over the key/value pairs of the cache ordered from the least to the most frequently used entry.
+// Expired entries are purged and the order is determined once ranging starts,
+// ranging does not count as a use of the entries.
+func (cache *LFU[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		for _, key := range cache.GetKeys() {
+			e, found := cache.entries[key]
+			if !found {
+				continue
+			}
+
+			if !yield(key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns a sequence over the keys of the cache ordered from the least to the most frequently used entry.
+func (cache *LFU[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return func(yield func(TKey) bool) {
+		for key := range cache.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the cache ordered from the least to the most frequently used entry.
+func (cache *LFU[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		for _, value := range cache.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package caches
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLFU(capacity int, keys ...string) *LFU[string, int] {
+	cache := NewLFU[string, int](capacity)
+	for i, key := range keys {
+		cache.Put(key, i)
+	}
+
+	return cache
+}
+
+func TestLFUPut(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalCache *LFU[string, int]
+		accesses      []string
+		key           string
+		keys          []string
+	}{
+		{
+			name:          "empty cache",
+			originalCache: newLFU(2),
+			key:           "foo",
+			keys:          []string{"foo"},
+		},
+		{
+			name:          "full cache, equal frequencies",
+			originalCache: newLFU(2, "foo", "bar"),
+			key:           "baz",
+			keys:          []string{"bar", "baz"},
+		},
+		{
+			name:          "full cache, different frequencies",
+			originalCache: newLFU(2, "foo", "bar"),
+			accesses:      []string{"foo", "foo", "bar"},
+			key:           "baz",
+			keys:          []string{"baz", "foo"},
+		},
+		{
+			name:          "full cache, overwrite",
+			originalCache: newLFU(2, "foo", "bar"),
+			accesses:      []string{"foo"},
+			key:           "foo",
+			keys:          []string{"bar", "foo"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			for _, key := range test.accesses {
+				test.originalCache.Get(key)
+			}
+
+			test.originalCache.Put(test.key, 10)
+
+			assert.Equalf(t, test.keys, test.originalCache.GetKeys(), test.name)
+		})
+	}
+}
+
+func TestLFUGet(t *testing.T) {
+	cache := newLFU(3, "foo", "bar", "baz")
+
+	cache.Get("bar")
+	cache.Get("bar")
+	cache.Get("foo")
+	cache.Get("qux")
+
+	assert.Equal(t, []string{"baz", "foo", "bar"}, cache.GetKeys())
+	assert.Equal(t, []int{2, 0, 1}, cache.GetValues())
+	assert.Equal(t, 3, cache.Frequency("bar"))
+	assert.Equal(t, 0, cache.Frequency("qux"))
+	assert.Equal(t, Stats{Hits: 3, Misses: 1}, cache.Stats())
+	assert.Equal(t, 0.75, cache.Stats().HitRate())
+
+	cache.ResetStats()
+	assert.Equal(t, Stats{}, cache.Stats())
+	assert.Equal(t, 0.0, cache.Stats().HitRate())
+}
+
+func TestLFUPeek(t *testing.T) {
+	cache := newLFU(2, "foo", "bar")
+
+	value, found := cache.Peek("foo")
+	assert.True(t, found)
+	assert.Equal(t, 0, value)
+	assert.Equal(t, 1, cache.Frequency("foo"))
+
+	cache.Put("baz", 2)
+
+	_, found = cache.Peek("foo")
+	assert.False(t, found)
+	assert.Equal(t, Stats{Evictions: 1}, cache.Stats())
+}
+
+func TestLFUEvictionAfterRemove(t *testing.T) {
+	evicted := []string{}
+
+	cache := NewLFUWithOptions(Options[string, int]{
+		Capacity: 2,
+		OnEvict:  func(key string, value int) { evicted = append(evicted, key) },
+	})
+
+	cache.Put("foo", 1)
+	cache.Put("bar", 2)
+	cache.Get("bar")
+	cache.Get("foo")
+	cache.Get("foo")
+	cache.Remove(utils.BasicComparator[string], "bar")
+	cache.Put("baz", 3)
+	cache.Get("baz")
+	cache.Put("qux", 4)
+
+	assert.Equal(t, []string{"qux", "foo"}, cache.GetKeys())
+	assert.Equal(t, []string{"baz"}, evicted)
+}
+
+func TestLFUClone(t *testing.T) {
+	cache := newLFU(3, "foo", "bar", "baz")
+	cache.Get("foo")
+
+	clone := cache.Clone()
+	assert.Equal(t, []string{"bar", "baz", "foo"}, clone.GetKeys())
+	assert.Equal(t, 2, clone.Frequency("foo"))
+
+	clone.Get("bar")
+	clone.Get("bar")
+	clone.Put("baz", 10)
+	clone.Put("qux", 3)
+
+	assert.Equal(t, []string{"bar", "baz", "foo"}, cache.GetKeys())
+	assert.Equal(t, []int{1, 2, 0}, cache.GetValues())
+	assert.Equal(t, 1, cache.Frequency("bar"))
+	assert.Equal(t, []string{"qux", "foo", "bar"}, clone.GetKeys())
+}
+
+func TestLFUOversizedEntry(t *testing.T) {
+	cache := NewLFUWithOptions(Options[string, int]{
+		Capacity: 10,
+		Weigher:  func(key string, value int) int { return value },
+	})
+
+	cache.Put("a", 3)
+	cache.Put("b", 3)
+	cache.Put("huge", 100)
+
+	assert.ElementsMatch(t, []string{"a", "b"}, cache.GetKeys())
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, 6, cache.Weight())
+	assert.Equal(t, Stats{Evictions: 1}, cache.Stats())
+
+	// Replacing an entry with an oversized one removes the old entry as well
+	cache.Put("a", 100)
+
+	_, found := cache.Peek("a")
+	assert.False(t, found)
+	_, found = cache.Get("a")
+	assert.False(t, found)
+	assert.Equal(t, []string{"b"}, cache.GetKeys())
+	assert.Equal(t, 3, cache.Weight())
+	assert.Equal(t, Stats{Evictions: 2, Misses: 1}, cache.Stats())
+}
+
+func TestLFUTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	evicted := []string{}
+
+	cache := NewLFUWithOptions(Options[string, int]{
+		Capacity: 10,
+		Weigher:  func(key string, value int) int { return value },
+		TTL:      time.Minute,
+		Clock:    clock.Now,
+		OnEvict:  func(key string, value int) { evicted = append(evicted, key) },
+	})
+
+	cache.Put("foo", 1)
+	cache.PutWithTTL("bar", 2, 0)
+
+	clock.Advance(time.Minute)
+
+	assert.Equal(t, []string{"bar"}, cache.GetKeys())
+	assert.Equal(t, 2, cache.Weight())
+	assert.Equal(t, []string{"foo"}, evicted)
+
+	cache.Clear()
+
+	assert.True(t, cache.IsEmpty())
+	assert.Equal(t, 0, cache.Weight())
+}
+
+func TestLFUSequences(t *testing.T) {
+	cache := NewLFU[string, int](3)
+	cache.Put("c", 3)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("c")
+	cache.Get("c")
+	cache.Get("b")
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range cache.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(cache.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(cache.Values()))
+
+	keys = []string{}
+	for key := range cache.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
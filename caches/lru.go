@@ -0,0 +1,293 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package caches
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/maps"
+	"github.com/JonasMuehlmann/datastructures.go/maps/linkedhashmap"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Map implementation
+var _ maps.Map[string, any] = (*LRU[string, any])(nil)
+
+// Assert Cloneable implementation
+var _ ds.Cloneable[*LRU[string, any]] = (*LRU[string, any])(nil)
+
+// Assert ModifyingReader implementation
+var _ maps.ModifyingReader = (*LRU[string, any])(nil)
+
+// LRU is a cache, which evicts the least recently used entry first.
+type LRU[TKey comparable, TValue any] struct {
+	base[TKey, TValue]
+	// entries is ordered from the least to the most recently used entry.
+	entries *linkedhashmap.Map[TKey, *entry[TValue]]
+}
+
+// NewLRU instantiates a new LRU cache, which holds at most capacity entries.
+func NewLRU[TKey comparable, TValue any](capacity int) *LRU[TKey, TValue] {
+	return NewLRUWithOptions(Options[TKey, TValue]{Capacity: capacity})
+}
+
+// NewLRUWithOptions instantiates a new LRU cache configured by options.
+func NewLRUWithOptions[TKey comparable, TValue any](options Options[TKey, TValue]) *LRU[TKey, TValue] {
+	return &LRU[TKey, TValue]{base: newBase(options), entries: linkedhashmap.New[TKey, *entry[TValue]]()}
+}
+
+// Put inserts key-value pair into the cache as the most recently used entry, using the default time to live.
+// Evicts the least recently used entries until the new entry fits.
+func (cache *LRU[TKey, TValue]) Put(key TKey, value TValue) {
+	cache.PutWithTTL(key, value, cache.options.TTL)
+}
+
+// PutWithTTL inserts key-value pair into the cache as the most recently used entry, which expires after ttl.
+// The entry does not expire if ttl is zero.
+// Evicts the least recently used entries until the new entry fits.
+// If the entry is heavier than the capacity, it replaces the entry of key, but is evicted immediately.
+func (cache *LRU[TKey, TValue]) PutWithTTL(key TKey, value TValue, ttl time.Duration) {
+	if old, found := cache.entries.Get(key); found {
+		cache.remove(key, old, false)
+	}
+
+	e := cache.newEntry(key, value, ttl)
+	if e.weight > cache.options.Capacity {
+		cache.evicted(key, e)
+
+		return
+	}
+
+	for !cache.fits(e.weight) && !cache.entries.IsEmpty() {
+		cache.evictOldest()
+	}
+
+	cache.entries.Put(key, e)
+	cache.track(e)
+}
+
+// Get searches the entry in the cache by key and returns its value, marking it as the most recently used entry.
+// Second return parameter is true if key was found and has not expired, otherwise false.
+func (cache *LRU[TKey, TValue]) Get(key TKey) (value TValue, found bool) {
+	e, found := cache.lookup(key)
+	if !found {
+		cache.stats.Misses++
+
+		return
+	}
+
+	cache.stats.Hits++
+
+	cache.entries.MoveToBack(key)
+
+	return e.value, true
+}
+
+// Peek searches the entry in the cache by key and returns its value without marking it as used.
+// Second return parameter is true if key was found and has not expired, otherwise false.
+// Does not affect the access statistics.
+func (cache *LRU[TKey, TValue]) Peek(key TKey) (value TValue, found bool) {
+	e, found := cache.entries.Get(key)
+	if !found || cache.isExpired(e) {
+		return value, false
+	}
+
+	return e.value, true
+}
+
+// Remove removes the entry from the cache by key.
+func (cache *LRU[TKey, TValue]) Remove(comparator utils.Comparator[TKey], key TKey) {
+	if e, found := cache.entries.Get(key); found {
+		cache.remove(key, e, false)
+	}
+}
+
+// GetKeys returns all keys ordered from the least to the most recently used entry.
+func (cache *LRU[TKey, TValue]) GetKeys() []TKey {
+	cache.purgeExpired()
+
+	return cache.entries.GetKeys()
+}
+
+// GetValues returns all values ordered from the least to the most recently used entry.
+func (cache *LRU[TKey, TValue]) GetValues() []TValue {
+	cache.purgeExpired()
+
+	values := make([]TValue, 0, cache.entries.Size())
+	for _, e := range cache.entries.GetValues() {
+		values = append(values, e.value)
+	}
+
+	return values
+}
+
+// MergeWith inserts all elements of other into the cache, which may evict entries.
+// If a key is contained in both the cache and other, the cache is left unchanged and false is returned.
+func (cache *LRU[TKey, TValue]) MergeWith(other *maps.Map[TKey, TValue]) bool {
+	keys := (*other).GetKeys()
+
+	for _, key := range keys {
+		if _, found := cache.Peek(key); found {
+			return false
+		}
+	}
+
+	for _, key := range keys {
+		value, _ := (*other).Get(key)
+		cache.Put(key, value)
+	}
+
+	return true
+}
+
+// MergeWithSafe inserts all elements of other into the cache, which may evict entries.
+// If a key is contained in both the cache and other, other's value is used if overwriteOriginal is true, otherwise the original value is kept.
+func (cache *LRU[TKey, TValue]) MergeWithSafe(other *maps.Map[TKey, TValue], overwriteOriginal bool) {
+	for _, key := range (*other).GetKeys() {
+		if _, found := cache.Peek(key); found && !overwriteOriginal {
+			continue
+		}
+
+		value, _ := (*other).Get(key)
+		cache.Put(key, value)
+	}
+}
+
+// Empty returns true if the cache does not contain any unexpired entries.
+func (cache *LRU[TKey, TValue]) IsEmpty() bool {
+	return cache.Size() == 0
+}
+
+// Size returns the number of unexpired entries in the cache.
+func (cache *LRU[TKey, TValue]) Size() int {
+	cache.purgeExpired()
+
+	return cache.entries.Size()
+}
+
+// Clear removes all entries from the cache without evicting them.
+// The access statistics are kept.
+func (cache *LRU[TKey, TValue]) Clear() {
+	cache.entries.Clear()
+	cache.weight = 0
+	cache.expiring = 0
+}
+
+// Clone returns a copy of the cache with the same options, access statistics and order of use.
+func (cache *LRU[TKey, TValue]) Clone() *LRU[TKey, TValue] {
+	clone := &LRU[TKey, TValue]{base: cache.base, entries: linkedhashmap.New[TKey, *entry[TValue]]()}
+
+	for _, key := range cache.entries.GetKeys() {
+		e, _ := cache.entries.Get(key)
+		copied := *e
+		clone.entries.Put(key, &copied)
+	}
+
+	return clone
+}
+
+// String returns a string representation of container
+func (cache *LRU[TKey, TValue]) ToString() string {
+	str := "LRUCache\nmap["
+	for _, key := range cache.GetKeys() {
+		e, _ := cache.entries.Get(key)
+		str += fmt.Sprintf("%v:%v ", key, e.value)
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// lookup returns the entry of key, removing it if it has expired.
+func (cache *LRU[TKey, TValue]) lookup(key TKey) (*entry[TValue], bool) {
+	e, found := cache.entries.Get(key)
+	if !found {
+		return nil, false
+	}
+
+	if cache.isExpired(e) {
+		cache.remove(key, e, true)
+
+		return nil, false
+	}
+
+	return e, true
+}
+
+func (cache *LRU[TKey, TValue]) remove(key TKey, e *entry[TValue], evicted bool) {
+	cache.entries.Remove(nil, key)
+	cache.untrack(key, e, evicted)
+}
+
+func (cache *LRU[TKey, TValue]) evictOldest() {
+	it := cache.entries.First()
+	key, _ := it.GetKey()
+	e, _ := it.Get()
+
+	cache.remove(key, e, true)
+}
+
+// purgeExpired evicts all expired entries.
+func (cache *LRU[TKey, TValue]) purgeExpired() {
+	if cache.expiring == 0 {
+		return
+	}
+
+	for _, key := range cache.entries.GetKeys() {
+		cache.lookup(key)
+	}
+}
+
+// All returns a sequence over the key/value pairs of the cache ordered from the least to the most recently used entry.
+// Expired entries are purged once ranging starts, ranging does not count as a use of the entries.
+func (cache *LRU[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		cache.purgeExpired()
+
+		for key, e := range cache.entries.All() {
+			if !yield(key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence over the key/value pairs of the cache ordered from the most to the least recently used entry.
+// Expired entries are purged once ranging starts, ranging does not count as a use of the entries.
+func (cache *LRU[TKey, TValue]) Backward() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		cache.purgeExpired()
+
+		for key, e := range cache.entries.Backward() {
+			if !yield(key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns a sequence over the keys of the cache ordered from the least to the most recently used entry.
+func (cache *LRU[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return func(yield func(TKey) bool) {
+		for key := range cache.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the cache ordered from the least to the most recently used entry.
+func (cache *LRU[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		for _, value := range cache.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,335 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package caches
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/JonasMuehlmann/datastructures.go/maps"
+	"github.com/JonasMuehlmann/datastructures.go/maps/hashmap"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return clock.now
+}
+
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.now = clock.now.Add(d)
+}
+
+func newLRU(capacity int, keys ...string) *LRU[string, int] {
+	cache := NewLRU[string, int](capacity)
+	for i, key := range keys {
+		cache.Put(key, i)
+	}
+
+	return cache
+}
+
+func TestLRUPut(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalCache *LRU[string, int]
+		key           string
+		keys          []string
+		evictions     uint64
+	}{
+		{
+			name:          "empty cache",
+			originalCache: newLRU(2),
+			key:           "foo",
+			keys:          []string{"foo"},
+			evictions:     0,
+		},
+		{
+			name:          "full cache",
+			originalCache: newLRU(2, "foo", "bar"),
+			key:           "baz",
+			keys:          []string{"bar", "baz"},
+			evictions:     1,
+		},
+		{
+			name:          "full cache, overwrite",
+			originalCache: newLRU(2, "foo", "bar"),
+			key:           "foo",
+			keys:          []string{"bar", "foo"},
+			evictions:     0,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.originalCache.Put(test.key, 10)
+
+			assert.Equalf(t, test.keys, test.originalCache.GetKeys(), test.name)
+			assert.Equalf(t, test.evictions, test.originalCache.Stats().Evictions, test.name)
+		})
+	}
+}
+
+func TestLRUGet(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalCache *LRU[string, int]
+		key           string
+		value         int
+		found         bool
+		keys          []string
+	}{
+		{
+			name:          "empty cache",
+			originalCache: newLRU(2),
+			key:           "foo",
+			found:         false,
+			keys:          []string{},
+		},
+		{
+			name:          "least recently used",
+			originalCache: newLRU(3, "foo", "bar", "baz"),
+			key:           "foo",
+			value:         0,
+			found:         true,
+			keys:          []string{"bar", "baz", "foo"},
+		},
+		{
+			name:          "missing",
+			originalCache: newLRU(3, "foo", "bar", "baz"),
+			key:           "qux",
+			found:         false,
+			keys:          []string{"foo", "bar", "baz"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			value, found := test.originalCache.Get(test.key)
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+			assert.Equalf(t, test.keys, test.originalCache.GetKeys(), test.name)
+
+			if test.found {
+				assert.Equalf(t, Stats{Hits: 1}, test.originalCache.Stats(), test.name)
+			} else {
+				assert.Equalf(t, Stats{Misses: 1}, test.originalCache.Stats(), test.name)
+			}
+		})
+	}
+}
+
+func TestLRUPeek(t *testing.T) {
+	cache := newLRU(2, "foo", "bar")
+
+	value, found := cache.Peek("foo")
+	assert.True(t, found)
+	assert.Equal(t, 0, value)
+
+	cache.Put("baz", 2)
+
+	_, found = cache.Peek("foo")
+	assert.False(t, found)
+	assert.Equal(t, []string{"bar", "baz"}, cache.GetKeys())
+	assert.Equal(t, Stats{Evictions: 1}, cache.Stats())
+}
+
+func TestLRUWeigher(t *testing.T) {
+	evicted := []string{}
+
+	cache := NewLRUWithOptions(Options[string, int]{
+		Capacity: 10,
+		Weigher:  func(key string, value int) int { return value },
+		OnEvict:  func(key string, value int) { evicted = append(evicted, key) },
+	})
+
+	cache.Put("foo", 4)
+	cache.Put("bar", 4)
+	cache.Get("foo")
+	cache.Put("baz", 5)
+
+	assert.Equal(t, []string{"foo", "baz"}, cache.GetKeys())
+	assert.Equal(t, 9, cache.Weight())
+	assert.Equal(t, []string{"bar"}, evicted)
+
+	cache.Put("qux", 11)
+
+	assert.Equal(t, []string{"foo", "baz"}, cache.GetKeys())
+	assert.Equal(t, 9, cache.Weight())
+	assert.Equal(t, []string{"bar", "qux"}, evicted)
+}
+
+func TestLRUOversizedEntry(t *testing.T) {
+	cache := NewLRUWithOptions(Options[string, int]{
+		Capacity: 10,
+		Weigher:  func(key string, value int) int { return value },
+	})
+
+	cache.Put("a", 3)
+	cache.Put("b", 3)
+	cache.Put("huge", 100)
+
+	assert.Equal(t, []string{"a", "b"}, cache.GetKeys())
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, 6, cache.Weight())
+	assert.Equal(t, Stats{Evictions: 1}, cache.Stats())
+
+	// Replacing an entry with an oversized one removes the old entry as well
+	cache.Put("a", 100)
+
+	_, found := cache.Peek("a")
+	assert.False(t, found)
+	_, found = cache.Get("a")
+	assert.False(t, found)
+	assert.Equal(t, []string{"b"}, cache.GetKeys())
+	assert.Equal(t, 3, cache.Weight())
+	assert.Equal(t, Stats{Evictions: 2, Misses: 1}, cache.Stats())
+}
+
+func TestLRUTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	evicted := []string{}
+
+	cache := NewLRUWithOptions(Options[string, int]{
+		Capacity: 10,
+		TTL:      time.Minute,
+		Clock:    clock.Now,
+		OnEvict:  func(key string, value int) { evicted = append(evicted, key) },
+	})
+
+	cache.Put("foo", 1)
+	cache.PutWithTTL("bar", 2, time.Hour)
+	cache.PutWithTTL("baz", 3, 0)
+
+	clock.Advance(time.Minute)
+
+	_, found := cache.Get("foo")
+	assert.False(t, found)
+	assert.Equal(t, []string{"foo"}, evicted)
+
+	clock.Advance(time.Hour)
+
+	assert.Equal(t, 1, cache.Size())
+	assert.Equal(t, []string{"baz"}, cache.GetKeys())
+	assert.Equal(t, []string{"foo", "bar"}, evicted)
+	assert.Equal(t, Stats{Misses: 1, Evictions: 2}, cache.Stats())
+}
+
+func TestLRURemove(t *testing.T) {
+	evicted := []string{}
+
+	cache := NewLRUWithOptions(Options[string, int]{
+		Capacity: 2,
+		OnEvict:  func(key string, value int) { evicted = append(evicted, key) },
+	})
+
+	cache.Put("foo", 1)
+	cache.Put("bar", 2)
+	cache.Remove(utils.BasicComparator[string], "foo")
+	cache.Put("baz", 3)
+
+	assert.Equal(t, []string{"bar", "baz"}, cache.GetKeys())
+	assert.Equal(t, []int{2, 3}, cache.GetValues())
+	assert.Empty(t, evicted)
+
+	cache.Clear()
+
+	assert.True(t, cache.IsEmpty())
+	assert.Equal(t, 0, cache.Weight())
+	assert.Empty(t, evicted)
+}
+
+func TestLRUMergeWith(t *testing.T) {
+	cache := newLRU(3, "foo")
+
+	var other maps.Map[string, int] = hashmap.NewFromMap(map[string]int{"foo": 5, "bar": 6})
+
+	assert.False(t, cache.MergeWith(&other))
+
+	cache.MergeWithSafe(&other, false)
+
+	value, _ := cache.Peek("foo")
+	assert.Equal(t, 0, value)
+	assert.Equal(t, 2, cache.Size())
+
+	other = hashmap.NewFromMap(map[string]int{"baz": 7})
+
+	assert.True(t, cache.MergeWith(&other))
+	assert.Equal(t, 3, cache.Size())
+}
+
+func TestLRUClone(t *testing.T) {
+	cache := newLRU(3, "foo", "bar", "baz")
+	cache.Get("foo")
+
+	clone := cache.Clone()
+	assert.Equal(t, []string{"bar", "baz", "foo"}, clone.GetKeys())
+	assert.Equal(t, cache.Stats(), clone.Stats())
+
+	clone.Put("bar", 10)
+	clone.Put("qux", 3)
+
+	assert.Equal(t, []string{"bar", "baz", "foo"}, cache.GetKeys())
+	assert.Equal(t, []int{1, 2, 0}, cache.GetValues())
+	assert.Equal(t, []string{"foo", "bar", "qux"}, clone.GetKeys())
+	assert.Equal(t, 3, cache.Weight())
+}
+
+func TestLRUSequences(t *testing.T) {
+	cache := NewLRU[string, int](3)
+	cache.Put("c", 3)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("c")
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range cache.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	keys = []string{}
+	values = []int{}
+	for key, value := range cache.Backward() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"c", "b", "a"}, keys)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(cache.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(cache.Values()))
+
+	keys = []string{}
+	for key := range cache.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
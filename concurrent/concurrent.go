@@ -0,0 +1,16 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package concurrent provides thread safe wrappers around the List, Map, Set, Queue and Stack interfaces.
+//
+// Every wrapper guards the wrapped container with a sync.RWMutex, read-only methods acquire the read lock, all other methods acquire the write lock.
+// Compound operations like Map.GetOrPut or List.Update run atomically under a single write lock,
+// Do allows running arbitrary compound operations on the wrapped container while holding the lock.
+//
+// Iterators of the wrapped containers are not exposed, since they would access the container without holding the lock.
+// Instead, Snapshot returns an iterator over a copy of the container's elements, which does not hold the lock while the caller iterates.
+// Clone returns a new wrapper around a copy of the wrapped container, the constructors therefore require it to implement ds.Cloneable.
+//
+// The wrapped container must not be accessed directly after wrapping it.
+package concurrent
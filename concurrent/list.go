@@ -0,0 +1,238 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/lists"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert List implementation
+var _ lists.List[any] = (*List[any])(nil)
+
+// Assert Cloneable implementation
+var _ ds.Cloneable[*List[any]] = (*List[any])(nil)
+
+// List wraps a list and guards all accesses to it with a read-write mutex.
+type List[T any] struct {
+	mutex sync.RWMutex
+	list  lists.List[T]
+	// clone copies the wrapped list, whose type is only known when wrapping it.
+	clone func(list lists.List[T]) lists.List[T]
+}
+
+// NewList instantiates a new thread safe list wrapping list.
+// list must not be accessed directly afterwards.
+func NewList[T any, TList interface {
+	lists.List[T]
+	ds.Cloneable[TList]
+}](list TList) *List[T] {
+	return &List[T]{list: list, clone: func(list lists.List[T]) lists.List[T] { return list.(TList).Clone() }}
+}
+
+// Get returns the element at index.
+// Second return parameter is true if index is within bounds of the list, otherwise false.
+func (list *List[T]) Get(index int) (T, bool) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.Get(index)
+}
+
+// Remove removes the element at the given index from the list.
+func (list *List[T]) Remove(index int) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Remove(index)
+}
+
+// Contains checks if all values are present in the list.
+func (list *List[T]) Contains(comparator utils.Comparator[T], values ...T) bool {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.Contains(comparator, values...)
+}
+
+// Sort sorts the list's elements in-place using the comparator.
+func (list *List[T]) Sort(comparator utils.Comparator[T]) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Sort(comparator)
+}
+
+// Swap swaps the elements at the given indices.
+func (list *List[T]) Swap(index1, index2 int) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Swap(index1, index2)
+}
+
+// Insert inserts values at index, shifting the element at index and all following elements to the right.
+func (list *List[T]) Insert(index int, values ...T) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Insert(index, values...)
+}
+
+// Set overwrites the element at index.
+func (list *List[T]) Set(index int, value T) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Set(index, value)
+}
+
+// PushBack appends values to the end of the list.
+func (list *List[T]) PushBack(values ...T) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.PushBack(values...)
+}
+
+// PushFront prepends values to the start of the list.
+func (list *List[T]) PushFront(values ...T) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.PushFront(values...)
+}
+
+// PopBack removes the last n elements of the list and returns them.
+func (list *List[T]) PopBack(n int) []T {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	return list.list.PopBack(n)
+}
+
+// PopFront removes the first n elements of the list and returns them.
+func (list *List[T]) PopFront(n int) []T {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	return list.list.PopFront(n)
+}
+
+// Empty returns true if list does not contain any elements.
+func (list *List[T]) IsEmpty() bool {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.IsEmpty()
+}
+
+// Size returns number of elements within the list.
+func (list *List[T]) Size() int {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.Size()
+}
+
+// Clear removes all elements from the list.
+func (list *List[T]) Clear() {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.list.Clear()
+}
+
+// Clone returns a new thread safe list wrapping a copy of the wrapped list, which is taken under the read lock.
+func (list *List[T]) Clone() *List[T] {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return &List[T]{list: list.clone(list.list), clone: list.clone}
+}
+
+// GetValues returns all elements in the list.
+func (list *List[T]) GetValues() []T {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return list.list.GetValues()
+}
+
+// ToString returns a string representation of the wrapped list.
+func (list *List[T]) ToString() string {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	return "Concurrent" + list.list.ToString()
+}
+
+// CompareAndSwap replaces the element at index with new, if it is equal to old.
+// Returns true if the element was swapped, otherwise false.
+func (list *List[T]) CompareAndSwap(comparator utils.Comparator[T], index int, old T, new T) bool {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	value, found := list.list.Get(index)
+	if !found || comparator(value, old) != 0 {
+		return false
+	}
+
+	list.list.Set(index, new)
+
+	return true
+}
+
+// Update replaces the element at index with the value returned by update.
+// Returns false if index is out of bounds, otherwise true.
+// update is called while holding the lock and must not access the list.
+func (list *List[T]) Update(index int, update func(value T) T) bool {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	value, found := list.list.Get(index)
+	if !found {
+		return false
+	}
+
+	list.list.Set(index, update(value))
+
+	return true
+}
+
+// Do calls f with the wrapped list while holding the lock, making arbitrary compound operations atomic.
+// f must not retain the wrapped list.
+func (list *List[T]) Do(f func(list lists.List[T])) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	f(list.list)
+}
+
+// Snapshot returns an iterator over a copy of the list's elements, which points to one element before it's first.
+// The lock is only held while copying the elements.
+func (list *List[T]) Snapshot() *SnapshotIterator[int, T] {
+	return newIndexedSnapshotIterator(list.GetValues())
+}
+
+// All returns a sequence over the index/value pairs of a snapshot of the list, which is taken once ranging starts.
+// The lock is not held while yielding.
+func (list *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		ds.Seq2(list.Snapshot())(yield)
+	}
+}
+
+// Values returns a sequence over the values of a snapshot of the list, which is taken once ranging starts.
+// The lock is not held while yielding.
+func (list *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(list.Snapshot())(yield)
+	}
+}
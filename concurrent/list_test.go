@@ -0,0 +1,170 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/lists"
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentListCompareAndSwap(t *testing.T) {
+	tests := []struct {
+		name         string
+		originalList *List[string]
+		index        int
+		old          string
+		swapped      bool
+		newItems     []string
+	}{
+		{
+			name:         "empty list",
+			originalList: NewList[string](arraylist.New[string]()),
+			index:        0,
+			old:          "foo",
+			swapped:      false,
+			newItems:     []string{},
+		},
+		{
+			name:         "value differs",
+			originalList: NewList[string](arraylist.New("foo", "bar")),
+			index:        1,
+			old:          "foo",
+			swapped:      false,
+			newItems:     []string{"foo", "bar"},
+		},
+		{
+			name:         "value equal",
+			originalList: NewList[string](arraylist.New("foo", "bar")),
+			index:        1,
+			old:          "bar",
+			swapped:      true,
+			newItems:     []string{"foo", "baz"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			swapped := test.originalList.CompareAndSwap(utils.BasicComparator[string], test.index, test.old, "baz")
+
+			assert.Equalf(t, test.swapped, swapped, test.name)
+			assert.Equalf(t, test.newItems, test.originalList.GetValues(), test.name)
+		})
+	}
+}
+
+func TestConcurrentListUpdate(t *testing.T) {
+	list := NewList[int](arraylist.New(0, 0))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				list.Update(1, func(value int) int {
+					return value + 1
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, []int{0, 10000}, list.GetValues())
+	assert.False(t, list.Update(2, func(value int) int { return value }))
+}
+
+func TestConcurrentListDo(t *testing.T) {
+	list := NewList[int](arraylist.New[int]())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			list.Do(func(list lists.List[int]) {
+				list.PushBack(list.Size())
+			})
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < 100; i++ {
+		value, _ := list.Get(i)
+		assert.Equal(t, i, value)
+	}
+}
+
+func TestConcurrentListSnapshot(t *testing.T) {
+	list := NewList[string](arraylist.New("foo", "bar"))
+
+	it := list.Snapshot()
+	list.PushBack("baz")
+	list.Set(0, "qux")
+
+	assert.Equal(t, 2, it.Size())
+
+	values := []string{}
+	for it.Next() {
+		value, _ := it.Get()
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"foo", "bar"}, values)
+	assert.True(t, it.IsEnd())
+
+	assert.True(t, it.Previous())
+	index, _ := it.Index()
+	assert.Equal(t, 1, index)
+}
+
+func TestConcurrentListSequences(t *testing.T) {
+	list := NewList[int](arraylist.New[int](1, 2, 3))
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range list.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(list.Values()))
+
+	values = []int{}
+	for value := range list.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
+
+func TestConcurrentListClone(t *testing.T) {
+	list := NewList[int](arraylist.New(1, 2, 3))
+
+	clone := list.Clone()
+	clone.Set(0, 10)
+	clone.PushBack(4)
+
+	assert.Equal(t, []int{1, 2, 3}, list.GetValues())
+	assert.Equal(t, []int{10, 2, 3, 4}, clone.GetValues())
+}
@@ -0,0 +1,293 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/maps"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Map implementation
+var _ maps.Map[string, any] = (*Map[string, any])(nil)
+
+// Assert Cloneable implementation
+var _ ds.Cloneable[*Map[string, any]] = (*Map[string, any])(nil)
+
+// Map wraps a map and guards all accesses to it with a read-write mutex.
+// If the wrapped map implements maps.ModifyingReader and its reads modify it, reads take the exclusive lock as well.
+type Map[TKey any, TValue any] struct {
+	mutex sync.RWMutex
+	m     maps.Map[TKey, TValue]
+	// exclusiveReads is true if reading the wrapped map modifies it.
+	exclusiveReads bool
+	// clone copies the wrapped map, whose type is only known when wrapping it.
+	clone func(m maps.Map[TKey, TValue]) maps.Map[TKey, TValue]
+}
+
+// NewMap instantiates a new thread safe map wrapping m.
+// m must not be accessed directly afterwards.
+func NewMap[TKey any, TValue any, TMap interface {
+	maps.Map[TKey, TValue]
+	ds.Cloneable[TMap]
+}](m TMap) *Map[TKey, TValue] {
+	return &Map[TKey, TValue]{
+		m:              m,
+		exclusiveReads: readsModify(m),
+		clone:          func(m maps.Map[TKey, TValue]) maps.Map[TKey, TValue] { return m.(TMap).Clone() },
+	}
+}
+
+func readsModify[TKey any, TValue any](m maps.Map[TKey, TValue]) bool {
+	reader, ok := m.(maps.ModifyingReader)
+
+	return ok && reader.ReadsModify()
+}
+
+// readLock locks the mutex for reading the wrapped map, exclusively if reading modifies it.
+func (m *Map[TKey, TValue]) readLock() {
+	if m.exclusiveReads {
+		m.mutex.Lock()
+	} else {
+		m.mutex.RLock()
+	}
+}
+
+func (m *Map[TKey, TValue]) readUnlock() {
+	if m.exclusiveReads {
+		m.mutex.Unlock()
+	} else {
+		m.mutex.RUnlock()
+	}
+}
+
+// Put inserts key-value pair into the map.
+func (m *Map[TKey, TValue]) Put(key TKey, value TValue) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.m.Put(key, value)
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[TKey, TValue]) Get(key TKey) (value TValue, found bool) {
+	m.readLock()
+	defer m.readUnlock()
+
+	return m.m.Get(key)
+}
+
+// Remove removes the element from the map by key.
+func (m *Map[TKey, TValue]) Remove(comparator utils.Comparator[TKey], key TKey) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.m.Remove(comparator, key)
+}
+
+// GetKeys returns all keys of the wrapped map.
+func (m *Map[TKey, TValue]) GetKeys() []TKey {
+	m.readLock()
+	defer m.readUnlock()
+
+	return m.m.GetKeys()
+}
+
+// MergeWith inserts all elements of other into the map, following the semantics of the wrapped map.
+func (m *Map[TKey, TValue]) MergeWith(other *maps.Map[TKey, TValue]) bool {
+	snapshot := snapshotMap(*other)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.m.MergeWith(&snapshot)
+}
+
+// MergeWithSafe inserts all elements of other into the map, following the semantics of the wrapped map.
+func (m *Map[TKey, TValue]) MergeWithSafe(other *maps.Map[TKey, TValue], overwriteOriginal bool) {
+	snapshot := snapshotMap(*other)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.m.MergeWithSafe(&snapshot, overwriteOriginal)
+}
+
+// snapshotMap returns a copy of other if it is a thread safe map, otherwise other itself.
+// Merges read other through the copy, so the locks of two maps are never held at once, which could deadlock.
+func snapshotMap[TKey any, TValue any](other maps.Map[TKey, TValue]) maps.Map[TKey, TValue] {
+	if otherThis, ok := other.(*Map[TKey, TValue]); ok {
+		return otherThis.Clone().m
+	}
+
+	return other
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[TKey, TValue]) IsEmpty() bool {
+	m.readLock()
+	defer m.readUnlock()
+
+	return m.m.IsEmpty()
+}
+
+// Size returns number of elements in the map.
+func (m *Map[TKey, TValue]) Size() int {
+	m.readLock()
+	defer m.readUnlock()
+
+	return m.m.Size()
+}
+
+// Clear removes all elements from the map.
+func (m *Map[TKey, TValue]) Clear() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.m.Clear()
+}
+
+// Clone returns a new thread safe map wrapping a copy of the wrapped map, which is taken under the lock used for reading.
+func (m *Map[TKey, TValue]) Clone() *Map[TKey, TValue] {
+	m.readLock()
+	defer m.readUnlock()
+
+	return &Map[TKey, TValue]{m: m.clone(m.m), exclusiveReads: m.exclusiveReads, clone: m.clone}
+}
+
+// GetValues returns all values of the wrapped map.
+func (m *Map[TKey, TValue]) GetValues() []TValue {
+	m.readLock()
+	defer m.readUnlock()
+
+	return m.m.GetValues()
+}
+
+// ToString returns a string representation of the wrapped map.
+func (m *Map[TKey, TValue]) ToString() string {
+	m.readLock()
+	defer m.readUnlock()
+
+	return "Concurrent" + m.m.ToString()
+}
+
+// GetOrPut returns the value of key if it is present.
+// Otherwise it inserts value and returns it.
+// Second return parameter is true if the value was already present, otherwise false.
+func (m *Map[TKey, TValue]) GetOrPut(key TKey, value TValue) (actual TValue, loaded bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	actual, loaded = m.m.Get(key)
+	if !loaded {
+		m.m.Put(key, value)
+		actual = value
+	}
+
+	return
+}
+
+// ComputeIfAbsent returns the value of key if it is present.
+// Otherwise it inserts the value returned by compute and returns it.
+// compute is called while holding the lock and must not access the map.
+func (m *Map[TKey, TValue]) ComputeIfAbsent(key TKey, compute func(key TKey) TValue) TValue {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	value, found := m.m.Get(key)
+	if !found {
+		value = compute(key)
+		m.m.Put(key, value)
+	}
+
+	return value
+}
+
+// CompareAndSwap replaces the value of key with new, if key is present and its value is equal to old.
+// Returns true if the value was swapped, otherwise false.
+func (m *Map[TKey, TValue]) CompareAndSwap(comparator utils.Comparator[TValue], key TKey, old TValue, new TValue) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	value, found := m.m.Get(key)
+	if !found || comparator(value, old) != 0 {
+		return false
+	}
+
+	m.m.Put(key, new)
+
+	return true
+}
+
+// Update replaces the value of key with the value returned by update and returns it.
+// update receives the current value and whether key is present.
+// update is called while holding the lock and must not access the map.
+func (m *Map[TKey, TValue]) Update(key TKey, update func(value TValue, found bool) TValue) TValue {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	value := update(m.m.Get(key))
+	m.m.Put(key, value)
+
+	return value
+}
+
+// Do calls f with the wrapped map while holding the lock, making arbitrary compound operations atomic.
+// f must not retain the wrapped map.
+func (m *Map[TKey, TValue]) Do(f func(m maps.Map[TKey, TValue])) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	f(m.m)
+}
+
+// Snapshot returns an iterator over a copy of the map's elements, which points to one element before it's first.
+// The lock is only held while copying the elements.
+func (m *Map[TKey, TValue]) Snapshot() *SnapshotIterator[TKey, TValue] {
+	m.readLock()
+	defer m.readUnlock()
+
+	keys := m.m.GetKeys()
+	values := make([]TValue, 0, len(keys))
+
+	for _, key := range keys {
+		value, _ := m.m.Get(key)
+		values = append(values, value)
+	}
+
+	return newSnapshotIterator(keys, values)
+}
+
+// All returns a sequence over the key/value pairs of a snapshot of the map, which is taken once ranging starts.
+// The lock is not held while yielding.
+func (m *Map[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.Seq2(m.Snapshot())(yield)
+	}
+}
+
+// Keys returns a sequence over the keys of a snapshot of the map, which is taken once ranging starts.
+// The lock is not held while yielding.
+func (m *Map[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return func(yield func(TKey) bool) {
+		for key := range m.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of a snapshot of the map, which is taken once ranging starts.
+// The lock is not held while yielding.
+func (m *Map[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		ds.Seq(m.Snapshot())(yield)
+	}
+}
@@ -0,0 +1,322 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JonasMuehlmann/datastructures.go/caches"
+	"github.com/JonasMuehlmann/datastructures.go/maps"
+	"github.com/JonasMuehlmann/datastructures.go/maps/hashmap"
+	"github.com/JonasMuehlmann/datastructures.go/maps/treemap"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentMapGetOrPut(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Map[string, int]
+		key         string
+		value       int
+		actual      int
+		loaded      bool
+	}{
+		{
+			name:        "empty map",
+			originalMap: NewMap[string, int](hashmap.New[string, int]()),
+			key:         "foo",
+			value:       1,
+			actual:      1,
+			loaded:      false,
+		},
+		{
+			name:        "key present",
+			originalMap: NewMap[string, int](hashmap.NewFromMap(map[string]int{"foo": 2})),
+			key:         "foo",
+			value:       1,
+			actual:      2,
+			loaded:      true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			actual, loaded := test.originalMap.GetOrPut(test.key, test.value)
+
+			assert.Equalf(t, test.actual, actual, test.name)
+			assert.Equalf(t, test.loaded, loaded, test.name)
+
+			value, found := test.originalMap.Get(test.key)
+			assert.Truef(t, found, test.name)
+			assert.Equalf(t, test.actual, value, test.name)
+		})
+	}
+}
+
+func TestConcurrentMapComputeIfAbsent(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Map[string, int]
+		key         string
+		value       int
+		computed    bool
+	}{
+		{
+			name:        "empty map",
+			originalMap: NewMap[string, int](hashmap.New[string, int]()),
+			key:         "foo",
+			value:       3,
+			computed:    true,
+		},
+		{
+			name:        "key present",
+			originalMap: NewMap[string, int](hashmap.NewFromMap(map[string]int{"foo": 2})),
+			key:         "foo",
+			value:       2,
+			computed:    false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			computed := false
+			value := test.originalMap.ComputeIfAbsent(test.key, func(key string) int {
+				computed = true
+
+				return len(key)
+			})
+
+			assert.Equalf(t, test.value, value, test.name)
+			assert.Equalf(t, test.computed, computed, test.name)
+		})
+	}
+}
+
+func TestConcurrentMapCompareAndSwap(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Map[string, int]
+		key         string
+		old         int
+		swapped     bool
+		newItems    map[string]int
+	}{
+		{
+			name:        "empty map",
+			originalMap: NewMap[string, int](hashmap.New[string, int]()),
+			key:         "foo",
+			old:         0,
+			swapped:     false,
+			newItems:    map[string]int{},
+		},
+		{
+			name:        "value differs",
+			originalMap: NewMap[string, int](hashmap.NewFromMap(map[string]int{"foo": 2})),
+			key:         "foo",
+			old:         1,
+			swapped:     false,
+			newItems:    map[string]int{"foo": 2},
+		},
+		{
+			name:        "value equal",
+			originalMap: NewMap[string, int](hashmap.NewFromMap(map[string]int{"foo": 2})),
+			key:         "foo",
+			old:         2,
+			swapped:     true,
+			newItems:    map[string]int{"foo": 10},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			swapped := test.originalMap.CompareAndSwap(utils.BasicComparator[int], test.key, test.old, 10)
+
+			assert.Equalf(t, test.swapped, swapped, test.name)
+			test.originalMap.Do(func(m maps.Map[string, int]) {
+				assert.Equalf(t, test.newItems, m.(*hashmap.Map[string, int]).GetMap(), test.name)
+			})
+		})
+	}
+}
+
+func TestConcurrentMapUpdate(t *testing.T) {
+	m := NewMap[string, int](treemap.New[string, int](utils.BasicComparator[string]))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				m.Update("foo", func(value int, found bool) int {
+					return value + 1
+				})
+				m.GetOrPut("bar", j)
+				m.Snapshot()
+			}
+		}()
+	}
+	wg.Wait()
+
+	value, _ := m.Get("foo")
+	assert.Equal(t, 10000, value)
+	assert.Equal(t, 2, m.Size())
+}
+
+func TestConcurrentMapMergeWithSelf(t *testing.T) {
+	m := NewMap[string, int](hashmap.NewFromMap(map[string]int{"foo": 1}))
+
+	var other maps.Map[string, int] = m
+
+	assert.False(t, m.MergeWith(&other))
+	m.MergeWithSafe(&other, true)
+
+	assert.Equal(t, []string{"foo"}, m.GetKeys())
+}
+
+func TestConcurrentMapMergeWithDoesNotDeadlock(t *testing.T) {
+	a := NewMap[string, int](hashmap.NewFromMap(map[string]int{"foo": 1}))
+	b := NewMap[string, int](hashmap.NewFromMap(map[string]int{"bar": 2}))
+
+	var other maps.Map[string, int] = a
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		a.Do(func(maps.Map[string, int]) {
+			go b.MergeWithSafe(&other, false)
+
+			// Let the merge wait for a's lock, it must not hold b's lock meanwhile
+			time.Sleep(10 * time.Millisecond)
+			b.Put("baz", 3)
+		})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("deadlock")
+	}
+
+	value, _ := b.Get("baz")
+	assert.Equal(t, 3, value)
+}
+
+// Run with -race, reading a cache modifies it, so concurrent reads must be serialized.
+func TestConcurrentMapWrappingCache(t *testing.T) {
+	cache := caches.NewLRU[string, int](10)
+	m := NewMap[string, int](cache)
+	m.Put("foo", 1)
+	m.Put("bar", 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				m.Get("foo")
+				m.Get("baz")
+				m.Size()
+				m.GetKeys()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, caches.Stats{Hits: 800, Misses: 800}, cache.Stats())
+	// Get marked foo as the most recently used entry
+	assert.Equal(t, []string{"bar", "foo"}, m.GetKeys())
+	assert.Equal(t, 2, m.Clone().Size())
+}
+
+func TestConcurrentMapSnapshot(t *testing.T) {
+	m := NewMap[string, int](treemap.NewFromMap(utils.BasicComparator[string], map[string]int{"bar": 2, "foo": 1}))
+
+	it := m.Snapshot()
+	m.Put("baz", 3)
+	m.Remove(utils.BasicComparator[string], "foo")
+
+	keys := []string{}
+	values := []int{}
+	for it.Next() {
+		key, _ := it.GetKey()
+		value, _ := it.Get()
+
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"bar", "foo"}, keys)
+	assert.Equal(t, []int{2, 1}, values)
+	assert.Equal(t, []string{"bar", "baz"}, m.GetKeys())
+}
+
+func TestConcurrentMapSequences(t *testing.T) {
+	m := NewMap[string, int](treemap.New[string, int](utils.BasicComparator[string]))
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range m.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(m.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(m.Values()))
+
+	keys = []string{}
+	for key := range m.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
+
+func TestConcurrentMapClone(t *testing.T) {
+	m := NewMap[string, int](treemap.New[string, int](utils.BasicComparator[string]))
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	clone := m.Clone()
+	clone.Put("a", 10)
+	clone.Remove(utils.BasicComparator[string], "b")
+
+	value, _ := m.Get("a")
+	assert.Equal(t, 1, value)
+	assert.Equal(t, []string{"a", "b"}, m.GetKeys())
+	assert.Equal(t, []string{"a"}, clone.GetKeys())
+}
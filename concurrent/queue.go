@@ -0,0 +1,141 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/queues"
+)
+
+// Assert Queue implementation
+var _ queues.Queue[any] = (*Queue[any])(nil)
+
+// Assert Cloneable implementation
+var _ ds.Cloneable[*Queue[any]] = (*Queue[any])(nil)
+
+// Queue wraps a queue and guards all accesses to it with a read-write mutex.
+type Queue[T any] struct {
+	mutex sync.RWMutex
+	queue queues.Queue[T]
+	// clone copies the wrapped queue, whose type is only known when wrapping it.
+	clone func(queue queues.Queue[T]) queues.Queue[T]
+}
+
+// NewQueue instantiates a new thread safe queue wrapping queue.
+// queue must not be accessed directly afterwards.
+func NewQueue[T any, TQueue interface {
+	queues.Queue[T]
+	ds.Cloneable[TQueue]
+}](queue TQueue) *Queue[T] {
+	return &Queue[T]{queue: queue, clone: func(queue queues.Queue[T]) queues.Queue[T] { return queue.(TQueue).Clone() }}
+}
+
+// Enqueue adds a value to the end of the queue.
+func (queue *Queue[T]) Enqueue(value T) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	queue.queue.Enqueue(value)
+}
+
+// Dequeue removes first element of the queue and returns it.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue[T]) Dequeue() (value T, ok bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.queue.Dequeue()
+}
+
+// Peek returns first element of the queue without removing it.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) Peek() (value T, ok bool) {
+	queue.mutex.RLock()
+	defer queue.mutex.RUnlock()
+
+	return queue.queue.Peek()
+}
+
+// Empty returns true if queue does not contain any elements.
+func (queue *Queue[T]) IsEmpty() bool {
+	queue.mutex.RLock()
+	defer queue.mutex.RUnlock()
+
+	return queue.queue.IsEmpty()
+}
+
+// Size returns number of elements within the queue.
+func (queue *Queue[T]) Size() int {
+	queue.mutex.RLock()
+	defer queue.mutex.RUnlock()
+
+	return queue.queue.Size()
+}
+
+// Clear removes all elements from the queue.
+func (queue *Queue[T]) Clear() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	queue.queue.Clear()
+}
+
+// Clone returns a new thread safe queue wrapping a copy of the wrapped queue, which is taken under the read lock.
+func (queue *Queue[T]) Clone() *Queue[T] {
+	queue.mutex.RLock()
+	defer queue.mutex.RUnlock()
+
+	return &Queue[T]{queue: queue.clone(queue.queue), clone: queue.clone}
+}
+
+// GetValues returns all elements in the queue.
+func (queue *Queue[T]) GetValues() []T {
+	queue.mutex.RLock()
+	defer queue.mutex.RUnlock()
+
+	return queue.queue.GetValues()
+}
+
+// ToString returns a string representation of the wrapped queue.
+func (queue *Queue[T]) ToString() string {
+	queue.mutex.RLock()
+	defer queue.mutex.RUnlock()
+
+	return "Concurrent" + queue.queue.ToString()
+}
+
+// Do calls f with the wrapped queue while holding the lock, making arbitrary compound operations atomic.
+// f must not retain the wrapped queue.
+func (queue *Queue[T]) Do(f func(queue queues.Queue[T])) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	f(queue.queue)
+}
+
+// Snapshot returns an iterator over a copy of the queue's elements, which points to one element before it's first.
+// The lock is only held while copying the elements.
+func (queue *Queue[T]) Snapshot() *SnapshotIterator[int, T] {
+	return newIndexedSnapshotIterator(queue.GetValues())
+}
+
+// All returns a sequence over the index/value pairs of a snapshot of the queue, which is taken once ranging starts.
+// The lock is not held while yielding.
+func (queue *Queue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		ds.Seq2(queue.Snapshot())(yield)
+	}
+}
+
+// Values returns a sequence over the values of a snapshot of the queue, which is taken once ranging starts.
+// The lock is not held while yielding.
+func (queue *Queue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(queue.Snapshot())(yield)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/queues/arrayqueue"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentQueue(t *testing.T) {
+	queue := NewQueue[int](arrayqueue.New[int]())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			queue.Enqueue(i)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 100, queue.Size())
+
+	dequeued := make([]bool, 100)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			value, ok := queue.Dequeue()
+			assert.True(t, ok)
+
+			dequeued[value] = true
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, queue.IsEmpty())
+	assert.NotContains(t, dequeued, false)
+}
+
+func TestConcurrentQueueSequences(t *testing.T) {
+	queue := NewQueue[int](arrayqueue.New[int](1, 2, 3))
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range queue.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(queue.Values()))
+
+	values = []int{}
+	for value := range queue.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
+
+func TestConcurrentQueueClone(t *testing.T) {
+	queue := NewQueue[int](arrayqueue.New(1, 2))
+
+	clone := queue.Clone()
+	clone.Dequeue()
+	clone.Enqueue(3)
+
+	assert.Equal(t, []int{1, 2}, queue.GetValues())
+	assert.Equal(t, []int{2, 3}, clone.GetValues())
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/sets"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Set implementation
+var _ sets.Set[any] = (*Set[any])(nil)
+
+// Assert Cloneable implementation
+var _ ds.Cloneable[*Set[any]] = (*Set[any])(nil)
+
+// Set wraps a set and guards all accesses to it with a read-write mutex.
+type Set[T any] struct {
+	mutex sync.RWMutex
+	set   sets.Set[T]
+	// clone copies the wrapped set, whose type is only known when wrapping it.
+	clone func(set sets.Set[T]) sets.Set[T]
+}
+
+// NewSet instantiates a new thread safe set wrapping set.
+// set must not be accessed directly afterwards.
+func NewSet[T any, TSet interface {
+	sets.Set[T]
+	ds.Cloneable[TSet]
+}](set TSet) *Set[T] {
+	return &Set[T]{set: set, clone: func(set sets.Set[T]) sets.Set[T] { return set.(TSet).Clone() }}
+}
+
+// Add adds the elements to the set.
+func (set *Set[T]) Add(elements ...T) {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	set.set.Add(elements...)
+}
+
+// Remove removes the elements from the set.
+func (set *Set[T]) Remove(comparator utils.Comparator[T], elements ...T) {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	set.set.Remove(comparator, elements...)
+}
+
+// Contains checks if all elements are present in the set.
+func (set *Set[T]) Contains(elements ...T) bool {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.set.Contains(elements...)
+}
+
+// MakeIntersectionWith returns a new thread safe set containing the elements present in both sets.
+func (set *Set[T]) MakeIntersectionWith(other sets.Set[T]) sets.Set[T] {
+	other = snapshot(other)
+
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.wrap(set.set.MakeIntersectionWith(other))
+}
+
+// MakeUnionWith returns a new thread safe set containing the elements present in either set.
+func (set *Set[T]) MakeUnionWith(other sets.Set[T]) sets.Set[T] {
+	other = snapshot(other)
+
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.wrap(set.set.MakeUnionWith(other))
+}
+
+// MakeDifferenceWith returns a new thread safe set containing the elements present in this set but not in other.
+func (set *Set[T]) MakeDifferenceWith(other sets.Set[T]) sets.Set[T] {
+	other = snapshot(other)
+
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.wrap(set.set.MakeDifferenceWith(other))
+}
+
+// MakeSymmetricDifferenceWith returns a new thread safe set containing the elements present in exactly one of the sets.
+func (set *Set[T]) MakeSymmetricDifferenceWith(other sets.Set[T]) sets.Set[T] {
+	other = snapshot(other)
+
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.wrap(set.set.MakeSymmetricDifferenceWith(other))
+}
+
+// IsSubsetOf returns true if all elements of the set are contained in other.
+func (set *Set[T]) IsSubsetOf(other sets.Set[T]) bool {
+	other = snapshot(other)
+
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.set.IsSubsetOf(other)
+}
+
+// IsSupersetOf returns true if all elements of other are contained in the set.
+func (set *Set[T]) IsSupersetOf(other sets.Set[T]) bool {
+	other = snapshot(other)
+
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.set.IsSupersetOf(other)
+}
+
+// IsDisjointWith returns true if the set and other have no elements in common.
+func (set *Set[T]) IsDisjointWith(other sets.Set[T]) bool {
+	other = snapshot(other)
+
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.set.IsDisjointWith(other)
+}
+
+// Equals returns true if the set and other contain the same elements.
+func (set *Set[T]) Equals(other sets.Set[T]) bool {
+	other = snapshot(other)
+
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.set.Equals(other)
+}
+
+// UnionInPlace adds all elements of other to the set.
+func (set *Set[T]) UnionInPlace(other sets.Set[T]) {
+	other = snapshot(other)
+
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	set.set.UnionInPlace(other)
+}
+
+// RetainAll removes all elements from the set, which are not contained in other.
+func (set *Set[T]) RetainAll(other sets.Set[T]) {
+	other = snapshot(other)
+
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	set.set.RetainAll(other)
+}
+
+// RemoveAll removes all elements from the set, which are contained in other.
+func (set *Set[T]) RemoveAll(other sets.Set[T]) {
+	other = snapshot(other)
+
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	set.set.RemoveAll(other)
+}
+
+// wrap returns a new thread safe set wrapping result, which the wrapped set returned and is therefore of its type.
+func (set *Set[T]) wrap(result sets.Set[T]) *Set[T] {
+	return &Set[T]{set: result, clone: set.clone}
+}
+
+// snapshot returns a copy of other if it is a thread safe set, otherwise other itself.
+// Binary methods read other through the copy, so the locks of two sets are never held at once, which could deadlock.
+func snapshot[T any](other sets.Set[T]) sets.Set[T] {
+	if otherThis, ok := other.(*Set[T]); ok {
+		return otherThis.Clone().set
+	}
+
+	return other
+}
+
+// Empty returns true if set does not contain any elements.
+func (set *Set[T]) IsEmpty() bool {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.set.IsEmpty()
+}
+
+// Size returns number of elements within the set.
+func (set *Set[T]) Size() int {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.set.Size()
+}
+
+// Clear removes all elements from the set.
+func (set *Set[T]) Clear() {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	set.set.Clear()
+}
+
+// Clone returns a new thread safe set wrapping a copy of the wrapped set, which is taken under the read lock.
+func (set *Set[T]) Clone() *Set[T] {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return &Set[T]{set: set.clone(set.set), clone: set.clone}
+}
+
+// GetValues returns all elements in the set.
+func (set *Set[T]) GetValues() []T {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.set.GetValues()
+}
+
+// ToString returns a string representation of the wrapped set.
+func (set *Set[T]) ToString() string {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return "Concurrent" + set.set.ToString()
+}
+
+// AddIfAbsent adds element to the set, if it is not present yet.
+// Returns true if the element was added, otherwise false.
+func (set *Set[T]) AddIfAbsent(element T) bool {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	if set.set.Contains(element) {
+		return false
+	}
+
+	set.set.Add(element)
+
+	return true
+}
+
+// Do calls f with the wrapped set while holding the lock, making arbitrary compound operations atomic.
+// f must not retain the wrapped set.
+func (set *Set[T]) Do(f func(set sets.Set[T])) {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	f(set.set)
+}
+
+// Snapshot returns an iterator over a copy of the set's elements, which points to one element before it's first.
+// The lock is only held while copying the elements.
+func (set *Set[T]) Snapshot() *SnapshotIterator[int, T] {
+	return newIndexedSnapshotIterator(set.GetValues())
+}
+
+// All returns a sequence over the elements of a snapshot of the set, which is taken once ranging starts.
+// The lock is not held while yielding.
+func (set *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(set.Snapshot())(yield)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JonasMuehlmann/datastructures.go/sets"
+	"github.com/JonasMuehlmann/datastructures.go/sets/hashset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentSetAddIfAbsent(t *testing.T) {
+	set := NewSet[int](hashset.New[int]())
+
+	added := make([]bool, 100)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			added[i] = set.AddIfAbsent(1)
+		}(i)
+	}
+	wg.Wait()
+
+	count := 0
+	for _, wasAdded := range added {
+		if wasAdded {
+			count++
+		}
+	}
+
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 1, set.Size())
+}
+
+func TestConcurrentSetMakeUnionWith(t *testing.T) {
+	set := NewSet[int](hashset.New(1, 2))
+
+	union := set.MakeUnionWith(hashset.New(2, 3))
+
+	_, ok := union.(*Set[int])
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []int{1, 2, 3}, union.GetValues())
+}
+
+func TestConcurrentSetSequences(t *testing.T) {
+	set := NewSet[string](hashset.New("c", "a", "b"))
+
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, slices.Collect(set.All()))
+
+	items := []string{}
+	for item := range set.All() {
+		items = append(items, item)
+		if len(items) == 2 {
+			break
+		}
+	}
+
+	assert.Len(t, items, 2)
+}
+
+func TestConcurrentSetInPlaceOperationsDoNotDeadlock(t *testing.T) {
+	a := NewSet[int](hashset.New(1, 2, 3))
+	b := NewSet[int](hashset.New(2, 3, 4))
+
+	// Each operation reads the other set, so holding the write lock while reading it would deadlock
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+
+			a.UnionInPlace(b)
+			a.RetainAll(b)
+		}()
+		go func() {
+			defer wg.Done()
+
+			b.UnionInPlace(a)
+			b.RemoveAll(hashset.New(1))
+		}()
+	}
+	wg.Wait()
+
+	assert.Subset(t, []int{1, 2, 3, 4}, a.GetValues())
+	assert.Subset(t, []int{2, 3, 4}, b.GetValues())
+
+	// The set itself can be passed as well
+	b.UnionInPlace(b)
+	b.RetainAll(b)
+	assert.Subset(t, []int{2, 3, 4}, b.GetValues())
+
+	a.RemoveAll(a)
+	assert.True(t, a.IsEmpty())
+}
+
+func TestConcurrentSetBinaryOperationsDoNotDeadlock(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation func(a *Set[int], b *Set[int])
+	}{
+		{name: "MakeIntersectionWith", operation: func(a *Set[int], b *Set[int]) { a.MakeIntersectionWith(b) }},
+		{name: "MakeUnionWith", operation: func(a *Set[int], b *Set[int]) { a.MakeUnionWith(b) }},
+		{name: "MakeDifferenceWith", operation: func(a *Set[int], b *Set[int]) { a.MakeDifferenceWith(b) }},
+		{name: "MakeSymmetricDifferenceWith", operation: func(a *Set[int], b *Set[int]) { a.MakeSymmetricDifferenceWith(b) }},
+		{name: "IsSubsetOf", operation: func(a *Set[int], b *Set[int]) { a.IsSubsetOf(b) }},
+		{name: "IsSupersetOf", operation: func(a *Set[int], b *Set[int]) { a.IsSupersetOf(b) }},
+		{name: "IsDisjointWith", operation: func(a *Set[int], b *Set[int]) { a.IsDisjointWith(b) }},
+		{name: "Equals", operation: func(a *Set[int], b *Set[int]) { a.Equals(b) }},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := NewSet[int](hashset.New(1, 2, 3))
+			b := NewSet[int](hashset.New(2, 3, 4))
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+
+				b.Do(func(sets.Set[int]) {
+					go test.operation(a, b)
+
+					// Let the operation wait for b's lock, it must not hold a's lock meanwhile
+					time.Sleep(10 * time.Millisecond)
+					a.Add(5)
+				})
+			}()
+
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("deadlock")
+			}
+
+			assert.True(t, a.Contains(5))
+		})
+	}
+}
+
+func TestConcurrentSetClone(t *testing.T) {
+	set := NewSet[int](hashset.New(1, 2))
+
+	clone := set.Clone()
+	clone.Add(3)
+	clone.Remove(nil, 1)
+
+	assert.ElementsMatch(t, []int{1, 2}, set.GetValues())
+	assert.ElementsMatch(t, []int{2, 3}, clone.GetValues())
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Iterator implementation
+var _ ds.ReadCompForIndexIterator[int, any] = (*SnapshotIterator[int, any])(nil)
+var _ ds.BidirectionalIterator = (*SnapshotIterator[int, any])(nil)
+var _ ds.OrderedIterator = (*SnapshotIterator[int, any])(nil)
+var _ ds.SizedIterator = (*SnapshotIterator[int, any])(nil)
+
+// SnapshotIterator iterates over a copy of a container's elements, which was taken while holding the container's lock.
+// The lock is not held during iteration, so the container can be modified concurrently
+// without affecting the iterator or being blocked by it.
+type SnapshotIterator[TKey any, TValue any] struct {
+	keys   []TKey
+	values []TValue
+	index  int
+}
+
+// newSnapshotIterator returns an iterator over the given keys and values, which points to one element before it's first.
+func newSnapshotIterator[TKey any, TValue any](keys []TKey, values []TValue) *SnapshotIterator[TKey, TValue] {
+	return &SnapshotIterator[TKey, TValue]{keys: keys, values: values, index: -1}
+}
+
+// newIndexedSnapshotIterator returns an iterator over the given values, whose keys are their indices.
+func newIndexedSnapshotIterator[TValue any](values []TValue) *SnapshotIterator[int, TValue] {
+	keys := make([]int, len(values))
+	for i := range keys {
+		keys[i] = i
+	}
+
+	return newSnapshotIterator(keys, values)
+}
+
+func (it *SnapshotIterator[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *SnapshotIterator[TKey, TValue]) IsEnd() bool {
+	return len(it.values) == 0 || it.index == len(it.values)
+}
+
+func (it *SnapshotIterator[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *SnapshotIterator[TKey, TValue]) IsLast() bool {
+	return it.index == len(it.values)-1
+}
+
+func (it *SnapshotIterator[TKey, TValue]) IsValid() bool {
+	return len(it.values) > 0 && !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *SnapshotIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bool {
+	otherThis, ok := other.(*SnapshotIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) == 0
+}
+
+func (it *SnapshotIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) int {
+	otherThis, ok := other.(*SnapshotIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.index - otherThis.index
+}
+
+func (it *SnapshotIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*SnapshotIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) > 0
+}
+
+func (it *SnapshotIterator[TKey, TValue]) IsBefore(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*SnapshotIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) < 0
+}
+
+func (it *SnapshotIterator[TKey, TValue]) Size() int {
+	return len(it.values)
+}
+
+func (it *SnapshotIterator[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *SnapshotIterator[TKey, TValue]) GetKey() (key TKey, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.keys[it.index], true
+}
+
+func (it *SnapshotIterator[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.values[it.index], true
+}
+
+func (it *SnapshotIterator[TKey, TValue]) Next() bool {
+	return it.NextN(1)
+}
+
+func (it *SnapshotIterator[TKey, TValue]) NextN(i int) bool {
+	it.index = utils.Min(it.index+i, len(it.values))
+
+	return it.IsValid()
+}
+
+func (it *SnapshotIterator[TKey, TValue]) Previous() bool {
+	return it.PreviousN(1)
+}
+
+func (it *SnapshotIterator[TKey, TValue]) PreviousN(n int) bool {
+	it.index = utils.Max(it.index-n, -1)
+
+	return it.IsValid()
+}
+
+func (it *SnapshotIterator[TKey, TValue]) MoveBy(n int) bool {
+	if n > 0 {
+		return it.NextN(n)
+	} else if n < 0 {
+		return it.PreviousN(-n)
+	}
+
+	return it.IsValid()
+}
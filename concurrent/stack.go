@@ -0,0 +1,141 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/stacks"
+)
+
+// Assert Stack implementation
+var _ stacks.Stack[any] = (*Stack[any])(nil)
+
+// Assert Cloneable implementation
+var _ ds.Cloneable[*Stack[any]] = (*Stack[any])(nil)
+
+// Stack wraps a stack and guards all accesses to it with a read-write mutex.
+type Stack[T any] struct {
+	mutex sync.RWMutex
+	stack stacks.Stack[T]
+	// clone copies the wrapped stack, whose type is only known when wrapping it.
+	clone func(stack stacks.Stack[T]) stacks.Stack[T]
+}
+
+// NewStack instantiates a new thread safe stack wrapping stack.
+// stack must not be accessed directly afterwards.
+func NewStack[T any, TStack interface {
+	stacks.Stack[T]
+	ds.Cloneable[TStack]
+}](stack TStack) *Stack[T] {
+	return &Stack[T]{stack: stack, clone: func(stack stacks.Stack[T]) stacks.Stack[T] { return stack.(TStack).Clone() }}
+}
+
+// Push adds a value onto the top of the stack.
+func (stack *Stack[T]) Push(value T) {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+
+	stack.stack.Push(value)
+}
+
+// Pop removes top element on stack and returns it.
+// Second return parameter is true, unless the stack was empty and there was nothing to pop.
+func (stack *Stack[T]) Pop() (value T, ok bool) {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+
+	return stack.stack.Pop()
+}
+
+// Peek returns top element on the stack without removing it.
+// Second return parameter is true, unless the stack was empty and there was nothing to peek.
+func (stack *Stack[T]) Peek() (value T, ok bool) {
+	stack.mutex.RLock()
+	defer stack.mutex.RUnlock()
+
+	return stack.stack.Peek()
+}
+
+// Empty returns true if stack does not contain any elements.
+func (stack *Stack[T]) IsEmpty() bool {
+	stack.mutex.RLock()
+	defer stack.mutex.RUnlock()
+
+	return stack.stack.IsEmpty()
+}
+
+// Size returns number of elements within the stack.
+func (stack *Stack[T]) Size() int {
+	stack.mutex.RLock()
+	defer stack.mutex.RUnlock()
+
+	return stack.stack.Size()
+}
+
+// Clear removes all elements from the stack.
+func (stack *Stack[T]) Clear() {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+
+	stack.stack.Clear()
+}
+
+// Clone returns a new thread safe stack wrapping a copy of the wrapped stack, which is taken under the read lock.
+func (stack *Stack[T]) Clone() *Stack[T] {
+	stack.mutex.RLock()
+	defer stack.mutex.RUnlock()
+
+	return &Stack[T]{stack: stack.clone(stack.stack), clone: stack.clone}
+}
+
+// GetValues returns all elements in the stack.
+func (stack *Stack[T]) GetValues() []T {
+	stack.mutex.RLock()
+	defer stack.mutex.RUnlock()
+
+	return stack.stack.GetValues()
+}
+
+// ToString returns a string representation of the wrapped stack.
+func (stack *Stack[T]) ToString() string {
+	stack.mutex.RLock()
+	defer stack.mutex.RUnlock()
+
+	return "Concurrent" + stack.stack.ToString()
+}
+
+// Do calls f with the wrapped stack while holding the lock, making arbitrary compound operations atomic.
+// f must not retain the wrapped stack.
+func (stack *Stack[T]) Do(f func(stack stacks.Stack[T])) {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+
+	f(stack.stack)
+}
+
+// Snapshot returns an iterator over a copy of the stack's elements, which points to one element before it's first.
+// The lock is only held while copying the elements.
+func (stack *Stack[T]) Snapshot() *SnapshotIterator[int, T] {
+	return newIndexedSnapshotIterator(stack.GetValues())
+}
+
+// All returns a sequence over the index/value pairs of a snapshot of the stack, which is taken once ranging starts.
+// The lock is not held while yielding.
+func (stack *Stack[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		ds.Seq2(stack.Snapshot())(yield)
+	}
+}
+
+// Values returns a sequence over the values of a snapshot of the stack, which is taken once ranging starts.
+// The lock is not held while yielding.
+func (stack *Stack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(stack.Snapshot())(yield)
+	}
+}
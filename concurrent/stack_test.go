@@ -0,0 +1,73 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/stacks/arraystack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentStack(t *testing.T) {
+	stack := NewStack[int](arraystack.New[int]())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			stack.Push(i)
+		}(i)
+	}
+	wg.Wait()
+
+	it := stack.Snapshot()
+	stack.Clear()
+
+	assert.Equal(t, 100, it.Size())
+	assert.True(t, stack.IsEmpty())
+}
+
+func TestConcurrentStackSequences(t *testing.T) {
+	stack := NewStack[int](arraystack.New[int](1, 2, 3))
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range stack.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(stack.Values()))
+
+	values = []int{}
+	for value := range stack.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
+
+func TestConcurrentStackClone(t *testing.T) {
+	stack := NewStack[int](arraystack.New(1, 2))
+
+	clone := stack.Clone()
+	clone.Pop()
+	clone.Push(3)
+
+	assert.Equal(t, 2, stack.Size())
+	assert.ElementsMatch(t, []int{1, 2}, stack.GetValues())
+	assert.ElementsMatch(t, []int{1, 3}, clone.GetValues())
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package deques provides an abstract Deque interface.
+//
+// In computer science, a double-ended queue (abbreviated to deque) is an abstract data type that generalizes a queue, for which elements can be added to or removed from either the front (head) or back (tail).
+//
+// Reference: https://en.wikipedia.org/wiki/Double-ended_queue
+package deques
+
+import "github.com/JonasMuehlmann/datastructures.go/ds"
+
+// Deque interface that all deques implement.
+type Deque[T any] interface {
+	PushFront(value T)
+	PushBack(value T)
+	PopFront() (value T, ok bool)
+	PopBack() (value T, ok bool)
+	PeekFront() (value T, ok bool)
+	PeekBack() (value T, ok bool)
+
+	Get(index int) (value T, ok bool)
+	Set(index int, value T)
+
+	ds.Container[T]
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ringbuffer
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Iterator implementation
+var _ ds.ReadWriteOrdCompBidRandCollIterator[int, any] = (*Iterator[any])(nil)
+
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*Iterator[any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*Iterator[any])(nil)
+
+// Assert ConstantTimeAccessIterator implementation
+var _ ds.ConstantTimeAccessIterator = (*Iterator[any])(nil)
+
+// Iterator holding the iterator's state
+type Iterator[T any] struct {
+	deque *Deque[T]
+	index int
+	// Redundant but has better locality
+	value T
+	size  int
+	guard ds.ModificationGuard
+}
+
+// NewIterator returns a stateful iterator whose values can be fetched by an index.
+func (deque *Deque[T]) NewIterator(index int, size int) *Iterator[T] {
+	it := &Iterator[T]{deque: deque, index: index, size: utils.Min(deque.Size(), size), guard: deque.modifications.Guard()}
+
+	if it.IsValid() {
+		it.value, _ = deque.Get(it.index)
+	}
+
+	return it
+}
+
+func (it *Iterator[T]) IsValid() bool {
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the deque was structurally modified after the iterator was created.
+func (it *Iterator[T]) Err() error {
+	return it.guard.Err()
+}
+
+func (it *Iterator[T]) Get() (value T, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.value, true
+}
+
+func (it *Iterator[T]) Set(value T) bool {
+	if !it.IsValid() {
+		return false
+	}
+	it.deque.Set(it.index, value)
+	it.value = value
+
+	return true
+}
+
+// If other is of type IndexedIterator, IndexedIterator.Index() will be used, possibly executing in O(1)
+func (it *Iterator[T]) DistanceTo(other ds.OrderedIterator) int {
+	otherThis, ok := other.(*Iterator[T])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.index - otherThis.index
+}
+
+func (it *Iterator[T]) IsAfter(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*Iterator[T])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) > 0
+}
+
+func (it *Iterator[T]) IsBefore(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*Iterator[T])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) < 0
+}
+
+func (it *Iterator[T]) IsEqual(other ds.ComparableIterator) bool {
+	otherThis, ok := other.(*Iterator[T])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) == 0
+}
+
+// CanCompare checks if other is an iterator of the same deque.
+func (it *Iterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*Iterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.deque != it.deque {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
+func (it *Iterator[T]) Next() bool {
+	it.index = utils.Min(it.index+1, it.size)
+
+	if !it.IsValid() {
+		return false
+	}
+
+	it.value, _ = it.deque.Get(it.index)
+
+	return true
+}
+
+func (it *Iterator[T]) NextN(i int) bool {
+	it.index = utils.Min(it.index+i, it.size)
+
+	if !it.IsValid() {
+		return false
+	}
+
+	it.value, _ = it.deque.Get(it.index)
+
+	return true
+}
+
+func (it *Iterator[T]) Previous() bool {
+	it.index = utils.Max(it.index-1, -1)
+
+	if !it.IsValid() {
+		return false
+	}
+
+	it.value, _ = it.deque.Get(it.index)
+
+	return true
+}
+
+func (it *Iterator[T]) PreviousN(n int) bool {
+	it.index = utils.Max(it.index-n, -1)
+
+	if !it.IsValid() {
+		return false
+	}
+
+	it.value, _ = it.deque.Get(it.index)
+
+	return true
+}
+
+func (it *Iterator[T]) MoveBy(n int) bool {
+	if n > 0 {
+		return it.NextN(n)
+	} else if n < 0 {
+		return it.PreviousN(-n)
+	}
+
+	return it.IsValid()
+}
+
+func (it *Iterator[T]) Size() int {
+	return it.size
+}
+
+func (it *Iterator[T]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *Iterator[T]) GetKey() (int, bool) {
+	return it.Index()
+}
+
+func (it *Iterator[T]) MoveTo(i int) bool {
+	return it.MoveBy(i - it.index)
+}
+
+func (it *Iterator[T]) MoveToKey(i int) bool {
+	return it.MoveTo(i)
+}
+
+func (it *Iterator[T]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Iterator[T]) IsEnd() bool {
+	return it.size == 0 || it.index == it.size
+}
+
+func (it *Iterator[T]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Iterator[T]) IsLast() bool {
+	return it.index == it.size-1
+}
+
+func (it *Iterator[T]) GetAt(i int) (value T, found bool) {
+	if it.size == 0 || it.guard.Err() != nil {
+		return
+	}
+
+	return it.deque.Get(i)
+}
+
+func (it *Iterator[T]) SetAt(i int, value T) bool {
+	if it.size == 0 || !it.deque.withinRange(i) || it.guard.Err() != nil {
+		return false
+	}
+	it.deque.Set(i, value)
+
+	return true
+}
+
+func (it *Iterator[T]) GetAtKey(i int) (value T, found bool) {
+	return it.GetAt(i)
+}
+
+func (it *Iterator[T]) SetAtKey(i int, value T) bool {
+	return it.SetAt(i, value)
+}
+
+// HasConstantTimeAccess returns true, since the elements are indexed directly.
+func (it *Iterator[T]) HasConstantTimeAccess() bool {
+	return true
+}
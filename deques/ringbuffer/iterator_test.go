@@ -0,0 +1,1083 @@
+package ringbuffer
+
+import (
+	"testing"
+
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	NoMoveMagicPosition = 7869543205234798
+)
+
+func TestRingBufferDequeIteratorIsValid(t *testing.T) {
+	tests := []struct {
+		name         string
+		list         *Deque[int]
+		position     int
+		isValid      bool
+		iteratorInit func(*Deque[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+	}{
+		{
+			name:         "Empty",
+			list:         New[int](),
+			position:     NoMoveMagicPosition,
+			isValid:      false,
+			iteratorInit: (*Deque[int]).Begin,
+		},
+		{
+			name:         "One element, begin",
+			list:         New[int](1),
+			position:     NoMoveMagicPosition,
+			isValid:      false,
+			iteratorInit: (*Deque[int]).Begin,
+		},
+		{
+			name:         "One element, end",
+			list:         New[int](1),
+			position:     NoMoveMagicPosition,
+			isValid:      false,
+			iteratorInit: (*Deque[int]).End,
+		},
+		{
+			name:         "One element, first",
+			list:         New[int](1),
+			position:     NoMoveMagicPosition,
+			isValid:      true,
+			iteratorInit: (*Deque[int]).First,
+		},
+		{
+			name:         "One element, last",
+			list:         New[int](1),
+			position:     NoMoveMagicPosition,
+			isValid:      true,
+			iteratorInit: (*Deque[int]).Last,
+		},
+		{
+			name:         "3 elements, middle",
+			list:         New[int](1, 2, 3),
+			position:     1,
+			isValid:      true,
+			iteratorInit: (*Deque[int]).Begin,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.iteratorInit(test.list)
+
+			if test.position != NoMoveMagicPosition {
+				it.MoveTo(test.position)
+			}
+
+			isValid := it.IsValid()
+
+			assert.Equalf(t, test.isValid, isValid, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorIndex(t *testing.T) {
+	tests := []struct {
+		name         string
+		list         *Deque[int]
+		position     int
+		valid        bool
+		iteratorInit func(*Deque[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+	}{
+		{
+			name:         "Empty",
+			list:         New[int](),
+			position:     -1,
+			valid:        false,
+			iteratorInit: (*Deque[int]).Begin,
+		},
+		{
+			name:         "One element, begin",
+			list:         New[int](1),
+			position:     -1,
+			valid:        false,
+			iteratorInit: (*Deque[int]).Begin,
+		},
+		{
+			name:         "One element, end",
+			list:         New[int](1),
+			position:     1,
+			valid:        false,
+			iteratorInit: (*Deque[int]).End,
+		},
+		{
+			name:         "One element, first",
+			list:         New[int](1),
+			position:     0,
+			valid:        true,
+			iteratorInit: (*Deque[int]).First,
+		},
+		{
+			name:         "One element, last",
+			list:         New[int](1),
+			position:     0,
+			valid:        true,
+			iteratorInit: (*Deque[int]).Last,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.iteratorInit(test.list)
+
+			position, valid := it.Index()
+
+			assert.Equalf(t, test.valid, valid, test.name)
+			if test.valid {
+				assert.Equalf(t, test.position, position, test.name)
+			}
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorNext(t *testing.T) {
+	tests := []struct {
+		name          string
+		list          *Deque[int]
+		position      int
+		isValidBefore bool
+		isValidAfter  bool
+		iteratorInit  func(*Deque[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+	}{
+		{
+			name:          "Empty",
+			list:          New[int](),
+			position:      NoMoveMagicPosition,
+			isValidBefore: false,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "One element, begin",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			isValidBefore: false,
+			isValidAfter:  true,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "One element, end",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			isValidBefore: false,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).End,
+		},
+		{
+			name:          "One element, first",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).First,
+		},
+		{
+			name:          "One element, last",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Last,
+		},
+		{
+			name:          "3 elements, middle",
+			list:          New[int](1, 2, 3),
+			position:      1,
+			isValidBefore: true,
+			isValidAfter:  true,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.iteratorInit(test.list)
+
+			if test.position != NoMoveMagicPosition {
+				it.MoveTo(test.position)
+			}
+
+			isValidBefore := it.IsValid()
+			assert.Equalf(t, test.isValidBefore, isValidBefore, test.name)
+
+			it.Next()
+
+			isValidAfter := it.IsValid()
+			assert.Equalf(t, test.isValidAfter, isValidAfter, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorNextN(t *testing.T) {
+	tests := []struct {
+		name          string
+		list          *Deque[int]
+		position      int
+		n             int
+		isValidBefore bool
+		isValidAfter  bool
+		iteratorInit  func(*Deque[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+	}{
+		{
+			name:          "Empty",
+			list:          New[int](),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: false,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "One element, begin",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: false,
+			isValidAfter:  true,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "One element, end",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: false,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).End,
+		},
+		{
+			name:          "One element, first",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).First,
+		},
+		{
+			name:          "One element, last",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Last,
+		},
+		{
+			name:          "3 elements, middle",
+			list:          New[int](1, 2, 3),
+			position:      1,
+			n:             1,
+			isValidBefore: true,
+			isValidAfter:  true,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "3 elements, middle, move out of bounds",
+			list:          New[int](1, 2, 3),
+			position:      1,
+			n:             5,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.iteratorInit(test.list)
+
+			if test.position != NoMoveMagicPosition {
+				it.MoveTo(test.position)
+			}
+
+			isValidBefore := it.IsValid()
+			assert.Equalf(t, test.isValidBefore, isValidBefore, test.name)
+
+			it.NextN(test.n)
+
+			isValidAfter := it.IsValid()
+			assert.Equalf(t, test.isValidAfter, isValidAfter, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorPrevious(t *testing.T) {
+	tests := []struct {
+		name          string
+		list          *Deque[int]
+		position      int
+		isValidBefore bool
+		isValidAfter  bool
+		iteratorInit  func(*Deque[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+	}{
+		{
+			name:          "Empty",
+			list:          New[int](),
+			position:      NoMoveMagicPosition,
+			isValidBefore: false,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "One element, begin",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			isValidBefore: false,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "One element, end",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			isValidBefore: false,
+			isValidAfter:  true,
+			iteratorInit:  (*Deque[int]).End,
+		},
+		{
+			name:          "One element, first",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).First,
+		},
+		{
+			name:          "One element, last",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Last,
+		},
+		{
+			name:          "3 elements, middle",
+			list:          New[int](1, 2, 3),
+			position:      1,
+			isValidBefore: true,
+			isValidAfter:  true,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.iteratorInit(test.list)
+
+			if test.position != NoMoveMagicPosition {
+				it.MoveTo(test.position)
+			}
+
+			isValidBefore := it.IsValid()
+			assert.Equalf(t, test.isValidBefore, isValidBefore, test.name)
+
+			it.Previous()
+
+			isValidAfter := it.IsValid()
+			assert.Equalf(t, test.isValidAfter, isValidAfter, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorPreviousN(t *testing.T) {
+	tests := []struct {
+		name          string
+		list          *Deque[int]
+		position      int
+		n             int
+		isValidBefore bool
+		isValidAfter  bool
+		iteratorInit  func(*Deque[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+	}{
+		{
+			name:          "Empty",
+			list:          New[int](),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: false,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "One element, begin",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: false,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "One element, end",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: false,
+			isValidAfter:  true,
+			iteratorInit:  (*Deque[int]).End,
+		},
+		{
+			name:          "One element, first",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).First,
+		},
+		{
+			name:          "One element, last",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Last,
+		},
+		{
+			name:          "3 elements, middle",
+			list:          New[int](1, 2, 3),
+			position:      1,
+			n:             1,
+			isValidBefore: true,
+			isValidAfter:  true,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "3 elements, middle, move out of bounds",
+			list:          New[int](1, 2, 3),
+			position:      1,
+			n:             5,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.iteratorInit(test.list)
+
+			if test.position != NoMoveMagicPosition {
+				it.MoveTo(test.position)
+			}
+
+			isValidBefore := it.IsValid()
+			assert.Equalf(t, test.isValidBefore, isValidBefore, test.name)
+
+			it.PreviousN(test.n)
+
+			isValidAfter := it.IsValid()
+			assert.Equalf(t, test.isValidAfter, isValidAfter, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorMoveBy(t *testing.T) {
+	tests := []struct {
+		name          string
+		list          *Deque[int]
+		position      int
+		n             int
+		isValidBefore bool
+		isValidAfter  bool
+		iteratorInit  func(*Deque[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+	}{
+		{
+			name:          "Empty",
+			list:          New[int](),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: false,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "One element, begin",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: false,
+			isValidAfter:  true,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "One element, end",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: false,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).End,
+		},
+		{
+			name:          "One element, first",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).First,
+		},
+		{
+			name:          "One element, last",
+			list:          New[int](1),
+			position:      NoMoveMagicPosition,
+			n:             1,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Last,
+		},
+		{
+			name:          "3 elements, middle",
+			list:          New[int](1, 2, 3),
+			position:      1,
+			n:             1,
+			isValidBefore: true,
+			isValidAfter:  true,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "5 elements, middle, forward by 2",
+			list:          New[int](1, 2, 3, 4, 5),
+			position:      2,
+			n:             2,
+			isValidBefore: true,
+			isValidAfter:  true,
+			iteratorInit:  (*Deque[int]).Begin,
+		}, {
+			name:          "5 elements, middle, backward by 2",
+			list:          New[int](1, 2, 3, 4, 5),
+			position:      2,
+			n:             -2,
+			isValidBefore: true,
+			isValidAfter:  true,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+		{
+			name:          "3 elements, middle, move out of bounds",
+			list:          New[int](1, 2, 3),
+			position:      1,
+			n:             5,
+			isValidBefore: true,
+			isValidAfter:  false,
+			iteratorInit:  (*Deque[int]).Begin,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.iteratorInit(test.list)
+
+			if test.position != NoMoveMagicPosition {
+				it.MoveTo(test.position)
+			}
+
+			isValidBefore := it.IsValid()
+			assert.Equalf(t, test.isValidBefore, isValidBefore, test.name)
+
+			it.MoveBy(test.n)
+
+			isValidAfter := it.IsValid()
+			assert.Equalf(t, test.isValidAfter, isValidAfter, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorGet(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     *Deque[int]
+		position int
+		value    int
+		found    bool
+	}{
+		{
+			name:     "Empty",
+			list:     New[int](),
+			position: NoMoveMagicPosition,
+			found:    false,
+		},
+		{
+			name:     "One element, begin",
+			list:     New[int](1),
+			position: NoMoveMagicPosition,
+			found:    false,
+		},
+		{
+			name:     "One element, first",
+			list:     New[int](1),
+			position: 0,
+			value:    1,
+			found:    true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.list.Begin()
+
+			if test.position != NoMoveMagicPosition {
+				it.MoveTo(test.position)
+			}
+
+			value, found := it.Get()
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorSet(t *testing.T) {
+	tests := []struct {
+		name        string
+		list        *Deque[int]
+		position    int
+		value       int
+		successfull bool
+	}{
+		{
+			name:        "Empty",
+			list:        New[int](),
+			position:    NoMoveMagicPosition,
+			value:       1,
+			successfull: false,
+		},
+		{
+			name:        "One element, begin",
+			list:        New[int](1),
+			position:    NoMoveMagicPosition,
+			value:       1,
+			successfull: false,
+		},
+		{
+			name:        "One element, first",
+			list:        New[int](1),
+			position:    0,
+			value:       1,
+			successfull: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.list.Begin()
+
+			if test.position != NoMoveMagicPosition {
+				it.MoveTo(test.position)
+			}
+
+			successfull := it.Set(test.value)
+
+			assert.Equalf(t, test.successfull, successfull, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorGetAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     *Deque[int]
+		position int
+		value    int
+		found    bool
+	}{
+		{
+			name:     "Empty",
+			list:     New[int](),
+			position: 0,
+			found:    false,
+		},
+		{
+			name:     "One element, begin",
+			list:     New[int](1),
+			position: -1,
+			found:    false,
+		},
+		{
+			name:     "One element, first",
+			list:     New[int](1),
+			position: 0,
+			value:    1,
+			found:    true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.list.Begin()
+
+			value, found := it.GetAt(test.position)
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorSetAt(t *testing.T) {
+	tests := []struct {
+		name        string
+		list        *Deque[int]
+		position    int
+		value       int
+		successfull bool
+	}{
+		{
+			name:        "Empty",
+			list:        New[int](),
+			position:    0,
+			value:       1,
+			successfull: false,
+		},
+		{
+			name:        "One element, begin",
+			list:        New[int](1),
+			position:    -1,
+			value:       1,
+			successfull: false,
+		},
+		{
+			name:        "One element, first",
+			list:        New[int](1),
+			position:    0,
+			value:       1,
+			successfull: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.list.Begin()
+
+			successfull := it.SetAt(test.position, test.value)
+
+			assert.Equalf(t, test.successfull, successfull, test.name)
+		})
+	}
+}
+
+// NOTE: Missing test case: other does not implement IndexedIterator
+func TestRingBufferDequeIteratorDistanceTo(t *testing.T) {
+	tests := []struct {
+		name      string
+		position1 int
+		position2 int
+		distance  int
+	}{
+		{
+			name:      "Equal",
+			position1: 0,
+			position2: 0,
+			distance:  0,
+		},
+		{
+			name:      "First lower",
+			position1: 0,
+			position2: 1,
+			distance:  -1,
+		},
+		{
+			name:      "Second lower",
+			position1: 1,
+			position2: 0,
+			distance:  1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it1 := New[int](1, 2, 3, 4, 5).Begin()
+			it2 := New[int](1, 2, 3, 4, 5).Begin()
+
+			it1.MoveTo(test.position1)
+			it2.MoveTo(test.position2)
+
+			distance := it1.DistanceTo(it2)
+
+			assert.Equalf(t, test.distance, distance, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorIsAfter(t *testing.T) {
+	tests := []struct {
+		name      string
+		position1 int
+		position2 int
+		isAfter   bool
+	}{
+		{
+			name:      "Equal",
+			position1: 0,
+			position2: 0,
+			isAfter:   false,
+		},
+		{
+			name:      "First lower",
+			position1: 0,
+			position2: 1,
+			isAfter:   false,
+		},
+		{
+			name:      "Second lower",
+			position1: 1,
+			position2: 0,
+			isAfter:   true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it1 := New[int](1, 2, 3, 4, 5).Begin()
+			it2 := New[int](1, 2, 3, 4, 5).Begin()
+
+			it1.MoveTo(test.position1)
+			it2.MoveTo(test.position2)
+
+			isAfter := it1.IsAfter(it2)
+
+			assert.Equalf(t, test.isAfter, isAfter, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorIsBefore(t *testing.T) {
+	tests := []struct {
+		name      string
+		position1 int
+		position2 int
+		isAfter   bool
+	}{
+		{
+			name:      "Equal",
+			position1: 0,
+			position2: 0,
+			isAfter:   false,
+		},
+		{
+			name:      "First lower",
+			position1: 0,
+			position2: 1,
+			isAfter:   true,
+		},
+		{
+			name:      "Second lower",
+			position1: 1,
+			position2: 0,
+			isAfter:   false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it1 := New[int](1, 2, 3, 4, 5).Begin()
+			it2 := New[int](1, 2, 3, 4, 5).Begin()
+
+			it1.MoveTo(test.position1)
+			it2.MoveTo(test.position2)
+
+			isAfter := it1.IsBefore(it2)
+
+			assert.Equalf(t, test.isAfter, isAfter, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorIsEqual(t *testing.T) {
+	tests := []struct {
+		name      string
+		position1 int
+		position2 int
+		isAfter   bool
+	}{
+		{
+			name:      "Equal",
+			position1: 0,
+			position2: 0,
+			isAfter:   true,
+		},
+		{
+			name:      "First lower",
+			position1: 0,
+			position2: 1,
+			isAfter:   false,
+		},
+		{
+			name:      "Second lower",
+			position1: 1,
+			position2: 0,
+			isAfter:   false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it1 := New[int](1, 2, 3, 4, 5).Begin()
+			it2 := New[int](1, 2, 3, 4, 5).Begin()
+
+			it1.MoveTo(test.position1)
+			it2.MoveTo(test.position2)
+
+			isAfter := it1.IsEqual(it2)
+
+			assert.Equalf(t, test.isAfter, isAfter, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorIsBeginEndFirstLast(t *testing.T) {
+	tests := []struct {
+		name          string
+		iteratorInit  func(*Deque[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+		iteratorCheck func(ds.ReadWriteOrdCompBidRandCollIterator[int, int]) bool
+	}{
+		{
+			name:          "Begin",
+			iteratorInit:  (*Deque[int]).Begin,
+			iteratorCheck: (ds.ReadWriteOrdCompBidRandCollIterator[int, int]).IsBegin,
+		},
+		{
+			name:          "End",
+			iteratorInit:  (*Deque[int]).End,
+			iteratorCheck: (ds.ReadWriteOrdCompBidRandCollIterator[int, int]).IsEnd,
+		},
+		{
+			name:          "First",
+			iteratorInit:  (*Deque[int]).First,
+			iteratorCheck: (ds.ReadWriteOrdCompBidRandCollIterator[int, int]).IsFirst,
+		},
+		{
+			name:          "Last",
+			iteratorInit:  (*Deque[int]).Last,
+			iteratorCheck: (ds.ReadWriteOrdCompBidRandCollIterator[int, int]).IsLast,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.iteratorInit(New[int](1, 2, 4, 5))
+			assert.Truef(t, test.iteratorCheck(it), test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIteratorSize(t *testing.T) {
+	tests := []struct {
+		name         string
+		list         *Deque[int]
+		iteratorInit func(*Deque[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+		size         int
+	}{
+		{
+			name:         "Empty",
+			list:         New[int](),
+			size:         0,
+			iteratorInit: (*Deque[int]).First,
+		},
+
+		{
+			name:         "One element, first",
+			list:         New[int](1),
+			size:         1,
+			iteratorInit: (*Deque[int]).First,
+		},
+
+		{
+			name:         "3 elements, middle",
+			list:         New[int](1, 2, 3),
+			size:         3,
+			iteratorInit: (*Deque[int]).First,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.iteratorInit(test.list)
+
+			size := it.Size()
+
+			assert.Equalf(t, test.size, size, test.name)
+		})
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ringbuffer implements a deque backed by a growable ring buffer.
+//
+// Elements are stored in a slice, which is used as if it were connected end-to-end.
+// Pushing and popping at both ends runs in amortized O(1), indexed access runs in O(1).
+// The buffer doubles its capacity when it is full and halves it when it is only a quarter full.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Double-ended_queue
+package ringbuffer
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/deques"
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Deque implementation
+var _ deques.Deque[any] = (*Deque[any])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Deque[any]] = (*Deque[any])(nil)
+var _ ds.Equatable[*Deque[any], any] = (*Deque[any])(nil)
+
+// MinCapacity is the capacity, below which the buffer never shrinks.
+const MinCapacity = 16
+
+// Deque holds elements in a ring buffer.
+type Deque[T any] struct {
+	values []T
+	start  int
+	size   int
+
+	modifications ds.ModificationCounter
+}
+
+// New instantiates a new deque and adds the passed values, if any, to the back of the deque.
+func New[T any](values ...T) *Deque[T] {
+	return NewFromSlice(values)
+}
+
+// NewFromSlice instantiates a new deque containing the provided slice.
+// The slice is copied, so that the deque does not alias it.
+func NewFromSlice[T any](slice []T) *Deque[T] {
+	deque := &Deque[T]{values: make([]T, utils.Max(MinCapacity, len(slice)))}
+	deque.size = copy(deque.values, slice)
+
+	return deque
+}
+
+// NewFromIterator instantiates a new deque containing the elements provided by the passed iterator.
+func NewFromIterator[T any](begin ds.ReadForIterator[T]) *Deque[T] {
+	length := 0
+	sizedIterator, ok := begin.(ds.SizedIterator)
+	if ok {
+		length = sizedIterator.Size()
+	}
+
+	length = utils.Max(length, 0)
+	elements := make([]T, 0, length)
+
+	for begin.Next() {
+		newItem, _ := begin.Get()
+		elements = append(elements, newItem)
+	}
+
+	return NewFromSlice(elements)
+}
+
+// NewFromIterators instantiates a new deque containing the elements provided by first, until it is equal to end.
+// end is a sentinel and not included.
+func NewFromIterators[T any](begin ds.ReadCompForIterator[T], end ds.ComparableIterator) *Deque[T] {
+	length := 0
+	sizedFirst, ok := begin.(ds.OrderedIterator)
+	sizedLast, ok2 := end.(ds.OrderedIterator)
+	if ok && ok2 {
+		length = -sizedFirst.DistanceTo(sizedLast)
+		if length < 0 {
+			length = 0
+		}
+	}
+
+	elements := make([]T, 0, length)
+
+	for !begin.IsEqual(end) && begin.Next() {
+		newItem, _ := begin.Get()
+		elements = append(elements, newItem)
+	}
+
+	return NewFromSlice(elements)
+}
+
+// PushFront adds a value to the front of the deque.
+func (deque *Deque[T]) PushFront(value T) {
+	deque.growIfFull()
+
+	deque.start = deque.physicalIndex(-1)
+	deque.values[deque.start] = value
+	deque.size++
+	deque.modifications.Modified()
+}
+
+// PushBack adds a value to the back of the deque.
+func (deque *Deque[T]) PushBack(value T) {
+	deque.growIfFull()
+
+	deque.values[deque.physicalIndex(deque.size)] = value
+	deque.size++
+	deque.modifications.Modified()
+}
+
+// PopFront removes the first element of the deque and returns it.
+// Second return parameter is true, unless the deque was empty and there was nothing to pop.
+func (deque *Deque[T]) PopFront() (value T, ok bool) {
+	if deque.IsEmpty() {
+		return
+	}
+
+	var zero T
+
+	value, ok = deque.values[deque.start], true
+	deque.values[deque.start] = zero
+
+	deque.start = deque.physicalIndex(1)
+	deque.size--
+	deque.modifications.Modified()
+
+	deque.shrinkIfSparse()
+
+	return
+}
+
+// PopBack removes the last element of the deque and returns it.
+// Second return parameter is true, unless the deque was empty and there was nothing to pop.
+func (deque *Deque[T]) PopBack() (value T, ok bool) {
+	if deque.IsEmpty() {
+		return
+	}
+
+	var zero T
+
+	i := deque.physicalIndex(deque.size - 1)
+	value, ok = deque.values[i], true
+	deque.values[i] = zero
+
+	deque.size--
+	deque.modifications.Modified()
+
+	deque.shrinkIfSparse()
+
+	return
+}
+
+// PeekFront returns the first element of the deque without removing it.
+// Second return parameter is true, unless the deque was empty and there was nothing to peek.
+func (deque *Deque[T]) PeekFront() (value T, ok bool) {
+	return deque.Get(0)
+}
+
+// PeekBack returns the last element of the deque without removing it.
+// Second return parameter is true, unless the deque was empty and there was nothing to peek.
+func (deque *Deque[T]) PeekBack() (value T, ok bool) {
+	return deque.Get(deque.size - 1)
+}
+
+// Get returns the element at index, counted from the front of the deque.
+// Second return parameter is true if index is within bounds of the deque, otherwise false.
+func (deque *Deque[T]) Get(index int) (value T, ok bool) {
+	if !deque.withinRange(index) {
+		return
+	}
+
+	return deque.values[deque.physicalIndex(index)], true
+}
+
+// Set overwrites the element at index, counted from the front of the deque.
+// Does nothing if index is out of bounds.
+func (deque *Deque[T]) Set(index int, value T) {
+	if !deque.withinRange(index) {
+		return
+	}
+
+	deque.values[deque.physicalIndex(index)] = value
+}
+
+// Empty returns true if deque does not contain any elements.
+func (deque *Deque[T]) IsEmpty() bool {
+	return deque.size == 0
+}
+
+// Size returns number of elements within the deque.
+func (deque *Deque[T]) Size() int {
+	return deque.size
+}
+
+// Capacity returns the number of elements the deque can hold without growing.
+func (deque *Deque[T]) Capacity() int {
+	return len(deque.values)
+}
+
+// Clear removes all elements from the deque.
+func (deque *Deque[T]) Clear() {
+	deque.values = make([]T, MinCapacity)
+	deque.start = 0
+	deque.size = 0
+	deque.modifications.Modified()
+}
+
+// Values returns all elements in the deque from front to back.
+func (deque *Deque[T]) GetValues() []T {
+	values := make([]T, deque.size)
+
+	n := copy(values, deque.values[deque.start:])
+	copy(values[n:], deque.values[:deque.start])
+
+	return values
+}
+
+// String returns a string representation of container
+func (deque *Deque[T]) ToString() string {
+	str := "RingBufferDeque\n"
+	values := []string{}
+	for _, value := range deque.GetValues() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+
+	return str
+}
+
+// Clone returns a copy of the deque with the same capacity, which does not share its buffer with the original.
+func (deque *Deque[T]) Clone() *Deque[T] {
+	values := make([]T, len(deque.values))
+	copy(values, deque.values)
+
+	return &Deque[T]{values: values, start: deque.start, size: deque.size}
+}
+
+// Equals returns true if both deques hold equal values in the same order, regardless of their capacities.
+func (deque *Deque[T]) Equals(other *Deque[T], comparator utils.Comparator[T]) bool {
+	if deque.size != other.size {
+		return false
+	}
+
+	for i := 0; i < deque.size; i++ {
+		if comparator(deque.values[deque.physicalIndex(i)], other.values[other.physicalIndex(i)]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Check that the index is within bounds of the deque
+func (deque *Deque[T]) withinRange(index int) bool {
+	return index >= 0 && index < deque.size
+}
+
+// physicalIndex maps an index relative to the front of the deque to an index into the buffer.
+func (deque *Deque[T]) physicalIndex(index int) int {
+	i := (deque.start + index) % len(deque.values)
+	if i < 0 {
+		i += len(deque.values)
+	}
+
+	return i
+}
+
+func (deque *Deque[T]) growIfFull() {
+	if deque.size == len(deque.values) {
+		deque.resize(utils.Max(MinCapacity, 2*len(deque.values)))
+	}
+}
+
+func (deque *Deque[T]) shrinkIfSparse() {
+	if len(deque.values) > MinCapacity && deque.size <= len(deque.values)/4 {
+		deque.resize(utils.Max(MinCapacity, len(deque.values)/2))
+	}
+}
+
+// resize moves the elements into a new buffer of the given capacity, so that the front is at index 0.
+func (deque *Deque[T]) resize(capacity int) {
+	values := make([]T, capacity)
+
+	if deque.size > 0 {
+		n := copy(values, deque.values[deque.start:utils.Min(len(deque.values), deque.start+deque.size)])
+		copy(values[n:deque.size], deque.values)
+	}
+
+	deque.values = values
+	deque.start = 0
+}
+
+//******************************************************************//
+//                             Iterator                             //
+//******************************************************************//
+
+// Begin returns an initialized iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (deque *Deque[T]) Begin() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return deque.NewIterator(-1, deque.Size())
+}
+
+// End returns an initialized iterator, which points to one element afrer it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (deque *Deque[T]) End() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return deque.NewIterator(deque.Size(), deque.Size())
+}
+
+// First returns an initialized iterator, which points to it's first element.
+func (deque *Deque[T]) First() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return deque.NewIterator(0, deque.Size())
+}
+
+// Last returns an initialized iterator, which points to it's last element.
+func (deque *Deque[T]) Last() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return deque.NewIterator(deque.Size()-1, deque.Size())
+}
+
+// All returns a sequence over the index/value pairs of the deque in iteration order.
+func (deque *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range ds.Seq(deque.Begin()) {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns a sequence over the index/value pairs of the deque in reverse iteration order.
+func (deque *Deque[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := deque.Size() - 1
+		for value := range ds.BackwardSeq(deque.End()) {
+			if !yield(i, value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// Values returns a sequence over the values of the deque in iteration order.
+func (deque *Deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(deque.Begin())(yield)
+	}
+}
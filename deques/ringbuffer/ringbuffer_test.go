@@ -0,0 +1,649 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ringbuffer
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWrapped creates a deque, whose elements wrap around the end of the buffer.
+func newWrapped(values ...string) *Deque[string] {
+	deque := New[string]()
+
+	for i := len(values) - 1; i >= 0; i-- {
+		deque.PushFront(values[i])
+	}
+
+	return deque
+}
+
+func TestRingBufferDequeGetValues(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalDeque *Deque[string]
+		values        []string
+	}{
+		{
+			name:          "empty deque",
+			originalDeque: New[string](),
+			values:        []string{},
+		},
+		{
+			name:          "3 items",
+			originalDeque: New[string]("foo", "bar", "baz"),
+			values:        []string{"foo", "bar", "baz"},
+		},
+		{
+			name:          "3 items, wrapped",
+			originalDeque: newWrapped("foo", "bar", "baz"),
+			values:        []string{"foo", "bar", "baz"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			values := test.originalDeque.GetValues()
+
+			assert.Equalf(t, test.values, values, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeIsEmpty(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalDeque *Deque[string]
+		isEmpty       bool
+	}{
+		{
+			name:          "empty deque",
+			originalDeque: New[string](),
+			isEmpty:       true,
+		},
+		{
+			name:          "3 items",
+			originalDeque: New[string]("foo", "bar", "baz"),
+			isEmpty:       false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			isEmpty := test.originalDeque.IsEmpty()
+
+			assert.Equalf(t, test.isEmpty, isEmpty, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeClear(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalDeque *Deque[string]
+	}{
+		{
+			name:          "empty deque",
+			originalDeque: New[string](),
+		},
+		{
+			name:          "3 items, wrapped",
+			originalDeque: newWrapped("foo", "bar", "baz"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.originalDeque.Clear()
+
+			assert.Truef(t, test.originalDeque.IsEmpty(), test.name)
+			assert.Equalf(t, []string{}, test.originalDeque.GetValues(), test.name)
+		})
+	}
+}
+
+func TestRingBufferDequePush(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalDeque *Deque[string]
+		value         string
+		front         bool
+		newItems      []string
+	}{
+		{
+			name:          "empty deque, front",
+			originalDeque: New[string](),
+			value:         "foo",
+			front:         true,
+			newItems:      []string{"foo"},
+		},
+		{
+			name:          "empty deque, back",
+			originalDeque: New[string](),
+			value:         "foo",
+			front:         false,
+			newItems:      []string{"foo"},
+		},
+		{
+			name:          "3 items, front",
+			originalDeque: New[string]("foo", "bar", "baz"),
+			value:         "qux",
+			front:         true,
+			newItems:      []string{"qux", "foo", "bar", "baz"},
+		},
+		{
+			name:          "3 items, back",
+			originalDeque: New[string]("foo", "bar", "baz"),
+			value:         "qux",
+			front:         false,
+			newItems:      []string{"foo", "bar", "baz", "qux"},
+		},
+		{
+			name:          "3 items, wrapped, back",
+			originalDeque: newWrapped("foo", "bar", "baz"),
+			value:         "qux",
+			front:         false,
+			newItems:      []string{"foo", "bar", "baz", "qux"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			if test.front {
+				test.originalDeque.PushFront(test.value)
+			} else {
+				test.originalDeque.PushBack(test.value)
+			}
+
+			assert.Equalf(t, test.newItems, test.originalDeque.GetValues(), test.name)
+		})
+	}
+}
+
+func TestRingBufferDequePop(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalDeque *Deque[string]
+		front         bool
+		value         string
+		found         bool
+		newItems      []string
+	}{
+		{
+			name:          "empty deque, front",
+			originalDeque: New[string](),
+			front:         true,
+			found:         false,
+			newItems:      []string{},
+		},
+		{
+			name:          "empty deque, back",
+			originalDeque: New[string](),
+			front:         false,
+			found:         false,
+			newItems:      []string{},
+		},
+		{
+			name:          "3 items, front",
+			originalDeque: New[string]("foo", "bar", "baz"),
+			front:         true,
+			value:         "foo",
+			found:         true,
+			newItems:      []string{"bar", "baz"},
+		},
+		{
+			name:          "3 items, back",
+			originalDeque: New[string]("foo", "bar", "baz"),
+			front:         false,
+			value:         "baz",
+			found:         true,
+			newItems:      []string{"foo", "bar"},
+		},
+		{
+			name:          "3 items, wrapped, back",
+			originalDeque: newWrapped("foo", "bar", "baz"),
+			front:         false,
+			value:         "baz",
+			found:         true,
+			newItems:      []string{"foo", "bar"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			var value string
+			var found bool
+
+			if test.front {
+				value, found = test.originalDeque.PopFront()
+			} else {
+				value, found = test.originalDeque.PopBack()
+			}
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+			assert.Equalf(t, test.newItems, test.originalDeque.GetValues(), test.name)
+		})
+	}
+}
+
+func TestRingBufferDequePeek(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalDeque *Deque[string]
+		found         bool
+		front         string
+		back          string
+	}{
+		{
+			name:          "empty deque",
+			originalDeque: New[string](),
+			found:         false,
+		},
+		{
+			name:          "1 item",
+			originalDeque: New[string]("foo"),
+			found:         true,
+			front:         "foo",
+			back:          "foo",
+		},
+		{
+			name:          "3 items, wrapped",
+			originalDeque: newWrapped("foo", "bar", "baz"),
+			found:         true,
+			front:         "foo",
+			back:          "baz",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			front, found := test.originalDeque.PeekFront()
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.front, front, test.name)
+
+			back, found := test.originalDeque.PeekBack()
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.back, back, test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeGetSet(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalDeque *Deque[string]
+		index         int
+		found         bool
+		value         string
+		newItems      []string
+	}{
+		{
+			name:          "empty deque",
+			originalDeque: New[string](),
+			index:         0,
+			found:         false,
+			newItems:      []string{},
+		},
+		{
+			name:          "negative index",
+			originalDeque: New[string]("foo", "bar", "baz"),
+			index:         -1,
+			found:         false,
+			newItems:      []string{"foo", "bar", "baz"},
+		},
+		{
+			name:          "index out of bounds",
+			originalDeque: New[string]("foo", "bar", "baz"),
+			index:         3,
+			found:         false,
+			newItems:      []string{"foo", "bar", "baz"},
+		},
+		{
+			name:          "3 items, wrapped",
+			originalDeque: newWrapped("foo", "bar", "baz"),
+			index:         2,
+			found:         true,
+			value:         "baz",
+			newItems:      []string{"foo", "bar", "qux"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			value, found := test.originalDeque.Get(test.index)
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+
+			test.originalDeque.Set(test.index, "qux")
+
+			assert.Equalf(t, test.newItems, test.originalDeque.GetValues(), test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeGrowAndShrink(t *testing.T) {
+	deque := New[int]()
+	values := []int{}
+
+	for i := 0; i < 10*MinCapacity; i++ {
+		if i%2 == 0 {
+			deque.PushFront(i)
+			values = append([]int{i}, values...)
+		} else {
+			deque.PushBack(i)
+			values = append(values, i)
+		}
+	}
+
+	assert.Equal(t, values, deque.GetValues())
+	assert.GreaterOrEqual(t, deque.Capacity(), deque.Size())
+
+	for i := 0; i < 9*MinCapacity; i++ {
+		if i%2 == 0 {
+			deque.PopFront()
+			values = values[1:]
+		} else {
+			deque.PopBack()
+			values = values[:len(values)-1]
+		}
+
+		assert.Equal(t, len(values), deque.Size())
+	}
+
+	assert.Equal(t, values, deque.GetValues())
+	assert.LessOrEqual(t, deque.Capacity(), 4*MinCapacity)
+}
+
+func TestRingBufferDequeNewFromSlice(t *testing.T) {
+	slice := []string{"foo", "bar", "baz"}
+	deque := NewFromSlice(slice)
+
+	deque.Set(0, "qux")
+
+	assert.Equal(t, []string{"qux", "bar", "baz"}, deque.GetValues())
+	assert.Equal(t, []string{"foo", "bar", "baz"}, slice)
+}
+
+func TestRingBufferDequeNewFromIterator(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalDeque *Deque[string]
+	}{
+		{
+			name:          "empty deque",
+			originalDeque: New[string](),
+		},
+		{
+			name:          "single item",
+			originalDeque: New[string]("foo"),
+		},
+		{
+			name:          "3 items, wrapped",
+			originalDeque: newWrapped("foo", "bar", "baz"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.originalDeque.Begin()
+			newDeque := NewFromIterator[string](it)
+
+			assert.Equalf(t, test.originalDeque.GetValues(), newDeque.GetValues(), test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeNewFromIterators(t *testing.T) {
+	tests := []struct {
+		name              string
+		originalDeque     *Deque[string]
+		iteratorInitFirst func(*Deque[string]) ds.ReadWriteOrdCompBidRandCollIterator[int, string]
+		iteratorInitEnd   func(*Deque[string]) ds.ReadWriteOrdCompBidRandCollIterator[int, string]
+	}{
+		{
+			name:              "empty deque",
+			originalDeque:     New[string](),
+			iteratorInitFirst: (*Deque[string]).Begin,
+			iteratorInitEnd:   (*Deque[string]).End,
+		},
+		{
+			name:              "3 items, wrapped",
+			originalDeque:     newWrapped("foo", "bar", "baz"),
+			iteratorInitFirst: (*Deque[string]).Begin,
+			iteratorInitEnd:   (*Deque[string]).End,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			first := test.iteratorInitFirst(test.originalDeque)
+			end := test.iteratorInitEnd(test.originalDeque)
+			newDeque := NewFromIterators[string](first, end)
+
+			assert.Equalf(t, test.originalDeque.GetValues(), newDeque.GetValues(), test.name)
+		})
+	}
+}
+
+func TestRingBufferDequeSerialization(t *testing.T) {
+	deque := newWrapped("foo", "bar", "baz")
+
+	json, err := deque.ToJSON()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `["foo","bar","baz"]`, string(json))
+
+	newDeque := New[string]("qux")
+	err = newDeque.FromJSON(json)
+	assert.NoError(t, err)
+	assert.Equal(t, deque.GetValues(), newDeque.GetValues())
+
+	err = newDeque.FromJSON([]byte(`{}`))
+	assert.Error(t, err)
+	assert.Equal(t, deque.GetValues(), newDeque.GetValues())
+}
+
+func BenchmarkRingBufferDequePushFront(b *testing.B) {
+	b.StopTimer()
+	variants := []struct {
+		name string
+		f    func(n int, name string)
+	}{
+		{
+			name: "Ours",
+			f: func(n int, name string) {
+				m := New[string]()
+				b.StartTimer()
+				for i := 0; i < n; i++ {
+					m.PushFront("foo")
+				}
+				b.StopTimer()
+				require.Equalf(b, n, m.Size(), name)
+			},
+		},
+		{
+			name: "Raw",
+			f: func(n int, name string) {
+				m := make([]string, 0)
+				b.StartTimer()
+				for i := 0; i < n; i++ {
+					m = append([]string{"foo"}, m...)
+				}
+				b.StopTimer()
+				require.Equalf(b, n, len(m), name)
+			},
+		},
+	}
+
+	for _, variant := range variants {
+		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
+	}
+}
+
+func TestRingBufferClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Deque[string]
+	}{
+		{
+			name:     "empty",
+			original: New[string](),
+		},
+		{
+			name:     "3 items",
+			original: New[string]("foo", "bar", "baz"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			values := test.original.GetValues()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+
+			clone.PushFront("qux")
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+		})
+	}
+}
+
+func TestRingBufferEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Deque[string]
+		b     *Deque[string]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     New[string](),
+			b:     New[string](),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     New[string]("foo", "bar", "baz"),
+			b:     New[string]("foo", "bar", "baz"),
+			equal: true,
+		},
+		{
+			name:  "different order",
+			a:     New[string]("foo", "bar", "baz"),
+			b:     New[string]("baz", "bar", "foo"),
+			equal: false,
+		},
+		{
+			name:  "different values",
+			a:     New[string]("foo", "bar"),
+			b:     New[string]("foo", "qux"),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     New[string]("foo"),
+			b:     New[string]("foo", "bar"),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[string]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	deque := New[int](2, 3)
+	deque.PushFront(1)
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range deque.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	indices = []int{}
+	values = []int{}
+	for i, value := range deque.Backward() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{2, 1, 0}, indices)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(deque.Values()))
+
+	values = []int{}
+	for value := range deque.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ringbuffer
+
+import (
+	"encoding/json"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+)
+
+// Assert Serialization implementation
+var _ ds.JSONSerializer = (*Deque[any])(nil)
+var _ ds.JSONDeserializer = (*Deque[any])(nil)
+
+// ToJSON outputs the JSON representation of the deque's elements from front to back.
+func (deque *Deque[T]) ToJSON() ([]byte, error) {
+	return json.Marshal(deque.GetValues())
+}
+
+// FromJSON populates the deque's elements from the input JSON representation.
+// The previous elements are replaced.
+func (deque *Deque[T]) FromJSON(data []byte) error {
+	var values []T
+	err := json.Unmarshal(data, &values)
+	if err == nil {
+		*deque = *NewFromSlice(values)
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (deque *Deque[T]) UnmarshalJSON(bytes []byte) error {
+	return deque.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (deque *Deque[T]) MarshalJSON() ([]byte, error) {
+	return deque.ToJSON()
+}
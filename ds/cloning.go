@@ -0,0 +1,22 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ds
+
+import "github.com/JonasMuehlmann/datastructures.go/utils"
+
+// Cloneable provides copying of a container.
+type Cloneable[TContainer any] interface {
+	// Clone returns a copy of the container, which does not share any internal state with the original.
+	// The elements themselves are copied shallowly.
+	Clone() TContainer
+}
+
+// Equatable provides deep comparison of two containers of the same type.
+type Equatable[TContainer any, TValue any] interface {
+	// Equals returns true if both containers hold the same elements in the same order,
+	// where the order is only relevant for containers which define it.
+	// Values are compared with valueComparator, keys and hashed elements are compared by the container itself.
+	Equals(other TContainer, valueComparator utils.Comparator[TValue]) bool
+}
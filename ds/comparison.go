@@ -0,0 +1,107 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ds
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrIncompatibleIterators is reported when comparing iterators, whose types can not be compared with each other.
+	ErrIncompatibleIterators = errors.New("iterators of incompatible types can not be compared")
+	// ErrForeignIterators is reported when comparing iterators of different containers.
+	ErrForeignIterators = errors.New("iterators of different containers can not be compared")
+)
+
+// IteratorError describes why two iterators can not be compared.
+type IteratorError struct {
+	Iterator Iterator
+	Other    Iterator
+	// Err is either ErrIncompatibleIterators or ErrForeignIterators.
+	Err error
+}
+
+// NewIteratorError returns an error reporting, that it can not be compared with other for the reason err.
+func NewIteratorError(it Iterator, other Iterator, err error) *IteratorError {
+	return &IteratorError{Iterator: it, Other: other, Err: err}
+}
+
+func (err *IteratorError) Error() string {
+	return fmt.Sprintf("%v: %T and %T", err.Err, err.Iterator, err.Other)
+}
+
+func (err *IteratorError) Unwrap() error {
+	return err.Err
+}
+
+// CheckedIterator defines an Iterator, which can check if it can be compared with another iterator,
+// before IsEqual, IsBefore, IsAfter or DistanceTo panic.
+type CheckedIterator interface {
+	// *********************    Inherited methods    ********************//
+	Iterator
+	// ************************    Own methods    ***********************//
+
+	// CanCompare returns an *IteratorError if other is of a type, which the iterator can not be compared with,
+	// or belongs to another container, otherwise nil.
+	CanCompare(other Iterator) error
+}
+
+// CanCompare returns an *IteratorError if it can not be compared with other, otherwise nil.
+//
+// Iterators, which do not implement CheckedIterator, can only be compared with iterators of their own type
+// and are not checked to belong to the same container.
+func CanCompare(it Iterator, other Iterator) error {
+	if it == nil || other == nil {
+		return NewIteratorError(it, other, ErrIncompatibleIterators)
+	}
+
+	if checked, ok := it.(CheckedIterator); ok {
+		return checked.CanCompare(other)
+	}
+
+	if reflect.TypeOf(it) != reflect.TypeOf(other) {
+		return NewIteratorError(it, other, ErrIncompatibleIterators)
+	}
+
+	return nil
+}
+
+// CheckedIsEqual is like it.IsEqual(other), but returns an error instead of panicking for incomparable iterators.
+func CheckedIsEqual(it ComparableIterator, other ComparableIterator) (bool, error) {
+	if err := CanCompare(it, other); err != nil {
+		return false, err
+	}
+
+	return it.IsEqual(other), nil
+}
+
+// CheckedIsBefore is like it.IsBefore(other), but returns an error instead of panicking for incomparable iterators.
+func CheckedIsBefore(it OrderedIterator, other OrderedIterator) (bool, error) {
+	if err := CanCompare(it, other); err != nil {
+		return false, err
+	}
+
+	return it.IsBefore(other), nil
+}
+
+// CheckedIsAfter is like it.IsAfter(other), but returns an error instead of panicking for incomparable iterators.
+func CheckedIsAfter(it OrderedIterator, other OrderedIterator) (bool, error) {
+	if err := CanCompare(it, other); err != nil {
+		return false, err
+	}
+
+	return it.IsAfter(other), nil
+}
+
+// CheckedDistanceTo is like it.DistanceTo(other), but returns an error instead of panicking for incomparable iterators.
+func CheckedDistanceTo(it OrderedIterator, other OrderedIterator) (int, error) {
+	if err := CanCompare(it, other); err != nil {
+		return 0, err
+	}
+
+	return it.DistanceTo(other), nil
+}
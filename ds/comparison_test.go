@@ -0,0 +1,83 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ds
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// indexIterator is a minimal OrderedIterator and ComparableIterator, which does not implement CheckedIterator.
+type indexIterator[T any] struct {
+	index int
+}
+
+func (it *indexIterator[T]) IsBegin() bool { return it.index < 0 }
+func (it *indexIterator[T]) IsEnd() bool   { return false }
+func (it *indexIterator[T]) IsFirst() bool { return it.index == 0 }
+func (it *indexIterator[T]) IsLast() bool  { return false }
+func (it *indexIterator[T]) IsValid() bool { return it.index >= 0 }
+
+func (it *indexIterator[T]) DistanceTo(other OrderedIterator) int {
+	return it.index - other.(*indexIterator[T]).index
+}
+
+func (it *indexIterator[T]) IsBefore(other OrderedIterator) bool { return it.DistanceTo(other) < 0 }
+func (it *indexIterator[T]) IsAfter(other OrderedIterator) bool  { return it.DistanceTo(other) > 0 }
+
+func (it *indexIterator[T]) IsEqual(other ComparableIterator) bool {
+	return it.index == other.(*indexIterator[T]).index
+}
+
+func TestCheckedComparisons(t *testing.T) {
+	first := &indexIterator[int]{index: 1}
+	second := &indexIterator[int]{index: 3}
+
+	equal, err := CheckedIsEqual(first, second)
+	assert.NoError(t, err)
+	assert.False(t, equal)
+
+	before, err := CheckedIsBefore(first, second)
+	assert.NoError(t, err)
+	assert.True(t, before)
+
+	after, err := CheckedIsAfter(first, second)
+	assert.NoError(t, err)
+	assert.False(t, after)
+
+	distance, err := CheckedDistanceTo(first, second)
+	assert.NoError(t, err)
+	assert.Equal(t, -2, distance)
+}
+
+func TestCheckedComparisonsIncompatible(t *testing.T) {
+	it := &indexIterator[int]{index: 1}
+	other := &indexIterator[string]{index: 1}
+
+	_, err := CheckedIsEqual(it, other)
+	assert.ErrorIs(t, err, ErrIncompatibleIterators)
+
+	_, err = CheckedIsBefore(it, other)
+	assert.ErrorIs(t, err, ErrIncompatibleIterators)
+
+	_, err = CheckedIsAfter(it, other)
+	assert.ErrorIs(t, err, ErrIncompatibleIterators)
+
+	distance, err := CheckedDistanceTo(it, other)
+	assert.ErrorIs(t, err, ErrIncompatibleIterators)
+	assert.Equal(t, 0, distance)
+
+	var iteratorErr *IteratorError
+	if assert.True(t, errors.As(err, &iteratorErr)) {
+		assert.Same(t, it, iteratorErr.Iterator)
+		assert.Same(t, other, iteratorErr.Other)
+	}
+
+	assert.Equal(t, "iterators of incompatible types can not be compared: *ds.indexIterator[int] and *ds.indexIterator[string]", err.Error())
+
+	assert.ErrorIs(t, CanCompare(it, nil), ErrIncompatibleIterators)
+}
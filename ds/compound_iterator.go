@@ -49,6 +49,24 @@ type ReadWriteOrdCompBidRandCollIterator[TKey any, TValue any] interface {
 	RandomAccessWriteableIterator[TKey, TValue]
 }
 
+type ReadOrdCompBidRandCollIterator[TKey any, TValue any] interface {
+	ComparableIterator
+	OrderedIterator
+	BidirectionalIterator
+	RandomAccessReadableIterator[TKey, TValue]
+}
+
+type ReadWriteBidIterator[TValue any] interface {
+	ReadableIterator[TValue]
+	WritableIterator[TValue]
+	BidirectionalIterator
+}
+
+type ReadBidIterator[TValue any] interface {
+	ReadableIterator[TValue]
+	BidirectionalIterator
+}
+
 type ReadForIterator[TValue any] interface {
 	ReadableIterator[TValue]
 	ForwardIterator
@@ -82,3 +100,14 @@ type ReadCompIterator[TValue any] interface {
 	ReadableIterator[TValue]
 	ComparableIterator
 }
+
+type ReadBackIterator[TValue any] interface {
+	ReadableIterator[TValue]
+	BackwardIterator
+}
+
+type ReadBackIndexIterator[TKey any, TValue any] interface {
+	ReadableIterator[TValue]
+	BackwardIterator
+	IndexedIterator[TKey]
+}
@@ -32,6 +32,7 @@ type Iterator interface {
 }
 
 // OrderedIterator defines an Iterator, which can be said to be in a position before or after others's position.
+// Comparing iterators of incompatible types panics, CheckedIsBefore, CheckedIsAfter and CheckedDistanceTo return an error instead.
 type OrderedIterator interface {
 	// *********************    Inherited methods    ********************//
 	Iterator
@@ -47,6 +48,7 @@ type OrderedIterator interface {
 }
 
 // ComparableIterator defines an Iterator, which can be said to be in a position equal to other's position.
+// Comparing iterators of incompatible types panics, CheckedIsEqual returns an error instead.
 type ComparableIterator interface {
 	// *********************    Inherited methods    ********************//
 	Iterator
@@ -196,3 +198,57 @@ type RandomAccessWriteableIterator[TKey any, TValue any] interface {
 	// GetAtKey sets the value at the given key of the iterator.
 	SetAtKey(i TKey, value TValue) bool
 }
+
+// ConstantTimeAccessIterator defines an Iterator, which can be moved to, read from and written to at every index in O(1).
+// Algorithms work on the indices of such iterators instead of buffering the elements.
+type ConstantTimeAccessIterator interface {
+	// *********************    Inherited methods    ********************//
+	Iterator
+	// ************************    Own methods    ***********************//
+
+	// HasConstantTimeAccess returns true if MoveTo, GetAt and SetAt execute in O(1).
+	HasConstantTimeAccess() bool
+}
+
+// ErasableIterator defines an Iterator, which can be used to remove the underlying values.
+type ErasableIterator interface {
+	// *********************    Inherited methods    ********************//
+	Iterator
+	// ************************    Own methods    ***********************//
+
+	// Remove removes the value at the iterator's position and moves the iterator to the next element.
+	// Returns false if the iterator is not in a valid position.
+	Remove() bool
+}
+
+// InsertableIterator defines an Iterator, which can be used to insert values next to the iterator's position.
+type InsertableIterator[TValue any] interface {
+	// *********************    Inherited methods    ********************//
+	Iterator
+	// ************************    Own methods    ***********************//
+
+	// InsertBefore inserts value before the iterator's position, the iterator keeps pointing to the same element.
+	// Inserting at the end appends value, returns false if the iterator points to one element before it's first.
+	InsertBefore(value TValue) bool
+
+	// InsertAfter inserts value after the iterator's position, the iterator keeps pointing to the same element.
+	// Inserting at the beginning prepends value, returns false if the iterator points to one element after it's last.
+	InsertAfter(value TValue) bool
+}
+
+// KeyedInsertableIterator defines an Iterator, which can be used to insert key/value pairs next to the iterator's position.
+type KeyedInsertableIterator[TKey any, TValue any] interface {
+	// *********************    Inherited methods    ********************//
+	Iterator
+	// ************************    Own methods    ***********************//
+
+	// InsertBefore inserts the key/value pair before the iterator's position, the iterator keeps pointing to the same element.
+	// Inserting at the end appends the pair, returns false if the iterator points to one element before it's first
+	// or the pair can not be inserted at the position, e.g. because key is already contained or would violate an ordering.
+	InsertBefore(key TKey, value TValue) bool
+
+	// InsertAfter inserts the key/value pair after the iterator's position, the iterator keeps pointing to the same element.
+	// Inserting at the beginning prepends the pair, returns false if the iterator points to one element after it's last
+	// or the pair can not be inserted at the position, e.g. because key is already contained or would violate an ordering.
+	InsertAfter(key TKey, value TValue) bool
+}
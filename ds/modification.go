@@ -0,0 +1,92 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ds
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// ErrConcurrentModification is reported by fail-fast iterators,
+// whose container was structurally modified after their creation, other than through the iterator itself.
+var ErrConcurrentModification = errors.New("container was structurally modified after the iterator was created")
+
+// FailFastIterator defines an Iterator, which detects structural modifications of its container.
+//
+// Once the container was modified, other than through the iterator itself, the iterator is no longer valid
+// and does not read or write any elements.
+type FailFastIterator interface {
+	// *********************    Inherited methods    ********************//
+	Iterator
+	// ************************    Own methods    ***********************//
+
+	// Err returns ErrConcurrentModification if the iterator's container was structurally modified, otherwise nil.
+	// In strict mode, Err panics instead.
+	Err() error
+}
+
+var strictIterators atomic.Bool
+
+// SetStrictIterators enables or disables the strict mode of all fail-fast iterators.
+// In strict mode, iterators panic when they are used after their container was structurally modified,
+// which helps to find the code path modifying the container.
+func SetStrictIterators(strict bool) {
+	strictIterators.Store(strict)
+}
+
+// ModificationCounter counts the structural modifications of a container, i.e. those, which add, remove or relink elements.
+//
+// The counter is only allocated once the first iterator is created,
+// so that modifications of containers without iterators cost nothing and leave equal containers deeply equal.
+// The zero value is ready to use.
+type ModificationCounter struct {
+	count atomic.Pointer[uint64]
+}
+
+// Modified records a structural modification.
+func (counter *ModificationCounter) Modified() {
+	if count := counter.count.Load(); count != nil {
+		*count++
+	}
+}
+
+// Guard returns a guard, which detects all modifications recorded after this call.
+func (counter *ModificationCounter) Guard() ModificationGuard {
+	count := counter.count.Load()
+	if count == nil {
+		counter.count.CompareAndSwap(nil, new(uint64))
+		count = counter.count.Load()
+	}
+
+	return ModificationGuard{count: count, expected: *count}
+}
+
+// ModificationGuard is used by an iterator to detect modifications of its container.
+// The zero value never reports a modification.
+type ModificationGuard struct {
+	count    *uint64
+	expected uint64
+}
+
+// Err returns ErrConcurrentModification if a modification was recorded since the guard was created or synced.
+// In strict mode, Err panics instead.
+func (guard *ModificationGuard) Err() error {
+	if guard.count == nil || *guard.count == guard.expected {
+		return nil
+	}
+
+	if strictIterators.Load() {
+		panic("Iterator used after its container was structurally modified, other than through the iterator itself")
+	}
+
+	return ErrConcurrentModification
+}
+
+// Sync accepts all recorded modifications, it is called after an iterator modified its container itself.
+func (guard *ModificationGuard) Sync() {
+	if guard.count != nil {
+		guard.expected = *guard.count
+	}
+}
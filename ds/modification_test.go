@@ -0,0 +1,51 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModificationGuard(t *testing.T) {
+	var counter ModificationCounter
+
+	// Modifications before the first guard are not counted
+	counter.Modified()
+	assert.Nil(t, counter.count.Load())
+
+	guard := counter.Guard()
+	assert.NoError(t, guard.Err())
+
+	counter.Modified()
+	assert.ErrorIs(t, guard.Err(), ErrConcurrentModification)
+
+	newGuard := counter.Guard()
+	assert.NoError(t, newGuard.Err())
+
+	guard.Sync()
+	assert.NoError(t, guard.Err())
+}
+
+func TestModificationGuardZeroValue(t *testing.T) {
+	var guard ModificationGuard
+
+	guard.Sync()
+	assert.NoError(t, guard.Err())
+}
+
+func TestModificationGuardStrict(t *testing.T) {
+	SetStrictIterators(true)
+	defer SetStrictIterators(false)
+
+	var counter ModificationCounter
+
+	guard := counter.Guard()
+	assert.NotPanics(t, func() { _ = guard.Err() })
+
+	counter.Modified()
+	assert.Panics(t, func() { _ = guard.Err() })
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ds
+
+// Reverse returns an iterator, which moves it in the opposite direction.
+// The reversed iterator's beginning is it's end, so calling Next moves it backward.
+func Reverse[T any](it ReadBidIterator[T]) ReadBidIterator[T] {
+	return &reverseIterator[T]{it}
+}
+
+// reverseIterator swaps the directions of a ReadBidIterator.
+type reverseIterator[T any] struct {
+	ReadBidIterator[T]
+}
+
+func (it *reverseIterator[T]) IsBegin() bool {
+	return it.ReadBidIterator.IsEnd()
+}
+
+func (it *reverseIterator[T]) IsEnd() bool {
+	return it.ReadBidIterator.IsBegin()
+}
+
+func (it *reverseIterator[T]) IsFirst() bool {
+	return it.ReadBidIterator.IsLast()
+}
+
+func (it *reverseIterator[T]) IsLast() bool {
+	return it.ReadBidIterator.IsFirst()
+}
+
+func (it *reverseIterator[T]) Next() bool {
+	return it.ReadBidIterator.Previous()
+}
+
+func (it *reverseIterator[T]) NextN(n int) bool {
+	return it.ReadBidIterator.PreviousN(n)
+}
+
+func (it *reverseIterator[T]) Previous() bool {
+	return it.ReadBidIterator.Next()
+}
+
+func (it *reverseIterator[T]) PreviousN(n int) bool {
+	return it.ReadBidIterator.NextN(n)
+}
+
+func (it *reverseIterator[T]) MoveBy(n int) bool {
+	return it.ReadBidIterator.MoveBy(-n)
+}
+
+func (it *reverseIterator[T]) IsReversed() bool {
+	return !IsReversed(it.ReadBidIterator)
+}
+
+// ReversedIterator defines an Iterator, which moves in the opposite direction of its indices,
+// so calling Next moves it to the next smaller index.
+type ReversedIterator interface {
+	// *********************    Inherited methods    ********************//
+	Iterator
+	// ************************    Own methods    ***********************//
+
+	// IsReversed returns true if the iterator moves in the opposite direction of its indices.
+	IsReversed() bool
+}
+
+// IsReversed checks if it moves in the opposite direction of its indices.
+func IsReversed(it Iterator) bool {
+	reversed, ok := it.(ReversedIterator)
+
+	return ok && reversed.IsReversed()
+}
+
+// Assert Iterator implementation
+var _ ReadWriteOrdCompBidRandCollIterator[int, any] = (*ReverseIterator[int, any])(nil)
+
+// Assert ReversedIterator and ConstantTimeAccessIterator implementation
+var _ ReversedIterator = (*ReverseIterator[int, any])(nil)
+var _ ConstantTimeAccessIterator = (*ReverseIterator[int, any])(nil)
+
+// Assert FailFastIterator and CheckedIterator implementation
+var _ FailFastIterator = (*ReverseIterator[int, any])(nil)
+var _ CheckedIterator = (*ReverseIterator[int, any])(nil)
+
+// ReverseIterator is like Reverse, but keeps all capabilities of a ReadWriteOrdCompBidRandCollIterator.
+//
+// Indices and keys are those of the underlying iterator, so MoveTo, GetAt and SetAt address the same elements.
+// Between two ReverseIterators, the order of the positions is reversed, so an iterator closer to the underlying end is said to come before others.
+// A ReverseIterator can also be compared with the underlying type of iterator, in both directions.
+// Such mixed comparisons use the order of the underlying iterator, so that it.DistanceTo(other) == -other.DistanceTo(it).
+type ReverseIterator[TKey any, TValue any] struct {
+	ReadWriteOrdCompBidRandCollIterator[TKey, TValue]
+}
+
+// NewReverseIterator returns an iterator, which moves it in the opposite direction.
+func NewReverseIterator[TKey any, TValue any](it ReadWriteOrdCompBidRandCollIterator[TKey, TValue]) *ReverseIterator[TKey, TValue] {
+	return &ReverseIterator[TKey, TValue]{it}
+}
+
+// Base returns the underlying iterator, which is moved by the reversed iterator.
+func (it *ReverseIterator[TKey, TValue]) Base() ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return it.ReadWriteOrdCompBidRandCollIterator
+}
+
+func (it *ReverseIterator[TKey, TValue]) IsBegin() bool {
+	return it.Base().IsEnd()
+}
+
+func (it *ReverseIterator[TKey, TValue]) IsEnd() bool {
+	return it.Base().IsBegin()
+}
+
+func (it *ReverseIterator[TKey, TValue]) IsFirst() bool {
+	return it.Base().IsLast()
+}
+
+func (it *ReverseIterator[TKey, TValue]) IsLast() bool {
+	return it.Base().IsFirst()
+}
+
+func (it *ReverseIterator[TKey, TValue]) Next() bool {
+	return it.Base().Previous()
+}
+
+func (it *ReverseIterator[TKey, TValue]) NextN(n int) bool {
+	return it.Base().PreviousN(n)
+}
+
+func (it *ReverseIterator[TKey, TValue]) Previous() bool {
+	return it.Base().Next()
+}
+
+func (it *ReverseIterator[TKey, TValue]) PreviousN(n int) bool {
+	return it.Base().NextN(n)
+}
+
+func (it *ReverseIterator[TKey, TValue]) MoveBy(n int) bool {
+	return it.Base().MoveBy(-n)
+}
+
+func (it *ReverseIterator[TKey, TValue]) IsEqual(other ComparableIterator) bool {
+	return it.Base().IsEqual(BaseOf[TKey, TValue](other))
+}
+
+func (it *ReverseIterator[TKey, TValue]) IsBefore(other OrderedIterator) bool {
+	return it.DistanceTo(other) < 0
+}
+
+func (it *ReverseIterator[TKey, TValue]) IsAfter(other OrderedIterator) bool {
+	return it.DistanceTo(other) > 0
+}
+
+// DistanceTo measures the distance in reverse if other is a ReverseIterator, otherwise in the order of the underlying iterator.
+func (it *ReverseIterator[TKey, TValue]) DistanceTo(other OrderedIterator) int {
+	if reversed, ok := other.(*ReverseIterator[TKey, TValue]); ok {
+		return -it.Base().DistanceTo(reversed.Base())
+	}
+
+	return it.Base().DistanceTo(other)
+}
+
+// CanCompare checks if other is a ReverseIterator or an iterator, which the underlying iterator can be compared with.
+func (it *ReverseIterator[TKey, TValue]) CanCompare(other Iterator) error {
+	return CanCompare(it.Base(), BaseOf[TKey, TValue](other))
+}
+
+// IsReversed returns true, unless the underlying iterator is reversed itself.
+func (it *ReverseIterator[TKey, TValue]) IsReversed() bool {
+	return !IsReversed(it.Base())
+}
+
+// HasConstantTimeAccess returns true if the underlying iterator is a ConstantTimeAccessIterator with constant time access.
+func (it *ReverseIterator[TKey, TValue]) HasConstantTimeAccess() bool {
+	constantTime, ok := it.Base().(ConstantTimeAccessIterator)
+
+	return ok && constantTime.HasConstantTimeAccess()
+}
+
+// Err returns the error of the underlying iterator, if it is a FailFastIterator.
+func (it *ReverseIterator[TKey, TValue]) Err() error {
+	if failFast, ok := it.Base().(FailFastIterator); ok {
+		return failFast.Err()
+	}
+
+	return nil
+}
+
+// BaseOf returns the underlying iterator of other, if it is a ReverseIterator, otherwise other.
+// Iterators use it to compare themselves with ReverseIterators, which are based on them.
+func BaseOf[TKey any, TValue any, TIterator Iterator](other TIterator) TIterator {
+	if reversed, ok := any(other).(*ReverseIterator[TKey, TValue]); ok {
+		if base, ok := reversed.Base().(TIterator); ok {
+			return base
+		}
+	}
+
+	return other
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (it *sliceIterator[T]) Next() bool {
+	if it.index < len(it.values) {
+		it.index++
+	}
+
+	return it.IsValid()
+}
+
+func (it *sliceIterator[T]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		it.Next()
+	}
+
+	return it.IsValid()
+}
+
+func (it *sliceIterator[T]) MoveBy(n int) bool {
+	if n < 0 {
+		return it.PreviousN(-n)
+	}
+
+	return it.NextN(n)
+}
+
+func TestReverse(t *testing.T) {
+	it := Reverse[string](&sliceIterator[string]{values: []string{"a", "b", "c"}, index: 3})
+
+	assert.True(t, it.IsBegin())
+	assert.False(t, it.IsEnd())
+	assert.True(t, IsReversed(it))
+
+	values := []string{}
+	for it.Next() {
+		value, found := it.Get()
+		assert.True(t, found)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"c", "b", "a"}, values)
+	assert.True(t, it.IsEnd())
+
+	_, found := it.Get()
+	assert.False(t, found)
+}
+
+func TestReverseMovement(t *testing.T) {
+	it := Reverse[int](&sliceIterator[int]{values: []int{1, 2, 3}, index: 3})
+
+	assert.True(t, it.Next())
+	assert.True(t, it.IsFirst())
+	assert.True(t, it.NextN(2))
+	assert.True(t, it.IsLast())
+
+	value, _ := it.Get()
+	assert.Equal(t, 1, value)
+
+	assert.True(t, it.PreviousN(1))
+	assert.True(t, it.MoveBy(-1))
+	assert.True(t, it.IsFirst())
+
+	value, _ = it.Get()
+	assert.Equal(t, 3, value)
+
+	assert.False(t, it.Previous())
+	assert.True(t, it.IsBegin())
+	assert.False(t, it.IsEnd())
+}
+
+func TestReverseTwice(t *testing.T) {
+	it := Reverse(Reverse[int](&sliceIterator[int]{values: []int{1, 2, 3}, index: -1}))
+
+	assert.False(t, IsReversed(it))
+	assert.True(t, it.Next())
+
+	value, _ := it.Get()
+	assert.Equal(t, 1, value)
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ds
+
+import "iter"
+
+// Seq returns a sequence over the values provided by the passed iterator, starting after its current position.
+// The iterator is advanced while the sequence is consumed, so the sequence can only be ranged over once.
+func Seq[TValue any](begin ReadForIterator[TValue]) iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		for begin.Next() {
+			value, _ := begin.Get()
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns a sequence over the key/value pairs provided by the passed iterator, starting after its current position.
+// The iterator is advanced while the sequence is consumed, so the sequence can only be ranged over once.
+func Seq2[TKey any, TValue any](begin ReadForIndexIterator[TKey, TValue]) iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		for begin.Next() {
+			key, _ := begin.GetKey()
+			value, _ := begin.Get()
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// BackwardSeq returns a sequence over the values provided by the passed iterator, starting before its current position
+// and moving towards the front.
+// The iterator is moved while the sequence is consumed, so the sequence can only be ranged over once.
+func BackwardSeq[TValue any](end ReadBackIterator[TValue]) iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		for end.Previous() {
+			value, _ := end.Get()
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// BackwardSeq2 returns a sequence over the key/value pairs provided by the passed iterator, starting before its current position
+// and moving towards the front.
+// The iterator is moved while the sequence is consumed, so the sequence can only be ranged over once.
+func BackwardSeq2[TKey any, TValue any](end ReadBackIndexIterator[TKey, TValue]) iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		for end.Previous() {
+			key, _ := end.GetKey()
+			value, _ := end.Get()
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Assert Iterator implementation
+var _ ReadForIndexIterator[int, any] = (*SeqIterator[int, any])(nil)
+
+// SeqIterator adapts a sequence to the iterators of this library.
+//
+// The sequence is pulled lazily, one element ahead of the iterator's position.
+// Since the length of a sequence is not known in advance, Size returns -1.
+// Stop must be called if the iterator is abandoned before reaching its end.
+type SeqIterator[TKey any, TValue any] struct {
+	next func() (TKey, TValue, bool)
+	stop func()
+
+	key   TKey
+	value TValue
+	index int
+
+	nextKey      TKey
+	nextValue    TValue
+	hasLookahead bool
+	isEnd        bool
+}
+
+// NewSeqIterator returns a stateful iterator over the values of seq, whose keys are the values' indices.
+func NewSeqIterator[TValue any](seq iter.Seq[TValue]) *SeqIterator[int, TValue] {
+	return NewSeq2Iterator(func(yield func(int, TValue) bool) {
+		i := 0
+		for value := range seq {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	})
+}
+
+// NewSeq2Iterator returns a stateful iterator over the key/value pairs of seq.
+func NewSeq2Iterator[TKey any, TValue any](seq iter.Seq2[TKey, TValue]) *SeqIterator[TKey, TValue] {
+	next, stop := iter.Pull2(seq)
+
+	return &SeqIterator[TKey, TValue]{
+		next:  next,
+		stop:  stop,
+		index: -1,
+	}
+}
+
+// Stop releases the underlying sequence, the iterator is at its end afterwards.
+func (it *SeqIterator[TKey, TValue]) Stop() {
+	it.stop()
+	it.hasLookahead = false
+	it.isEnd = true
+}
+
+func (it *SeqIterator[TKey, TValue]) IsBegin() bool {
+	return it.index == -1 && !it.isEnd
+}
+
+func (it *SeqIterator[TKey, TValue]) IsEnd() bool {
+	return it.isEnd
+}
+
+func (it *SeqIterator[TKey, TValue]) IsFirst() bool {
+	return it.IsValid() && it.index == 0
+}
+
+// IsLast returns true if the iterator points to the last element.
+// This pulls the next element of the sequence, if it was not pulled yet.
+func (it *SeqIterator[TKey, TValue]) IsLast() bool {
+	return it.IsValid() && !it.peek()
+}
+
+func (it *SeqIterator[TKey, TValue]) IsValid() bool {
+	return it.index >= 0 && !it.isEnd
+}
+
+// Size returns -1, since the length of a sequence is not known in advance.
+func (it *SeqIterator[TKey, TValue]) Size() int {
+	return -1
+}
+
+// Index returns the number of elements before the current element.
+func (it *SeqIterator[TKey, TValue]) Index() (int, bool) {
+	if !it.IsValid() {
+		return 0, false
+	}
+
+	return it.index, true
+}
+
+func (it *SeqIterator[TKey, TValue]) GetKey() (key TKey, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.key, true
+}
+
+func (it *SeqIterator[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.value, true
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the sequence.
+func (it *SeqIterator[TKey, TValue]) Next() bool {
+	if !it.peek() {
+		if !it.isEnd {
+			it.Stop()
+		}
+
+		return false
+	}
+
+	it.key, it.value = it.nextKey, it.nextValue
+	it.hasLookahead = false
+	it.index++
+
+	return true
+}
+
+func (it *SeqIterator[TKey, TValue]) NextN(n int) bool {
+	if n <= 0 {
+		return false
+	}
+
+	for i := 0; i < n; i++ {
+		if !it.Next() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *SeqIterator[TKey, TValue]) peek() bool {
+	if it.isEnd {
+		return false
+	}
+
+	if !it.hasLookahead {
+		it.nextKey, it.nextValue, it.hasLookahead = it.next()
+	}
+
+	return it.hasLookahead
+}
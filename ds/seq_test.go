@@ -0,0 +1,165 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ds
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeqIterator(t *testing.T) {
+	it := NewSeqIterator(slices.Values([]string{"a", "b", "c"}))
+
+	assert.True(t, it.IsBegin())
+	assert.False(t, it.IsValid())
+	assert.Equal(t, -1, it.Size())
+
+	assert.True(t, it.Next())
+	assert.True(t, it.IsFirst())
+	assert.False(t, it.IsLast())
+
+	value, found := it.Get()
+	assert.True(t, found)
+	assert.Equal(t, "a", value)
+
+	assert.True(t, it.NextN(2))
+	assert.True(t, it.IsLast())
+
+	index, found := it.Index()
+	assert.True(t, found)
+	assert.Equal(t, 2, index)
+
+	key, found := it.GetKey()
+	assert.True(t, found)
+	assert.Equal(t, 2, key)
+
+	value, found = it.Get()
+	assert.True(t, found)
+	assert.Equal(t, "c", value)
+
+	assert.False(t, it.Next())
+	assert.True(t, it.IsEnd())
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Next())
+
+	_, found = it.Get()
+	assert.False(t, found)
+}
+
+func TestSeqIteratorStop(t *testing.T) {
+	pulled := 0
+	it := NewSeqIterator(func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	assert.True(t, it.NextN(3))
+	assert.Equal(t, 3, pulled)
+
+	// IsLast needs to look ahead by one element
+	assert.False(t, it.IsLast())
+	assert.Equal(t, 4, pulled)
+
+	it.Stop()
+
+	assert.True(t, it.IsEnd())
+	assert.False(t, it.Next())
+	assert.Equal(t, 4, pulled)
+}
+
+func TestSeqRoundTrip(t *testing.T) {
+	values := []int{1, 2, 3}
+	assert.Equal(t, values, slices.Collect(Seq[int](NewSeqIterator(slices.Values(values)))))
+
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	assert.Equal(t, m, maps.Collect(Seq2[string, int](NewSeq2Iterator(maps.All(m)))))
+}
+
+func TestSeqBreak(t *testing.T) {
+	it := NewSeqIterator(slices.Values([]int{1, 2, 3, 4}))
+
+	values := []int{}
+	for value := range Seq[int](it) {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+
+	// The iterator is left at the last yielded element
+	value, found := it.Get()
+	assert.True(t, found)
+	assert.Equal(t, 2, value)
+
+	assert.Equal(t, []int{3, 4}, slices.Collect(Seq[int](it)))
+}
+
+func TestBackwardSeq(t *testing.T) {
+	it := &sliceIterator[string]{values: []string{"a", "b", "c"}, index: 3}
+	assert.Equal(t, []string{"c", "b", "a"}, slices.Collect(BackwardSeq[string](it)))
+
+	it = &sliceIterator[string]{values: []string{"a", "b", "c"}, index: 3}
+	keys := []int{}
+	values := []string{}
+	for key, value := range BackwardSeq2[int, string](it) {
+		keys = append(keys, key)
+		values = append(values, value)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{2, 1}, keys)
+	assert.Equal(t, []string{"c", "b"}, values)
+}
+
+// sliceIterator is a minimal backward iterator over a slice.
+type sliceIterator[T any] struct {
+	values []T
+	index  int
+}
+
+func (it *sliceIterator[T]) IsBegin() bool { return it.index == -1 }
+func (it *sliceIterator[T]) IsEnd() bool   { return it.index == len(it.values) }
+func (it *sliceIterator[T]) IsFirst() bool { return it.index == 0 }
+func (it *sliceIterator[T]) IsLast() bool  { return it.index == len(it.values)-1 }
+func (it *sliceIterator[T]) IsValid() bool { return !it.IsBegin() && !it.IsEnd() }
+func (it *sliceIterator[T]) Size() int     { return len(it.values) }
+
+func (it *sliceIterator[T]) Index() (int, bool)  { return it.index, it.IsValid() }
+func (it *sliceIterator[T]) GetKey() (int, bool) { return it.Index() }
+
+func (it *sliceIterator[T]) Get() (value T, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.values[it.index], true
+}
+
+func (it *sliceIterator[T]) Previous() bool {
+	if it.index > -1 {
+		it.index--
+	}
+
+	return it.IsValid()
+}
+
+func (it *sliceIterator[T]) PreviousN(n int) bool {
+	for i := 0; i < n; i++ {
+		it.Previous()
+	}
+
+	return it.IsValid()
+}
@@ -0,0 +1,307 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package adjacencygraph implements directed and undirected graphs stored as adjacency maps.
+//
+// Every vertex maps to its neighbors and the weights of the edges leading to them.
+// Both the vertices and their neighbors are held in tree maps, so that iterating them is deterministic.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Adjacency_list
+package adjacencygraph
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/graphs"
+	"github.com/JonasMuehlmann/datastructures.go/maps/treemap"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Graph implementation
+var _ graphs.Graph[string, int] = (*Graph[string, int])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Graph[string, int]] = (*Graph[string, int])(nil)
+var _ ds.Equatable[*Graph[string, int], string] = (*Graph[string, int])(nil)
+
+// Graph holds the vertices and their outgoing edges
+type Graph[TVertex comparable, TWeight graphs.Weight] struct {
+	adjacency  *treemap.Map[TVertex, *treemap.Map[TVertex, TWeight]]
+	Comparator utils.Comparator[TVertex]
+	directed   bool
+	numEdges   int
+}
+
+// NewDirected instantiates a new empty directed graph ordering the vertices by comparator.
+func NewDirected[TVertex comparable, TWeight graphs.Weight](comparator utils.Comparator[TVertex]) *Graph[TVertex, TWeight] {
+	return &Graph[TVertex, TWeight]{
+		adjacency:  treemap.New[TVertex, *treemap.Map[TVertex, TWeight]](comparator),
+		Comparator: comparator,
+		directed:   true,
+	}
+}
+
+// NewUndirected instantiates a new empty undirected graph ordering the vertices by comparator.
+func NewUndirected[TVertex comparable, TWeight graphs.Weight](comparator utils.Comparator[TVertex]) *Graph[TVertex, TWeight] {
+	return &Graph[TVertex, TWeight]{
+		adjacency:  treemap.New[TVertex, *treemap.Map[TVertex, TWeight]](comparator),
+		Comparator: comparator,
+		directed:   false,
+	}
+}
+
+// NewDirectedFromEdges instantiates a new directed graph containing the passed edges and their vertices.
+func NewDirectedFromEdges[TVertex comparable, TWeight graphs.Weight](comparator utils.Comparator[TVertex], edges ...graphs.Edge[TVertex, TWeight]) *Graph[TVertex, TWeight] {
+	graph := NewDirected[TVertex, TWeight](comparator)
+	for _, edge := range edges {
+		graph.AddEdge(edge.From, edge.To, edge.Weight)
+	}
+
+	return graph
+}
+
+// NewUndirectedFromEdges instantiates a new undirected graph containing the passed edges and their vertices.
+func NewUndirectedFromEdges[TVertex comparable, TWeight graphs.Weight](comparator utils.Comparator[TVertex], edges ...graphs.Edge[TVertex, TWeight]) *Graph[TVertex, TWeight] {
+	graph := NewUndirected[TVertex, TWeight](comparator)
+	for _, edge := range edges {
+		graph.AddEdge(edge.From, edge.To, edge.Weight)
+	}
+
+	return graph
+}
+
+// AddVertex adds the vertices, which are not yet contained.
+func (graph *Graph[TVertex, TWeight]) AddVertex(vertices ...TVertex) {
+	for _, vertex := range vertices {
+		if !graph.HasVertex(vertex) {
+			graph.adjacency.Put(vertex, treemap.New[TVertex, TWeight](graph.Comparator))
+		}
+	}
+}
+
+// RemoveVertex removes the vertex and all edges incident to it.
+func (graph *Graph[TVertex, TWeight]) RemoveVertex(vertex TVertex) {
+	neighbors, found := graph.adjacency.Get(vertex)
+	if !found {
+		return
+	}
+
+	graph.numEdges -= neighbors.Size()
+	graph.adjacency.Remove(nil, vertex)
+
+	if !graph.directed {
+		for _, neighbor := range neighbors.GetKeys() {
+			if reverse, found := graph.adjacency.Get(neighbor); found {
+				reverse.Remove(nil, vertex)
+			}
+		}
+
+		return
+	}
+
+	for _, other := range graph.adjacency.GetValues() {
+		if _, found := other.Get(vertex); found {
+			other.Remove(nil, vertex)
+			graph.numEdges--
+		}
+	}
+}
+
+// HasVertex returns true if the vertex is contained.
+func (graph *Graph[TVertex, TWeight]) HasVertex(vertex TVertex) bool {
+	_, found := graph.adjacency.Get(vertex)
+
+	return found
+}
+
+// AddEdge adds the edge, adding its vertices if necessary, or updates its weight if it is already contained.
+func (graph *Graph[TVertex, TWeight]) AddEdge(from TVertex, to TVertex, weight TWeight) {
+	graph.AddVertex(from, to)
+
+	if !graph.HasEdge(from, to) {
+		graph.numEdges++
+	}
+
+	neighbors, _ := graph.adjacency.Get(from)
+	neighbors.Put(to, weight)
+
+	if !graph.directed {
+		reverse, _ := graph.adjacency.Get(to)
+		reverse.Put(from, weight)
+	}
+}
+
+// RemoveEdge removes the edge, its vertices are kept.
+func (graph *Graph[TVertex, TWeight]) RemoveEdge(from TVertex, to TVertex) {
+	if !graph.HasEdge(from, to) {
+		return
+	}
+
+	neighbors, _ := graph.adjacency.Get(from)
+	neighbors.Remove(nil, to)
+
+	if !graph.directed {
+		reverse, _ := graph.adjacency.Get(to)
+		reverse.Remove(nil, from)
+	}
+
+	graph.numEdges--
+}
+
+// HasEdge returns true if the edge is contained.
+func (graph *Graph[TVertex, TWeight]) HasEdge(from TVertex, to TVertex) bool {
+	_, found := graph.GetWeight(from, to)
+
+	return found
+}
+
+// GetWeight returns the weight of the edge.
+// Second return parameter is true if the edge was found, otherwise false.
+func (graph *Graph[TVertex, TWeight]) GetWeight(from TVertex, to TVertex) (weight TWeight, found bool) {
+	neighbors, found := graph.adjacency.Get(from)
+	if !found {
+		return
+	}
+
+	return neighbors.Get(to)
+}
+
+// GetNeighbors returns the vertices reachable over a single edge from vertex in-order.
+func (graph *Graph[TVertex, TWeight]) GetNeighbors(vertex TVertex) []TVertex {
+	neighbors, found := graph.adjacency.Get(vertex)
+	if !found {
+		return []TVertex{}
+	}
+
+	return neighbors.GetKeys()
+}
+
+// GetVertices returns all vertices in-order.
+func (graph *Graph[TVertex, TWeight]) GetVertices() []TVertex {
+	return graph.adjacency.GetKeys()
+}
+
+// GetEdges returns all edges ordered by their vertices, edges of undirected graphs are returned once.
+func (graph *Graph[TVertex, TWeight]) GetEdges() []graphs.Edge[TVertex, TWeight] {
+	edges := make([]graphs.Edge[TVertex, TWeight], 0, graph.numEdges)
+
+	for _, from := range graph.adjacency.GetKeys() {
+		neighbors, _ := graph.adjacency.Get(from)
+
+		for _, to := range neighbors.GetKeys() {
+			if !graph.directed && graph.Comparator(from, to) > 0 {
+				continue
+			}
+
+			weight, _ := neighbors.Get(to)
+			edges = append(edges, graphs.Edge[TVertex, TWeight]{From: from, To: to, Weight: weight})
+		}
+	}
+
+	return edges
+}
+
+// NumEdges returns the number of edges.
+func (graph *Graph[TVertex, TWeight]) NumEdges() int {
+	return graph.numEdges
+}
+
+// IsDirected returns true if the graph is directed.
+func (graph *Graph[TVertex, TWeight]) IsDirected() bool {
+	return graph.directed
+}
+
+// IsEmpty returns true if the graph does not contain any vertices.
+func (graph *Graph[TVertex, TWeight]) IsEmpty() bool {
+	return graph.adjacency.IsEmpty()
+}
+
+// Size returns the number of vertices.
+func (graph *Graph[TVertex, TWeight]) Size() int {
+	return graph.adjacency.Size()
+}
+
+// Clear removes all vertices and edges.
+func (graph *Graph[TVertex, TWeight]) Clear() {
+	graph.adjacency.Clear()
+	graph.numEdges = 0
+}
+
+// GetValues returns all vertices in-order.
+func (graph *Graph[TVertex, TWeight]) GetValues() []TVertex {
+	return graph.GetVertices()
+}
+
+// ToString returns a string representation of container
+func (graph *Graph[TVertex, TWeight]) ToString() string {
+	str := "UndirectedGraph\n"
+	arrow := "--"
+
+	if graph.directed {
+		str = "DirectedGraph\n"
+		arrow = "->"
+	}
+
+	edges := []string{}
+	for _, edge := range graph.GetEdges() {
+		edges = append(edges, fmt.Sprintf("%v %v %v (%v)", edge.From, arrow, edge.To, edge.Weight))
+	}
+	str += strings.Join(edges, ", ")
+
+	return str
+}
+
+// Clone returns a copy of the graph, which does not share any adjacency maps with the original.
+func (graph *Graph[TVertex, TWeight]) Clone() *Graph[TVertex, TWeight] {
+	clone := &Graph[TVertex, TWeight]{
+		adjacency:  treemap.New[TVertex, *treemap.Map[TVertex, TWeight]](graph.Comparator),
+		Comparator: graph.Comparator,
+		directed:   graph.directed,
+		numEdges:   graph.numEdges,
+	}
+
+	for _, vertex := range graph.adjacency.GetKeys() {
+		neighbors, _ := graph.adjacency.Get(vertex)
+		clone.adjacency.Put(vertex, neighbors.Clone())
+	}
+
+	return clone
+}
+
+// Equals returns true if both graphs are either directed or undirected and hold the same vertices and weighted edges.
+// Vertices are compared with the graph's comparator, so the comparator is not used and may be nil.
+func (graph *Graph[TVertex, TWeight]) Equals(other *Graph[TVertex, TWeight], _ utils.Comparator[TVertex]) bool {
+	if graph.directed != other.directed || graph.numEdges != other.numEdges {
+		return false
+	}
+
+	return graph.adjacency.Equals(other.adjacency, func(a, b *treemap.Map[TVertex, TWeight]) int {
+		if a.Equals(b, utils.BasicComparator[TWeight]) {
+			return 0
+		}
+
+		return 1
+	})
+}
+
+// All returns a sequence over the vertices of the graph in-order.
+func (graph *Graph[TVertex, TWeight]) All() iter.Seq[TVertex] {
+	return graph.adjacency.Keys()
+}
+
+// Backward returns a sequence over the vertices of the graph in reverse order.
+func (graph *Graph[TVertex, TWeight]) Backward() iter.Seq[TVertex] {
+	return func(yield func(TVertex) bool) {
+		for vertex := range graph.adjacency.Backward() {
+			if !yield(vertex) {
+				return
+			}
+		}
+	}
+}
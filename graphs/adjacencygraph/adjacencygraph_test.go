@@ -0,0 +1,325 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package adjacencygraph
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/graphs"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type edge = graphs.Edge[string, int]
+
+func newEdge(from string, to string, weight int) edge {
+	return edge{From: from, To: to, Weight: weight}
+}
+
+func newDirected(edges ...edge) *Graph[string, int] {
+	return NewDirectedFromEdges(utils.BasicComparator[string], edges...)
+}
+
+func newUndirected(edges ...edge) *Graph[string, int] {
+	return NewUndirectedFromEdges(utils.BasicComparator[string], edges...)
+}
+
+func TestAddEdge(t *testing.T) {
+	tests := []struct {
+		name     string
+		graph    *Graph[string, int]
+		edge     edge
+		edges    []edge
+		vertices []string
+	}{
+		{
+			name:     "directed, new vertices",
+			graph:    newDirected(),
+			edge:     newEdge("a", "b", 1),
+			edges:    []edge{newEdge("a", "b", 1)},
+			vertices: []string{"a", "b"},
+		},
+		{
+			name:     "directed, opposite edge",
+			graph:    newDirected(newEdge("b", "a", 2)),
+			edge:     newEdge("a", "b", 1),
+			edges:    []edge{newEdge("a", "b", 1), newEdge("b", "a", 2)},
+			vertices: []string{"a", "b"},
+		},
+		{
+			name:     "directed, update weight",
+			graph:    newDirected(newEdge("a", "b", 2)),
+			edge:     newEdge("a", "b", 1),
+			edges:    []edge{newEdge("a", "b", 1)},
+			vertices: []string{"a", "b"},
+		},
+		{
+			name:     "undirected, reversed vertices",
+			graph:    newUndirected(),
+			edge:     newEdge("b", "a", 1),
+			edges:    []edge{newEdge("a", "b", 1)},
+			vertices: []string{"a", "b"},
+		},
+		{
+			name:     "undirected, update weight",
+			graph:    newUndirected(newEdge("a", "b", 2)),
+			edge:     newEdge("b", "a", 1),
+			edges:    []edge{newEdge("a", "b", 1)},
+			vertices: []string{"a", "b"},
+		},
+		{
+			name:     "undirected, self loop",
+			graph:    newUndirected(),
+			edge:     newEdge("a", "a", 1),
+			edges:    []edge{newEdge("a", "a", 1)},
+			vertices: []string{"a"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.graph.AddEdge(test.edge.From, test.edge.To, test.edge.Weight)
+
+			assert.Truef(t, test.graph.HasEdge(test.edge.From, test.edge.To), test.name)
+			assert.Equalf(t, test.edges, test.graph.GetEdges(), test.name)
+			assert.Equalf(t, len(test.edges), test.graph.NumEdges(), test.name)
+			assert.Equalf(t, test.vertices, test.graph.GetVertices(), test.name)
+		})
+	}
+}
+
+func TestRemoveEdge(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph *Graph[string, int]
+		from  string
+		to    string
+		edges []edge
+	}{
+		{
+			name:  "missing edge",
+			graph: newDirected(newEdge("a", "b", 1)),
+			from:  "b",
+			to:    "a",
+			edges: []edge{newEdge("a", "b", 1)},
+		},
+		{
+			name:  "directed",
+			graph: newDirected(newEdge("a", "b", 1), newEdge("b", "a", 2)),
+			from:  "a",
+			to:    "b",
+			edges: []edge{newEdge("b", "a", 2)},
+		},
+		{
+			name:  "undirected, reversed vertices",
+			graph: newUndirected(newEdge("a", "b", 1), newEdge("b", "c", 2)),
+			from:  "b",
+			to:    "a",
+			edges: []edge{newEdge("b", "c", 2)},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.graph.RemoveEdge(test.from, test.to)
+
+			assert.Falsef(t, test.graph.HasEdge(test.from, test.to), test.name)
+			assert.Equalf(t, test.edges, test.graph.GetEdges(), test.name)
+			assert.Equalf(t, len(test.edges), test.graph.NumEdges(), test.name)
+		})
+	}
+}
+
+func TestRemoveVertex(t *testing.T) {
+	tests := []struct {
+		name     string
+		graph    *Graph[string, int]
+		vertex   string
+		edges    []edge
+		vertices []string
+	}{
+		{
+			name:     "missing vertex",
+			graph:    newDirected(newEdge("a", "b", 1)),
+			vertex:   "c",
+			edges:    []edge{newEdge("a", "b", 1)},
+			vertices: []string{"a", "b"},
+		},
+		{
+			name:     "directed, incoming and outgoing edges",
+			graph:    newDirected(newEdge("a", "b", 1), newEdge("b", "c", 2), newEdge("c", "a", 3), newEdge("c", "b", 4)),
+			vertex:   "b",
+			edges:    []edge{newEdge("c", "a", 3)},
+			vertices: []string{"a", "c"},
+		},
+		{
+			name:     "undirected",
+			graph:    newUndirected(newEdge("a", "b", 1), newEdge("b", "c", 2), newEdge("c", "a", 3)),
+			vertex:   "b",
+			edges:    []edge{newEdge("a", "c", 3)},
+			vertices: []string{"a", "c"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.graph.RemoveVertex(test.vertex)
+
+			assert.Falsef(t, test.graph.HasVertex(test.vertex), test.name)
+			assert.Equalf(t, test.edges, test.graph.GetEdges(), test.name)
+			assert.Equalf(t, len(test.edges), test.graph.NumEdges(), test.name)
+			assert.Equalf(t, test.vertices, test.graph.GetVertices(), test.name)
+		})
+	}
+}
+
+func TestGetNeighbors(t *testing.T) {
+	directed := newDirected(newEdge("a", "c", 1), newEdge("a", "b", 2), newEdge("b", "a", 3))
+	undirected := newUndirected(newEdge("a", "c", 1), newEdge("b", "a", 2))
+
+	assert.Equal(t, []string{"b", "c"}, directed.GetNeighbors("a"))
+	assert.Equal(t, []string{}, directed.GetNeighbors("c"))
+	assert.Equal(t, []string{}, directed.GetNeighbors("x"))
+	assert.Equal(t, []string{"a"}, undirected.GetNeighbors("c"))
+
+	weight, found := undirected.GetWeight("a", "b")
+	assert.True(t, found)
+	assert.Equal(t, 2, weight)
+
+	directed.Clear()
+	assert.True(t, directed.IsEmpty())
+	assert.Equal(t, 0, directed.NumEdges())
+}
+
+func TestClone(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph *Graph[string, int]
+	}{
+		{
+			name:  "directed",
+			graph: newDirected(newEdge("a", "b", 1), newEdge("b", "c", 2)),
+		},
+		{
+			name:  "undirected",
+			graph: newUndirected(newEdge("a", "b", 1), newEdge("b", "c", 2)),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			edges := test.graph.GetEdges()
+			clone := test.graph.Clone()
+
+			assert.Truef(t, clone.Equals(test.graph, nil), test.name)
+			assert.Equalf(t, edges, clone.GetEdges(), test.name)
+
+			clone.AddEdge("c", "a", 3)
+			clone.RemoveEdge("a", "b")
+
+			assert.Falsef(t, clone.Equals(test.graph, nil), test.name)
+			assert.Equalf(t, edges, test.graph.GetEdges(), test.name)
+			assert.Equalf(t, 2, test.graph.NumEdges(), test.name)
+		})
+	}
+}
+
+func TestEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Graph[string, int]
+		b     *Graph[string, int]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     newDirected(),
+			b:     newDirected(),
+			equal: true,
+		},
+		{
+			name:  "undirected, reversed edges",
+			a:     newUndirected(newEdge("a", "b", 1), newEdge("b", "c", 2)),
+			b:     newUndirected(newEdge("c", "b", 2), newEdge("b", "a", 1)),
+			equal: true,
+		},
+		{
+			name:  "directed, reversed edges",
+			a:     newDirected(newEdge("a", "b", 1)),
+			b:     newDirected(newEdge("b", "a", 1)),
+			equal: false,
+		},
+		{
+			name:  "different directedness",
+			a:     newDirected(),
+			b:     newUndirected(),
+			equal: false,
+		},
+		{
+			name:  "different weights",
+			a:     newDirected(newEdge("a", "b", 1)),
+			b:     newDirected(newEdge("a", "b", 2)),
+			equal: false,
+		},
+		{
+			name: "different vertices",
+			a:    newDirected(newEdge("a", "b", 1)),
+			b: func() *Graph[string, int] {
+				graph := newDirected(newEdge("a", "b", 1))
+				graph.AddVertex("c")
+
+				return graph
+			}(),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, nil), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, nil), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	graph := newDirected(newEdge("b", "c", 1), newEdge("a", "b", 2))
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(graph.All()))
+	assert.Equal(t, []string{"c", "b", "a"}, slices.Collect(graph.Backward()))
+
+	vertices := []string{}
+	for vertex := range graph.All() {
+		vertices = append(vertices, vertex)
+		if len(vertices) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, vertices)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs
+
+// StronglyConnectedComponents partitions the vertices into the maximal sets, in which every vertex can reach every other vertex, using Tarjan's algorithm.
+// The components are returned in reverse topological order, i.e. no edge leads from a component to a later one.
+//
+// For undirected graphs these are the connected components.
+func StronglyConnectedComponents[TVertex comparable, TWeight Weight](graph Graph[TVertex, TWeight]) [][]TVertex {
+	indices := make(map[TVertex]int, graph.Size())
+	// Smallest index reachable from the vertex's subtree over at most one edge not part of the search tree
+	lowlinks := make(map[TVertex]int, graph.Size())
+	onStack := make(map[TVertex]bool, graph.Size())
+	stack := []TVertex{}
+	components := [][]TVertex{}
+
+	discover := func(vertex TVertex) searchFrame[TVertex] {
+		indices[vertex] = len(indices)
+		lowlinks[vertex] = indices[vertex]
+		onStack[vertex] = true
+		stack = append(stack, vertex)
+
+		return searchFrame[TVertex]{vertex: vertex, neighbors: graph.GetNeighbors(vertex)}
+	}
+
+	for _, root := range graph.GetVertices() {
+		if _, found := indices[root]; found {
+			continue
+		}
+
+		callStack := []searchFrame[TVertex]{discover(root)}
+
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+
+			if top.index < len(top.neighbors) {
+				neighbor := top.neighbors[top.index]
+				top.index++
+
+				if _, found := indices[neighbor]; !found {
+					callStack = append(callStack, discover(neighbor))
+				} else if onStack[neighbor] && indices[neighbor] < lowlinks[top.vertex] {
+					lowlinks[top.vertex] = indices[neighbor]
+				}
+
+				continue
+			}
+
+			vertex := top.vertex
+			callStack = callStack[:len(callStack)-1]
+
+			if len(callStack) > 0 {
+				parent := callStack[len(callStack)-1].vertex
+				if lowlinks[vertex] < lowlinks[parent] {
+					lowlinks[parent] = lowlinks[vertex]
+				}
+			}
+
+			if lowlinks[vertex] != indices[vertex] {
+				continue
+			}
+
+			// vertex is the root of a component, which consists of it and all vertices above it on the stack
+			i := len(stack) - 1
+			for stack[i] != vertex {
+				i--
+			}
+
+			component := append([]TVertex{}, stack[i:]...)
+			for _, member := range component {
+				onStack[member] = false
+			}
+
+			stack = stack[:i]
+			components = append(components, component)
+		}
+	}
+
+	return components
+}
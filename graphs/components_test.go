@@ -0,0 +1,59 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs_test
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/graphs"
+	"github.com/JonasMuehlmann/datastructures.go/graphs/adjacencygraph"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	tests := []struct {
+		name       string
+		graph      *adjacencygraph.Graph[string, int]
+		components [][]string
+	}{
+		{
+			name:       "empty",
+			graph:      newDirected(),
+			components: [][]string{},
+		},
+		{
+			name:       "acyclic",
+			graph:      newDirected(newEdge("a", "b", 1), newEdge("b", "c", 1)),
+			components: [][]string{{"c"}, {"b"}, {"a"}},
+		},
+		{
+			name: "several cycles",
+			graph: newDirected(
+				newEdge("a", "b", 1), newEdge("b", "c", 1), newEdge("c", "a", 1),
+				newEdge("c", "d", 1),
+				newEdge("d", "e", 1), newEdge("e", "d", 1),
+				newEdge("e", "f", 1),
+			),
+			components: [][]string{{"f"}, {"d", "e"}, {"a", "b", "c"}},
+		},
+		{
+			name:       "undirected",
+			graph:      newUndirected(newEdge("a", "b", 1), newEdge("c", "d", 1)),
+			components: [][]string{{"a", "b"}, {"c", "d"}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.components, graphs.StronglyConnectedComponents[string, int](test.graph), test.name)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package graphs provides an abstract Graph interface and algorithms working on it.
+//
+// A graph is a set of vertices connected by weighted edges.
+// In a directed graph an edge leads from one vertex to another, in an undirected graph it connects both vertices in either direction.
+//
+// The algorithms visit neighbors in the order returned by the graph, so that graphs with an ordered adjacency produce deterministic results.
+//
+// Reference: https://en.wikipedia.org/wiki/Graph_(abstract_data_type)
+package graphs
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"golang.org/x/exp/constraints"
+)
+
+// Weight is the constraint for the weights of edges.
+type Weight interface {
+	constraints.Integer | constraints.Float
+}
+
+// Edge is a weighted edge leading from From to To.
+type Edge[TVertex comparable, TWeight Weight] struct {
+	From   TVertex
+	To     TVertex
+	Weight TWeight
+}
+
+// Graph interface that all graphs implement.
+type Graph[TVertex comparable, TWeight Weight] interface {
+	// AddVertex adds the vertices, which are not yet contained.
+	AddVertex(vertices ...TVertex)
+	// RemoveVertex removes the vertex and all edges incident to it.
+	RemoveVertex(vertex TVertex)
+	HasVertex(vertex TVertex) bool
+	// AddEdge adds the edge, adding its vertices if necessary, or updates its weight if it is already contained.
+	AddEdge(from TVertex, to TVertex, weight TWeight)
+	RemoveEdge(from TVertex, to TVertex)
+	HasEdge(from TVertex, to TVertex) bool
+	GetWeight(from TVertex, to TVertex) (weight TWeight, found bool)
+	// GetNeighbors returns the vertices reachable over a single edge from vertex.
+	GetNeighbors(vertex TVertex) []TVertex
+	GetVertices() []TVertex
+	// GetEdges returns all edges, edges of undirected graphs are returned once.
+	GetEdges() []Edge[TVertex, TWeight]
+	NumEdges() int
+	IsDirected() bool
+
+	ds.Container[TVertex]
+}
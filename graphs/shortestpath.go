@@ -0,0 +1,121 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/queues/priorityqueue"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// ShortestPaths holds the shortest paths from a source vertex to all vertices reachable from it.
+type ShortestPaths[TVertex comparable, TWeight Weight] struct {
+	Source    TVertex
+	distances map[TVertex]TWeight
+	previous  map[TVertex]TVertex
+}
+
+// DistanceTo returns the total weight of the shortest path from the source to vertex.
+// Second return parameter is true if vertex is reachable, otherwise false.
+func (paths *ShortestPaths[TVertex, TWeight]) DistanceTo(vertex TVertex) (distance TWeight, found bool) {
+	distance, found = paths.distances[vertex]
+
+	return
+}
+
+// PathTo returns the vertices of the shortest path from the source to vertex, including both.
+// Second return parameter is true if vertex is reachable, otherwise false.
+func (paths *ShortestPaths[TVertex, TWeight]) PathTo(vertex TVertex) (path []TVertex, found bool) {
+	if _, found = paths.distances[vertex]; !found {
+		return
+	}
+
+	path = []TVertex{vertex}
+	for vertex != paths.Source {
+		vertex = paths.previous[vertex]
+		path = append(path, vertex)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, true
+}
+
+// Dijkstra finds the shortest paths from source to all vertices reachable from it.
+// Edge weights must not be negative.
+func Dijkstra[TVertex comparable, TWeight Weight](graph Graph[TVertex, TWeight], source TVertex) *ShortestPaths[TVertex, TWeight] {
+	return search(graph, source, func(TVertex) TWeight { return 0 }, func(TVertex) bool { return false })
+}
+
+// AStar finds the shortest path from source to target, exploring the vertices by their distance from source plus the estimated distance to target.
+// heuristic estimates the distance from a vertex to target, it must never overestimate it for the path to be the shortest.
+// Edge weights must not be negative.
+//
+// Third return parameter is true if target is reachable, otherwise false.
+func AStar[TVertex comparable, TWeight Weight](graph Graph[TVertex, TWeight], source TVertex, target TVertex, heuristic func(vertex TVertex) TWeight) (path []TVertex, distance TWeight, found bool) {
+	paths := search(graph, source, heuristic, func(vertex TVertex) bool { return vertex == target })
+
+	if distance, found = paths.DistanceTo(target); !found {
+		return
+	}
+
+	path, _ = paths.PathTo(target)
+
+	return path, distance, true
+}
+
+type searchEntry[TVertex comparable, TWeight Weight] struct {
+	vertex   TVertex
+	distance TWeight
+	// distance plus the heuristic's estimate
+	priority TWeight
+}
+
+// search explores the graph from source by the sum of the distance and the heuristic's estimate, until isTarget returns true for a settled vertex.
+func search[TVertex comparable, TWeight Weight](graph Graph[TVertex, TWeight], source TVertex, heuristic func(TVertex) TWeight, isTarget func(TVertex) bool) *ShortestPaths[TVertex, TWeight] {
+	paths := &ShortestPaths[TVertex, TWeight]{
+		Source:    source,
+		distances: make(map[TVertex]TWeight),
+		previous:  make(map[TVertex]TVertex),
+	}
+
+	if !graph.HasVertex(source) {
+		return paths
+	}
+
+	queue := priorityqueue.New(func(a, b searchEntry[TVertex, TWeight]) int {
+		return utils.BasicComparator(a.priority, b.priority)
+	})
+
+	paths.distances[source] = 0
+	queue.Enqueue(searchEntry[TVertex, TWeight]{vertex: source, priority: heuristic(source)})
+
+	for entry, ok := queue.Dequeue(); ok; entry, ok = queue.Dequeue() {
+		// Entries are not updated in place, outdated ones are skipped instead
+		if entry.distance > paths.distances[entry.vertex] {
+			continue
+		}
+
+		if isTarget(entry.vertex) {
+			break
+		}
+
+		for _, neighbor := range graph.GetNeighbors(entry.vertex) {
+			weight, _ := graph.GetWeight(entry.vertex, neighbor)
+			distance := entry.distance + weight
+
+			if known, found := paths.distances[neighbor]; found && known <= distance {
+				continue
+			}
+
+			paths.distances[neighbor] = distance
+			paths.previous[neighbor] = entry.vertex
+			queue.Enqueue(searchEntry[TVertex, TWeight]{vertex: neighbor, distance: distance, priority: distance + heuristic(neighbor)})
+		}
+	}
+
+	return paths
+}
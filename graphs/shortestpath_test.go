@@ -0,0 +1,135 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs_test
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/graphs"
+	"github.com/JonasMuehlmann/datastructures.go/graphs/adjacencygraph"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDijkstra(t *testing.T) {
+	graph := newDirected(
+		newEdge("a", "b", 7),
+		newEdge("a", "c", 9),
+		newEdge("a", "f", 14),
+		newEdge("b", "c", 10),
+		newEdge("b", "d", 15),
+		newEdge("c", "d", 11),
+		newEdge("c", "f", 2),
+		newEdge("d", "e", 6),
+		newEdge("f", "e", 9),
+		newEdge("g", "a", 1),
+	)
+
+	paths := graphs.Dijkstra[string, int](graph, "a")
+
+	tests := []struct {
+		name     string
+		target   string
+		path     []string
+		distance int
+		found    bool
+	}{
+		{
+			name:     "source",
+			target:   "a",
+			path:     []string{"a"},
+			distance: 0,
+			found:    true,
+		},
+		{
+			name:     "direct edge is longer",
+			target:   "f",
+			path:     []string{"a", "c", "f"},
+			distance: 11,
+			found:    true,
+		},
+		{
+			name:     "several hops",
+			target:   "e",
+			path:     []string{"a", "c", "f", "e"},
+			distance: 20,
+			found:    true,
+		},
+		{
+			name:   "unreachable",
+			target: "g",
+			found:  false,
+		},
+		{
+			name:   "missing vertex",
+			target: "x",
+			found:  false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			distance, found := paths.DistanceTo(test.target)
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+
+			path, found := paths.PathTo(test.target)
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.path, path, test.name)
+		})
+	}
+}
+
+func TestAStar(t *testing.T) {
+	type point struct{ x, y int }
+
+	comparator := func(a, b point) int {
+		if a.x != b.x {
+			return a.x - b.x
+		}
+
+		return a.y - b.y
+	}
+
+	// 5x5 grid with a wall at x == 2, which is open at y == 4
+	grid := adjacencygraph.NewUndirected[point, int](comparator)
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			if x == 2 && y != 4 {
+				continue
+			}
+
+			if x+1 < 5 && (x+1 != 2 || y == 4) {
+				grid.AddEdge(point{x, y}, point{x + 1, y}, 1)
+			}
+
+			if y+1 < 5 && x != 2 {
+				grid.AddEdge(point{x, y}, point{x, y + 1}, 1)
+			}
+		}
+	}
+
+	target := point{4, 0}
+	manhattan := func(p point) int {
+		return utils.Max(p.x-target.x, target.x-p.x) + utils.Max(p.y-target.y, target.y-p.y)
+	}
+
+	path, distance, found := graphs.AStar[point, int](grid, point{0, 0}, target, manhattan)
+	assert.True(t, found)
+	assert.Equal(t, 12, distance)
+	assert.Len(t, path, 13)
+	assert.Equal(t, point{0, 0}, path[0])
+	assert.Equal(t, target, path[12])
+	assert.Contains(t, path, point{2, 4})
+
+	_, _, found = graphs.AStar[point, int](grid, point{0, 0}, point{9, 9}, manhattan)
+	assert.False(t, found)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/queues/priorityqueue"
+	"github.com/JonasMuehlmann/datastructures.go/unionfind"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// MinimumSpanningTree returns the edges of a minimum spanning forest using Kruskal's algorithm.
+// If the graph is connected, this is a tree connecting all vertices with the smallest total weight.
+//
+// Edges of directed graphs are treated as undirected.
+func MinimumSpanningTree[TVertex comparable, TWeight Weight](graph Graph[TVertex, TWeight]) []Edge[TVertex, TWeight] {
+	edges := priorityqueue.NewFromSlice(func(a, b Edge[TVertex, TWeight]) int {
+		return utils.BasicComparator(a.Weight, b.Weight)
+	}, graph.GetEdges())
+
+	components := unionfind.NewFromSlice(graph.GetVertices())
+	tree := make([]Edge[TVertex, TWeight], 0, utils.Max(graph.Size()-1, 0))
+
+	for components.NumSets() > 1 {
+		edge, ok := edges.Dequeue()
+		if !ok {
+			break
+		}
+
+		if components.Union(edge.From, edge.To) {
+			tree = append(tree, edge)
+		}
+	}
+
+	return tree
+}
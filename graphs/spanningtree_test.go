@@ -0,0 +1,73 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs_test
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/graphs"
+	"github.com/JonasMuehlmann/datastructures.go/graphs/adjacencygraph"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinimumSpanningTree(t *testing.T) {
+	tests := []struct {
+		name   string
+		graph  *adjacencygraph.Graph[string, int]
+		edges  int
+		weight int
+	}{
+		{
+			name:   "empty",
+			graph:  newUndirected(),
+			edges:  0,
+			weight: 0,
+		},
+		{
+			name: "connected",
+			graph: newUndirected(
+				newEdge("a", "b", 4),
+				newEdge("a", "c", 1),
+				newEdge("b", "c", 2),
+				newEdge("b", "d", 5),
+				newEdge("c", "d", 8),
+				newEdge("d", "e", 3),
+			),
+			edges:  4,
+			weight: 11,
+		},
+		{
+			name:   "forest",
+			graph:  newUndirected(newEdge("a", "b", 4), newEdge("b", "c", 1), newEdge("a", "c", 2), newEdge("d", "e", 3)),
+			edges:  3,
+			weight: 6,
+		},
+		{
+			name:   "directed",
+			graph:  newDirected(newEdge("a", "b", 4), newEdge("b", "a", 1), newEdge("b", "c", 2)),
+			edges:  2,
+			weight: 3,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			tree := graphs.MinimumSpanningTree[string, int](test.graph)
+
+			weight := 0
+			for _, edge := range tree {
+				weight += edge.Weight
+			}
+
+			assert.Lenf(t, tree, test.edges, test.name)
+			assert.Equalf(t, test.weight, weight, test.name)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs
+
+type visitState int
+
+const (
+	unvisited visitState = iota
+	inProgress
+	finished
+)
+
+type searchFrame[TVertex comparable] struct {
+	vertex    TVertex
+	neighbors []TVertex
+	index     int
+}
+
+// TopologicalSort orders the vertices of a directed graph, so that every edge leads from an earlier to a later vertex.
+// If the graph contains a cycle, order is nil and cycle holds the vertices of one cycle in the order of its edges.
+//
+// In an undirected graph every edge forms a cycle.
+func TopologicalSort[TVertex comparable, TWeight Weight](graph Graph[TVertex, TWeight]) (order []TVertex, cycle []TVertex) {
+	states := make(map[TVertex]visitState, graph.Size())
+	postorder := make([]TVertex, 0, graph.Size())
+
+	for _, root := range graph.GetVertices() {
+		if states[root] != unvisited {
+			continue
+		}
+
+		states[root] = inProgress
+		stack := []searchFrame[TVertex]{{vertex: root, neighbors: graph.GetNeighbors(root)}}
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+
+			if top.index == len(top.neighbors) {
+				states[top.vertex] = finished
+				postorder = append(postorder, top.vertex)
+				stack = stack[:len(stack)-1]
+
+				continue
+			}
+
+			neighbor := top.neighbors[top.index]
+			top.index++
+
+			switch states[neighbor] {
+			case unvisited:
+				states[neighbor] = inProgress
+				stack = append(stack, searchFrame[TVertex]{vertex: neighbor, neighbors: graph.GetNeighbors(neighbor)})
+			case inProgress:
+				// Edge back into the current path, the path from neighbor on is a cycle
+				for i := range stack {
+					if stack[i].vertex == neighbor {
+						for _, frame := range stack[i:] {
+							cycle = append(cycle, frame.vertex)
+						}
+
+						return nil, cycle
+					}
+				}
+			}
+		}
+	}
+
+	order = make([]TVertex, len(postorder))
+	for i, vertex := range postorder {
+		order[len(postorder)-1-i] = vertex
+	}
+
+	return order, nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs_test
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/graphs"
+	"github.com/JonasMuehlmann/datastructures.go/graphs/adjacencygraph"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	withIsolatedVertex := newDirected(newEdge("b", "a", 1))
+	withIsolatedVertex.AddVertex("c")
+
+	tests := []struct {
+		name  string
+		graph *adjacencygraph.Graph[string, int]
+		order []string
+		cycle []string
+	}{
+		{
+			name:  "empty",
+			graph: newDirected(),
+			order: []string{},
+		},
+		{
+			name:  "isolated vertex",
+			graph: withIsolatedVertex,
+			order: []string{"c", "b", "a"},
+		},
+		{
+			name:  "diamond",
+			graph: newDirected(newEdge("a", "b", 1), newEdge("a", "c", 1), newEdge("b", "d", 1), newEdge("c", "d", 1)),
+			order: []string{"a", "c", "b", "d"},
+		},
+		{
+			name:  "cycle",
+			graph: newDirected(newEdge("a", "b", 1), newEdge("b", "c", 1), newEdge("c", "d", 1), newEdge("d", "b", 1)),
+			cycle: []string{"b", "c", "d"},
+		},
+		{
+			name:  "self loop",
+			graph: newDirected(newEdge("a", "b", 1), newEdge("b", "b", 1)),
+			cycle: []string{"b"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			order, cycle := graphs.TopologicalSort[string, int](test.graph)
+
+			assert.Equalf(t, test.order, order, test.name)
+			assert.Equalf(t, test.cycle, cycle, test.name)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+)
+
+// Assert Iterator implementation
+var _ ds.ReadForIterator[string] = (*TraversalIterator[string, int])(nil)
+
+// TraversalIterator lazily visits every vertex reachable from a start vertex once.
+//
+// The traversal looks one vertex ahead, so that IsLast() can be answered.
+// Modifying the graph while traversing it leads to undefined results.
+type TraversalIterator[TVertex comparable, TWeight Weight] struct {
+	graph        Graph[TVertex, TWeight]
+	breadthFirst bool
+	visited      map[TVertex]struct{}
+	// Vertices to visit next in breadth-first order
+	queue []TVertex
+	// Partially explored vertices in depth-first order
+	stack []traversalFrame[TVertex]
+
+	current    TVertex
+	next       TVertex
+	hasNext    bool
+	index      int
+	isFinished bool
+}
+
+type traversalFrame[TVertex comparable] struct {
+	neighbors []TVertex
+	index     int
+}
+
+// NewBreadthFirstIterator returns an iterator visiting the vertices reachable from start in breadth-first order, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func NewBreadthFirstIterator[TVertex comparable, TWeight Weight](graph Graph[TVertex, TWeight], start TVertex) *TraversalIterator[TVertex, TWeight] {
+	return newTraversalIterator(graph, start, true)
+}
+
+// NewDepthFirstIterator returns an iterator visiting the vertices reachable from start in depth-first preorder, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func NewDepthFirstIterator[TVertex comparable, TWeight Weight](graph Graph[TVertex, TWeight], start TVertex) *TraversalIterator[TVertex, TWeight] {
+	return newTraversalIterator(graph, start, false)
+}
+
+func newTraversalIterator[TVertex comparable, TWeight Weight](graph Graph[TVertex, TWeight], start TVertex, breadthFirst bool) *TraversalIterator[TVertex, TWeight] {
+	it := &TraversalIterator[TVertex, TWeight]{
+		graph:        graph,
+		breadthFirst: breadthFirst,
+		visited:      make(map[TVertex]struct{}),
+		index:        -1,
+	}
+
+	if graph.HasVertex(start) {
+		it.next = start
+		it.hasNext = true
+		it.visit(start)
+	}
+
+	return it
+}
+
+func (it *TraversalIterator[TVertex, TWeight]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *TraversalIterator[TVertex, TWeight]) IsEnd() bool {
+	return it.isFinished
+}
+
+func (it *TraversalIterator[TVertex, TWeight]) IsFirst() bool {
+	return it.index == 0 && !it.isFinished
+}
+
+func (it *TraversalIterator[TVertex, TWeight]) IsLast() bool {
+	return it.IsValid() && !it.hasNext
+}
+
+func (it *TraversalIterator[TVertex, TWeight]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+// Next moves the iterator to the next vertex and returns true if there was a next vertex.
+// If Next() was called for the first time, then it will point the iterator to the start vertex if it exists.
+func (it *TraversalIterator[TVertex, TWeight]) Next() bool {
+	if !it.hasNext {
+		it.isFinished = true
+
+		return false
+	}
+
+	it.current = it.next
+	it.index++
+	it.next, it.hasNext = it.advance()
+
+	return true
+}
+
+func (it *TraversalIterator[TVertex, TWeight]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		if !it.Next() {
+			return false
+		}
+	}
+
+	return n > 0
+}
+
+// Get returns the current vertex.
+func (it *TraversalIterator[TVertex, TWeight]) Get() (value TVertex, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+// Index returns the number of vertices visited before the current one.
+func (it *TraversalIterator[TVertex, TWeight]) Index() (index int, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.index, true
+}
+
+// visit marks vertex as visited and schedules exploring its neighbors.
+func (it *TraversalIterator[TVertex, TWeight]) visit(vertex TVertex) {
+	it.visited[vertex] = struct{}{}
+
+	if it.breadthFirst {
+		it.queue = append(it.queue, vertex)
+	} else {
+		it.stack = append(it.stack, traversalFrame[TVertex]{neighbors: it.graph.GetNeighbors(vertex)})
+	}
+}
+
+// advance returns the vertex to visit after the ones already visited.
+func (it *TraversalIterator[TVertex, TWeight]) advance() (vertex TVertex, found bool) {
+	if it.breadthFirst {
+		for len(it.queue) > 0 {
+			// The queue holds visited vertices, whose neighbors were not yet explored
+			for _, neighbor := range it.graph.GetNeighbors(it.queue[0]) {
+				if _, visited := it.visited[neighbor]; !visited {
+					it.visit(neighbor)
+				}
+			}
+
+			it.queue = it.queue[1:]
+
+			if len(it.queue) > 0 {
+				return it.queue[0], true
+			}
+		}
+
+		return
+	}
+
+	for len(it.stack) > 0 {
+		frame := &it.stack[len(it.stack)-1]
+
+		if frame.index == len(frame.neighbors) {
+			it.stack = it.stack[:len(it.stack)-1]
+
+			continue
+		}
+
+		neighbor := frame.neighbors[frame.index]
+		frame.index++
+
+		if _, visited := it.visited[neighbor]; !visited {
+			it.visit(neighbor)
+
+			return neighbor, true
+		}
+	}
+
+	return
+}
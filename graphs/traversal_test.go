@@ -0,0 +1,118 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphs_test
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/graphs"
+	"github.com/JonasMuehlmann/datastructures.go/graphs/adjacencygraph"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type edge = graphs.Edge[string, int]
+
+func newEdge(from string, to string, weight int) edge {
+	return edge{From: from, To: to, Weight: weight}
+}
+
+func newDirected(edges ...edge) *adjacencygraph.Graph[string, int] {
+	return adjacencygraph.NewDirectedFromEdges(utils.BasicComparator[string], edges...)
+}
+
+func newUndirected(edges ...edge) *adjacencygraph.Graph[string, int] {
+	return adjacencygraph.NewUndirectedFromEdges(utils.BasicComparator[string], edges...)
+}
+
+func collect(it *graphs.TraversalIterator[string, int]) []string {
+	vertices := []string{}
+	for it.Next() {
+		vertex, _ := it.Get()
+		vertices = append(vertices, vertex)
+	}
+
+	return vertices
+}
+
+func TestTraversalIterator(t *testing.T) {
+	//   a -> b -> d
+	//   |    |
+	//   v    v
+	//   c -> e    f
+	directed := newDirected(newEdge("a", "b", 1), newEdge("a", "c", 1), newEdge("b", "d", 1), newEdge("b", "e", 1), newEdge("c", "e", 1), newEdge("f", "a", 1))
+	undirected := newUndirected(newEdge("a", "b", 1), newEdge("a", "c", 1), newEdge("b", "d", 1), newEdge("c", "e", 1))
+
+	tests := []struct {
+		name     string
+		it       *graphs.TraversalIterator[string, int]
+		vertices []string
+	}{
+		{
+			name:     "breadth first",
+			it:       graphs.NewBreadthFirstIterator[string, int](directed, "a"),
+			vertices: []string{"a", "b", "c", "d", "e"},
+		},
+		{
+			name:     "depth first",
+			it:       graphs.NewDepthFirstIterator[string, int](directed, "a"),
+			vertices: []string{"a", "b", "d", "e", "c"},
+		},
+		{
+			name:     "breadth first, undirected",
+			it:       graphs.NewBreadthFirstIterator[string, int](undirected, "b"),
+			vertices: []string{"b", "a", "d", "c", "e"},
+		},
+		{
+			name:     "depth first, undirected",
+			it:       graphs.NewDepthFirstIterator[string, int](undirected, "b"),
+			vertices: []string{"b", "a", "c", "e", "d"},
+		},
+		{
+			name:     "sink",
+			it:       graphs.NewDepthFirstIterator[string, int](directed, "e"),
+			vertices: []string{"e"},
+		},
+		{
+			name:     "missing start",
+			it:       graphs.NewBreadthFirstIterator[string, int](directed, "x"),
+			vertices: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Truef(t, test.it.IsBegin(), test.name)
+			assert.Equalf(t, test.vertices, collect(test.it), test.name)
+			assert.Truef(t, test.it.IsEnd(), test.name)
+		})
+	}
+}
+
+func TestTraversalIteratorPosition(t *testing.T) {
+	graph := newDirected(newEdge("a", "b", 1), newEdge("b", "c", 1))
+	it := graphs.NewBreadthFirstIterator[string, int](graph, "a")
+
+	assert.False(t, it.IsValid())
+	assert.True(t, it.Next())
+	assert.True(t, it.IsFirst())
+
+	assert.True(t, it.NextN(2))
+	assert.True(t, it.IsLast())
+
+	index, found := it.Index()
+	assert.True(t, found)
+	assert.Equal(t, 2, index)
+
+	assert.False(t, it.Next())
+	_, found = it.Get()
+	assert.False(t, found)
+}
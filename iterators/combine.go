@@ -0,0 +1,69 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iterators
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Enumerate returns an iterator over the values of begin, whose keys are the values' indices.
+// This adapts any forward iterator to constructors and functions, which require an indexed iterator.
+func Enumerate[T any](begin ds.ReadForIterator[T]) *Iterator[T] {
+	return newIterator(sizeOf(begin), func() (value T, ok bool) {
+		if !begin.Next() {
+			return
+		}
+
+		return begin.Get()
+	})
+}
+
+// Zip returns an iterator over pairs of the values of first and second, which ends with the shorter of both.
+func Zip[TFirst any, TSecond any](first ds.ReadForIterator[TFirst], second ds.ReadForIterator[TSecond]) *Iterator[Pair[TFirst, TSecond]] {
+	size := -1
+	firstSize, secondSize := sizeOf(first), sizeOf(second)
+	if firstSize >= 0 && secondSize >= 0 {
+		size = utils.Min(firstSize, secondSize)
+	}
+
+	return newIterator(size, func() (pair Pair[TFirst, TSecond], ok bool) {
+		if !first.Next() || !second.Next() {
+			return
+		}
+
+		pair.First, _ = first.Get()
+		pair.Second, _ = second.Get()
+
+		return pair, true
+	})
+}
+
+// Chain returns an iterator over the values of all passed iterators, one after another.
+func Chain[T any](iterators ...ds.ReadForIterator[T]) *Iterator[T] {
+	size := 0
+	for _, it := range iterators {
+		itSize := sizeOf(it)
+		if itSize < 0 {
+			size = -1
+
+			break
+		}
+
+		size += itSize
+	}
+
+	return newIterator(size, func() (value T, ok bool) {
+		for len(iterators) > 0 {
+			if iterators[0].Next() {
+				return iterators[0].Get()
+			}
+
+			iterators = iterators[1:]
+		}
+
+		return
+	})
+}
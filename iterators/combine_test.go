@@ -0,0 +1,130 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iterators
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	"github.com/JonasMuehlmann/datastructures.go/sets/treeset"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumerate(t *testing.T) {
+	it := Enumerate(ds.NewSeqIterator(maps.Keys(map[string]bool{"a": true, "b": true, "c": true})))
+
+	set := treeset.NewFromIterator(utils.BasicComparator[string], it)
+	assert.Equal(t, []string{"a", "b", "c"}, set.GetValues())
+
+	keys := []int{}
+	for key := range ds.Seq2[int, int](Enumerate(arraylist.New(5, 6, 7).Begin())) {
+		keys = append(keys, key)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, keys)
+}
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  []int
+		second []string
+		result []Pair[int, string]
+	}{
+		{
+			name:   "empty",
+			first:  []int{},
+			second: []string{"a"},
+			result: nil,
+		},
+		{
+			name:   "equal length",
+			first:  []int{1, 2},
+			second: []string{"a", "b"},
+			result: []Pair[int, string]{{1, "a"}, {2, "b"}},
+		},
+		{
+			name:   "first longer",
+			first:  []int{1, 2, 3},
+			second: []string{"a", "b"},
+			result: []Pair[int, string]{{1, "a"}, {2, "b"}},
+		},
+		{
+			name:   "second longer",
+			first:  []int{1},
+			second: []string{"a", "b"},
+			result: []Pair[int, string]{{1, "a"}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := Zip(arraylist.NewFromSlice(test.first).Begin(), arraylist.NewFromSlice(test.second).Begin())
+
+			assert.Equalf(t, utils.Min(len(test.first), len(test.second)), it.Size(), test.name)
+			assert.Equalf(t, test.result, slices.Collect(ds.Seq[Pair[int, string]](it)), test.name)
+		})
+	}
+}
+
+func TestChain(t *testing.T) {
+	tests := []struct {
+		name   string
+		values [][]int
+		result []int
+	}{
+		{
+			name:   "no iterators",
+			values: [][]int{},
+			result: nil,
+		},
+		{
+			name:   "empty iterators",
+			values: [][]int{{}, {}},
+			result: nil,
+		},
+		{
+			name:   "mixed iterators",
+			values: [][]int{{1, 2}, {}, {3}, {4, 5}},
+			result: []int{1, 2, 3, 4, 5},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			iterators := make([]ds.ReadForIterator[int], 0, len(test.values))
+			for _, values := range test.values {
+				iterators = append(iterators, arraylist.NewFromSlice(values).Begin())
+			}
+
+			it := Chain(iterators...)
+
+			assert.Equalf(t, len(test.result), it.Size(), test.name)
+			assert.Equalf(t, test.result, slices.Collect(ds.Seq[int](it)), test.name)
+		})
+	}
+}
+
+func TestChainUnknownSize(t *testing.T) {
+	it := Chain[int](arraylist.New(1).Begin(), Filter(arraylist.New(2, 3).Begin(), isEven))
+
+	assert.Equal(t, -1, it.Size())
+	assert.Equal(t, []int{1, 2}, slices.Collect(ds.Seq[int](it)))
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iterators
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Filter returns an iterator over the values of begin, which satisfy predicate.
+func Filter[T any](begin ds.ReadForIterator[T], predicate func(value T) bool) *Iterator[T] {
+	return newIterator(-1, func() (value T, ok bool) {
+		for begin.Next() {
+			value, _ = begin.Get()
+			if predicate(value) {
+				return value, true
+			}
+		}
+
+		return
+	})
+}
+
+// TakeWhile returns an iterator over the leading values of begin, which satisfy predicate.
+// The first value not satisfying predicate is consumed from begin.
+func TakeWhile[T any](begin ds.ReadForIterator[T], predicate func(value T) bool) *Iterator[T] {
+	done := false
+
+	return newIterator(-1, func() (value T, ok bool) {
+		if done || !begin.Next() {
+			return
+		}
+
+		value, _ = begin.Get()
+		if !predicate(value) {
+			done = true
+
+			return
+		}
+
+		return value, true
+	})
+}
+
+// Dedup returns an iterator over the values of begin, where consecutive runs of equal values are reduced to their first value.
+func Dedup[T any](begin ds.ReadForIterator[T], comparator utils.Comparator[T]) *Iterator[T] {
+	var previous T
+	hasPrevious := false
+
+	return newIterator(-1, func() (value T, ok bool) {
+		for begin.Next() {
+			value, _ = begin.Get()
+			if !hasPrevious || comparator(previous, value) != 0 {
+				previous, hasPrevious = value, true
+
+				return value, true
+			}
+		}
+
+		return
+	})
+}
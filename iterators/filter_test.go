@@ -0,0 +1,149 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iterators
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	"github.com/JonasMuehlmann/datastructures.go/sets/treeset"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		result []int
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			result: nil,
+		},
+		{
+			name:   "no matches",
+			values: []int{1, 3, 5},
+			result: nil,
+		},
+		{
+			name:   "mixed",
+			values: []int{1, 2, 3, 4, 6, 7},
+			result: []int{2, 4, 6},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := Filter(arraylist.NewFromSlice(test.values).Begin(), isEven)
+
+			assert.Equalf(t, -1, it.Size(), test.name)
+			assert.Equalf(t, test.result, slices.Collect(ds.Seq[int](it)), test.name)
+		})
+	}
+}
+
+func TestFilterIntoConstructor(t *testing.T) {
+	set := treeset.New(utils.BasicComparator[int], 5, 4, 3, 2, 1)
+
+	list := arraylist.NewFromIterator[int](Filter(set.OrderedBegin(utils.BasicComparator[int]), isEven))
+	assert.Equal(t, []int{2, 4}, list.GetValues())
+
+	evens := treeset.NewFromIterator(utils.BasicComparator[int], Filter(list.Begin(), isEven))
+	assert.Equal(t, []int{2, 4}, evens.GetValues())
+}
+
+func TestTakeWhile(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		result []int
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			result: nil,
+		},
+		{
+			name:   "first fails",
+			values: []int{1, 2, 4},
+			result: nil,
+		},
+		{
+			name:   "all match",
+			values: []int{2, 4, 6},
+			result: []int{2, 4, 6},
+		},
+		{
+			name:   "stops at first failure",
+			values: []int{2, 4, 5, 6},
+			result: []int{2, 4},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := TakeWhile(arraylist.NewFromSlice(test.values).Begin(), isEven)
+
+			assert.Equalf(t, test.result, slices.Collect(ds.Seq[int](it)), test.name)
+			assert.Falsef(t, it.Next(), test.name)
+		})
+	}
+}
+
+func TestDedup(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		result []int
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			result: nil,
+		},
+		{
+			name:   "no duplicates",
+			values: []int{1, 2, 3},
+			result: []int{1, 2, 3},
+		},
+		{
+			name:   "consecutive duplicates",
+			values: []int{1, 1, 2, 2, 2, 1, 3, 3},
+			result: []int{1, 2, 1, 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := Dedup(arraylist.NewFromSlice(test.values).Begin(), utils.BasicComparator[int])
+
+			assert.Equalf(t, test.result, slices.Collect(ds.Seq[int](it)), test.name)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package iterators provides lazy adapters over the iterators of this library.
+//
+// Adapters only advance their sources when they are advanced themselves,
+// so they can be chained and passed to the NewFromIterator constructors of the containers without intermediate copies.
+//
+// Most adapters return a forward Iterator.
+// Map keeps the source's bidirectional and random access capabilities,
+// since mapping values does not change their positions.
+//
+// Advancing a source, which is wrapped by an adapter, leaves the adapter in an undefined state.
+package iterators
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+)
+
+// Assert Iterator implementation
+var _ ds.ReadForIndexIterator[int, any] = (*Iterator[any])(nil)
+
+// Pair holds two values, which were produced together.
+type Pair[TFirst any, TSecond any] struct {
+	First  TFirst
+	Second TSecond
+}
+
+// Iterator is a forward iterator over the values produced by an adapter.
+//
+// The values are produced lazily, one element ahead of the iterator's position.
+// The key of a value is its index.
+type Iterator[T any] struct {
+	next func() (T, bool)
+	size int
+
+	value T
+	index int
+
+	lookahead    T
+	hasLookahead bool
+	isEnd        bool
+}
+
+// newIterator returns an iterator, which points to one element before it's first.
+// size is the number of values next will produce, or -1 if it is not known.
+func newIterator[T any](size int, next func() (T, bool)) *Iterator[T] {
+	return &Iterator[T]{next: next, size: size, index: -1}
+}
+
+func (it *Iterator[T]) IsBegin() bool {
+	return it.index == -1 && !it.isEnd
+}
+
+func (it *Iterator[T]) IsEnd() bool {
+	return it.isEnd
+}
+
+func (it *Iterator[T]) IsFirst() bool {
+	return it.IsValid() && it.index == 0
+}
+
+// IsLast returns true if the iterator points to the last element.
+// This produces the next element, if it was not produced yet.
+func (it *Iterator[T]) IsLast() bool {
+	return it.IsValid() && !it.peek()
+}
+
+func (it *Iterator[T]) IsValid() bool {
+	return it.index >= 0 && !it.isEnd
+}
+
+// Size returns the number of elements, if it can be derived from the sources, otherwise -1.
+func (it *Iterator[T]) Size() int {
+	return it.size
+}
+
+// Index returns the number of elements before the current element.
+func (it *Iterator[T]) Index() (int, bool) {
+	if !it.IsValid() {
+		return 0, false
+	}
+
+	return it.index, true
+}
+
+// GetKey returns the current element's index.
+func (it *Iterator[T]) GetKey() (int, bool) {
+	return it.Index()
+}
+
+func (it *Iterator[T]) Get() (value T, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.value, true
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element.
+func (it *Iterator[T]) Next() bool {
+	if !it.peek() {
+		it.isEnd = true
+
+		return false
+	}
+
+	it.value = it.lookahead
+	it.hasLookahead = false
+	it.index++
+
+	return true
+}
+
+func (it *Iterator[T]) NextN(n int) bool {
+	if n <= 0 {
+		return false
+	}
+
+	for i := 0; i < n; i++ {
+		if !it.Next() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Iterator[T]) peek() bool {
+	if it.isEnd {
+		return false
+	}
+
+	if !it.hasLookahead {
+		it.lookahead, it.hasLookahead = it.next()
+	}
+
+	return it.hasLookahead
+}
+
+// sizeOf returns the size of it, if it is a SizedIterator, otherwise -1.
+func sizeOf(it ds.Iterator) int {
+	sized, ok := it.(ds.SizedIterator)
+	if !ok {
+		return -1
+	}
+
+	return sized.Size()
+}
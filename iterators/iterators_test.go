@@ -0,0 +1,71 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iterators
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterator(t *testing.T) {
+	it := Enumerate(Map(arraylist.New(1, 2, 3).Begin(), func(value int) int { return value * 10 }))
+
+	assert.True(t, it.IsBegin())
+	assert.False(t, it.IsValid())
+	assert.Equal(t, 3, it.Size())
+
+	_, found := it.Get()
+	assert.False(t, found)
+
+	assert.True(t, it.Next())
+	assert.True(t, it.IsFirst())
+	assert.False(t, it.IsLast())
+
+	value, found := it.Get()
+	assert.True(t, found)
+	assert.Equal(t, 10, value)
+
+	assert.True(t, it.NextN(2))
+	assert.True(t, it.IsLast())
+
+	key, found := it.GetKey()
+	assert.True(t, found)
+	assert.Equal(t, 2, key)
+
+	value, found = it.Get()
+	assert.True(t, found)
+	assert.Equal(t, 30, value)
+
+	assert.False(t, it.Next())
+	assert.True(t, it.IsEnd())
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Next())
+
+	_, found = it.Get()
+	assert.False(t, found)
+}
+
+func TestIteratorIsLazy(t *testing.T) {
+	pulled := 0
+	it := Filter(arraylist.New(1, 2, 3).Begin(), func(value int) bool {
+		pulled++
+
+		return true
+	})
+
+	assert.Equal(t, 0, pulled)
+
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, pulled)
+
+	// IsLast needs to look ahead by one element
+	assert.False(t, it.IsLast())
+	assert.Equal(t, 2, pulled)
+
+	assert.True(t, it.Next())
+	assert.Equal(t, 2, pulled)
+}
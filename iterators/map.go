@@ -0,0 +1,235 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iterators
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+)
+
+// Assert Iterator implementation
+var _ ds.ReadOrdCompBidRandCollIterator[int, any] = (*RandomAccessMapIterator[int, any, any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*RandomAccessMapIterator[int, any, any])(nil)
+
+// Assert Iterator implementation
+var _ ds.ReadForIndexIterator[int, any] = (*mapIterator[int, any, any])(nil)
+
+// Map returns an iterator over the values of begin transformed by mapping, which keeps begin's keys.
+// If begin is a bidirectional, random access iterator, the returned iterator is a *RandomAccessMapIterator,
+// which keeps those capabilities, otherwise it only moves forward.
+func Map[TKey any, TIn any, TOut any](begin ds.ReadForIndexIterator[TKey, TIn], mapping func(value TIn) TOut) ds.ReadForIndexIterator[TKey, TOut] {
+	if it, ok := begin.(ds.ReadOrdCompBidRandCollIterator[TKey, TIn]); ok {
+		return MapRandomAccess(it, mapping)
+	}
+
+	return &mapIterator[TKey, TIn, TOut]{it: begin, mapping: mapping}
+}
+
+// mapIterator is a forward iterator over the values of another iterator transformed by a mapping.
+// Values are transformed whenever they are read.
+type mapIterator[TKey any, TIn any, TOut any] struct {
+	it      ds.ReadForIndexIterator[TKey, TIn]
+	mapping func(value TIn) TOut
+}
+
+func (it *mapIterator[TKey, TIn, TOut]) IsBegin() bool {
+	return it.it.IsBegin()
+}
+
+func (it *mapIterator[TKey, TIn, TOut]) IsEnd() bool {
+	return it.it.IsEnd()
+}
+
+func (it *mapIterator[TKey, TIn, TOut]) IsFirst() bool {
+	return it.it.IsFirst()
+}
+
+func (it *mapIterator[TKey, TIn, TOut]) IsLast() bool {
+	return it.it.IsLast()
+}
+
+func (it *mapIterator[TKey, TIn, TOut]) IsValid() bool {
+	return it.it.IsValid()
+}
+
+func (it *mapIterator[TKey, TIn, TOut]) Size() int {
+	return it.it.Size()
+}
+
+func (it *mapIterator[TKey, TIn, TOut]) Index() (int, bool) {
+	return it.it.Index()
+}
+
+func (it *mapIterator[TKey, TIn, TOut]) GetKey() (TKey, bool) {
+	return it.it.GetKey()
+}
+
+func (it *mapIterator[TKey, TIn, TOut]) Next() bool {
+	return it.it.Next()
+}
+
+func (it *mapIterator[TKey, TIn, TOut]) NextN(n int) bool {
+	return it.it.NextN(n)
+}
+
+func (it *mapIterator[TKey, TIn, TOut]) Get() (value TOut, found bool) {
+	in, found := it.it.Get()
+	if !found {
+		return
+	}
+
+	return it.mapping(in), true
+}
+
+// FlatMap returns an iterator over the values of the iterators, which mapping returns for the values of begin.
+func FlatMap[TIn any, TOut any](begin ds.ReadForIterator[TIn], mapping func(value TIn) ds.ReadForIterator[TOut]) *Iterator[TOut] {
+	var inner ds.ReadForIterator[TOut]
+
+	return newIterator(-1, func() (value TOut, ok bool) {
+		for inner == nil || !inner.Next() {
+			if !begin.Next() {
+				return
+			}
+
+			in, _ := begin.Get()
+			inner = mapping(in)
+		}
+
+		return inner.Get()
+	})
+}
+
+// RandomAccessMapIterator is a bidirectional, random access iterator over the values of another iterator transformed by a mapping.
+// Moving the iterator moves the underlying iterator, values are transformed whenever they are read.
+//
+// Since the mapping can not be inverted, values can not be written through the iterator.
+type RandomAccessMapIterator[TKey any, TIn any, TOut any] struct {
+	it      ds.ReadOrdCompBidRandCollIterator[TKey, TIn]
+	mapping func(value TIn) TOut
+}
+
+// MapRandomAccess returns an iterator over the values of it transformed by mapping,
+// which keeps the position, bidirectional movement and random access of it.
+// Unlike Map, the result's type is known statically.
+func MapRandomAccess[TKey any, TIn any, TOut any](it ds.ReadOrdCompBidRandCollIterator[TKey, TIn], mapping func(value TIn) TOut) *RandomAccessMapIterator[TKey, TIn, TOut] {
+	return &RandomAccessMapIterator[TKey, TIn, TOut]{it: it, mapping: mapping}
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) IsBegin() bool {
+	return it.it.IsBegin()
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) IsEnd() bool {
+	return it.it.IsEnd()
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) IsFirst() bool {
+	return it.it.IsFirst()
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) IsLast() bool {
+	return it.it.IsLast()
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) IsValid() bool {
+	return it.it.IsValid()
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) IsEqual(other ds.ComparableIterator) bool {
+	otherThis, ok := other.(*RandomAccessMapIterator[TKey, TIn, TOut])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.it.IsEqual(otherThis.it)
+}
+
+// CanCompare checks if other is a RandomAccessMapIterator, whose underlying iterator can be compared with it's own.
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*RandomAccessMapIterator[TKey, TIn, TOut])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	return ds.CanCompare(it.it, otherThis.it)
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) DistanceTo(other ds.OrderedIterator) int {
+	otherThis, ok := other.(*RandomAccessMapIterator[TKey, TIn, TOut])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.it.DistanceTo(otherThis.it)
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) IsAfter(other ds.OrderedIterator) bool {
+	return it.DistanceTo(other) > 0
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) IsBefore(other ds.OrderedIterator) bool {
+	return it.DistanceTo(other) < 0
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) Size() int {
+	return it.it.Size()
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) Index() (int, bool) {
+	return it.it.Index()
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) GetKey() (TKey, bool) {
+	return it.it.GetKey()
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) Next() bool {
+	return it.it.Next()
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) NextN(n int) bool {
+	return it.it.NextN(n)
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) Previous() bool {
+	return it.it.Previous()
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) PreviousN(n int) bool {
+	return it.it.PreviousN(n)
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) MoveBy(n int) bool {
+	return it.it.MoveBy(n)
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) MoveTo(i int) bool {
+	return it.it.MoveTo(i)
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) MoveToKey(key TKey) bool {
+	return it.it.MoveToKey(key)
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) Get() (value TOut, found bool) {
+	return it.mapped(it.it.Get())
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) GetAt(i int) (value TOut, found bool) {
+	return it.mapped(it.it.GetAt(i))
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) GetAtKey(key TKey) (value TOut, found bool) {
+	return it.mapped(it.it.GetAtKey(key))
+}
+
+func (it *RandomAccessMapIterator[TKey, TIn, TOut]) mapped(in TIn, found bool) (value TOut, ok bool) {
+	if !found {
+		return
+	}
+
+	return it.mapping(in), true
+}
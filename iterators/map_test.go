@@ -0,0 +1,159 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iterators
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	"github.com/JonasMuehlmann/datastructures.go/maps/treemap"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		result []string
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			result: nil,
+		},
+		{
+			name:   "3 items",
+			values: []int{1, 2, 3},
+			result: []string{"1", "2", "3"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := Map(arraylist.NewFromSlice(test.values).Begin(), strconv.Itoa)
+
+			assert.Equalf(t, len(test.values), it.Size(), test.name)
+			assert.Equalf(t, test.result, slices.Collect(ds.Seq[string](it)), test.name)
+		})
+	}
+}
+
+func TestMapKeepsCapabilities(t *testing.T) {
+	m := treemap.New[string, int](utils.BasicComparator[string])
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	it, ok := Map(m.OrderedBegin(utils.BasicComparator[string]), strconv.Itoa).(ds.ReadOrdCompBidRandCollIterator[string, string])
+	assert.True(t, ok)
+
+	assert.True(t, it.MoveToKey("b"))
+	value, _ := it.Get()
+	assert.Equal(t, "2", value)
+
+	assert.True(t, it.Previous())
+	key, _ := it.GetKey()
+	assert.Equal(t, "a", key)
+
+	value, _ = it.GetAt(1)
+	assert.Equal(t, "2", value)
+
+	// A forward source stays forward
+	forward := Map(Filter(arraylist.New(1, 2, 3).Begin(), func(value int) bool { return value > 1 }), strconv.Itoa)
+
+	_, ok = forward.(ds.BidirectionalIterator)
+	assert.False(t, ok)
+	assert.Equal(t, []string{"2", "3"}, slices.Collect(ds.Seq[string](forward)))
+}
+
+func TestFlatMap(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		result []int
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			result: nil,
+		},
+		{
+			name:   "empty inner iterators",
+			values: []int{0, 0},
+			result: nil,
+		},
+		{
+			name:   "mixed inner iterators",
+			values: []int{1, 0, 3, 2},
+			result: []int{0, 0, 1, 2, 0, 1},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := FlatMap(arraylist.NewFromSlice(test.values).Begin(), func(value int) ds.ReadForIterator[int] {
+				list := arraylist.New[int]()
+				for i := 0; i < value; i++ {
+					list.PushBack(i)
+				}
+
+				return list.Begin()
+			})
+
+			assert.Equalf(t, -1, it.Size(), test.name)
+			assert.Equalf(t, test.result, slices.Collect(ds.Seq[int](it)), test.name)
+		})
+	}
+}
+
+func TestMapRandomAccess(t *testing.T) {
+	list := arraylist.New("a", "bb", "ccc")
+	it := MapRandomAccess(list.Begin(), func(value string) int { return len(value) })
+	other := MapRandomAccess(list.End(), func(value string) int { return len(value) })
+
+	assert.True(t, it.IsBegin())
+	assert.Equal(t, 3, it.Size())
+	assert.Equal(t, -4, it.DistanceTo(other))
+	assert.True(t, it.IsBefore(other))
+
+	value, found := it.GetAt(2)
+	assert.True(t, found)
+	assert.Equal(t, 3, value)
+
+	_, found = it.GetAt(3)
+	assert.False(t, found)
+
+	assert.True(t, it.MoveTo(1))
+
+	value, found = it.Get()
+	assert.True(t, found)
+	assert.Equal(t, 2, value)
+
+	assert.True(t, it.Previous())
+	assert.True(t, it.IsFirst())
+
+	value, found = it.Get()
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+
+	assert.True(t, other.PreviousN(3))
+	assert.True(t, it.IsEqual(other))
+
+	assert.Panics(t, func() { it.IsEqual(list.Begin()) })
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iterators
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Take returns an iterator over the first n values of begin.
+func Take[T any](begin ds.ReadForIterator[T], n int) *Iterator[T] {
+	n = utils.Max(n, 0)
+
+	size := sizeOf(begin)
+	if size >= 0 {
+		size = utils.Min(size, n)
+	}
+
+	taken := 0
+
+	return newIterator(size, func() (value T, ok bool) {
+		if taken >= n || !begin.Next() {
+			return
+		}
+
+		taken++
+
+		return begin.Get()
+	})
+}
+
+// Skip returns an iterator over the values of begin after its first n values.
+// The skipped values are consumed once the iterator is advanced for the first time.
+func Skip[T any](begin ds.ReadForIterator[T], n int) *Iterator[T] {
+	n = utils.Max(n, 0)
+
+	size := sizeOf(begin)
+	if size >= 0 {
+		size = utils.Max(size-n, 0)
+	}
+
+	skipped := false
+
+	return newIterator(size, func() (value T, ok bool) {
+		if !skipped {
+			skipped = true
+
+			for i := 0; i < n; i++ {
+				if !begin.Next() {
+					return
+				}
+			}
+		}
+
+		if !begin.Next() {
+			return
+		}
+
+		return begin.Get()
+	})
+}
+
+// Window returns an iterator over all overlapping windows of size consecutive values of begin.
+// Every window is a new slice, if begin has less than size values, there are no windows.
+func Window[T any](begin ds.ReadForIterator[T], size int) *Iterator[[]T] {
+	if size < 1 {
+		panic("Invalid size, should be at least 1")
+	}
+
+	numWindows := sizeOf(begin)
+	if numWindows >= 0 {
+		numWindows = utils.Max(numWindows-size+1, 0)
+	}
+
+	// buffer holds the current window and is never handed out, so callers can not modify it
+	buffer := make([]T, 0, size)
+
+	return newIterator(numWindows, func() (window []T, ok bool) {
+		if len(buffer) == size {
+			buffer = append(buffer[:0], buffer[1:]...)
+		}
+
+		for len(buffer) < size {
+			if !begin.Next() {
+				return nil, false
+			}
+
+			value, _ := begin.Get()
+			buffer = append(buffer, value)
+		}
+
+		return append(make([]T, 0, size), buffer...), true
+	})
+}
+
+// Chunk returns an iterator over consecutive, non-overlapping chunks of size values of begin.
+// Every chunk is a new slice, the last chunk holds the remaining values and can be smaller.
+func Chunk[T any](begin ds.ReadForIterator[T], size int) *Iterator[[]T] {
+	if size < 1 {
+		panic("Invalid size, should be at least 1")
+	}
+
+	numChunks := sizeOf(begin)
+	if numChunks >= 0 {
+		numChunks = (numChunks + size - 1) / size
+	}
+
+	return newIterator(numChunks, func() (chunk []T, ok bool) {
+		chunk = make([]T, 0, size)
+
+		for len(chunk) < size && begin.Next() {
+			value, _ := begin.Get()
+			chunk = append(chunk, value)
+		}
+
+		if len(chunk) == 0 {
+			return nil, false
+		}
+
+		return chunk, true
+	})
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iterators
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTake(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		n      int
+		size   int
+		result []int
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			n:      2,
+			size:   0,
+			result: nil,
+		},
+		{
+			name:   "negative n",
+			values: []int{1, 2, 3},
+			n:      -1,
+			size:   0,
+			result: nil,
+		},
+		{
+			name:   "less than available",
+			values: []int{1, 2, 3},
+			n:      2,
+			size:   2,
+			result: []int{1, 2},
+		},
+		{
+			name:   "more than available",
+			values: []int{1, 2, 3},
+			n:      5,
+			size:   3,
+			result: []int{1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := Take(arraylist.NewFromSlice(test.values).Begin(), test.n)
+
+			assert.Equalf(t, test.size, it.Size(), test.name)
+			assert.Equalf(t, test.result, slices.Collect(ds.Seq[int](it)), test.name)
+		})
+	}
+}
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		n      int
+		size   int
+		result []int
+	}{
+		{
+			name:   "empty",
+			values: []int{},
+			n:      2,
+			size:   0,
+			result: nil,
+		},
+		{
+			name:   "zero",
+			values: []int{1, 2, 3},
+			n:      0,
+			size:   3,
+			result: []int{1, 2, 3},
+		},
+		{
+			name:   "less than available",
+			values: []int{1, 2, 3},
+			n:      2,
+			size:   1,
+			result: []int{3},
+		},
+		{
+			name:   "more than available",
+			values: []int{1, 2, 3},
+			n:      5,
+			size:   0,
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := Skip(arraylist.NewFromSlice(test.values).Begin(), test.n)
+
+			assert.Equalf(t, test.size, it.Size(), test.name)
+			assert.Equalf(t, test.result, slices.Collect(ds.Seq[int](it)), test.name)
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      []int
+		windowSize  int
+		size        int
+		result      [][]int
+		shouldPanic bool
+	}{
+		{
+			name:        "invalid size",
+			values:      []int{1, 2, 3},
+			windowSize:  0,
+			shouldPanic: true,
+		},
+		{
+			name:       "too few values",
+			values:     []int{1, 2},
+			windowSize: 3,
+			size:       0,
+			result:     nil,
+		},
+		{
+			name:       "single window",
+			values:     []int{1, 2, 3},
+			windowSize: 3,
+			size:       1,
+			result:     [][]int{{1, 2, 3}},
+		},
+		{
+			name:       "overlapping windows",
+			values:     []int{1, 2, 3, 4},
+			windowSize: 2,
+			size:       3,
+			result:     [][]int{{1, 2}, {2, 3}, {3, 4}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			if test.shouldPanic {
+				assert.Panicsf(t, func() { Window(arraylist.NewFromSlice(test.values).Begin(), test.windowSize) }, test.name)
+
+				return
+			}
+
+			it := Window(arraylist.NewFromSlice(test.values).Begin(), test.windowSize)
+
+			assert.Equalf(t, test.size, it.Size(), test.name)
+			assert.Equalf(t, test.result, slices.Collect(ds.Seq[[]int](it)), test.name)
+		})
+	}
+}
+
+func TestWindowReturnsCopies(t *testing.T) {
+	it := Window(arraylist.New(1, 2, 3).Begin(), 2)
+
+	assert.True(t, it.Next())
+	first, _ := it.Get()
+	first[1] = 0
+
+	assert.True(t, it.Next())
+	second, _ := it.Get()
+	assert.Equal(t, []int{2, 3}, second)
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      []int
+		chunkSize   int
+		size        int
+		result      [][]int
+		shouldPanic bool
+	}{
+		{
+			name:        "invalid size",
+			values:      []int{1, 2, 3},
+			chunkSize:   0,
+			shouldPanic: true,
+		},
+		{
+			name:      "empty",
+			values:    []int{},
+			chunkSize: 2,
+			size:      0,
+			result:    nil,
+		},
+		{
+			name:      "even split",
+			values:    []int{1, 2, 3, 4},
+			chunkSize: 2,
+			size:      2,
+			result:    [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name:      "shorter last chunk",
+			values:    []int{1, 2, 3, 4, 5},
+			chunkSize: 2,
+			size:      3,
+			result:    [][]int{{1, 2}, {3, 4}, {5}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			if test.shouldPanic {
+				assert.Panicsf(t, func() { Chunk(arraylist.NewFromSlice(test.values).Begin(), test.chunkSize) }, test.name)
+
+				return
+			}
+
+			it := Chunk(arraylist.NewFromSlice(test.values).Begin(), test.chunkSize)
+
+			assert.Equalf(t, test.size, it.Size(), test.name)
+			assert.Equalf(t, test.result, slices.Collect(ds.Seq[[]int](it)), test.name)
+		})
+	}
+}
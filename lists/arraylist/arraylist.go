@@ -12,6 +12,7 @@ package arraylist
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
@@ -22,8 +23,13 @@ import (
 // Assert List implementation.
 var _ lists.List[any] = (*List[any])(nil)
 
+// Assert Cloneable and Equatable implementation.
+var _ ds.Cloneable[*List[any]] = (*List[any])(nil)
+var _ ds.Equatable[*List[any], any] = (*List[any])(nil)
+
 type List[T any] struct {
-	elements []T
+	elements      []T
+	modifications ds.ModificationCounter
 }
 
 const (
@@ -95,11 +101,13 @@ func NewFromIterators[T any](begin ds.ReadCompForIterator[T], end ds.ComparableI
 // Add appends a value at the end of the list.
 func (list *List[T]) PushBack(values ...T) {
 	list.elements = append(list.elements, values...)
+	list.modifications.Modified()
 
 }
 
 func (list *List[T]) PushFront(values ...T) {
 	list.elements = append(values, list.elements...)
+	list.modifications.Modified()
 }
 
 func (list *List[T]) PopBack(n int) (popped []T) {
@@ -107,6 +115,7 @@ func (list *List[T]) PopBack(n int) (popped []T) {
 		popped = list.elements[len(list.elements)-n:]
 
 		list.elements = list.elements[:len(list.elements)-n]
+		list.modifications.Modified()
 	}
 
 	return
@@ -117,6 +126,7 @@ func (list *List[T]) PopFront(n int) (popped []T) {
 		popped = list.elements[:n]
 
 		list.elements = list.elements[n:]
+		list.modifications.Modified()
 	}
 
 	return
@@ -141,6 +151,7 @@ func (list *List[T]) Remove(index int) {
 
 	list.elements[index] = list.elements[len(list.elements)-1]
 	list.elements = list.elements[:len(list.elements)-1]
+	list.modifications.Modified()
 }
 
 // RemoveStable removes the element at the given index from the list.
@@ -151,6 +162,7 @@ func (list *List[T]) RemoveStable(index int) {
 	}
 
 	list.elements = append(list.elements[:index], list.elements[index+1:]...) // shift to the left by one (slow operation, need ways to optimize this)
+	list.modifications.Modified()
 }
 
 // Contains checks if elements (one or more) are present in the set.
@@ -210,6 +222,7 @@ func (list *List[T]) Size() int {
 // Clear removes all elements from the list.
 func (list *List[T]) Clear() {
 	list.elements = []T{}
+	list.modifications.Modified()
 }
 
 // Sort sorts values (in-place) using.
@@ -246,6 +259,7 @@ func (list *List[T]) Insert(index int, values ...T) {
 	copy(newList[index+len(values):], list.elements[index+len(values)-1:])
 
 	list.elements = newList
+	list.modifications.Modified()
 }
 
 // Set the value at specified index
@@ -274,6 +288,19 @@ func (list *List[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the list, which does not share its underlying array with the original.
+func (list *List[T]) Clone() *List[T] {
+	elements := make([]T, len(list.elements))
+	copy(elements, list.elements)
+
+	return &List[T]{elements: elements}
+}
+
+// Equals returns true if both lists hold equal values in the same order.
+func (list *List[T]) Equals(other *List[T], comparator utils.Comparator[T]) bool {
+	return utils.SlicesEqual(list.elements, other.elements, comparator)
+}
+
 // ShrinkToFit shrinks the array so that len == cap.
 func (list *List[T]) ShrinkToFit() {
 	list.elements = append([]T{}, list.elements...)
@@ -360,3 +387,36 @@ func (list *List[T]) ReverseFirst() ds.ReadWriteOrdCompBidRandCollIterator[int,
 func (list *List[T]) ReverseLast() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 	return list.NewReverseIterator(0, list.Size())
 }
+
+// All returns a sequence over the index/value pairs of the list in iteration order.
+func (list *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range ds.Seq(list.Begin()) {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns a sequence over the index/value pairs of the list in reverse iteration order.
+func (list *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := list.Size() - 1
+		for value := range ds.BackwardSeq(list.End()) {
+			if !yield(i, value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// Values returns a sequence over the values of the list in iteration order.
+func (list *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(list.Begin())(yield)
+	}
+}
@@ -6,12 +6,11 @@
 package arraylist
 
 import (
+	"slices"
 	"testing"
 
-	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/ds"
-
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1166,3 +1165,125 @@ func BenchmarkArrayListPopFront(b *testing.B) {
 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 	}
 }
+
+func TestArrayListClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *List[string]
+	}{
+		{
+			name:     "empty",
+			original: New[string](),
+		},
+		{
+			name:     "3 items",
+			original: New[string]("foo", "bar", "baz"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			values := test.original.GetValues()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+
+			clone.PushBack("qux")
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+		})
+	}
+}
+
+func TestArrayListEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *List[string]
+		b     *List[string]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     New[string](),
+			b:     New[string](),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     New[string]("foo", "bar", "baz"),
+			b:     New[string]("foo", "bar", "baz"),
+			equal: true,
+		},
+		{
+			name:  "different order",
+			a:     New[string]("foo", "bar", "baz"),
+			b:     New[string]("baz", "bar", "foo"),
+			equal: false,
+		},
+		{
+			name:  "different values",
+			a:     New[string]("foo", "bar"),
+			b:     New[string]("foo", "qux"),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     New[string]("foo"),
+			b:     New[string]("foo", "bar"),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[string]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	list := New[int](1, 2, 3)
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range list.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	indices = []int{}
+	values = []int{}
+	for i, value := range list.Backward() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{2, 1, 0}, indices)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(list.Values()))
+
+	values = []int{}
+	for value := range list.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
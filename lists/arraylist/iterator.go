@@ -13,8 +13,22 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[int, any] = (*Iterator[any])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*Iterator[any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*Iterator[any])(nil)
+
+// Assert ConstantTimeAccessIterator implementation
+var _ ds.ConstantTimeAccessIterator = (*Iterator[any])(nil)
+
+// Assert ErasableIterator and InsertableIterator implementation
+var _ ds.ErasableIterator = (*Iterator[any])(nil)
+var _ ds.InsertableIterator[any] = (*Iterator[any])(nil)
+
 // Iterator holding the iterator's state
 type Iterator[T any] struct {
+	guard ds.ModificationGuard
 	list  *List[T]
 	index int
 	// Redundant but has better locality
@@ -24,7 +38,7 @@ type Iterator[T any] struct {
 
 // NewIterator returns a stateful iterator whose values can be fetched by an index.
 func (list *List[T]) NewIterator(index int, size int) *Iterator[T] {
-	it := &Iterator[T]{list: list, index: index, size: size}
+	it := &Iterator[T]{list: list, index: index, size: size, guard: list.modifications.Guard()}
 	it.size = utils.Min(list.Size(), size)
 	it.size = utils.Max(list.Size(), -1)
 
@@ -36,7 +50,12 @@ func (list *List[T]) NewIterator(index int, size int) *Iterator[T] {
 }
 
 func (it *Iterator[T]) IsValid() bool {
-	return it.size > 0 && !it.IsBegin() && !it.IsEnd()
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the list was structurally modified, other than through the iterator.
+func (it *Iterator[T]) Err() error {
+	return it.guard.Err()
 }
 
 func (it *Iterator[T]) Get() (value T, found bool) {
@@ -57,41 +76,71 @@ func (it *Iterator[T]) Set(value T) bool {
 	return true
 }
 
-// If other is of type IndexedIterator, IndexedIterator.Index() will be used, possibly executing in O(1)
-func (it *Iterator[T]) DistanceTo(other ds.OrderedIterator) int {
-	otherThis, ok := other.(*Iterator[T])
-	if !ok {
-		panic(ds.CanOnlyCompareEqualIteratorTypes)
+// Remove removes the current element in O(n) and moves the iterator to the next element.
+// The order of the remaining elements is preserved.
+func (it *Iterator[T]) Remove() bool {
+	if !it.IsValid() {
+		return false
+	}
+
+	it.list.RemoveStable(it.index)
+	it.guard.Sync()
+	it.size--
+
+	if it.IsValid() {
+		it.value = it.list.elements[it.index]
 	}
 
-	return it.index - otherThis.index
+	return true
 }
 
-func (it *Iterator[T]) IsAfter(other ds.OrderedIterator) bool {
-	otherThis, ok := other.(*Iterator[T])
-	if !ok {
-		panic(ds.CanOnlyCompareEqualIteratorTypes)
+// InsertBefore inserts value before the current element or at the end of the list in O(n).
+func (it *Iterator[T]) InsertBefore(value T) bool {
+	if !it.IsValid() && it.index != it.size {
+		return false
 	}
 
-	return it.DistanceTo(otherThis) > 0
+	it.list.Insert(it.index, value)
+	it.guard.Sync()
+	it.index++
+	it.size++
+
+	return true
 }
 
-func (it *Iterator[T]) IsBefore(other ds.OrderedIterator) bool {
-	otherThis, ok := other.(*Iterator[T])
-	if !ok {
-		panic(ds.CanOnlyCompareEqualIteratorTypes)
+// InsertAfter inserts value after the current element or at the start of the list in O(n).
+func (it *Iterator[T]) InsertAfter(value T) bool {
+	if !it.IsValid() && !it.IsBegin() {
+		return false
 	}
 
-	return it.DistanceTo(otherThis) < 0
+	it.list.Insert(it.index+1, value)
+	it.guard.Sync()
+	it.size++
+
+	return true
+}
+
+// If other is of type IndexedIterator, IndexedIterator.Index() will be used, possibly executing in O(1)
+func (it *Iterator[T]) DistanceTo(other ds.OrderedIterator) int {
+	return it.index - indexOf[T](other)
+}
+
+func (it *Iterator[T]) IsAfter(other ds.OrderedIterator) bool {
+	return it.DistanceTo(other) > 0
+}
+
+func (it *Iterator[T]) IsBefore(other ds.OrderedIterator) bool {
+	return it.DistanceTo(other) < 0
 }
 
 func (it *Iterator[T]) IsEqual(other ds.ComparableIterator) bool {
-	otherThis, ok := other.(*Iterator[T])
-	if !ok {
-		panic(ds.CanOnlyCompareEqualIteratorTypes)
-	}
+	return it.index == indexOf[T](other)
+}
 
-	return it.DistanceTo(otherThis) == 0
+// CanCompare checks if other is an Iterator or ReverseIterator of the same list.
+func (it *Iterator[T]) CanCompare(other ds.Iterator) error {
+	return canCompare[T](it, it.list, other)
 }
 
 func (it *Iterator[T]) Next() bool {
@@ -189,7 +238,7 @@ func (it *Iterator[T]) IsLast() bool {
 }
 
 func (it *Iterator[T]) GetAt(i int) (value T, found bool) {
-	if it.size == 0 || !it.list.withinRange(i) {
+	if it.size == 0 || !it.list.withinRange(i) || it.guard.Err() != nil {
 		return
 	}
 
@@ -197,7 +246,7 @@ func (it *Iterator[T]) GetAt(i int) (value T, found bool) {
 }
 
 func (it *Iterator[T]) SetAt(i int, value T) bool {
-	if it.size == 0 || !it.list.withinRange(i) {
+	if it.size == 0 || !it.list.withinRange(i) || it.guard.Err() != nil {
 		return false
 	}
 	it.list.elements[i] = value
@@ -212,3 +261,41 @@ func (it *Iterator[T]) GetAtKey(i int) (value T, found bool) {
 func (it *Iterator[T]) SetAtKey(i int, value T) bool {
 	return it.SetAt(i, value)
 }
+
+// indexOf returns the list index of other, which can be an Iterator or a ReverseIterator.
+// Comparing a forward with a reverse iterator compares the positions of the elements they point to.
+func indexOf[T any](other ds.Iterator) int {
+	switch otherThis := other.(type) {
+	case *Iterator[T]:
+		return otherThis.index
+	case *ReverseIterator[T]:
+		return otherThis.index
+	}
+
+	panic(ds.CanOnlyCompareEqualIteratorTypes)
+}
+
+// canCompare checks if other is an Iterator or ReverseIterator of list.
+func canCompare[T any](it ds.Iterator, list *List[T], other ds.Iterator) error {
+	var otherList *List[T]
+
+	switch otherThis := other.(type) {
+	case *Iterator[T]:
+		otherList = otherThis.list
+	case *ReverseIterator[T]:
+		otherList = otherThis.list
+	default:
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherList != list {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
+// HasConstantTimeAccess returns true, since the elements are indexed directly.
+func (it *Iterator[T]) HasConstantTimeAccess() bool {
+	return true
+}
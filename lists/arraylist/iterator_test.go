@@ -1089,3 +1089,470 @@ func TestArrayListIteratorSize(t *testing.T) {
 		})
 	}
 }
+
+func TestArrayListIteratorRemove(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     *List[int]
+		position int
+		removed  bool
+		values   []int
+		value    int
+		found    bool
+	}{
+		{
+			name:     "Empty",
+			list:     New[int](),
+			position: -1,
+			removed:  false,
+			values:   []int{},
+		},
+		{
+			name:     "3 elements, begin",
+			list:     New[int](1, 2, 3),
+			position: -1,
+			removed:  false,
+			values:   []int{1, 2, 3},
+		},
+		{
+			name:     "3 elements, first",
+			list:     New[int](1, 2, 3),
+			position: 0,
+			removed:  true,
+			values:   []int{2, 3},
+			value:    2,
+			found:    true,
+		},
+		{
+			name:     "3 elements, middle",
+			list:     New[int](1, 2, 3),
+			position: 1,
+			removed:  true,
+			values:   []int{1, 3},
+			value:    3,
+			found:    true,
+		},
+		{
+			name:     "3 elements, last",
+			list:     New[int](1, 2, 3),
+			position: 2,
+			removed:  true,
+			values:   []int{1, 2},
+			found:    false,
+		},
+		{
+			name:     "3 elements, end",
+			list:     New[int](1, 2, 3),
+			position: 3,
+			removed:  false,
+			values:   []int{1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := test.list.Begin().(*Iterator[int])
+			for i := -1; i < test.position; i++ {
+				it.Next()
+			}
+
+			assert.Equalf(t, test.removed, it.Remove(), test.name)
+			assert.Equalf(t, test.values, test.list.GetValues(), test.name)
+			assert.Equalf(t, len(test.values), it.Size(), test.name)
+
+			value, found := it.Get()
+			assert.Equalf(t, test.value, value, test.name)
+			assert.Equalf(t, test.found, found, test.name)
+		})
+	}
+}
+
+func TestArrayListIteratorRemoveWhileIterating(t *testing.T) {
+	list := New[int](2, 1, 4, 6, 3, 8)
+	it := list.Begin().(*Iterator[int])
+
+	for it.Next(); it.IsValid(); {
+		value, _ := it.Get()
+		if value%2 == 0 {
+			it.Remove()
+		} else {
+			it.Next()
+		}
+	}
+
+	assert.Equal(t, []int{1, 3}, list.GetValues())
+	assert.True(t, it.IsEnd())
+
+	list.PushBack(5)
+	assert.Equal(t, []int{1, 3, 5}, list.GetValues())
+}
+
+func TestArrayListIteratorInsertBefore(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     *List[int]
+		position int
+		inserted bool
+		values   []int
+		index    int
+	}{
+		{
+			name:     "Empty, end",
+			list:     New[int](),
+			position: 0,
+			inserted: true,
+			values:   []int{0},
+			index:    1,
+		},
+		{
+			name:     "3 elements, begin",
+			list:     New[int](1, 2, 3),
+			position: -1,
+			inserted: false,
+			values:   []int{1, 2, 3},
+			index:    -1,
+		},
+		{
+			name:     "3 elements, first",
+			list:     New[int](1, 2, 3),
+			position: 0,
+			inserted: true,
+			values:   []int{0, 1, 2, 3},
+			index:    1,
+		},
+		{
+			name:     "3 elements, last",
+			list:     New[int](1, 2, 3),
+			position: 2,
+			inserted: true,
+			values:   []int{1, 2, 0, 3},
+			index:    3,
+		},
+		{
+			name:     "3 elements, end",
+			list:     New[int](1, 2, 3),
+			position: 3,
+			inserted: true,
+			values:   []int{1, 2, 3, 0},
+			index:    4,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := test.list.Begin().(*Iterator[int])
+			for i := -1; i < test.position; i++ {
+				it.Next()
+			}
+
+			valueBefore, foundBefore := it.Get()
+
+			assert.Equalf(t, test.inserted, it.InsertBefore(0), test.name)
+			assert.Equalf(t, test.values, test.list.GetValues(), test.name)
+			assert.Equalf(t, len(test.values), it.Size(), test.name)
+
+			index, _ := it.Index()
+			assert.Equalf(t, test.index, index, test.name)
+
+			value, found := it.Get()
+			assert.Equalf(t, valueBefore, value, test.name)
+			assert.Equalf(t, foundBefore, found, test.name)
+		})
+	}
+}
+
+func TestArrayListIteratorInsertAfter(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     *List[int]
+		position int
+		inserted bool
+		values   []int
+		next     int
+	}{
+		{
+			name:     "Empty, begin",
+			list:     New[int](),
+			position: -1,
+			inserted: true,
+			values:   []int{0},
+			next:     0,
+		},
+		{
+			name:     "3 elements, begin",
+			list:     New[int](1, 2, 3),
+			position: -1,
+			inserted: true,
+			values:   []int{0, 1, 2, 3},
+			next:     0,
+		},
+		{
+			name:     "3 elements, first",
+			list:     New[int](1, 2, 3),
+			position: 0,
+			inserted: true,
+			values:   []int{1, 0, 2, 3},
+			next:     0,
+		},
+		{
+			name:     "3 elements, last",
+			list:     New[int](1, 2, 3),
+			position: 2,
+			inserted: true,
+			values:   []int{1, 2, 3, 0},
+			next:     0,
+		},
+		{
+			name:     "3 elements, end",
+			list:     New[int](1, 2, 3),
+			position: 3,
+			inserted: false,
+			values:   []int{1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := test.list.Begin().(*Iterator[int])
+			for i := -1; i < test.position; i++ {
+				it.Next()
+			}
+
+			valueBefore, foundBefore := it.Get()
+
+			assert.Equalf(t, test.inserted, it.InsertAfter(0), test.name)
+			assert.Equalf(t, test.values, test.list.GetValues(), test.name)
+			assert.Equalf(t, len(test.values), it.Size(), test.name)
+
+			value, found := it.Get()
+			assert.Equalf(t, valueBefore, value, test.name)
+			assert.Equalf(t, foundBefore, found, test.name)
+
+			if test.inserted {
+				assert.Truef(t, it.Next(), test.name)
+
+				value, _ = it.Get()
+				assert.Equalf(t, test.next, value, test.name)
+			}
+		})
+	}
+}
+
+func TestArrayListIteratorFailFast(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*List[int])
+		err    error
+	}{
+		{
+			name:   "PushBack",
+			modify: func(list *List[int]) { list.PushBack(4) },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "PopFront",
+			modify: func(list *List[int]) { list.PopFront(1) },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "Remove",
+			modify: func(list *List[int]) { list.Remove(0) },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "Insert",
+			modify: func(list *List[int]) { list.Insert(0, 0) },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "Clear",
+			modify: func(list *List[int]) { list.Clear() },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "Set",
+			modify: func(list *List[int]) { list.Set(0, 5) },
+			err:    nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			list := New[int](1, 2, 3)
+			it := list.First().(*Iterator[int])
+
+			test.modify(list)
+
+			assert.Equalf(t, test.err, it.Err(), test.name)
+			assert.Equalf(t, test.err == nil, it.IsValid(), test.name)
+			assert.Equalf(t, test.err == nil, it.Next(), test.name)
+
+			_, found := it.Get()
+			assert.Equalf(t, test.err == nil, found, test.name)
+		})
+	}
+}
+
+func TestArrayListIteratorFailFastOwnModifications(t *testing.T) {
+	list := New[int](1, 2, 3)
+	it := list.First().(*Iterator[int])
+
+	assert.True(t, it.InsertAfter(4))
+	assert.True(t, it.InsertBefore(0))
+	assert.True(t, it.Remove())
+	assert.NoError(t, it.Err())
+
+	value, found := it.Get()
+	assert.True(t, found)
+	assert.Equal(t, 4, value)
+
+	other := list.First()
+	assert.True(t, other.IsValid())
+
+	list.PushBack(5)
+	assert.ErrorIs(t, it.Err(), ds.ErrConcurrentModification)
+	assert.ErrorIs(t, other.(*Iterator[int]).Err(), ds.ErrConcurrentModification)
+}
+
+func TestArrayListIteratorCompareWithReverseIterator(t *testing.T) {
+	tests := []struct {
+		name            string
+		position        int
+		reversePosition int
+		distance        int
+		reverseDistance int
+		isEqual         bool
+	}{
+		{
+			name:            "Same element",
+			position:        2,
+			reversePosition: 2,
+			distance:        0,
+			reverseDistance: 0,
+			isEqual:         true,
+		},
+		{
+			name:            "Forward before reverse",
+			position:        0,
+			reversePosition: 4,
+			distance:        -4,
+			reverseDistance: 4,
+			isEqual:         false,
+		},
+		{
+			name:            "Forward after reverse",
+			position:        3,
+			reversePosition: 1,
+			distance:        2,
+			reverseDistance: -2,
+			isEqual:         false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			list := New[int](1, 2, 3, 4, 5)
+			it := list.Begin()
+			reverseIt := list.ReverseBegin()
+
+			it.MoveTo(test.position)
+			reverseIt.MoveTo(test.reversePosition)
+
+			assert.NoErrorf(t, it.(ds.CheckedIterator).CanCompare(reverseIt), test.name)
+			assert.NoErrorf(t, reverseIt.(ds.CheckedIterator).CanCompare(it), test.name)
+
+			assert.Equalf(t, test.distance, it.DistanceTo(reverseIt), test.name)
+			assert.Equalf(t, test.reverseDistance, reverseIt.DistanceTo(it), test.name)
+			assert.Equalf(t, test.distance < 0, it.IsBefore(reverseIt), test.name)
+			assert.Equalf(t, test.distance > 0, it.IsAfter(reverseIt), test.name)
+			assert.Equalf(t, test.reverseDistance < 0, reverseIt.IsBefore(it), test.name)
+			assert.Equalf(t, test.isEqual, it.IsEqual(reverseIt), test.name)
+			assert.Equalf(t, test.isEqual, reverseIt.IsEqual(it), test.name)
+		})
+	}
+}
+
+func TestArrayListIteratorCanCompare(t *testing.T) {
+	list := New[int](1, 2, 3)
+	other := New[int](1, 2, 3)
+
+	tests := []struct {
+		name  string
+		it    ds.OrderedIterator
+		other ds.OrderedIterator
+		err   error
+	}{
+		{
+			name:  "Same list",
+			it:    list.First(),
+			other: list.Last(),
+			err:   nil,
+		},
+		{
+			name:  "Same list, reverse",
+			it:    list.First(),
+			other: list.ReverseFirst(),
+			err:   nil,
+		},
+		{
+			name:  "Other list",
+			it:    list.First(),
+			other: other.First(),
+			err:   ds.ErrForeignIterators,
+		},
+		{
+			name:  "Other list, reverse",
+			it:    list.ReverseFirst(),
+			other: other.First(),
+			err:   ds.ErrForeignIterators,
+		},
+		{
+			name:  "Other type",
+			it:    list.First(),
+			other: New[string]("a").First(),
+			err:   ds.ErrIncompatibleIterators,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			distance, err := ds.CheckedDistanceTo(test.it, test.other)
+			if test.err == nil {
+				assert.NoErrorf(t, err, test.name)
+				assert.Equalf(t, test.it.DistanceTo(test.other), distance, test.name)
+			} else {
+				assert.ErrorIsf(t, err, test.err, test.name)
+			}
+		})
+	}
+}
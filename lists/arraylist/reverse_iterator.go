@@ -13,8 +13,19 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[int, any] = (*ReverseIterator[any])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*ReverseIterator[any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*ReverseIterator[any])(nil)
+
+// Assert ReversedIterator and ConstantTimeAccessIterator implementation
+var _ ds.ReversedIterator = (*ReverseIterator[any])(nil)
+var _ ds.ConstantTimeAccessIterator = (*ReverseIterator[any])(nil)
+
 // Iterator holding the iterator's state
 type ReverseIterator[T any] struct {
+	guard ds.ModificationGuard
 	list  *List[T]
 	value T
 	index int
@@ -24,7 +35,7 @@ type ReverseIterator[T any] struct {
 
 // NewIterator returns a stateful iterator whose values can be fetched by an index.
 func (list *List[T]) NewReverseIterator(index int, size int) *ReverseIterator[T] {
-	it := &ReverseIterator[T]{list: list, index: index, size: size}
+	it := &ReverseIterator[T]{list: list, index: index, size: size, guard: list.modifications.Guard()}
 	it.size = utils.Min(list.Size(), size)
 	it.size = utils.Max(list.Size(), -1)
 
@@ -36,7 +47,12 @@ func (list *List[T]) NewReverseIterator(index int, size int) *ReverseIterator[T]
 }
 
 func (it *ReverseIterator[T]) IsValid() bool {
-	return it.size > 0 && !it.IsBegin() && !it.IsEnd()
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the list was structurally modified, other than through the iterator.
+func (it *ReverseIterator[T]) Err() error {
+	return it.guard.Err()
 }
 
 func (it *ReverseIterator[T]) Get() (value T, found bool) {
@@ -56,41 +72,30 @@ func (it *ReverseIterator[T]) Set(value T) bool {
 	return true
 }
 
-// If other is of type IndexedIterator, IndexedIterator.Index() will be used, possibly executing in O(1)
+// DistanceTo measures the distance in reverse if other is a ReverseIterator, otherwise in the order of the indices like Iterator does.
 func (it *ReverseIterator[T]) DistanceTo(other ds.OrderedIterator) int {
-	otherThis, ok := other.(*ReverseIterator[T])
-	if !ok {
-		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	if _, ok := other.(*ReverseIterator[T]); ok {
+		return indexOf[T](other) - it.index
 	}
 
-	return otherThis.index - it.index
+	return it.index - indexOf[T](other)
 }
 
 func (it *ReverseIterator[T]) IsAfter(other ds.OrderedIterator) bool {
-	otherThis, ok := other.(*ReverseIterator[T])
-	if !ok {
-		panic(ds.CanOnlyCompareEqualIteratorTypes)
-	}
-
-	return it.DistanceTo(otherThis) > 0
+	return it.DistanceTo(other) > 0
 }
 
 func (it *ReverseIterator[T]) IsBefore(other ds.OrderedIterator) bool {
-	otherThis, ok := other.(*ReverseIterator[T])
-	if !ok {
-		panic(ds.CanOnlyCompareEqualIteratorTypes)
-	}
-
-	return it.DistanceTo(otherThis) < 0
+	return it.DistanceTo(other) < 0
 }
 
 func (it *ReverseIterator[T]) IsEqual(other ds.ComparableIterator) bool {
-	otherThis, ok := other.(*ReverseIterator[T])
-	if !ok {
-		panic(ds.CanOnlyCompareEqualIteratorTypes)
-	}
+	return it.index == indexOf[T](other)
+}
 
-	return it.DistanceTo(otherThis) == 0
+// CanCompare checks if other is an Iterator or ReverseIterator of the same list.
+func (it *ReverseIterator[T]) CanCompare(other ds.Iterator) error {
+	return canCompare[T](it, it.list, other)
 }
 
 func (it *ReverseIterator[T]) Previous() bool {
@@ -185,7 +190,7 @@ func (it *ReverseIterator[T]) IsLast() bool {
 }
 
 func (it *ReverseIterator[T]) GetAt(i int) (value T, found bool) {
-	if it.size == 0 || !it.list.withinRange(i) {
+	if it.size == 0 || !it.list.withinRange(i) || it.guard.Err() != nil {
 		return
 	}
 
@@ -193,7 +198,7 @@ func (it *ReverseIterator[T]) GetAt(i int) (value T, found bool) {
 }
 
 func (it *ReverseIterator[T]) SetAt(i int, value T) bool {
-	if it.size == 0 || !it.list.withinRange(i) {
+	if it.size == 0 || !it.list.withinRange(i) || it.guard.Err() != nil {
 		return false
 	}
 	it.list.elements[i] = value
@@ -208,3 +213,13 @@ func (it *ReverseIterator[T]) GetAtKey(i int) (value T, found bool) {
 func (it *ReverseIterator[T]) SetAtKey(i int, value T) bool {
 	return it.SetAt(i, value)
 }
+
+// HasConstantTimeAccess returns true, since the elements are indexed directly.
+func (it *ReverseIterator[T]) HasConstantTimeAccess() bool {
+	return true
+}
+
+// IsReversed returns true, since the iterator moves from larger to smaller indices.
+func (it *ReverseIterator[T]) IsReversed() bool {
+	return true
+}
@@ -23,6 +23,8 @@ func (list *List[T]) ToJSON() ([]byte, error) {
 // FromJSON populates list's elements from the input JSON representation.
 func (list *List[T]) FromJSON(data []byte) error {
 	err := json.Unmarshal(data, &list.elements)
+	list.modifications.Modified()
+
 	return err
 }
 
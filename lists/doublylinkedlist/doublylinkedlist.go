@@ -12,6 +12,7 @@ package doublylinkedlist
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
@@ -22,17 +23,38 @@ import (
 // Assert List[T] implementation
 var _ lists.List[any] = (*List[any])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*List[any]] = (*List[any])(nil)
+var _ ds.Equatable[*List[any], any] = (*List[any])(nil)
+
 // List[T] holds the elements, where each element points to the next and previous element
 type List[T any] struct {
-	first *element[T]
-	last  *element[T]
+	first *Element[T]
+	last  *Element[T]
 	size  int
+
+	modifications ds.ModificationCounter
 }
 
-type element[T any] struct {
+// Element is a node of the list.
+// Elements returned by the list's *Element methods can be used to manipulate the list in O(1).
+// Once removed, an element no longer belongs to any list and is ignored by the *Element methods.
+type Element[T any] struct {
 	value T
-	prev  *element[T]
-	next  *element[T]
+	prev  *Element[T]
+	next  *Element[T]
+	// list is the list containing the element or nil if it was removed.
+	list *List[T]
+}
+
+// Value returns the value stored in the element.
+func (element *Element[T]) Value() T {
+	return element.value
+}
+
+// SetValue overwrites the value stored in the element.
+func (element *Element[T]) SetValue(value T) {
+	element.value = value
 }
 
 // New instantiates a new list and adds the passed values, if any, to the list
@@ -87,13 +109,21 @@ func (list *List[T]) PopBack(n int) (popped []T) {
 	popped = make([]T, 0, n)
 
 	for i := 0; i < n; i++ {
-		popped = append(popped, list.last.value)
+		element := list.last
+		popped = append(popped, element.value)
+
+		list.last = element.prev
+		if list.last == nil {
+			list.first = nil
+		} else {
+			list.last.next = nil
+		}
 
-		list.last = list.last.prev
-		list.last.next = nil
+		element.detach()
 	}
 
 	list.size -= n
+	list.modifications.Modified()
 
 	return
 }
@@ -106,13 +136,21 @@ func (list *List[T]) PopFront(n int) (popped []T) {
 	popped = make([]T, 0, n)
 
 	for i := 0; i < n; i++ {
-		popped = append(popped, list.first.value)
+		element := list.first
+		popped = append(popped, element.value)
+
+		list.first = element.next
+		if list.first == nil {
+			list.last = nil
+		} else {
+			list.first.prev = nil
+		}
 
-		list.first = list.first.next
-		list.first.prev = nil
+		element.detach()
 	}
 
 	list.size -= n
+	list.modifications.Modified()
 
 	return
 }
@@ -120,7 +158,7 @@ func (list *List[T]) PopFront(n int) (popped []T) {
 // Add appends a value (one or more) at the end of the list (same as Append())
 func (list *List[T]) PushBack(values ...T) {
 	for _, value := range values {
-		newElement := &element[T]{value: value, prev: list.last}
+		newElement := &Element[T]{value: value, prev: list.last, list: list}
 		if list.size == 0 {
 			list.first = newElement
 			list.last = newElement
@@ -129,6 +167,7 @@ func (list *List[T]) PushBack(values ...T) {
 			list.last = newElement
 		}
 		list.size++
+		list.modifications.Modified()
 	}
 }
 
@@ -136,7 +175,7 @@ func (list *List[T]) PushBack(values ...T) {
 func (list *List[T]) PushFront(values ...T) {
 	// in reverse to keep passed order i.e. ["c","d"] -> PushFront(["a","b"]) -> ["a","b","c",d"]
 	for v := len(values) - 1; v >= 0; v-- {
-		newElement := &element[T]{value: values[v], next: list.first}
+		newElement := &Element[T]{value: values[v], next: list.first, list: list}
 		if list.size == 0 {
 			list.first = newElement
 			list.last = newElement
@@ -145,6 +184,7 @@ func (list *List[T]) PushFront(values ...T) {
 			list.first = newElement
 		}
 		list.size++
+		list.modifications.Modified()
 	}
 }
 
@@ -181,7 +221,7 @@ func (list *List[T]) Remove(index int) {
 		return
 	}
 
-	var element *element[T]
+	var element *Element[T]
 	// determine traversal direction, last to first or first to last
 	if list.size-index < index {
 		element = list.last
@@ -206,9 +246,10 @@ func (list *List[T]) Remove(index int) {
 		element.next.prev = element.prev
 	}
 
-	element = nil
+	element.detach()
 
 	list.size--
+	list.modifications.Modified()
 }
 
 // Contains check if values (one or more) are present in the set.
@@ -272,7 +313,14 @@ func (list *List[T]) Size() int {
 
 // Clear removes all elements from the list.
 func (list *List[T]) Clear() {
+	for element := list.first; element != nil; {
+		next := element.next
+		element.detach()
+		element = next
+	}
+
 	list.size = 0
+	list.modifications.Modified()
 	list.first = nil
 	list.last = nil
 }
@@ -296,7 +344,7 @@ func (list *List[T]) Sort(comparator utils.Comparator[T]) {
 // Swap swaps values of two elements at the given indices.
 func (list *List[T]) Swap(i, j int) {
 	if list.withinRange(i) && list.withinRange(j) && i != j {
-		var element1, element2 *element[T]
+		var element1, element2 *Element[T]
 		for e, currentElement := 0, list.first; element1 == nil || element2 == nil; e, currentElement = e+1, currentElement.next {
 			switch e {
 			case i:
@@ -323,9 +371,10 @@ func (list *List[T]) Insert(index int, values ...T) {
 	}
 
 	list.size += len(values)
+	list.modifications.Modified()
 
-	var beforeElement *element[T]
-	var foundElement *element[T]
+	var beforeElement *Element[T]
+	var foundElement *Element[T]
 	// determine traversal direction, last to first or first to last
 	if list.size-index < index {
 		foundElement = list.last
@@ -342,7 +391,7 @@ func (list *List[T]) Insert(index int, values ...T) {
 	if foundElement == list.first {
 		oldNextElement := list.first
 		for i, value := range values {
-			newElement := &element[T]{value: value}
+			newElement := &Element[T]{value: value, list: list}
 			if i == 0 {
 				list.first = newElement
 			} else {
@@ -356,7 +405,7 @@ func (list *List[T]) Insert(index int, values ...T) {
 	} else {
 		oldNextElement := beforeElement.next
 		for _, value := range values {
-			newElement := &element[T]{value: value}
+			newElement := &Element[T]{value: value, list: list}
 			newElement.prev = beforeElement
 			beforeElement.next = newElement
 			beforeElement = newElement
@@ -379,7 +428,7 @@ func (list *List[T]) Set(index int, value T) {
 		return
 	}
 
-	var foundElement *element[T]
+	var foundElement *Element[T]
 	// determine traversal direction, last to first or first to last
 	if list.size-index < index {
 		foundElement = list.last
@@ -408,11 +457,192 @@ func (list *List[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the list, which does not share any elements with the original.
+func (list *List[T]) Clone() *List[T] {
+	clone := New[T]()
+	for element := list.first; element != nil; element = element.next {
+		clone.PushBack(element.value)
+	}
+
+	return clone
+}
+
+// Equals returns true if both lists hold equal values in the same order.
+func (list *List[T]) Equals(other *List[T], comparator utils.Comparator[T]) bool {
+	if list.size != other.size {
+		return false
+	}
+
+	for element, otherElement := list.first, other.first; element != nil; element, otherElement = element.next, otherElement.next {
+		if comparator(element.value, otherElement.value) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Check that the index is within bounds of the list
 func (list *List[T]) withinRange(index int) bool {
 	return index >= 0 && index < list.size
 }
 
+// FrontElement returns the first element of the list or nil if the list is empty.
+func (list *List[T]) FrontElement() *Element[T] {
+	return list.first
+}
+
+// BackElement returns the last element of the list or nil if the list is empty.
+func (list *List[T]) BackElement() *Element[T] {
+	return list.last
+}
+
+// PushBackElement appends a value at the end of the list and returns its element.
+func (list *List[T]) PushBackElement(value T) *Element[T] {
+	newElement := &Element[T]{value: value}
+	list.linkAfter(newElement, list.last)
+
+	return newElement
+}
+
+// PushFrontElement prepends a value at the start of the list and returns its element.
+func (list *List[T]) PushFrontElement(value T) *Element[T] {
+	newElement := &Element[T]{value: value}
+	list.linkAfter(newElement, nil)
+
+	return newElement
+}
+
+// InsertBeforeElement inserts a value directly before mark and returns its element.
+// Returns nil if mark is not an element of the list.
+func (list *List[T]) InsertBeforeElement(value T, mark *Element[T]) *Element[T] {
+	if mark.list != list {
+		return nil
+	}
+
+	newElement := &Element[T]{value: value}
+	list.linkAfter(newElement, mark.prev)
+
+	return newElement
+}
+
+// InsertAfterElement inserts a value directly after mark and returns its element.
+// Returns nil if mark is not an element of the list.
+func (list *List[T]) InsertAfterElement(value T, mark *Element[T]) *Element[T] {
+	if mark.list != list {
+		return nil
+	}
+
+	newElement := &Element[T]{value: value}
+	list.linkAfter(newElement, mark)
+
+	return newElement
+}
+
+// RemoveElement removes element from the list in O(1).
+// Does nothing if element is not an element of the list.
+func (list *List[T]) RemoveElement(element *Element[T]) {
+	if element.list != list {
+		return
+	}
+
+	list.unlink(element)
+}
+
+// MoveToFront moves element to the start of the list in O(1).
+// Does nothing if element is not an element of the list.
+func (list *List[T]) MoveToFront(element *Element[T]) {
+	if element.list != list || element == list.first {
+		return
+	}
+
+	list.unlink(element)
+	list.linkAfter(element, nil)
+}
+
+// MoveToBack moves element to the end of the list in O(1).
+// Does nothing if element is not an element of the list.
+func (list *List[T]) MoveToBack(element *Element[T]) {
+	if element.list != list || element == list.last {
+		return
+	}
+
+	list.unlink(element)
+	list.linkAfter(element, list.last)
+}
+
+// MoveBefore moves element directly before mark in O(1).
+// Does nothing if element or mark are not elements of the list.
+func (list *List[T]) MoveBefore(element *Element[T], mark *Element[T]) {
+	if element.list != list || mark.list != list || element == mark || element.next == mark {
+		return
+	}
+
+	list.unlink(element)
+	list.linkAfter(element, mark.prev)
+}
+
+// MoveAfter moves element directly after mark in O(1).
+// Does nothing if element or mark are not elements of the list.
+func (list *List[T]) MoveAfter(element *Element[T], mark *Element[T]) {
+	if element.list != list || mark.list != list || element == mark || element.prev == mark {
+		return
+	}
+
+	list.unlink(element)
+	list.linkAfter(element, mark)
+}
+
+// linkAfter links element into the list directly after mark or at the start of the list if mark is nil.
+func (list *List[T]) linkAfter(element *Element[T], mark *Element[T]) {
+	element.list = list
+	element.prev = mark
+
+	if mark == nil {
+		element.next = list.first
+		list.first = element
+	} else {
+		element.next = mark.next
+		mark.next = element
+	}
+
+	if element.next == nil {
+		list.last = element
+	} else {
+		element.next.prev = element
+	}
+
+	list.size++
+	list.modifications.Modified()
+}
+
+// unlink removes element from the list and detaches it.
+func (list *List[T]) unlink(element *Element[T]) {
+	if element.prev == nil {
+		list.first = element.next
+	} else {
+		element.prev.next = element.next
+	}
+
+	if element.next == nil {
+		list.last = element.prev
+	} else {
+		element.next.prev = element.prev
+	}
+
+	element.detach()
+
+	list.size--
+	list.modifications.Modified()
+}
+
+// detach resets the links of a removed element, so that it no longer refers to the list or its neighbours.
+func (element *Element[T]) detach() {
+	element.prev = nil
+	element.next = nil
+	element.list = nil
+}
+
 //******************************************************************//
 //                             Iterator                             //
 //******************************************************************//
@@ -438,3 +668,62 @@ func (list *List[T]) First() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 func (list *List[T]) Last() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 	return list.NewIterator(list.size-1, list.Size())
 }
+
+//******************************************************************//
+//                         Reverse iterator                         //
+//******************************************************************//
+
+// ReverseBegin returns an initialized, reversed iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (list *List[T]) ReverseBegin() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(list.End())
+}
+
+// ReverseEnd returns an initialized, reversed iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (list *List[T]) ReverseEnd() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(list.Begin())
+}
+
+// ReverseFirst returns an initialized, reversed iterator, which points to it's first element.
+func (list *List[T]) ReverseFirst() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(list.Last())
+}
+
+// ReverseLast returns an initialized, reversed iterator, which points to it's last element.
+func (list *List[T]) ReverseLast() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(list.First())
+}
+
+// All returns a sequence over the index/value pairs of the list in iteration order.
+func (list *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range ds.Seq(list.Begin()) {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns a sequence over the index/value pairs of the list in reverse iteration order.
+func (list *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := list.Size() - 1
+		for value := range ds.BackwardSeq(list.End()) {
+			if !yield(i, value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// Values returns a sequence over the values of the list in iteration order.
+func (list *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(list.Begin())(yield)
+	}
+}
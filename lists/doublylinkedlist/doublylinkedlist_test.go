@@ -6,13 +6,12 @@
 package doublylinkedlist
 
 import (
+	"slices"
 	"testing"
 
-	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/ds"
 	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
-
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1026,3 +1025,200 @@ func BenchmarkDoublyLinkedListPopFront(b *testing.B) {
 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 	}
 }
+
+func TestDoublyLinkedListClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *List[string]
+	}{
+		{
+			name:     "empty",
+			original: New[string](),
+		},
+		{
+			name:     "3 items",
+			original: New[string]("foo", "bar", "baz"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			values := test.original.GetValues()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+
+			for i := range values {
+				clone.Set(i, "qux")
+			}
+
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+
+			clone.PushBack("qux")
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+		})
+	}
+}
+
+func TestDoublyLinkedListEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *List[string]
+		b     *List[string]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     New[string](),
+			b:     New[string](),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     New[string]("foo", "bar", "baz"),
+			b:     New[string]("foo", "bar", "baz"),
+			equal: true,
+		},
+		{
+			name:  "different order",
+			a:     New[string]("foo", "bar", "baz"),
+			b:     New[string]("baz", "bar", "foo"),
+			equal: false,
+		},
+		{
+			name:  "different values",
+			a:     New[string]("foo", "bar"),
+			b:     New[string]("foo", "qux"),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     New[string]("foo"),
+			b:     New[string]("foo", "bar"),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[string]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	list := New[int](1, 2, 3)
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range list.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	indices = []int{}
+	values = []int{}
+	for i, value := range list.Backward() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{2, 1, 0}, indices)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(list.Values()))
+
+	values = []int{}
+	for value := range list.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
+
+func TestDoublyLinkedListRemoveElementTwice(t *testing.T) {
+	list := New[int](1, 2, 3)
+
+	element := list.FrontElement().next
+	list.RemoveElement(element)
+	list.RemoveElement(element)
+
+	assert.Equal(t, []int{1, 3}, list.GetValues())
+	assert.Equal(t, 2, list.Size())
+	assert.Equal(t, 3, list.FrontElement().next.Value())
+	assert.Equal(t, 1, list.BackElement().prev.Value())
+
+	// A removed element can neither be moved nor used as mark
+	list.MoveToFront(element)
+	list.MoveAfter(element, list.FrontElement())
+	assert.Nil(t, list.InsertAfterElement(4, element))
+	assert.Equal(t, []int{1, 3}, list.GetValues())
+
+	// Elements removed by other means are detached as well
+	front := list.FrontElement()
+	list.PopFront(1)
+	list.RemoveElement(front)
+	assert.Equal(t, []int{3}, list.GetValues())
+
+	back := list.BackElement()
+	list.Clear()
+	list.PushBack(5)
+	list.RemoveElement(back)
+	assert.Equal(t, []int{5}, list.GetValues())
+}
+
+func TestDoublyLinkedListForeignElement(t *testing.T) {
+	list := New[int](1, 2, 3)
+	other := New[int](4, 5)
+
+	foreign := other.FrontElement()
+
+	list.RemoveElement(foreign)
+	list.MoveToFront(other.BackElement())
+	list.MoveToBack(foreign)
+	list.MoveBefore(foreign, list.BackElement())
+	list.MoveAfter(list.FrontElement(), foreign)
+	assert.Nil(t, list.InsertBeforeElement(6, foreign))
+	assert.Nil(t, list.InsertAfterElement(6, foreign))
+
+	assert.Equal(t, []int{1, 2, 3}, list.GetValues())
+	assert.Equal(t, 3, list.Size())
+	assert.Equal(t, []int{4, 5}, other.GetValues())
+	assert.Equal(t, 2, other.Size())
+
+	// Elements of a list's clone belong to the clone only
+	clone := list.Clone()
+	list.RemoveElement(clone.FrontElement())
+	assert.Equal(t, []int{1, 2, 3}, clone.GetValues())
+	assert.Equal(t, []int{1, 2, 3}, list.GetValues())
+}
+
+func TestDoublyLinkedListPopAll(t *testing.T) {
+	list := New[int](1, 2, 3)
+
+	assert.Equal(t, []int{3, 2, 1}, list.PopBack(3))
+	assert.True(t, list.IsEmpty())
+	assert.Nil(t, list.FrontElement())
+
+	list.PushBack(1, 2)
+	assert.Equal(t, []int{1, 2}, list.PopFront(2))
+	assert.Nil(t, list.BackElement())
+}
@@ -13,18 +13,29 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[int, any] = (*Iterator[any])(nil)
 
+// Assert ErasableIterator and InsertableIterator implementation
+var _ ds.ErasableIterator = (*Iterator[any])(nil)
+var _ ds.InsertableIterator[any] = (*Iterator[any])(nil)
+
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*Iterator[any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*Iterator[any])(nil)
+
 // Iterator holding the iterator's state
 type Iterator[T any] struct {
 	list    *List[T]
 	index   int
-	element *element[T]
+	element *Element[T]
 	// Redundant but stored for better locality
-	size int
+	size  int
+	guard ds.ModificationGuard
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 func (list *List[T]) NewIterator(position int, size int) *Iterator[T] {
-	it := &Iterator[T]{list: list, index: position, size: size}
+	it := &Iterator[T]{list: list, index: position, size: size, guard: list.modifications.Guard()}
 	it.size = utils.Min(list.Size(), size)
 	it.size = utils.Max(list.Size(), -1)
 
@@ -35,7 +46,12 @@ func (list *List[T]) NewIterator(position int, size int) *Iterator[T] {
 }
 
 func (it *Iterator[T]) IsValid() bool {
-	return it.list.size > 0 && !it.IsBegin() && !it.IsEnd()
+	return it.list.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the list was structurally modified, other than through the iterator.
+func (it *Iterator[T]) Err() error {
+	return it.guard.Err()
 }
 
 func (it *Iterator[T]) Get() (value T, found bool) {
@@ -56,8 +72,64 @@ func (it *Iterator[T]) Set(value T) bool {
 	return true
 }
 
+// Remove removes the current element in O(1) and moves the iterator to the next element.
+func (it *Iterator[T]) Remove() bool {
+	if !it.IsValid() {
+		return false
+	}
+
+	next := it.element.next
+
+	it.list.unlink(it.element)
+	it.element = next
+	it.size--
+	it.guard.Sync()
+
+	return true
+}
+
+// InsertBefore inserts value before the current element or at the end of the list in O(1).
+func (it *Iterator[T]) InsertBefore(value T) bool {
+	switch {
+	case it.IsValid():
+		it.list.InsertBeforeElement(value, it.element)
+	case it.index == it.list.size:
+		it.list.PushBackElement(value)
+	default:
+		return false
+	}
+
+	it.index++
+	it.size++
+	it.guard.Sync()
+
+	return true
+}
+
+// InsertAfter inserts value after the current element or at the start of the list in O(1).
+func (it *Iterator[T]) InsertAfter(value T) bool {
+	switch {
+	case it.IsValid():
+		it.list.InsertAfterElement(value, it.element)
+	case it.IsBegin():
+		it.list.PushFrontElement(value)
+	default:
+		return false
+	}
+
+	it.size++
+	it.guard.Sync()
+
+	return true
+}
+
+// Element returns the current element, which can be used with the list's *Element methods.
+func (it *Iterator[T]) Element() (*Element[T], bool) {
+	return it.element, it.IsValid()
+}
+
 func (it *Iterator[T]) GetAt(i int) (value T, found bool) {
-	if it.list.size == 0 || !it.list.withinRange(i) {
+	if it.list.size == 0 || !it.list.withinRange(i) || it.guard.Err() != nil {
 		return
 	}
 
@@ -78,7 +150,7 @@ func (it *Iterator[T]) GetAtKey(i int) (value T, found bool) {
 }
 
 func (it *Iterator[T]) SetAt(i int, value T) bool {
-	if it.list.size == 0 || !it.list.withinRange(i) {
+	if it.list.size == 0 || !it.list.withinRange(i) || it.guard.Err() != nil {
 		return false
 	}
 
@@ -99,6 +171,8 @@ func (it *Iterator[T]) SetAtKey(i int, value T) bool {
 
 // If other is of type IndexedIterator, IndexedIterator.Index() will be used, possibly executing in O(1)
 func (it *Iterator[T]) DistanceTo(other ds.OrderedIterator) int {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -108,6 +182,8 @@ func (it *Iterator[T]) DistanceTo(other ds.OrderedIterator) int {
 }
 
 func (it *Iterator[T]) IsAfter(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -117,6 +193,8 @@ func (it *Iterator[T]) IsAfter(other ds.OrderedIterator) bool {
 }
 
 func (it *Iterator[T]) IsBefore(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -126,6 +204,8 @@ func (it *Iterator[T]) IsBefore(other ds.OrderedIterator) bool {
 }
 
 func (it *Iterator[T]) IsEqual(other ds.ComparableIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -134,6 +214,20 @@ func (it *Iterator[T]) IsEqual(other ds.ComparableIterator) bool {
 	return it.DistanceTo(otherThis) == 0
 }
 
+// CanCompare checks if other is an iterator of the same list.
+func (it *Iterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := ds.BaseOf[int, T](other).(*Iterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.list != it.list {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 func (it *Iterator[T]) Next() bool {
 	it.index = utils.Min(it.index+1, it.size)
 
@@ -158,7 +252,9 @@ func (it *Iterator[T]) NextN(n int) bool {
 	n = utils.Min(it.index+n, it.size) - it.index
 	it.index += n
 
+	// The beginning does not point to an element, so start at the first one
 	if it.index-n == -1 {
+		it.element = it.list.first
 		n -= 1
 	}
 
@@ -203,6 +299,12 @@ func (it *Iterator[T]) PreviousN(n int) bool {
 	n = it.index - utils.Max(it.index-n, -1)
 	it.index -= n
 
+	// The end does not point to an element, so start at the last one
+	if it.index+n == it.size {
+		it.element = it.list.last
+		n -= 1
+	}
+
 	if !it.IsValid() {
 		return false
 	}
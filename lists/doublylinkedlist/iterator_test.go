@@ -1057,3 +1057,345 @@ func TestDoublyLinkedlistIteratorSize(t *testing.T) {
 		})
 	}
 }
+
+func TestDoublyLinkedlistIteratorRemove(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     *List[int]
+		position int
+		removed  bool
+		values   []int
+		value    int
+		found    bool
+	}{
+		{
+			name:     "Empty",
+			list:     New[int](),
+			position: -1,
+			removed:  false,
+			values:   []int{},
+		},
+		{
+			name:     "3 elements, begin",
+			list:     New[int](1, 2, 3),
+			position: -1,
+			removed:  false,
+			values:   []int{1, 2, 3},
+		},
+		{
+			name:     "3 elements, first",
+			list:     New[int](1, 2, 3),
+			position: 0,
+			removed:  true,
+			values:   []int{2, 3},
+			value:    2,
+			found:    true,
+		},
+		{
+			name:     "3 elements, middle",
+			list:     New[int](1, 2, 3),
+			position: 1,
+			removed:  true,
+			values:   []int{1, 3},
+			value:    3,
+			found:    true,
+		},
+		{
+			name:     "3 elements, last",
+			list:     New[int](1, 2, 3),
+			position: 2,
+			removed:  true,
+			values:   []int{1, 2},
+			found:    false,
+		},
+		{
+			name:     "3 elements, end",
+			list:     New[int](1, 2, 3),
+			position: 3,
+			removed:  false,
+			values:   []int{1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := test.list.Begin().(*Iterator[int])
+			for i := -1; i < test.position; i++ {
+				it.Next()
+			}
+
+			assert.Equalf(t, test.removed, it.Remove(), test.name)
+			assert.Equalf(t, test.values, test.list.GetValues(), test.name)
+			assert.Equalf(t, len(test.values), it.Size(), test.name)
+
+			value, found := it.Get()
+			assert.Equalf(t, test.value, value, test.name)
+			assert.Equalf(t, test.found, found, test.name)
+		})
+	}
+}
+
+func TestDoublyLinkedlistIteratorRemoveWhileIterating(t *testing.T) {
+	list := New[int](2, 1, 4, 6, 3, 8)
+	it := list.Begin().(*Iterator[int])
+
+	for it.Next(); it.IsValid(); {
+		value, _ := it.Get()
+		if value%2 == 0 {
+			it.Remove()
+		} else {
+			it.Next()
+		}
+	}
+
+	assert.Equal(t, []int{1, 3}, list.GetValues())
+	assert.True(t, it.IsEnd())
+
+	list.PushBack(5)
+	assert.Equal(t, []int{1, 3, 5}, list.GetValues())
+}
+
+func TestDoublyLinkedlistIteratorInsertBefore(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     *List[int]
+		position int
+		inserted bool
+		values   []int
+		index    int
+	}{
+		{
+			name:     "Empty, end",
+			list:     New[int](),
+			position: 0,
+			inserted: true,
+			values:   []int{0},
+			index:    1,
+		},
+		{
+			name:     "3 elements, begin",
+			list:     New[int](1, 2, 3),
+			position: -1,
+			inserted: false,
+			values:   []int{1, 2, 3},
+			index:    -1,
+		},
+		{
+			name:     "3 elements, first",
+			list:     New[int](1, 2, 3),
+			position: 0,
+			inserted: true,
+			values:   []int{0, 1, 2, 3},
+			index:    1,
+		},
+		{
+			name:     "3 elements, last",
+			list:     New[int](1, 2, 3),
+			position: 2,
+			inserted: true,
+			values:   []int{1, 2, 0, 3},
+			index:    3,
+		},
+		{
+			name:     "3 elements, end",
+			list:     New[int](1, 2, 3),
+			position: 3,
+			inserted: true,
+			values:   []int{1, 2, 3, 0},
+			index:    4,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := test.list.Begin().(*Iterator[int])
+			for i := -1; i < test.position; i++ {
+				it.Next()
+			}
+
+			valueBefore, foundBefore := it.Get()
+
+			assert.Equalf(t, test.inserted, it.InsertBefore(0), test.name)
+			assert.Equalf(t, test.values, test.list.GetValues(), test.name)
+			assert.Equalf(t, len(test.values), it.Size(), test.name)
+
+			index, _ := it.Index()
+			assert.Equalf(t, test.index, index, test.name)
+
+			value, found := it.Get()
+			assert.Equalf(t, valueBefore, value, test.name)
+			assert.Equalf(t, foundBefore, found, test.name)
+		})
+	}
+}
+
+func TestDoublyLinkedlistIteratorInsertAfter(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     *List[int]
+		position int
+		inserted bool
+		values   []int
+		next     int
+	}{
+		{
+			name:     "Empty, begin",
+			list:     New[int](),
+			position: -1,
+			inserted: true,
+			values:   []int{0},
+			next:     0,
+		},
+		{
+			name:     "3 elements, begin",
+			list:     New[int](1, 2, 3),
+			position: -1,
+			inserted: true,
+			values:   []int{0, 1, 2, 3},
+			next:     0,
+		},
+		{
+			name:     "3 elements, first",
+			list:     New[int](1, 2, 3),
+			position: 0,
+			inserted: true,
+			values:   []int{1, 0, 2, 3},
+			next:     0,
+		},
+		{
+			name:     "3 elements, last",
+			list:     New[int](1, 2, 3),
+			position: 2,
+			inserted: true,
+			values:   []int{1, 2, 3, 0},
+			next:     0,
+		},
+		{
+			name:     "3 elements, end",
+			list:     New[int](1, 2, 3),
+			position: 3,
+			inserted: false,
+			values:   []int{1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := test.list.Begin().(*Iterator[int])
+			for i := -1; i < test.position; i++ {
+				it.Next()
+			}
+
+			valueBefore, foundBefore := it.Get()
+
+			assert.Equalf(t, test.inserted, it.InsertAfter(0), test.name)
+			assert.Equalf(t, test.values, test.list.GetValues(), test.name)
+			assert.Equalf(t, len(test.values), it.Size(), test.name)
+
+			value, found := it.Get()
+			assert.Equalf(t, valueBefore, value, test.name)
+			assert.Equalf(t, foundBefore, found, test.name)
+
+			if test.inserted {
+				assert.Truef(t, it.Next(), test.name)
+
+				value, _ = it.Get()
+				assert.Equalf(t, test.next, value, test.name)
+			}
+		})
+	}
+}
+
+func TestDoublyLinkedListIteratorFailFast(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*List[int])
+		err    error
+	}{
+		{
+			name:   "PushBack",
+			modify: func(list *List[int]) { list.PushBack(4) },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "PopFront",
+			modify: func(list *List[int]) { list.PopFront(1) },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "Remove",
+			modify: func(list *List[int]) { list.Remove(0) },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "Insert",
+			modify: func(list *List[int]) { list.Insert(0, 0) },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "Clear",
+			modify: func(list *List[int]) { list.Clear() },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "Set",
+			modify: func(list *List[int]) { list.Set(0, 5) },
+			err:    nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			list := New[int](1, 2, 3)
+			it := list.First().(*Iterator[int])
+
+			test.modify(list)
+
+			assert.Equalf(t, test.err, it.Err(), test.name)
+			assert.Equalf(t, test.err == nil, it.IsValid(), test.name)
+			assert.Equalf(t, test.err == nil, it.Next(), test.name)
+
+			_, found := it.Get()
+			assert.Equalf(t, test.err == nil, found, test.name)
+		})
+	}
+}
+
+func TestDoublyLinkedListIteratorFailFastOwnModifications(t *testing.T) {
+	list := New[int](1, 2, 3)
+	it := list.First().(*Iterator[int])
+
+	assert.True(t, it.InsertAfter(4))
+	assert.True(t, it.InsertBefore(0))
+	assert.True(t, it.Remove())
+	assert.NoError(t, it.Err())
+
+	value, found := it.Get()
+	assert.True(t, found)
+	assert.Equal(t, 4, value)
+
+	list.PushBack(5)
+	assert.ErrorIs(t, it.Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.Remove())
+	assert.Equal(t, []int{0, 4, 2, 3, 5}, list.GetValues())
+}
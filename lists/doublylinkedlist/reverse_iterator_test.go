@@ -0,0 +1,297 @@
+package doublylinkedlist
+
+import (
+	"testing"
+
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoublyLinkedListReverseIteratorIsBeginEndFirstLast(t *testing.T) {
+	tests := []struct {
+		name          string
+		iteratorInit  func(*List[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+		iteratorCheck func(ds.ReadWriteOrdCompBidRandCollIterator[int, int]) bool
+		value         int
+		found         bool
+	}{
+		{
+			name:          "Begin",
+			iteratorInit:  (*List[int]).ReverseBegin,
+			iteratorCheck: (ds.ReadWriteOrdCompBidRandCollIterator[int, int]).IsBegin,
+		},
+		{
+			name:          "End",
+			iteratorInit:  (*List[int]).ReverseEnd,
+			iteratorCheck: (ds.ReadWriteOrdCompBidRandCollIterator[int, int]).IsEnd,
+		},
+		{
+			name:          "First",
+			iteratorInit:  (*List[int]).ReverseFirst,
+			iteratorCheck: (ds.ReadWriteOrdCompBidRandCollIterator[int, int]).IsFirst,
+			value:         5,
+			found:         true,
+		},
+		{
+			name:          "Last",
+			iteratorInit:  (*List[int]).ReverseLast,
+			iteratorCheck: (ds.ReadWriteOrdCompBidRandCollIterator[int, int]).IsLast,
+			value:         1,
+			found:         true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := test.iteratorInit(New[int](1, 2, 4, 5))
+			assert.Truef(t, test.iteratorCheck(it), test.name)
+
+			value, found := it.Get()
+			assert.Equalf(t, test.value, value, test.name)
+			assert.Equalf(t, test.found, found, test.name)
+		})
+	}
+}
+
+func TestDoublyLinkedListReverseIteratorNext(t *testing.T) {
+	tests := []struct {
+		name   string
+		list   *List[int]
+		values []int
+	}{
+		{
+			name:   "Empty",
+			list:   New[int](),
+			values: []int{},
+		},
+		{
+			name:   "One element",
+			list:   New[int](1),
+			values: []int{1},
+		},
+		{
+			name:   "3 elements",
+			list:   New[int](1, 2, 3),
+			values: []int{3, 2, 1},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			values := []int{}
+			for it := test.list.ReverseBegin(); it.Next(); {
+				value, _ := it.Get()
+				values = append(values, value)
+			}
+
+			assert.Equalf(t, test.values, values, test.name)
+
+			reversed := []int{}
+			for it := test.list.ReverseEnd(); it.Previous(); {
+				value, _ := it.Get()
+				reversed = append([]int{value}, reversed...)
+			}
+
+			assert.Equalf(t, test.values, reversed, test.name)
+		})
+	}
+}
+
+func TestDoublyLinkedListReverseIteratorMoveBy(t *testing.T) {
+	tests := []struct {
+		name  string
+		n     int
+		moved bool
+		value int
+		index int
+	}{
+		{
+			name:  "Forward",
+			n:     2,
+			moved: true,
+			value: 1,
+			index: 0,
+		},
+		{
+			name:  "Backward",
+			n:     -1,
+			moved: true,
+			value: 4,
+			index: 3,
+		},
+		{
+			name:  "Past end",
+			n:     3,
+			moved: false,
+			index: -1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := New[int](1, 2, 3, 4).ReverseFirst()
+			it.Next()
+
+			assert.Equalf(t, test.moved, it.MoveBy(test.n), test.name)
+
+			value, _ := it.Get()
+			index, _ := it.Index()
+			assert.Equalf(t, test.value, value, test.name)
+			assert.Equalf(t, test.index, index, test.name)
+		})
+	}
+}
+
+func TestDoublyLinkedListReverseIteratorCompare(t *testing.T) {
+	list := New[int](1, 2, 3, 4)
+
+	tests := []struct {
+		name     string
+		it       ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+		other    ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+		distance int
+	}{
+		{
+			name:     "Reverse, equal",
+			it:       list.ReverseFirst(),
+			other:    list.ReverseFirst(),
+			distance: 0,
+		},
+		{
+			name:     "Reverse, before",
+			it:       list.ReverseFirst(),
+			other:    list.ReverseLast(),
+			distance: -3,
+		},
+		{
+			name:     "Reverse, after",
+			it:       list.ReverseLast(),
+			other:    list.ReverseFirst(),
+			distance: 3,
+		},
+		{
+			name:     "Forward, after",
+			it:       list.ReverseFirst(),
+			other:    list.First(),
+			distance: 3,
+		},
+		{
+			name:     "Forward, equal",
+			it:       list.ReverseLast(),
+			other:    list.First(),
+			distance: 0,
+		},
+		{
+			name:     "From forward, after",
+			it:       list.Last(),
+			other:    list.ReverseLast(),
+			distance: 3,
+		},
+		{
+			name:     "From forward, equal",
+			it:       list.First(),
+			other:    list.ReverseLast(),
+			distance: 0,
+		},
+		{
+			name:     "From forward begin, before",
+			it:       list.Begin(),
+			other:    list.ReverseFirst(),
+			distance: -4,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			distance, err := ds.CheckedDistanceTo(test.it, test.other)
+			assert.NoErrorf(t, err, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+			assert.Equalf(t, -test.distance, test.other.DistanceTo(test.it), test.name)
+			assert.Equalf(t, test.distance < 0, test.it.IsBefore(test.other), test.name)
+			assert.Equalf(t, test.distance > 0, test.it.IsAfter(test.other), test.name)
+			assert.Equalf(t, test.distance > 0, test.other.IsBefore(test.it), test.name)
+			assert.Equalf(t, test.distance == 0, test.it.IsEqual(test.other), test.name)
+		})
+	}
+}
+
+func TestDoublyLinkedListReverseIteratorCanCompare(t *testing.T) {
+	list := New[int](1, 2, 3)
+
+	_, err := ds.CheckedIsEqual(list.ReverseFirst(), New[int](1, 2, 3).ReverseFirst())
+	assert.ErrorIs(t, err, ds.ErrForeignIterators)
+
+	_, err = ds.CheckedIsEqual(list.ReverseFirst(), New[string]("a").ReverseFirst())
+	assert.ErrorIs(t, err, ds.ErrIncompatibleIterators)
+
+	assert.NoError(t, ds.CanCompare(list.First(), list.ReverseFirst()))
+	assert.ErrorIs(t, ds.CanCompare(list.First(), New[int](1, 2, 3).ReverseFirst()), ds.ErrForeignIterators)
+	assert.ErrorIs(t, ds.CanCompare(list.First(), New[string]("a").ReverseFirst()), ds.ErrIncompatibleIterators)
+}
+
+func TestDoublyLinkedListReverseIteratorRandomAccess(t *testing.T) {
+	list := New[int](1, 2, 3)
+	it := list.ReverseBegin()
+
+	assert.True(t, it.MoveTo(1))
+	assert.True(t, it.Next())
+
+	value, found := it.Get()
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+
+	assert.True(t, it.SetAt(2, 5))
+
+	value, found = it.GetAt(2)
+	assert.True(t, found)
+	assert.Equal(t, 5, value)
+	assert.Equal(t, 3, it.Size())
+	assert.Equal(t, []int{1, 2, 5}, list.GetValues())
+}
+
+func TestDoublyLinkedListReverseIteratorFailFast(t *testing.T) {
+	list := New[int]()
+	first := list.PushBackElement(1)
+	list.PushBack(2, 3)
+
+	it := list.ReverseFirst()
+
+	// Updating an element's value does not change the structure of the list
+	first.SetValue(10)
+
+	assert.NoError(t, it.(ds.FailFastIterator).Err())
+	assert.True(t, it.MoveTo(0))
+
+	value, _ := it.Get()
+	assert.Equal(t, 10, value)
+
+	// Moving an element keeps the size, but changes the order the iterator walks
+	list.MoveToBack(first)
+
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Next())
+}
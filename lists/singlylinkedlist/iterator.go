@@ -13,18 +13,31 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompForRandCollIterator[int, any] = (*Iterator[any])(nil)
 
+// Assert ErasableIterator and InsertableIterator implementation
+var _ ds.ErasableIterator = (*Iterator[any])(nil)
+var _ ds.InsertableIterator[any] = (*Iterator[any])(nil)
+
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*Iterator[any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*Iterator[any])(nil)
+
 // Iterator holding the iterator's state
 type Iterator[T any] struct {
 	list    *List[T]
 	index   int
 	element *element[T]
+	// The element before element, if it was passed while moving forward
+	previous *element[T]
 	// Redundant but stored for better locality
-	size int
+	size  int
+	guard ds.ModificationGuard
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 func (list *List[T]) NewIterator(position int, size int) *Iterator[T] {
-	it := &Iterator[T]{list: list, index: position, size: size}
+	it := &Iterator[T]{list: list, index: position, size: size, guard: list.modifications.Guard()}
 	it.size = utils.Min(list.Size(), size)
 	it.size = utils.Max(list.Size(), -1)
 
@@ -35,7 +48,12 @@ func (list *List[T]) NewIterator(position int, size int) *Iterator[T] {
 }
 
 func (it *Iterator[T]) IsValid() bool {
-	return it.list.size > 0 && !it.IsBegin() && !it.IsEnd()
+	return it.list.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the list was structurally modified, other than through the iterator.
+func (it *Iterator[T]) Err() error {
+	return it.guard.Err()
 }
 
 func (it *Iterator[T]) Get() (value T, found bool) {
@@ -56,6 +74,109 @@ func (it *Iterator[T]) Set(value T) bool {
 	return true
 }
 
+// Remove removes the current element and moves the iterator to the next element.
+// This runs in O(1), if the iterator was moved to the current element by Next(), otherwise in O(n).
+func (it *Iterator[T]) Remove() bool {
+	if !it.IsValid() {
+		return false
+	}
+
+	previous := it.predecessor()
+	next := it.element.next
+
+	if previous == nil {
+		it.list.first = next
+	} else {
+		previous.next = next
+	}
+
+	if it.element == it.list.last {
+		it.list.last = previous
+	}
+
+	it.element = next
+	it.list.size--
+	it.list.modifications.Modified()
+	it.size--
+	it.guard.Sync()
+
+	return true
+}
+
+// InsertBefore inserts value before the current element or at the end of the list.
+// This runs in O(1), if the iterator was moved to the current element by Next(), otherwise in O(n).
+func (it *Iterator[T]) InsertBefore(value T) bool {
+	switch {
+	case it.IsValid():
+		newElement := &element[T]{value: value, next: it.element}
+
+		previous := it.predecessor()
+		if previous == nil {
+			it.list.first = newElement
+		} else {
+			previous.next = newElement
+		}
+
+		it.previous = newElement
+		it.list.size++
+		it.list.modifications.Modified()
+	case it.index == it.list.size:
+		it.list.PushBack(value)
+	default:
+		return false
+	}
+
+	it.index++
+	it.size++
+	it.guard.Sync()
+
+	return true
+}
+
+// InsertAfter inserts value after the current element or at the start of the list in O(1).
+func (it *Iterator[T]) InsertAfter(value T) bool {
+	switch {
+	case it.IsValid():
+		newElement := &element[T]{value: value, next: it.element.next}
+		it.element.next = newElement
+
+		if it.element == it.list.last {
+			it.list.last = newElement
+		}
+
+		it.list.size++
+		it.list.modifications.Modified()
+	case it.IsBegin():
+		it.list.PushFront(value)
+		it.element = it.list.first
+	default:
+		return false
+	}
+
+	it.size++
+	it.guard.Sync()
+
+	return true
+}
+
+// predecessor returns the element before the current element.
+func (it *Iterator[T]) predecessor() *element[T] {
+	if it.element == it.list.first {
+		return nil
+	}
+
+	if it.previous != nil && it.previous.next == it.element {
+		return it.previous
+	}
+
+	previous := it.list.first
+	for previous.next != it.element {
+		previous = previous.next
+	}
+
+	return previous
+}
+
 // If other is of type IndexedIterator, IndexedIterator.Index() will be used, possibly executing in O(1)
 func (it *Iterator[T]) DistanceTo(other ds.OrderedIterator) int {
 	otherThis, ok := other.(*Iterator[T])
@@ -93,6 +214,20 @@ func (it *Iterator[T]) IsEqual(other ds.ComparableIterator) bool {
 	return it.DistanceTo(otherThis) == 0
 }
 
+// CanCompare checks if other is an iterator of the same list.
+func (it *Iterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*Iterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.list != it.list {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 func (it *Iterator[T]) Next() bool {
 	it.index = utils.Min(it.index+1, it.size)
 
@@ -101,6 +236,7 @@ func (it *Iterator[T]) Next() bool {
 	}
 
 	if !it.IsFirst() {
+		it.previous = it.element
 		it.element = it.element.next
 	}
 
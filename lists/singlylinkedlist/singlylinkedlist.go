@@ -12,6 +12,7 @@ package singlylinkedlist
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
@@ -22,11 +23,17 @@ import (
 // Assert List implementation
 var _ lists.List[any] = (*List[any])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*List[any]] = (*List[any])(nil)
+var _ ds.Equatable[*List[any], any] = (*List[any])(nil)
+
 // List holds the elements, where each element points to the next element
 type List[T any] struct {
 	first *element[T]
 	last  *element[T]
 	size  int
+
+	modifications ds.ModificationCounter
 }
 
 type element[T any] struct {
@@ -48,6 +55,7 @@ func (list *List[T]) PopBack(n int) (popped []T) {
 		list.first = nil
 		list.last = nil
 		list.size = 0
+		list.modifications.Modified()
 
 		return
 	}
@@ -67,6 +75,7 @@ func (list *List[T]) PopBack(n int) (popped []T) {
 	list.last = e
 
 	list.size -= n
+	list.modifications.Modified()
 
 	return
 }
@@ -84,6 +93,7 @@ func (list *List[T]) PopFront(n int) (popped []T) {
 	}
 
 	list.size -= n
+	list.modifications.Modified()
 
 	return
 }
@@ -144,6 +154,7 @@ func (list *List[T]) PushBack(values ...T) {
 			list.last = newElement
 		}
 		list.size++
+		list.modifications.Modified()
 	}
 }
 
@@ -157,6 +168,7 @@ func (list *List[T]) PushFront(values ...T) {
 			list.last = newElement
 		}
 		list.size++
+		list.modifications.Modified()
 	}
 }
 
@@ -206,6 +218,7 @@ func (list *List[T]) Remove(index int) {
 	element = nil
 
 	list.size--
+	list.modifications.Modified()
 }
 
 // Contains checks if values (one or more) are present in the set.
@@ -270,6 +283,7 @@ func (list *List[T]) Size() int {
 // Clear removes all elements from the list.
 func (list *List[T]) Clear() {
 	list.size = 0
+	list.modifications.Modified()
 	list.first = nil
 	list.last = nil
 }
@@ -320,6 +334,7 @@ func (list *List[T]) Insert(index int, values ...T) {
 	}
 
 	list.size += len(values)
+	list.modifications.Modified()
 
 	var beforeElement *element[T]
 	foundElement := list.first
@@ -381,6 +396,31 @@ func (list *List[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the list, which does not share any elements with the original.
+func (list *List[T]) Clone() *List[T] {
+	clone := New[T]()
+	for element := list.first; element != nil; element = element.next {
+		clone.PushBack(element.value)
+	}
+
+	return clone
+}
+
+// Equals returns true if both lists hold equal values in the same order.
+func (list *List[T]) Equals(other *List[T], comparator utils.Comparator[T]) bool {
+	if list.size != other.size {
+		return false
+	}
+
+	for element, otherElement := list.first, other.first; element != nil; element, otherElement = element.next, otherElement.next {
+		if comparator(element.value, otherElement.value) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Check that the index is within bounds of the list
 func (list *List[T]) withinRange(index int) bool {
 	return index >= 0 && index < list.size
@@ -411,3 +451,23 @@ func (list *List[T]) First() ds.ReadWriteOrdCompForRandCollIterator[int, T] {
 func (list *List[T]) Last() ds.ReadWriteOrdCompForRandCollIterator[int, T] {
 	return list.NewIterator(list.size-1, list.Size())
 }
+
+// All returns a sequence over the index/value pairs of the list in iteration order.
+func (list *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range ds.Seq(list.Begin()) {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Values returns a sequence over the values of the list in iteration order.
+func (list *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(list.Begin())(yield)
+	}
+}
@@ -18,6 +18,7 @@ package hashbidimap
 
 import (
 	"fmt"
+	"iter"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
 	"github.com/JonasMuehlmann/datastructures.go/maps"
@@ -25,10 +26,13 @@ import (
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 )
 
-// TODO: Should be implement an Equaler interface?
 // Assert Map implementation
 var _ maps.BidiMap[string, string] = (*Map[string, string])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Map[string, string]] = (*Map[string, string])(nil)
+var _ ds.Equatable[*Map[string, string], string] = (*Map[string, string])(nil)
+
 // Map holds the elements in two hashmaps.
 type Map[TKey comparable, TValue comparable] struct {
 	forwardMap      *hashmap.Map[TKey, TValue]
@@ -37,12 +41,53 @@ type Map[TKey comparable, TValue comparable] struct {
 	valueComparator utils.Comparator[TValue]
 }
 
+// MergeWith inserts all elements of other into the map.
+// If a key or value is contained in both maps or other maps multiple keys to the same value,
+// the map is left unchanged and false is returned.
 func (m *Map[TKey, TValue]) MergeWith(other *maps.Map[TKey, TValue]) bool {
-	panic("Not implemented")
+	keys := (*other).GetKeys()
+	values := make(map[TValue]struct{}, len(keys))
+
+	for _, key := range keys {
+		value, _ := (*other).Get(key)
+
+		_, keyFound := m.forwardMap.Get(key)
+		_, valueFound := m.inverseMap.Get(value)
+		_, valueDuplicated := values[value]
+
+		if keyFound || valueFound || valueDuplicated {
+			return false
+		}
+
+		values[value] = struct{}{}
+	}
+
+	for _, key := range keys {
+		value, _ := (*other).Get(key)
+		m.Put(key, value)
+	}
+
+	return true
 }
 
+// MergeWithSafe inserts all elements of other into the map.
+// If a key or value is contained in both maps, other's pair replaces the conflicting pairs if overwriteOriginal is true,
+// otherwise the original pairs are kept.
 func (m *Map[TKey, TValue]) MergeWithSafe(other *maps.Map[TKey, TValue], overwriteOriginal bool) {
-	panic("Not implemented")
+	for _, key := range (*other).GetKeys() {
+		value, _ := (*other).Get(key)
+
+		if !overwriteOriginal {
+			_, keyFound := m.forwardMap.Get(key)
+			_, valueFound := m.inverseMap.Get(value)
+
+			if keyFound || valueFound {
+				continue
+			}
+		}
+
+		m.Put(key, value)
+	}
 }
 
 // New instantiates a bidirectional map.
@@ -183,6 +228,21 @@ func (m *Map[TKey, TValue]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the map, which does not share any internal state with the original.
+func (m *Map[TKey, TValue]) Clone() *Map[TKey, TValue] {
+	return &Map[TKey, TValue]{
+		forwardMap:      m.forwardMap.Clone(),
+		inverseMap:      m.inverseMap.Clone(),
+		keyComparator:   m.keyComparator,
+		valueComparator: m.valueComparator,
+	}
+}
+
+// Equals returns true if both maps hold the same keys and the values of all keys are equal according to valueComparator.
+func (m *Map[TKey, TValue]) Equals(other *Map[TKey, TValue], valueComparator utils.Comparator[TValue]) bool {
+	return m.forwardMap.Equals(other.forwardMap, valueComparator)
+}
+
 //******************************************************************//
 //                         Ordered iterator                         //
 //******************************************************************//
@@ -210,3 +270,18 @@ func (m *Map[TKey, TValue]) OrderedFirst(comparator utils.Comparator[TKey]) ds.R
 func (m *Map[TKey, TValue]) OrderedLast(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
 	return m.NewOrderedIterator(m.Size()-1, m.Size())
 }
+
+// All returns a sequence over the key/value pairs of the map in no particular order.
+func (m *Map[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return m.forwardMap.All()
+}
+
+// Keys returns a sequence over the keys of the map in no particular order.
+func (m *Map[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return m.forwardMap.Keys()
+}
+
+// Values returns a sequence over the values of the map in no particular order.
+func (m *Map[TKey, TValue]) Values() iter.Seq[TValue] {
+	return m.forwardMap.Values()
+}
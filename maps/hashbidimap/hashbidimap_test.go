@@ -6,10 +6,10 @@
 package hashbidimap
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -507,3 +507,119 @@ func BenchmarkHashMapPut(b *testing.B) {
 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 	}
 }
+
+func TestHashBidiMapClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Map[string, int]
+	}{
+		{
+			name:     "empty",
+			original: NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{}),
+		},
+		{
+			name:     "3 items",
+			original: NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			size := test.original.Size()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+
+			clone.Put("qux", 4)
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, size, test.original.Size(), test.name)
+		})
+	}
+}
+
+func TestHashBidiMapEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Map[string, int]
+		b     *Map[string, int]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{}),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+			equal: true,
+		},
+		{
+			name:  "different values",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"foo": 1, "bar": 2}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"foo": 1, "bar": 3}),
+			equal: false,
+		},
+		{
+			name:  "different keys",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"foo": 1, "bar": 2}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"foo": 1, "qux": 2}),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"foo": 1}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"foo": 1, "bar": 2}),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[int]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	m := New[string, int](utils.BasicComparator[string], utils.BasicComparator[int])
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range m.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, keys)
+	assert.ElementsMatch(t, []int{1, 2, 3}, values)
+
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, slices.Collect(m.Keys()))
+	assert.ElementsMatch(t, []int{1, 2, 3}, slices.Collect(m.Values()))
+
+	keys = []string{}
+	for key := range m.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Len(t, keys, 2)
+}
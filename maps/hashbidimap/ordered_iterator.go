@@ -13,6 +13,12 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[string, any] = (*OrderedIterator[string, any])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[string, any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[string, any])(nil)
+
 // Iterator holding the iterator's state
 type OrderedIterator[TKey comparable, TValue any] struct {
 	*hashmap.OrderedIterator[TKey, TValue]
@@ -61,3 +67,17 @@ func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bo
 
 	return it.DistanceTo(otherThis) == 0
 }
+
+// CanCompare checks if other is an iterator of the same map.
+func (it *OrderedIterator[TKey, TValue]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if it.OrderedIterator.CanCompare(otherThis.OrderedIterator) != nil {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
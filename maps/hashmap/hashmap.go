@@ -14,6 +14,7 @@ package hashmap
 
 import (
 	"fmt"
+	"iter"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
 	"github.com/JonasMuehlmann/datastructures.go/maps"
@@ -23,17 +24,57 @@ import (
 // Assert Map implementation.
 var _ maps.Map[string, any] = (*Map[string, any])(nil)
 
+// Assert Cloneable and Equatable implementation.
+var _ ds.Cloneable[*Map[string, any]] = (*Map[string, any])(nil)
+var _ ds.Equatable[*Map[string, any], any] = (*Map[string, any])(nil)
+
 // Map holds the elements in go's native map.
 type Map[TKey comparable, TValue any] struct {
 	m map[TKey]TValue
+
+	modifications ds.ModificationCounter
 }
 
+// MergeWith inserts all elements of other into the map.
+// If a key is contained in both maps, the map is left unchanged and false is returned.
 func (m *Map[TKey, TValue]) MergeWith(other *maps.Map[TKey, TValue]) bool {
-	panic("Not implemented")
+	keys := (*other).GetKeys()
+
+	for _, key := range keys {
+		if _, found := m.m[key]; found {
+			return false
+		}
+	}
+
+	for _, key := range keys {
+		value, _ := (*other).Get(key)
+		m.m[key] = value
+	}
+
+	if len(keys) > 0 {
+		m.modifications.Modified()
+	}
+
+	return true
 }
 
+// MergeWithSafe inserts all elements of other into the map.
+// If a key is contained in both maps, other's value is used if overwriteOriginal is true, otherwise the original value is kept.
 func (m *Map[TKey, TValue]) MergeWithSafe(other *maps.Map[TKey, TValue], overwriteOriginal bool) {
-	panic("Not implemented")
+	size := len(m.m)
+
+	for _, key := range (*other).GetKeys() {
+		if _, found := m.m[key]; found && !overwriteOriginal {
+			continue
+		}
+
+		value, _ := (*other).Get(key)
+		m.m[key] = value
+	}
+
+	if len(m.m) != size {
+		m.modifications.Modified()
+	}
 }
 
 // New instantiates a hash map.
@@ -81,8 +122,14 @@ func NewFromIterators[TKey comparable, TValue any](begin ds.ReadCompForIndexIter
 }
 
 // Put inserts element into the map.
+// Updating the value of an existing key is not a structural modification.
 func (m *Map[TKey, TValue]) Put(key TKey, value TValue) {
+	size := len(m.m)
 	m.m[key] = value
+
+	if len(m.m) != size {
+		m.modifications.Modified()
+	}
 }
 
 // Get searches the element in the map by key and returns its value or nil if key is not found in map.
@@ -94,7 +141,12 @@ func (m *Map[TKey, TValue]) Get(key TKey) (value TValue, found bool) {
 
 // Remove removes the element from the map by key.
 func (m *Map[TKey, TValue]) Remove(comparator utils.Comparator[TKey], key TKey) {
+	size := len(m.m)
 	delete(m.m, key)
+
+	if len(m.m) != size {
+		m.modifications.Modified()
+	}
 }
 
 // Empty returns true if map does not contain any elements.
@@ -137,6 +189,7 @@ func (map_ *Map[TKey, TValue]) GetMap() map[TKey]TValue {
 // Clear removes all elements from the map.
 func (m *Map[TKey, TValue]) Clear() {
 	m.m = make(map[TKey]TValue)
+	m.modifications.Modified()
 }
 
 // String returns a string representation of container.
@@ -146,6 +199,21 @@ func (m *Map[TKey, TValue]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the map, which does not share its underlying map with the original.
+func (m *Map[TKey, TValue]) Clone() *Map[TKey, TValue] {
+	clone := make(map[TKey]TValue, len(m.m))
+	for key, value := range m.m {
+		clone[key] = value
+	}
+
+	return &Map[TKey, TValue]{m: clone}
+}
+
+// Equals returns true if both maps hold the same keys and the values of all keys are equal according to valueComparator.
+func (m *Map[TKey, TValue]) Equals(other *Map[TKey, TValue], valueComparator utils.Comparator[TValue]) bool {
+	return utils.MapsEqual(m.m, other.m, valueComparator)
+}
+
 //******************************************************************//
 //                         Ordered iterator                         //
 //******************************************************************//
@@ -174,3 +242,36 @@ func (m *Map[TKey, TValue]) OrderedFirst(comparator utils.Comparator[TKey]) ds.R
 func (m *Map[TKey, TValue]) OrderedLast(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
 	return m.NewOrderedIterator(len(m.m)-1, m.Size(), comparator)
 }
+
+// All returns a sequence over the key/value pairs of the map in no particular order.
+func (m *Map[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		for key, value := range m.m {
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns a sequence over the keys of the map in no particular order.
+func (m *Map[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return func(yield func(TKey) bool) {
+		for key := range m.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the map in no particular order.
+func (m *Map[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		for _, value := range m.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -13,6 +13,12 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[string, any] = (*OrderedIterator[string, any])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[string, any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[string, any])(nil)
+
 type OrderedIterator[TKey comparable, TValue any] struct {
 	m          *Map[TKey, TValue]
 	keys       []TKey
@@ -22,6 +28,7 @@ type OrderedIterator[TKey comparable, TValue any] struct {
 	key   TKey
 	value TValue
 	size  int
+	guard ds.ModificationGuard
 }
 
 func (m *Map[TKey, TValue]) NewOrderedIterator(position int, size int, comparator utils.Comparator[TKey]) *OrderedIterator[TKey, TValue] {
@@ -34,6 +41,7 @@ func (m *Map[TKey, TValue]) NewOrderedIterator(position int, size int, comparato
 		index:      position,
 		comparator: comparator,
 		size:       size,
+		guard:      m.modifications.Guard(),
 	}
 	it.size = utils.Min(m.Size(), size)
 	it.size = utils.Max(m.Size(), -1)
@@ -62,7 +70,13 @@ func (it *OrderedIterator[TKey, TValue]) IsLast() bool {
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsValid() bool {
-	return len(it.keys) > 0 && it.index >= 0 && it.index < len(it.keys)
+	return len(it.keys) > 0 && it.index >= 0 && it.index < len(it.keys) && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if keys were added to or removed from the map after the iterator was created.
+// The iterator holds a sorted snapshot of the keys, which is outdated afterwards.
+func (it *OrderedIterator[TKey, TValue]) Err() error {
+	return it.guard.Err()
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bool {
@@ -74,6 +88,20 @@ func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bo
 	return it.DistanceTo(otherThis) == 0
 }
 
+// CanCompare checks if other is an iterator of the same map.
+func (it *OrderedIterator[TKey, TValue]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.m != it.m {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 func (it *OrderedIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) int {
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
@@ -186,6 +214,10 @@ func (it *OrderedIterator[TKey, TValue]) MoveTo(n int) bool {
 }
 
 func (it *OrderedIterator[TKey, TValue]) MoveToKey(k TKey) bool {
+	if it.guard.Err() != nil {
+		return false
+	}
+
 	for i, key := range it.keys {
 		if key == k {
 			it.index = i
@@ -247,6 +279,8 @@ func (it *OrderedIterator[TKey, TValue]) GetAtKey(i TKey) (value TValue, found b
 	return it.m.Get(i)
 }
 
+// SetAtKey puts the key/value pair into the map.
+// Inserting a new key invalidates the iterator, because it is missing from the sorted keys.
 func (it *OrderedIterator[TKey, TValue]) SetAtKey(i TKey, value TValue) bool {
 	it.m.Put(i, value)
 
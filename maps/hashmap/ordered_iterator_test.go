@@ -1016,3 +1016,53 @@ func TestHashMapOrderedIteratorIsBeginEndFirstLast(t *testing.T) {
 		})
 	}
 }
+
+func TestHashMapOrderedIteratorFailFast(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Map[string, int])
+		err    error
+	}{
+		{
+			name:   "Put new key",
+			modify: func(m *Map[string, int]) { m.Put("d", 4) },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "Put existing key",
+			modify: func(m *Map[string, int]) { m.Put("a", 5) },
+			err:    nil,
+		},
+		{
+			name:   "Remove",
+			modify: func(m *Map[string, int]) { m.Remove(utils.BasicComparator[string], "a") },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "Clear",
+			modify: func(m *Map[string, int]) { m.Clear() },
+			err:    ds.ErrConcurrentModification,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			m := NewFromMap[string, int](map[string]int{"a": 1, "b": 2, "c": 3})
+			it := m.OrderedFirst(utils.BasicComparator[string]).(*OrderedIterator[string, int])
+
+			test.modify(m)
+
+			assert.Equalf(t, test.err, it.Err(), test.name)
+			assert.Equalf(t, test.err == nil, it.IsValid(), test.name)
+			assert.Equalf(t, test.err == nil, it.Next(), test.name)
+
+			_, found := it.Get()
+			assert.Equalf(t, test.err == nil, found, test.name)
+		})
+	}
+}
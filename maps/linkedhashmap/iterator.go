@@ -14,6 +14,16 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[string, any] = (*Iterator[string, any])(nil)
 
+// Assert ErasableIterator and KeyedInsertableIterator implementation
+var _ ds.ErasableIterator = (*Iterator[string, any])(nil)
+var _ ds.KeyedInsertableIterator[string, any] = (*Iterator[string, any])(nil)
+
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*Iterator[string, any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*Iterator[string, any])(nil)
+
 type Iterator[TKey comparable, TValue any] struct {
 	s             *Map[TKey, TValue]
 	orderIterator *doublylinkedlist.Iterator[TKey]
@@ -57,10 +67,17 @@ func (it *Iterator[TKey, TValue]) IsLast() bool {
 }
 
 func (it *Iterator[TKey, TValue]) IsValid() bool {
-	return it.size > 0 && !it.IsBegin() && !it.IsEnd()
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the map was structurally modified, other than through the iterator.
+func (it *Iterator[TKey, TValue]) Err() error {
+	return it.orderIterator.Err()
 }
 
 func (it *Iterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*Iterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -70,7 +87,23 @@ func (it *Iterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bool {
 
 }
 
+// CanCompare checks if other is an iterator of the same map.
+func (it *Iterator[TKey, TValue]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := ds.BaseOf[TKey, TValue](other).(*Iterator[TKey, TValue])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.s != it.s {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 func (it *Iterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) int {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*Iterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -80,6 +113,8 @@ func (it *Iterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) int {
 }
 
 func (it *Iterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*Iterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -89,6 +124,8 @@ func (it *Iterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool {
 }
 
 func (it *Iterator[TKey, TValue]) IsBefore(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*Iterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -180,6 +217,10 @@ func (it *Iterator[TKey, TValue]) MoveToKey(key TKey) bool {
 }
 
 func (it *Iterator[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
 	return it.s.Get(it.key)
 }
 
@@ -198,22 +239,74 @@ func (it *Iterator[TKey, TValue]) Set(value TValue) bool {
 	return true
 }
 
-func (it *Iterator[TKey, TValue]) GetAt(i int) (value TValue, found bool) {
+// Remove removes the current element in O(1) and moves the iterator to the next element.
+func (it *Iterator[TKey, TValue]) Remove() bool {
 	if !it.IsValid() {
-		return
+		return false
 	}
-	tmp := *it
-	tmp.MoveTo(i)
 
-	return tmp.Get()
+	delete(it.s.table, it.key)
+	it.orderIterator.Remove()
+	it.size--
+
+	it.key, _ = it.orderIterator.Get()
+
+	return true
 }
 
-func (it *Iterator[TKey, TValue]) SetAt(i int, value TValue) bool {
-	if !it.IsValid() {
+// InsertBefore inserts the key/value pair before the current element or at the end of the ordering in O(1).
+// Returns false if key is already contained.
+func (it *Iterator[TKey, TValue]) InsertBefore(key TKey, value TValue) bool {
+	if _, contains := it.s.table[key]; contains || !it.orderIterator.InsertBefore(key) {
 		return false
 	}
 
+	it.orderIterator.Previous()
+	element, _ := it.orderIterator.Element()
+	it.orderIterator.Next()
+
+	it.s.table[key] = entry[TKey, TValue]{value: value, element: element}
+	it.index++
+	it.size++
+
+	return true
+}
+
+// InsertAfter inserts the key/value pair after the current element or at the start of the ordering in O(1).
+// Returns false if key is already contained.
+func (it *Iterator[TKey, TValue]) InsertAfter(key TKey, value TValue) bool {
+	if _, contains := it.s.table[key]; contains || !it.orderIterator.InsertAfter(key) {
+		return false
+	}
+
+	it.orderIterator.Next()
+	element, _ := it.orderIterator.Element()
+	it.orderIterator.Previous()
+
+	it.s.table[key] = entry[TKey, TValue]{value: value, element: element}
+	it.size++
+
+	return true
+}
+
+// clone returns a copy of the iterator, which can be moved without moving it.
+func (it *Iterator[TKey, TValue]) clone() *Iterator[TKey, TValue] {
 	tmp := *it
+	orderIterator := *it.orderIterator
+	tmp.orderIterator = &orderIterator
+
+	return &tmp
+}
+
+func (it *Iterator[TKey, TValue]) GetAt(i int) (value TValue, found bool) {
+	tmp := it.clone()
+	tmp.MoveTo(i)
+
+	return tmp.Get()
+}
+
+func (it *Iterator[TKey, TValue]) SetAt(i int, value TValue) bool {
+	tmp := it.clone()
 	tmp.MoveTo(i)
 
 	return tmp.Set(value)
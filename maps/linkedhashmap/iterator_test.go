@@ -1,6 +1,7 @@
 package linkedhashmap
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
@@ -1017,3 +1018,281 @@ func TestLinkedHashIteratorIsBeginEndFirstLast(t *testing.T) {
 		})
 	}
 }
+
+func TestLinkedHashMapIteratorRemove(t *testing.T) {
+	m := New[string, int]()
+	m.Put("d", 4)
+	m.Put("a", 1)
+	m.Put("c", 3)
+	m.Put("b", 2)
+
+	it := m.Begin().(*Iterator[string, int])
+
+	for it.Next(); it.IsValid(); {
+		value, _ := it.Get()
+		if value%2 == 0 {
+			assert.True(t, it.Remove())
+		} else {
+			it.Next()
+		}
+	}
+
+	assert.True(t, it.IsEnd())
+	assert.False(t, it.Remove())
+	assert.Equal(t, []string{"a", "c"}, m.GetKeys())
+	assert.Equal(t, 2, m.Size())
+
+	_, found := m.Get("d")
+	assert.False(t, found)
+
+	// The removed keys are no longer part of the ordering
+	m.Put("d", 4)
+	assert.Equal(t, []string{"a", "c", "d"}, m.GetKeys())
+}
+
+func TestLinkedHashMapIteratorInsert(t *testing.T) {
+	m := New[string, int]()
+	m.Put("b", 2)
+	m.Put("d", 4)
+
+	it := m.Begin().(*Iterator[string, int])
+
+	assert.False(t, it.InsertBefore("a", 1))
+	assert.True(t, it.InsertAfter("a", 1))
+	assert.True(t, it.IsBegin())
+
+	assert.True(t, it.NextN(2))
+	assert.False(t, it.InsertAfter("a", 1))
+	assert.True(t, it.InsertAfter("c", 3))
+
+	key, _ := it.GetKey()
+	assert.Equal(t, "b", key)
+
+	assert.False(t, it.NextN(3))
+	assert.True(t, it.IsEnd())
+	assert.False(t, it.InsertAfter("e", 5))
+	assert.True(t, it.InsertBefore("e", 5))
+	assert.True(t, it.IsEnd())
+
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, m.GetKeys())
+	assert.Equal(t, 5, it.Size())
+
+	value, found := m.Get("c")
+	assert.True(t, found)
+	assert.Equal(t, 3, value)
+
+	// Inserted keys can be moved and removed like any other key in O(1)
+	assert.True(t, m.MoveToFront("e"))
+	m.Remove(nil, "c")
+	assert.Equal(t, []string{"e", "a", "b", "d"}, m.GetKeys())
+}
+
+func TestLinkedHashMapReverseIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		container *Map[string, int]
+	}{
+		{
+			name:      "Empty",
+			container: New[string, int](),
+		},
+		{
+			name:      "One element",
+			container: NewFromMap[string, int](map[string]int{"a": 1}),
+		},
+		{
+			name:      "3 elements",
+			container: NewFromMap[string, int](map[string]int{"a": 1, "b": 2, "c": 3}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			values := []int{}
+			for it := test.container.Begin(); it.Next(); {
+				value, _ := it.Get()
+				values = append(values, value)
+			}
+
+			reversed := []int{}
+			for it := test.container.ReverseBegin(); it.Next(); {
+				value, _ := it.Get()
+				reversed = append(reversed, value)
+			}
+
+			backward := []int{}
+			for it := test.container.ReverseEnd(); it.Previous(); {
+				value, _ := it.Get()
+				backward = append(backward, value)
+			}
+
+			assert.Equalf(t, values, backward, test.name)
+
+			slices.Reverse(values)
+			assert.Equalf(t, values, reversed, test.name)
+
+			first, found := test.container.ReverseFirst().Get()
+			last, _ := test.container.ReverseLast().Get()
+			assert.Equalf(t, len(values) > 0, found, test.name)
+
+			if len(values) > 0 {
+				assert.Equalf(t, values[0], first, test.name)
+				assert.Equalf(t, values[len(values)-1], last, test.name)
+			}
+		})
+	}
+}
+
+// newOrderedMap returns a map, whose insertion order is a, b, c.
+func newOrderedMap() *Map[string, int] {
+	m := New[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	return m
+}
+
+func TestLinkedHashMapReverseIteratorReordered(t *testing.T) {
+	m := newOrderedMap()
+	m.MoveToFront("c")
+
+	keys := []string{}
+	for it := m.ReverseBegin(); it.Next(); {
+		key, _ := it.GetKey()
+		keys = append(keys, key)
+	}
+
+	assert.Equal(t, []string{"b", "a", "c"}, keys)
+
+	it := m.ReverseBegin()
+	assert.True(t, it.MoveToKey("a"))
+
+	// Random access must not move the iterator off its key
+	value, found := it.GetAt(0)
+	assert.True(t, found)
+	assert.Equal(t, 3, value)
+	assert.True(t, it.SetAt(2, 20))
+
+	key, _ := it.GetKey()
+	assert.Equal(t, "a", key)
+
+	assert.True(t, it.Next())
+
+	key, _ = it.GetKey()
+	assert.Equal(t, "c", key)
+
+	value, _ = m.Get("b")
+	assert.Equal(t, 20, value)
+
+	// Overwriting a value keeps the key's position and does not invalidate the iterator
+	m.Put("c", 30)
+
+	assert.NoError(t, it.(ds.FailFastIterator).Err())
+
+	value, _ = it.Get()
+	assert.Equal(t, 30, value)
+	assert.True(t, it.IsLast())
+}
+
+func TestLinkedHashMapReverseIteratorCompare(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		reversed      bool
+		otherKey      string
+		otherReversed bool
+		distance      int
+	}{
+		{
+			name:          "Reversed keys",
+			key:           "a",
+			reversed:      true,
+			otherKey:      "c",
+			otherReversed: true,
+			distance:      -1,
+		},
+		{
+			name:          "Reversed key to forward key",
+			key:           "a",
+			reversed:      true,
+			otherKey:      "c",
+			otherReversed: false,
+			distance:      1,
+		},
+		{
+			name:          "Reversed key to same forward key",
+			key:           "b",
+			reversed:      true,
+			otherKey:      "b",
+			otherReversed: false,
+			distance:      0,
+		},
+		{
+			name:          "Forward key to reversed key",
+			key:           "c",
+			reversed:      false,
+			otherKey:      "b",
+			otherReversed: true,
+			distance:      -2,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			// c is moved to the front, so the order of the keys is c, a, b
+			m := newOrderedMap()
+			m.MoveToFront("c")
+
+			it := atKey(m, test.reversed, test.key)
+			other := atKey(m, test.otherReversed, test.otherKey)
+
+			distance, err := ds.CheckedDistanceTo(it, other)
+			assert.NoErrorf(t, err, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+			assert.Equalf(t, -test.distance, other.DistanceTo(it), test.name)
+			assert.Equalf(t, test.distance < 0, it.IsBefore(other), test.name)
+			assert.Equalf(t, test.distance > 0, other.IsBefore(it), test.name)
+			assert.Equalf(t, test.distance == 0, other.IsEqual(it), test.name)
+		})
+	}
+
+	m := newOrderedMap()
+	other := m.Clone()
+	assert.ErrorIs(t, ds.CanCompare(m.First(), other.ReverseFirst()), ds.ErrForeignIterators)
+	assert.ErrorIs(t, ds.CanCompare(m.ReverseFirst(), other.First()), ds.ErrForeignIterators)
+}
+
+func TestLinkedHashMapReverseIteratorFailFast(t *testing.T) {
+	m := newOrderedMap()
+	it := m.ReverseFirst()
+
+	// Reordering changes which key comes next, even though no key is added or removed
+	m.MoveToBack("a")
+
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Next())
+}
+
+// atKey returns a forward or reversed iterator of m, which points to key.
+func atKey(m *Map[string, int], reversed bool, key string) ds.ReadWriteOrdCompBidRandCollIterator[string, int] {
+	it := m.Begin()
+	if reversed {
+		it = m.ReverseBegin()
+	}
+
+	it.MoveToKey(key)
+
+	return it
+}
@@ -6,6 +6,7 @@
 // Package linkedhashmap is a map that preserves insertion-order.
 //
 // It is backed by a hash table to store values and doubly-linked list to store ordering.
+// The hash table also stores each key's node of the ordering, so that removing and moving keys runs in O(1).
 //
 // Structure is not thread safe.
 //
@@ -14,6 +15,7 @@ package linkedhashmap
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
@@ -25,35 +27,69 @@ import (
 // Assert Map implementation
 var _ maps.Map[string, any] = (*Map[string, any])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Map[string, any]] = (*Map[string, any])(nil)
+var _ ds.Equatable[*Map[string, any], any] = (*Map[string, any])(nil)
+
 // Map holds the elements in a regular hash table, and uses doubly-linked list to store key ordering.
 type Map[TKey comparable, TValue any] struct {
-	table    map[TKey]TValue
+	table    map[TKey]entry[TKey, TValue]
 	ordering *doublylinkedlist.List[TKey]
 }
 
+// entry holds a value and the node of its key in the ordering.
+type entry[TKey comparable, TValue any] struct {
+	value   TValue
+	element *doublylinkedlist.Element[TKey]
+}
+
+// MergeWith inserts all elements of other into the map, new keys are appended in the order of other.GetKeys().
+// If a key is contained in both maps, the map is left unchanged and false is returned.
 func (m *Map[TKey, TValue]) MergeWith(other *maps.Map[TKey, TValue]) bool {
-	panic("Not implemented")
+	keys := (*other).GetKeys()
+
+	for _, key := range keys {
+		if _, found := m.table[key]; found {
+			return false
+		}
+	}
+
+	for _, key := range keys {
+		value, _ := (*other).Get(key)
+		m.Put(key, value)
+	}
+
+	return true
 }
 
+// MergeWithSafe inserts all elements of other into the map, new keys are appended in the order of other.GetKeys().
+// If a key is contained in both maps, other's value is used if overwriteOriginal is true, otherwise the original value is kept.
+// Overwriting a value does not change the key's position in the ordering.
 func (m *Map[TKey, TValue]) MergeWithSafe(other *maps.Map[TKey, TValue], overwriteOriginal bool) {
-	panic("Not implemented")
+	for _, key := range (*other).GetKeys() {
+		if _, found := m.table[key]; found && !overwriteOriginal {
+			continue
+		}
+
+		value, _ := (*other).Get(key)
+		m.Put(key, value)
+	}
 }
 
 // New instantiates a linked-hash-map.
 func New[TKey comparable, TValue any]() *Map[TKey, TValue] {
 	return &Map[TKey, TValue]{
-		table:    make(map[TKey]TValue),
+		table:    make(map[TKey]entry[TKey, TValue]),
 		ordering: doublylinkedlist.New[TKey](),
 	}
 }
 
 // NewFromMap instantiates a new  set from the provided slice.
 func NewFromMap[TKey comparable, TValue any](map_ map[TKey]TValue) *Map[TKey, TValue] {
-	s := &Map[TKey, TValue]{table: make(map[TKey]TValue), ordering: doublylinkedlist.New[TKey]()}
+	s := New[TKey, TValue]()
 
 	for k, v := range map_ {
-		s.table[k] = v
-		s.ordering.PushBack(k)
+		s.Put(k, v)
 	}
 
 	return s
@@ -61,14 +97,13 @@ func NewFromMap[TKey comparable, TValue any](map_ map[TKey]TValue) *Map[TKey, TV
 
 // NewFromIterator instantiates a new set containing the elements provided by the passed iterator.
 func NewFromIterator[TKey comparable, TValue any](begin ds.ReadForIndexIterator[TKey, TValue]) *Map[TKey, TValue] {
-	s := &Map[TKey, TValue]{table: make(map[TKey]TValue), ordering: doublylinkedlist.New[TKey]()}
+	s := New[TKey, TValue]()
 
 	for begin.Next() {
 		newKey, _ := begin.GetKey()
 		newValue, _ := begin.Get()
 
-		s.table[newKey] = newValue
-		s.ordering.PushBack(newKey)
+		s.Put(newKey, newValue)
 	}
 
 	return s
@@ -77,14 +112,13 @@ func NewFromIterator[TKey comparable, TValue any](begin ds.ReadForIndexIterator[
 // NewFromIterators instantiates a new set containing the elements provided by first, until it is equal to end.
 // end is a sentinel and not included.
 func NewFromIterators[TKey comparable, TValue any](begin ds.ReadCompForIndexIterator[TKey, TValue], end ds.CompIndexIterator[TKey]) *Map[TKey, TValue] {
-	s := &Map[TKey, TValue]{table: make(map[TKey]TValue), ordering: doublylinkedlist.New[TKey]()}
+	s := New[TKey, TValue]()
 
 	for !begin.IsEqual(end) && begin.Next() {
 		newKey, _ := begin.GetKey()
 		newValue, _ := begin.Get()
 
-		s.table[newKey] = newValue
-		s.ordering.PushBack(newKey)
+		s.Put(newKey, newValue)
 	}
 
 	return s
@@ -93,31 +127,103 @@ func NewFromIterators[TKey comparable, TValue any](begin ds.ReadCompForIndexIter
 // Put inserts key-value pair into the map.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (m *Map[TKey, TValue]) Put(key TKey, value TValue) {
-	if _, contains := m.table[key]; !contains {
-		m.ordering.PushBack(key)
+	e, contains := m.table[key]
+	if !contains {
+		e.element = m.ordering.PushBackElement(key)
 	}
-	m.table[key] = value
+
+	e.value = value
+	m.table[key] = e
 }
 
 // Get searches the element in the map by key and returns its value or nil if key is not found in tree.
 // Second return parameter is true if key was found, otherwise false.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (m *Map[TKey, TValue]) Get(key TKey) (value TValue, found bool) {
+	e, found := m.table[key]
 
-	value, found = m.table[key]
-	return
+	return e.value, found
 }
 
-// Remove removes the element from the map by key.
-// Key should adhere to the comparator's type assertion, otherwise method panics.
+// Remove removes the element from the map by key in O(1).
+// The comparator is not used and may be nil.
 func (m *Map[TKey, TValue]) Remove(comparator utils.Comparator[TKey], key TKey) {
-	if _, contains := m.table[key]; contains {
+	if e, contains := m.table[key]; contains {
 		delete(m.table, key)
-		index := m.ordering.IndexOf(comparator, key)
-		m.ordering.Remove(index)
+		m.ordering.RemoveElement(e.element)
 	}
 }
 
+// MoveToFront moves key to the start of the ordering in O(1).
+// Returns false if key is not found, otherwise true.
+func (m *Map[TKey, TValue]) MoveToFront(key TKey) bool {
+	e, contains := m.table[key]
+	if !contains {
+		return false
+	}
+
+	m.ordering.MoveToFront(e.element)
+
+	return true
+}
+
+// MoveToBack moves key to the end of the ordering in O(1).
+// Returns false if key is not found, otherwise true.
+func (m *Map[TKey, TValue]) MoveToBack(key TKey) bool {
+	e, contains := m.table[key]
+	if !contains {
+		return false
+	}
+
+	m.ordering.MoveToBack(e.element)
+
+	return true
+}
+
+// InsertBefore inserts the key-value pair newKey, value directly before key in O(1).
+// If newKey is already contained, its value is overwritten and it is moved before key.
+// Returns false if key is not found, otherwise true.
+func (m *Map[TKey, TValue]) InsertBefore(key TKey, newKey TKey, value TValue) bool {
+	mark, contains := m.table[key]
+	if !contains {
+		return false
+	}
+
+	e, contains := m.table[newKey]
+	if contains {
+		m.ordering.MoveBefore(e.element, mark.element)
+	} else {
+		e.element = m.ordering.InsertBeforeElement(newKey, mark.element)
+	}
+
+	e.value = value
+	m.table[newKey] = e
+
+	return true
+}
+
+// InsertAfter inserts the key-value pair newKey, value directly after key in O(1).
+// If newKey is already contained, its value is overwritten and it is moved after key.
+// Returns false if key is not found, otherwise true.
+func (m *Map[TKey, TValue]) InsertAfter(key TKey, newKey TKey, value TValue) bool {
+	mark, contains := m.table[key]
+	if !contains {
+		return false
+	}
+
+	e, contains := m.table[newKey]
+	if contains {
+		m.ordering.MoveAfter(e.element, mark.element)
+	} else {
+		e.element = m.ordering.InsertAfterElement(newKey, mark.element)
+	}
+
+	e.value = value
+	m.table[newKey] = e
+
+	return true
+}
+
 // Empty returns true if map does not contain any elements
 func (m *Map[TKey, TValue]) IsEmpty() bool {
 	return m.Size() == 0
@@ -148,7 +254,7 @@ func (m *Map[TKey, TValue]) GetValues() []TValue {
 
 // Clear removes all elements from the map.
 func (m *Map[TKey, TValue]) Clear() {
-	m.table = make(map[TKey]TValue)
+	m.table = make(map[TKey]entry[TKey, TValue])
 	m.ordering.Clear()
 }
 
@@ -165,6 +271,35 @@ func (m *Map[TKey, TValue]) ToString() string {
 
 }
 
+// Clone returns a copy of the map with the same ordering, which does not share any internal state with the original.
+func (m *Map[TKey, TValue]) Clone() *Map[TKey, TValue] {
+	clone := New[TKey, TValue]()
+
+	for _, key := range m.ordering.GetValues() {
+		clone.Put(key, m.table[key].value)
+	}
+
+	return clone
+}
+
+// Equals returns true if both maps hold the same keys in the same order and the values of all keys are equal according to valueComparator.
+func (m *Map[TKey, TValue]) Equals(other *Map[TKey, TValue], valueComparator utils.Comparator[TValue]) bool {
+	if m.Size() != other.Size() {
+		return false
+	}
+
+	keys := m.ordering.GetValues()
+	otherKeys := other.ordering.GetValues()
+
+	for i, key := range keys {
+		if key != otherKeys[i] || valueComparator(m.table[key].value, other.table[key].value) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 //******************************************************************//
 //                         Ordered iterator                         //
 //******************************************************************//
@@ -194,3 +329,65 @@ func (m *Map[TKey, TValue]) First() ds.ReadWriteOrdCompBidRandCollIterator[TKey,
 func (m *Map[TKey, TValue]) Last() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
 	return m.NewIterator(m.Size()-1, m.Size())
 }
+
+//******************************************************************//
+//                         Reverse iterator                         //
+//******************************************************************//
+
+// ReverseBegin returns an initialized, reversed iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (m *Map[TKey, TValue]) ReverseBegin() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(m.End())
+}
+
+// ReverseEnd returns an initialized, reversed iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (m *Map[TKey, TValue]) ReverseEnd() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(m.Begin())
+}
+
+// ReverseFirst returns an initialized, reversed iterator, which points to it's first element.
+func (m *Map[TKey, TValue]) ReverseFirst() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(m.Last())
+}
+
+// ReverseLast returns an initialized, reversed iterator, which points to it's last element.
+func (m *Map[TKey, TValue]) ReverseLast() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(m.First())
+}
+
+// All returns a sequence over the key/value pairs of the map in insertion order.
+func (m *Map[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.Seq2(m.Begin())(yield)
+	}
+}
+
+// Backward returns a sequence over the key/value pairs of the map in reverse insertion order.
+func (m *Map[TKey, TValue]) Backward() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.BackwardSeq2(m.End())(yield)
+	}
+}
+
+// Keys returns a sequence over the keys of the map in insertion order.
+func (m *Map[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return func(yield func(TKey) bool) {
+		for key := range m.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the map in insertion order.
+func (m *Map[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		for _, value := range m.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
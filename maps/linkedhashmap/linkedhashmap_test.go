@@ -6,15 +6,33 @@
 package linkedhashmap
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/exp/maps"
 )
 
+func toNativeMap[TKey comparable, TValue any](m *Map[TKey, TValue]) map[TKey]TValue {
+	nativeMap := make(map[TKey]TValue, m.Size())
+	for key, e := range m.table {
+		nativeMap[key] = e.value
+	}
+
+	return nativeMap
+}
+
+func newOrdered(keys ...string) *Map[string, int] {
+	m := New[string, int]()
+	for i, key := range keys {
+		m.Put(key, i)
+	}
+
+	return m
+}
+
 func TestLinkedHashMapRemove(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -57,7 +75,219 @@ func TestLinkedHashMapRemove(t *testing.T) {
 			defer testCommon.HandlePanic(t, test.name)
 			test.originalMap.Remove(utils.BasicComparator[string], test.toRemove)
 
-			assert.Equalf(t, test.originalMap.table, test.newMap.table, test.name)
+			assert.Equalf(t, toNativeMap(test.newMap), toNativeMap(test.originalMap), test.name)
+		})
+	}
+}
+
+func TestLinkedHashMapRemoveKeepsOrdering(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Map[string, int]
+		toRemove    string
+		keys        []string
+	}{
+		{
+			name:        "first",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			toRemove:    "foo",
+			keys:        []string{"bar", "baz"},
+		},
+		{
+			name:        "middle",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			toRemove:    "bar",
+			keys:        []string{"foo", "baz"},
+		},
+		{
+			name:        "last",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			toRemove:    "baz",
+			keys:        []string{"foo", "bar"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.originalMap.Remove(nil, test.toRemove)
+
+			assert.Equalf(t, test.keys, test.originalMap.GetKeys(), test.name)
+			assert.Equalf(t, len(test.keys), test.originalMap.Size(), test.name)
+		})
+	}
+}
+
+func TestLinkedHashMapMove(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Map[string, int]
+		key         string
+		toFront     bool
+		moved       bool
+		keys        []string
+	}{
+		{
+			name:        "empty map",
+			originalMap: New[string, int](),
+			key:         "foo",
+			toFront:     true,
+			moved:       false,
+			keys:        []string{},
+		},
+		{
+			name:        "last to front",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			key:         "baz",
+			toFront:     true,
+			moved:       true,
+			keys:        []string{"baz", "foo", "bar"},
+		},
+		{
+			name:        "first to front",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			key:         "foo",
+			toFront:     true,
+			moved:       true,
+			keys:        []string{"foo", "bar", "baz"},
+		},
+		{
+			name:        "first to back",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			key:         "foo",
+			toFront:     false,
+			moved:       true,
+			keys:        []string{"bar", "baz", "foo"},
+		},
+		{
+			name:        "middle to back",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			key:         "bar",
+			toFront:     false,
+			moved:       true,
+			keys:        []string{"foo", "baz", "bar"},
+		},
+		{
+			name:        "missing key",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			key:         "qux",
+			toFront:     false,
+			moved:       false,
+			keys:        []string{"foo", "bar", "baz"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			var moved bool
+			if test.toFront {
+				moved = test.originalMap.MoveToFront(test.key)
+			} else {
+				moved = test.originalMap.MoveToBack(test.key)
+			}
+
+			assert.Equalf(t, test.moved, moved, test.name)
+			assert.Equalf(t, test.keys, test.originalMap.GetKeys(), test.name)
+		})
+	}
+}
+
+func TestLinkedHashMapInsert(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Map[string, int]
+		key         string
+		newKey      string
+		before      bool
+		inserted    bool
+		keys        []string
+		values      []int
+	}{
+		{
+			name:        "empty map",
+			originalMap: New[string, int](),
+			key:         "foo",
+			newKey:      "qux",
+			before:      true,
+			inserted:    false,
+			keys:        []string{},
+			values:      []int{},
+		},
+		{
+			name:        "before first",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			key:         "foo",
+			newKey:      "qux",
+			before:      true,
+			inserted:    true,
+			keys:        []string{"qux", "foo", "bar", "baz"},
+			values:      []int{10, 0, 1, 2},
+		},
+		{
+			name:        "after last",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			key:         "baz",
+			newKey:      "qux",
+			before:      false,
+			inserted:    true,
+			keys:        []string{"foo", "bar", "baz", "qux"},
+			values:      []int{0, 1, 2, 10},
+		},
+		{
+			name:        "after middle",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			key:         "bar",
+			newKey:      "qux",
+			before:      false,
+			inserted:    true,
+			keys:        []string{"foo", "bar", "qux", "baz"},
+			values:      []int{0, 1, 10, 2},
+		},
+		{
+			name:        "existing key before",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			key:         "foo",
+			newKey:      "baz",
+			before:      true,
+			inserted:    true,
+			keys:        []string{"baz", "foo", "bar"},
+			values:      []int{10, 0, 1},
+		},
+		{
+			name:        "existing key after itself",
+			originalMap: newOrdered("foo", "bar", "baz"),
+			key:         "bar",
+			newKey:      "bar",
+			before:      false,
+			inserted:    true,
+			keys:        []string{"foo", "bar", "baz"},
+			values:      []int{0, 10, 2},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			var inserted bool
+			if test.before {
+				inserted = test.originalMap.InsertBefore(test.key, test.newKey, 10)
+			} else {
+				inserted = test.originalMap.InsertAfter(test.key, test.newKey, 10)
+			}
+
+			assert.Equalf(t, test.inserted, inserted, test.name)
+			assert.Equalf(t, test.keys, test.originalMap.GetKeys(), test.name)
+			assert.Equalf(t, test.values, test.originalMap.GetValues(), test.name)
 		})
 	}
 }
@@ -109,7 +339,7 @@ func TestLinkedHashMapPut(t *testing.T) {
 			defer testCommon.HandlePanic(t, test.name)
 			test.originalMap.Put(test.keyToAdd, test.valueToAdd)
 
-			assert.Equalf(t, test.originalMap.table, test.newMap.table, test.name)
+			assert.Equalf(t, toNativeMap(test.newMap), toNativeMap(test.originalMap), test.name)
 		})
 	}
 }
@@ -351,7 +581,7 @@ func TestLinkedHashMapNewFromIterator(t *testing.T) {
 
 			newMap := NewFromIterator[string, int](it)
 
-			assert.EqualValues(t, test.originalMap.table, newMap.table, test.name)
+			assert.EqualValues(t, toNativeMap(test.originalMap), toNativeMap(newMap), test.name)
 		})
 	}
 
@@ -387,7 +617,7 @@ func TestLinkedHashMapNewFromIterators(t *testing.T) {
 
 			newMap := NewFromIterators[string, int](first, end)
 
-			assert.EqualValues(t, test.originalMap.table, newMap.table, test.name)
+			assert.EqualValues(t, toNativeMap(test.originalMap), toNativeMap(newMap), test.name)
 		})
 	}
 
@@ -582,3 +812,148 @@ func BenchmarkHashMapGetValues(b *testing.B) {
 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 	}
 }
+
+func TestLinkedHashMapClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Map[string, int]
+	}{
+		{
+			name:     "empty",
+			original: NewFromMap[string, int](map[string]int{}),
+		},
+		{
+			name:     "3 items",
+			original: NewFromMap[string, int](map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			size := test.original.Size()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+
+			values := test.original.GetValues()
+			for _, key := range test.original.GetKeys() {
+				clone.Put(key, 0)
+			}
+
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+			assert.Equalf(t, test.original.GetKeys(), clone.GetKeys(), test.name)
+
+			clone.Put("qux", 4)
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, size, test.original.Size(), test.name)
+		})
+	}
+}
+
+func TestLinkedHashMapEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Map[string, int]
+		b     *Map[string, int]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     NewFromMap[string, int](map[string]int{}),
+			b:     NewFromMap[string, int](map[string]int{}),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     newOrdered("foo", "bar", "baz"),
+			b:     newOrdered("foo", "bar", "baz"),
+			equal: true,
+		},
+		{
+			name:  "different values",
+			a:     NewFromMap[string, int](map[string]int{"foo": 1, "bar": 2}),
+			b:     NewFromMap[string, int](map[string]int{"foo": 1, "bar": 3}),
+			equal: false,
+		},
+		{
+			name:  "different keys",
+			a:     NewFromMap[string, int](map[string]int{"foo": 1, "bar": 2}),
+			b:     NewFromMap[string, int](map[string]int{"foo": 1, "qux": 2}),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     NewFromMap[string, int](map[string]int{"foo": 1}),
+			b:     NewFromMap[string, int](map[string]int{"foo": 1, "bar": 2}),
+			equal: false,
+		},
+		{
+			name: "different order",
+			a:    newOrdered("foo", "bar"),
+			b: func() *Map[string, int] {
+				m := newOrdered("foo", "bar")
+				m.MoveToFront("bar")
+
+				return m
+			}(),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[int]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range m.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"c", "a", "b"}, keys)
+	assert.Equal(t, []int{3, 1, 2}, values)
+
+	keys = []string{}
+	values = []int{}
+	for key, value := range m.Backward() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"b", "a", "c"}, keys)
+	assert.Equal(t, []int{2, 1, 3}, values)
+
+	assert.Equal(t, []string{"c", "a", "b"}, slices.Collect(m.Keys()))
+	assert.Equal(t, []int{3, 1, 2}, slices.Collect(m.Values()))
+
+	keys = []string{}
+	for key := range m.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"c", "a"}, keys)
+}
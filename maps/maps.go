@@ -21,6 +21,10 @@ import (
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 )
 
+const (
+	KeyOutOfViewRange = "Key is out of the view's range"
+)
+
 // Map interface that all maps implement.
 type Map[TKey, TValue any] interface {
 	Put(key TKey, value TValue)
@@ -33,9 +37,46 @@ type Map[TKey, TValue any] interface {
 	ds.Container[TValue]
 }
 
+// ModifyingReader is implemented by maps, whose reading methods like Get, GetKeys or Size modify them,
+// for example to record accesses or drop expired entries.
+// Thread safe wrappers must not let such maps be read concurrently.
+type ModifyingReader interface {
+	// ReadsModify returns true if reading the map can modify it.
+	ReadsModify() bool
+}
+
 // BidiMap interface that all bidirectional maps implement (extends the Map interface).
 type BidiMap[TKey, TValue any] interface {
 	GetKey(value TValue) (key TKey, found bool)
 
 	Map[TKey, TValue]
 }
+
+// NavigableMap interface that all maps ordered by key implement (extends the Map interface).
+//
+// The maps returned by SubMap, HeadMap and TailMap are live views of the original map,
+// changes to the view are reflected in the original map and vice versa.
+type NavigableMap[TKey, TValue any] interface {
+	// Lower returns the largest key strictly smaller than key and its value.
+	Lower(key TKey) (foundKey TKey, foundValue TValue, found bool)
+	// Higher returns the smallest key strictly larger than key and its value.
+	Higher(key TKey) (foundKey TKey, foundValue TValue, found bool)
+	// FloorEntry returns the largest key smaller than or equal to key and its value.
+	FloorEntry(key TKey) (foundKey TKey, foundValue TValue, found bool)
+	// CeilingEntry returns the smallest key larger than or equal to key and its value.
+	CeilingEntry(key TKey) (foundKey TKey, foundValue TValue, found bool)
+
+	// PollFirst removes and returns the smallest key and its value.
+	PollFirst() (key TKey, value TValue, found bool)
+	// PollLast removes and returns the largest key and its value.
+	PollLast() (key TKey, value TValue, found bool)
+
+	// SubMap returns a view of the elements with keys between from and to.
+	SubMap(from TKey, fromInclusive bool, to TKey, toInclusive bool) NavigableMap[TKey, TValue]
+	// HeadMap returns a view of the elements with keys smaller than (or equal to) to.
+	HeadMap(to TKey, toInclusive bool) NavigableMap[TKey, TValue]
+	// TailMap returns a view of the elements with keys larger than (or equal to) from.
+	TailMap(from TKey, fromInclusive bool) NavigableMap[TKey, TValue]
+
+	Map[TKey, TValue]
+}
@@ -0,0 +1,359 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package maps_test
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/maps"
+	"github.com/JonasMuehlmann/datastructures.go/maps/hashbidimap"
+	"github.com/JonasMuehlmann/datastructures.go/maps/hashmap"
+	"github.com/JonasMuehlmann/datastructures.go/maps/linkedhashmap"
+	"github.com/JonasMuehlmann/datastructures.go/maps/treebidimap"
+	"github.com/JonasMuehlmann/datastructures.go/maps/treemap"
+	"github.com/JonasMuehlmann/datastructures.go/skiplist"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/tries/radixtree"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type mapConstructor struct {
+	name   string
+	isBidi bool
+	new    func(elements map[string]int) maps.Map[string, int]
+}
+
+var mapConstructors = []mapConstructor{
+	{
+		name: "HashMap",
+		new: func(elements map[string]int) maps.Map[string, int] {
+			m := hashmap.New[string, int]()
+			for k, v := range elements {
+				m.Put(k, v)
+			}
+
+			return m
+		},
+	},
+	{
+		name: "LinkedHashMap",
+		new: func(elements map[string]int) maps.Map[string, int] {
+			m := linkedhashmap.New[string, int]()
+			for _, k := range sortedKeys(elements) {
+				m.Put(k, elements[k])
+			}
+
+			return m
+		},
+	},
+	{
+		name: "TreeMap",
+		new: func(elements map[string]int) maps.Map[string, int] {
+			return treemap.NewFromMap(utils.BasicComparator[string], elements)
+		},
+	},
+	{
+		name: "SkipListMap",
+		new: func(elements map[string]int) maps.Map[string, int] {
+			return skiplist.NewMapFromMap(utils.BasicComparator[string], elements)
+		},
+	},
+	{
+		name: "RadixTree",
+		new: func(elements map[string]int) maps.Map[string, int] {
+			return radixtree.NewFromMap[string](elements)
+		},
+	},
+	{
+		name:   "HashBidiMap",
+		isBidi: true,
+		new: func(elements map[string]int) maps.Map[string, int] {
+			m := hashbidimap.New[string, int](utils.BasicComparator[string], utils.BasicComparator[int])
+			for k, v := range elements {
+				m.Put(k, v)
+			}
+
+			return m
+		},
+	},
+	{
+		name:   "TreeBidiMap",
+		isBidi: true,
+		new: func(elements map[string]int) maps.Map[string, int] {
+			return treebidimap.NewFromMap(utils.BasicComparator[string], utils.BasicComparator[int], elements)
+		},
+	},
+}
+
+func sortedKeys(elements map[string]int) []string {
+	keys := make([]string, 0, len(elements))
+	for k := range elements {
+		keys = append(keys, k)
+	}
+
+	utils.Sort(keys, utils.BasicComparator[string])
+
+	return keys
+}
+
+func toNativeMap(m maps.Map[string, int]) map[string]int {
+	elements := make(map[string]int, m.Size())
+	for _, key := range m.GetKeys() {
+		elements[key], _ = m.Get(key)
+	}
+
+	return elements
+}
+
+func TestMapMergeWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		original map[string]int
+		other    map[string]int
+		result   map[string]int
+		merged   bool
+	}{
+		{
+			name:     "both empty",
+			original: map[string]int{},
+			other:    map[string]int{},
+			result:   map[string]int{},
+			merged:   true,
+		},
+		{
+			name:     "empty other",
+			original: map[string]int{"foo": 1},
+			other:    map[string]int{},
+			result:   map[string]int{"foo": 1},
+			merged:   true,
+		},
+		{
+			name:     "empty original",
+			original: map[string]int{},
+			other:    map[string]int{"foo": 1},
+			result:   map[string]int{"foo": 1},
+			merged:   true,
+		},
+		{
+			name:     "disjoint",
+			original: map[string]int{"foo": 1, "bar": 2},
+			other:    map[string]int{"baz": 3, "qux": 4},
+			result:   map[string]int{"foo": 1, "bar": 2, "baz": 3, "qux": 4},
+			merged:   true,
+		},
+		{
+			name:     "conflicting key",
+			original: map[string]int{"foo": 1, "bar": 2},
+			other:    map[string]int{"baz": 3, "bar": 4},
+			result:   map[string]int{"foo": 1, "bar": 2},
+			merged:   false,
+		},
+	}
+
+	for _, test := range tests {
+		for _, target := range mapConstructors {
+			for _, source := range mapConstructors {
+				test := test
+				target := target
+				source := source
+				name := test.name + "/" + source.name + " into " + target.name
+
+				t.Run(name, func(t *testing.T) {
+					t.Parallel()
+					defer testCommon.HandlePanic(t, name)
+
+					m := target.new(test.original)
+					other := source.new(test.other)
+
+					merged := m.MergeWith(&other)
+
+					assert.Equalf(t, test.merged, merged, name)
+					assert.Equalf(t, test.result, toNativeMap(m), name)
+					assert.Equalf(t, test.other, toNativeMap(other), name)
+				})
+			}
+		}
+	}
+}
+
+func TestMapMergeWithSafe(t *testing.T) {
+	tests := []struct {
+		name              string
+		original          map[string]int
+		other             map[string]int
+		overwriteOriginal bool
+		result            map[string]int
+	}{
+		{
+			name:              "both empty",
+			original:          map[string]int{},
+			other:             map[string]int{},
+			overwriteOriginal: true,
+			result:            map[string]int{},
+		},
+		{
+			name:              "disjoint",
+			original:          map[string]int{"foo": 1, "bar": 2},
+			other:             map[string]int{"baz": 3, "qux": 4},
+			overwriteOriginal: false,
+			result:            map[string]int{"foo": 1, "bar": 2, "baz": 3, "qux": 4},
+		},
+		{
+			name:              "conflicting key, overwrite",
+			original:          map[string]int{"foo": 1, "bar": 2},
+			other:             map[string]int{"baz": 3, "bar": 4},
+			overwriteOriginal: true,
+			result:            map[string]int{"foo": 1, "bar": 4, "baz": 3},
+		},
+		{
+			name:              "conflicting key, keep original",
+			original:          map[string]int{"foo": 1, "bar": 2},
+			other:             map[string]int{"baz": 3, "bar": 4},
+			overwriteOriginal: false,
+			result:            map[string]int{"foo": 1, "bar": 2, "baz": 3},
+		},
+	}
+
+	for _, test := range tests {
+		for _, target := range mapConstructors {
+			for _, source := range mapConstructors {
+				test := test
+				target := target
+				source := source
+				name := test.name + "/" + source.name + " into " + target.name
+
+				t.Run(name, func(t *testing.T) {
+					t.Parallel()
+					defer testCommon.HandlePanic(t, name)
+
+					m := target.new(test.original)
+					other := source.new(test.other)
+
+					m.MergeWithSafe(&other, test.overwriteOriginal)
+
+					assert.Equalf(t, test.result, toNativeMap(m), name)
+					assert.Equalf(t, test.other, toNativeMap(other), name)
+				})
+			}
+		}
+	}
+}
+
+func TestMapMergeWithConflictingValue(t *testing.T) {
+	tests := []struct {
+		name              string
+		original          map[string]int
+		other             map[string]int
+		safe              bool
+		overwriteOriginal bool
+		merged            bool
+		result            map[string]int
+		bidiResult        map[string]int
+	}{
+		{
+			name:       "MergeWith, value in both maps",
+			original:   map[string]int{"foo": 1, "bar": 2},
+			other:      map[string]int{"baz": 1},
+			merged:     false,
+			result:     map[string]int{"foo": 1, "bar": 2, "baz": 1},
+			bidiResult: map[string]int{"foo": 1, "bar": 2},
+		},
+		{
+			name:       "MergeWith, duplicate value in other",
+			original:   map[string]int{"foo": 1},
+			other:      map[string]int{"bar": 2, "baz": 2},
+			merged:     false,
+			result:     map[string]int{"foo": 1, "bar": 2, "baz": 2},
+			bidiResult: map[string]int{"foo": 1},
+		},
+		{
+			name:              "MergeWithSafe, overwrite",
+			original:          map[string]int{"foo": 1, "bar": 2},
+			other:             map[string]int{"baz": 1},
+			safe:              true,
+			overwriteOriginal: true,
+			result:            map[string]int{"foo": 1, "bar": 2, "baz": 1},
+			bidiResult:        map[string]int{"bar": 2, "baz": 1},
+		},
+		{
+			name:              "MergeWithSafe, keep original",
+			original:          map[string]int{"foo": 1, "bar": 2},
+			other:             map[string]int{"baz": 1},
+			safe:              true,
+			overwriteOriginal: false,
+			result:            map[string]int{"foo": 1, "bar": 2, "baz": 1},
+			bidiResult:        map[string]int{"foo": 1, "bar": 2},
+		},
+	}
+
+	for _, test := range tests {
+		for _, target := range mapConstructors {
+			test := test
+			target := target
+			name := test.name + "/" + target.name
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				defer testCommon.HandlePanic(t, name)
+
+				m := target.new(test.original)
+				other := mapConstructors[0].new(test.other)
+
+				if test.safe {
+					m.MergeWithSafe(&other, test.overwriteOriginal)
+				} else {
+					merged := m.MergeWith(&other)
+					assert.Equalf(t, test.merged || !target.isBidi, merged, name)
+				}
+
+				if !target.isBidi {
+					assert.Equalf(t, test.result, toNativeMap(m), name)
+
+					return
+				}
+
+				assert.Equalf(t, test.bidiResult, toNativeMap(m), name)
+
+				bidiMap := m.(maps.BidiMap[string, int])
+				for key, value := range test.bidiResult {
+					keyByValue, found := bidiMap.GetKey(value)
+
+					assert.Truef(t, found, name)
+					assert.Equalf(t, key, keyByValue, name)
+				}
+				assert.ElementsMatchf(t, m.GetKeys(), sortedKeys(test.bidiResult), name)
+				assert.Lenf(t, m.GetValues(), len(test.bidiResult), name)
+			})
+		}
+	}
+}
+
+func TestMapMergeWithPreservesOrdering(t *testing.T) {
+	m := linkedhashmap.New[string, int]()
+	m.Put("foo", 1)
+	m.Put("bar", 2)
+
+	otherOrdered := linkedhashmap.New[string, int]()
+	otherOrdered.Put("qux", 4)
+	otherOrdered.Put("baz", 3)
+	otherOrdered.Put("foo", 5)
+
+	var other maps.Map[string, int] = otherOrdered
+
+	m.MergeWithSafe(&other, true)
+
+	assert.Equal(t, []string{"foo", "bar", "qux", "baz"}, m.GetKeys())
+	assert.Equal(t, []int{5, 2, 4, 3}, m.GetValues())
+
+	tree := treemap.NewFromMap(utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2})
+
+	assert.False(t, tree.MergeWith(&other))
+
+	tree.MergeWithSafe(&other, false)
+
+	assert.Equal(t, []string{"bar", "baz", "foo", "qux"}, tree.GetKeys())
+	assert.Equal(t, []int{2, 3, 1, 4}, tree.GetValues())
+}
@@ -11,22 +11,120 @@ import (
 )
 
 // Assert Iterator implementation
-var _ ds.ReadWriteOrdCompBidRandCollIterator[string, any] = (*OrderedIterator[string, any])(nil)
+var _ ds.ReadWriteOrdCompBidRandCollIterator[string, int] = (*OrderedIterator[string, int])(nil)
+
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[string, int])(nil)
+
+// Assert ErasableIterator and KeyedInsertableIterator implementation
+var _ ds.ErasableIterator = (*OrderedIterator[string, int])(nil)
+var _ ds.KeyedInsertableIterator[string, int] = (*OrderedIterator[string, int])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[string, int])(nil)
 
 // Iterator holding the iterator's state
-type OrderedIterator[TKey comparable, TValue any] struct {
+type OrderedIterator[TKey comparable, TValue comparable] struct {
 	*redblacktree.OrderedIterator[TKey, TValue]
+	m *Map[TKey, TValue]
 }
 
 // NewIterator returns a stateful iterator whose values can be fetched by an index.
 func (list *Map[TKey, TValue]) NewOrderedIterator(index int, size int) *OrderedIterator[TKey, TValue] {
-	return &OrderedIterator[TKey, TValue]{list.forwardMap.NewOrderedIterator(index, size)}
+	return &OrderedIterator[TKey, TValue]{list.forwardMap.NewOrderedIterator(index, size), list}
+}
+
+// NOTE: The following methods need to be reimplemented to keep the inverse map in sync
+
+// Set updates the value of the current element.
+// Returns false if value is already mapped to another key.
+func (it *OrderedIterator[TKey, TValue]) Set(value TValue) bool {
+	key, found := it.GetKey()
+	if !found {
+		return false
+	}
+
+	old, _ := it.Get()
+	if !it.isFree(key, value) {
+		return false
+	}
+
+	it.OrderedIterator.Set(value)
+	it.m.inverseMap.Remove(old)
+	it.m.inverseMap.Put(value, key)
+
+	return true
+}
+
+// SetAt updates the value of the element at index i.
+// Returns false if value is already mapped to another key.
+func (it *OrderedIterator[TKey, TValue]) SetAt(i int, value TValue) bool {
+	inner := *it.OrderedIterator
+	tmp := OrderedIterator[TKey, TValue]{&inner, it.m}
+	tmp.MoveTo(i)
+
+	return tmp.Set(value)
+}
+
+// SetAtKey puts the key/value pair into the map, removing the key previously mapped to value.
+// Inserting a new key or removing another one is a structural modification, which the iterator can not follow, so it is invalidated.
+func (it *OrderedIterator[TKey, TValue]) SetAtKey(key TKey, value TValue) bool {
+	it.m.Put(key, value)
+
+	return true
+}
+
+// Remove removes the current element from the map in O(log n) and moves the iterator to the next element.
+func (it *OrderedIterator[TKey, TValue]) Remove() bool {
+	value, found := it.Get()
+	if !found || !it.OrderedIterator.Remove() {
+		return false
+	}
+
+	it.m.inverseMap.Remove(value)
+
+	return true
+}
+
+// InsertBefore inserts the key/value pair before the current element or at the end in O(log n).
+// Returns false if key is not between the keys of the previous and the current element, if it is outside of the iterator's range
+// or if value is already mapped to another key.
+func (it *OrderedIterator[TKey, TValue]) InsertBefore(key TKey, value TValue) bool {
+	if _, found := it.m.inverseMap.Get(value); found || !it.OrderedIterator.InsertBefore(key, value) {
+		return false
+	}
+
+	it.m.inverseMap.Put(value, key)
+
+	return true
+}
+
+// InsertAfter inserts the key/value pair after the current element or at the start in O(log n).
+// Returns false if key is not between the keys of the current and the next element, if it is outside of the iterator's range
+// or if value is already mapped to another key.
+func (it *OrderedIterator[TKey, TValue]) InsertAfter(key TKey, value TValue) bool {
+	if _, found := it.m.inverseMap.Get(value); found || !it.OrderedIterator.InsertAfter(key, value) {
+		return false
+	}
+
+	it.m.inverseMap.Put(value, key)
+
+	return true
+}
+
+// isFree checks if value is not mapped to a key other than key.
+func (it *OrderedIterator[TKey, TValue]) isFree(key TKey, value TValue) bool {
+	other, found := it.m.inverseMap.Get(value)
+
+	return !found || it.m.keyComparator(other, key) == 0
 }
 
 // NOTE: The following methods need to be reimplemented because of the type assertions they contain
 
 // If other is of type IndexedIterator, IndexedIterator.Index() will be used, possibly executing in O(1)
 func (it *OrderedIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) int {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -36,6 +134,8 @@ func (it *OrderedIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) in
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -45,6 +145,8 @@ func (it *OrderedIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsBefore(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -54,6 +156,8 @@ func (it *OrderedIterator[TKey, TValue]) IsBefore(other ds.OrderedIterator) bool
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -61,3 +165,17 @@ func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bo
 
 	return it.DistanceTo(otherThis) == 0
 }
+
+// CanCompare checks if other is an iterator of the same map and key range.
+func (it *OrderedIterator[TKey, TValue]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := ds.BaseOf[TKey, TValue](other).(*OrderedIterator[TKey, TValue])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if it.OrderedIterator.CanCompare(otherThis.OrderedIterator) != nil {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
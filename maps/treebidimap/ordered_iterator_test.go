@@ -1,6 +1,7 @@
 package treebidimap
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
@@ -1020,3 +1021,239 @@ func TestTreeMapOrderedIteratorIsBeginEndFirstLast(t *testing.T) {
 		})
 	}
 }
+
+func TestTreeBidiMapOrderedIteratorRemoveAndInsert(t *testing.T) {
+	m := NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"a": 1, "b": 2, "c": 3})
+	it := m.OrderedFirst(utils.BasicComparator[string]).(*OrderedIterator[string, int])
+
+	assert.True(t, it.Next())
+	assert.True(t, it.Remove())
+
+	_, found := m.GetKey(2)
+	assert.False(t, found)
+	assert.Equal(t, []int{1, 3}, m.GetValues())
+
+	// Values must stay unique
+	assert.False(t, it.InsertBefore("b", 1))
+	assert.True(t, it.InsertBefore("b", 4))
+	assert.False(t, it.InsertAfter("d", 3))
+	assert.True(t, it.InsertAfter("d", 5))
+
+	key, found := m.GetKey(4)
+	assert.True(t, found)
+	assert.Equal(t, "b", key)
+
+	key, found = m.GetKey(5)
+	assert.True(t, found)
+	assert.Equal(t, "d", key)
+
+	assert.Equal(t, []string{"a", "b", "c", "d"}, m.GetKeys())
+	assert.Equal(t, []int{1, 3, 4, 5}, m.GetValues())
+
+	assert.False(t, it.Set(1))
+	assert.True(t, it.Set(6))
+	assert.True(t, it.SetAt(0, 7))
+
+	_, found = m.GetKey(3)
+	assert.False(t, found)
+
+	key, found = m.GetKey(6)
+	assert.True(t, found)
+	assert.Equal(t, "c", key)
+
+	key, found = m.GetKey(7)
+	assert.True(t, found)
+	assert.Equal(t, "a", key)
+
+	assert.Equal(t, []int{4, 5, 6, 7}, m.GetValues())
+}
+
+func TestTreeBidiMapReverseIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		container *Map[string, int]
+	}{
+		{
+			name:      "Empty",
+			container: New[string, int](utils.BasicComparator[string], utils.BasicComparator[int]),
+		},
+		{
+			name:      "One element",
+			container: NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"a": 1}),
+		},
+		{
+			name:      "3 elements",
+			container: NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"a": 1, "b": 2, "c": 3}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			values := []int{}
+			for it := test.container.OrderedBegin(utils.BasicComparator[string]); it.Next(); {
+				value, _ := it.Get()
+				values = append(values, value)
+			}
+
+			reversed := []int{}
+			for it := test.container.ReverseBegin(utils.BasicComparator[string]); it.Next(); {
+				value, _ := it.Get()
+				reversed = append(reversed, value)
+			}
+
+			backward := []int{}
+			for it := test.container.ReverseEnd(utils.BasicComparator[string]); it.Previous(); {
+				value, _ := it.Get()
+				backward = append(backward, value)
+			}
+
+			assert.Equalf(t, values, backward, test.name)
+
+			slices.Reverse(values)
+			assert.Equalf(t, values, reversed, test.name)
+
+			first, found := test.container.ReverseFirst(utils.BasicComparator[string]).Get()
+			last, _ := test.container.ReverseLast(utils.BasicComparator[string]).Get()
+			assert.Equalf(t, len(values) > 0, found, test.name)
+
+			if len(values) > 0 {
+				assert.Equalf(t, values[0], first, test.name)
+				assert.Equalf(t, values[len(values)-1], last, test.name)
+			}
+		})
+	}
+}
+
+func TestTreeBidiMapReverseIteratorSet(t *testing.T) {
+	m := NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"a": 1, "b": 2, "c": 3})
+	it := m.ReverseFirst(utils.BasicComparator[string])
+
+	// Values stay unique, so a value mapped to another key is rejected
+	assert.False(t, it.Set(1))
+	assert.False(t, it.SetAt(1, 3))
+	assert.True(t, it.Set(3))
+
+	assert.True(t, it.Set(30))
+	assert.True(t, it.SetAt(0, 10))
+
+	key, _ := m.GetKey(30)
+	assert.Equal(t, "c", key)
+
+	key, _ = m.GetKey(10)
+	assert.Equal(t, "a", key)
+
+	_, found := m.GetKey(3)
+	assert.False(t, found)
+
+	_, found = m.GetKey(1)
+	assert.False(t, found)
+
+	// Updating values is not structural, so the iterator keeps moving through the keys
+	assert.NoError(t, it.(ds.FailFastIterator).Err())
+	assert.True(t, it.Next())
+
+	key, _ = it.GetKey()
+	assert.Equal(t, "b", key)
+}
+
+func TestTreeBidiMapReverseIteratorCompare(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		reversed      bool
+		otherKey      string
+		otherReversed bool
+		distance      int
+	}{
+		{
+			name:          "Reversed keys",
+			key:           "c",
+			reversed:      true,
+			otherKey:      "a",
+			otherReversed: true,
+			distance:      -2,
+		},
+		{
+			name:          "Reversed key to forward key",
+			key:           "c",
+			reversed:      true,
+			otherKey:      "a",
+			otherReversed: false,
+			distance:      2,
+		},
+		{
+			name:          "Reversed key to same forward key",
+			key:           "b",
+			reversed:      true,
+			otherKey:      "b",
+			otherReversed: false,
+			distance:      0,
+		},
+		{
+			name:          "Forward key to reversed key",
+			key:           "a",
+			reversed:      false,
+			otherKey:      "b",
+			otherReversed: true,
+			distance:      -1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			// The values are ordered opposite to the keys, the iterators must follow the keys
+			m := NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"a": 3, "b": 2, "c": 1})
+
+			it := atKey(m, test.reversed, test.key)
+			other := atKey(m, test.otherReversed, test.otherKey)
+
+			distance, err := ds.CheckedDistanceTo(it, other)
+			assert.NoErrorf(t, err, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+			assert.Equalf(t, -test.distance, other.DistanceTo(it), test.name)
+			assert.Equalf(t, test.distance < 0, it.IsBefore(other), test.name)
+			assert.Equalf(t, test.distance > 0, other.IsBefore(it), test.name)
+			assert.Equalf(t, test.distance == 0, other.IsEqual(it), test.name)
+		})
+	}
+
+	m := NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"a": 1, "b": 2, "c": 3})
+	view := m.HeadMap("b", true).(*View[string, int])
+	assert.ErrorIs(t, ds.CanCompare(m.ReverseLast(utils.BasicComparator[string]), view.OrderedFirst(utils.BasicComparator[string])), ds.ErrForeignIterators)
+	assert.ErrorIs(t, ds.CanCompare(m.ReverseFirst(utils.BasicComparator[string]), m.Clone().OrderedFirst(utils.BasicComparator[string])), ds.ErrForeignIterators)
+}
+
+func TestTreeBidiMapReverseIteratorFailFast(t *testing.T) {
+	m := NewFromMap[string, int](utils.BasicComparator[string], utils.BasicComparator[int], map[string]int{"a": 1, "b": 2, "c": 3})
+	it := m.ReverseFirst(utils.BasicComparator[string])
+
+	// Putting a value mapped to another key removes that key
+	m.Put("d", 1)
+
+	assert.Equal(t, []string{"b", "c", "d"}, m.GetKeys())
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Next())
+}
+
+// atKey returns a forward or reversed iterator of m, which points to key.
+func atKey(m *Map[string, int], reversed bool, key string) ds.ReadWriteOrdCompBidRandCollIterator[string, int] {
+	it := m.OrderedBegin(utils.BasicComparator[string])
+	if reversed {
+		it = m.ReverseBegin(utils.BasicComparator[string])
+	}
+
+	it.MoveToKey(key)
+
+	return it
+}
@@ -20,6 +20,7 @@ package treebidimap
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
@@ -30,6 +31,11 @@ import (
 
 // Assert Map implementation
 var _ maps.BidiMap[string, string] = (*Map[string, string])(nil)
+var _ maps.NavigableMap[string, string] = (*Map[string, string])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Map[string, string]] = (*Map[string, string])(nil)
+var _ ds.Equatable[*Map[string, string], string] = (*Map[string, string])(nil)
 
 // Map holds the elements in two red-black trees.
 type Map[TKey comparable, TValue comparable] struct {
@@ -94,16 +100,63 @@ func NewFromIterators[TKey comparable, TValue comparable](keyComparator utils.Co
 	return tree
 }
 
+// MergeWith inserts all elements of other into the map.
+// If a key or value is contained in both maps or other maps multiple keys to the same value,
+// the map is left unchanged and false is returned.
 func (m *Map[TKey, TValue]) MergeWith(other *maps.Map[TKey, TValue]) bool {
-	panic("Not implemented")
+	keys := (*other).GetKeys()
+	values := make(map[TValue]struct{}, len(keys))
+
+	for _, key := range keys {
+		value, _ := (*other).Get(key)
+
+		_, keyFound := m.forwardMap.Get(key)
+		_, valueFound := m.inverseMap.Get(value)
+		_, valueDuplicated := values[value]
+
+		if keyFound || valueFound || valueDuplicated {
+			return false
+		}
+
+		values[value] = struct{}{}
+	}
+
+	for _, key := range keys {
+		value, _ := (*other).Get(key)
+		m.Put(key, value)
+	}
+
+	return true
 }
 
+// MergeWithSafe inserts all elements of other into the map.
+// If a key or value is contained in both maps, other's pair replaces the conflicting pairs if overwriteOriginal is true,
+// otherwise the original pairs are kept.
 func (m *Map[TKey, TValue]) MergeWithSafe(other *maps.Map[TKey, TValue], overwriteOriginal bool) {
-	panic("Not implemented")
+	for _, key := range (*other).GetKeys() {
+		value, _ := (*other).Get(key)
+
+		if !overwriteOriginal {
+			_, keyFound := m.forwardMap.Get(key)
+			_, valueFound := m.inverseMap.Get(value)
+
+			if keyFound || valueFound {
+				continue
+			}
+		}
+
+		m.Put(key, value)
+	}
 }
 
 // Put inserts element into the map.
 func (m *Map[TKey, TValue]) Put(key TKey, value TValue) {
+	if valueByKey, ok := m.forwardMap.Get(key); ok {
+		m.inverseMap.Remove(valueByKey)
+	}
+	if keyByValue, ok := m.inverseMap.Get(value); ok {
+		m.forwardMap.Remove(keyByValue)
+	}
 	m.forwardMap.Put(key, value)
 	m.inverseMap.Put(value, key)
 }
@@ -154,6 +207,91 @@ func (m *Map[TKey, TValue]) Clear() {
 	m.inverseMap.Clear()
 }
 
+// Lower finds the largest key strictly smaller than the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) Lower(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	node, _ := m.forwardMap.Lower(key)
+
+	return nodeEntry(node)
+}
+
+// Higher finds the smallest key strictly larger than the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) Higher(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	node, _ := m.forwardMap.Higher(key)
+
+	return nodeEntry(node)
+}
+
+// FloorEntry finds the largest key smaller than or equal to the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) FloorEntry(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	node, _ := m.forwardMap.Floor(key)
+
+	return nodeEntry(node)
+}
+
+// CeilingEntry finds the smallest key larger than or equal to the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) CeilingEntry(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	node, _ := m.forwardMap.Ceiling(key)
+
+	return nodeEntry(node)
+}
+
+// PollFirst removes the minimum key and its value from the map and returns them.
+// Third return parameter is false if the map is empty, otherwise true.
+func (m *Map[TKey, TValue]) PollFirst() (key TKey, value TValue, found bool) {
+	key, value, found = nodeEntry(m.forwardMap.Left())
+	if found {
+		m.Remove(m.keyComparator, key)
+	}
+
+	return
+}
+
+// PollLast removes the maximum key and its value from the map and returns them.
+// Third return parameter is false if the map is empty, otherwise true.
+func (m *Map[TKey, TValue]) PollLast() (key TKey, value TValue, found bool) {
+	key, value, found = nodeEntry(m.forwardMap.Right())
+	if found {
+		m.Remove(m.keyComparator, key)
+	}
+
+	return
+}
+
+// SubMap returns a live view of the elements with keys between from and to.
+func (m *Map[TKey, TValue]) SubMap(from TKey, fromInclusive bool, to TKey, toInclusive bool) maps.NavigableMap[TKey, TValue] {
+	return &View[TKey, TValue]{m: m, view: m.forwardMap.NewView(redblacktree.NewRange(from, fromInclusive, to, toInclusive))}
+}
+
+// HeadMap returns a live view of the elements with keys smaller than (or equal to) to.
+func (m *Map[TKey, TValue]) HeadMap(to TKey, toInclusive bool) maps.NavigableMap[TKey, TValue] {
+	return &View[TKey, TValue]{m: m, view: m.forwardMap.NewView(redblacktree.NewHeadRange(to, toInclusive))}
+}
+
+// TailMap returns a live view of the elements with keys larger than (or equal to) from.
+func (m *Map[TKey, TValue]) TailMap(from TKey, fromInclusive bool) maps.NavigableMap[TKey, TValue] {
+	return &View[TKey, TValue]{m: m, view: m.forwardMap.NewView(redblacktree.NewTailRange(from, fromInclusive))}
+}
+
+func nodeEntry[TKey comparable, TValue comparable](node *redblacktree.Node[TKey, TValue]) (key TKey, value TValue, found bool) {
+	if node == nil {
+		return
+	}
+
+	return node.Key, node.Value, true
+}
+
 // String returns a string representation of container
 func (m *Map[TKey, TValue]) ToString() string {
 	str := "TreeBidiMap\nmap["
@@ -167,6 +305,22 @@ func (m *Map[TKey, TValue]) ToString() string {
 	return strings.TrimRight(str, " ") + "]"
 }
 
+// Clone returns a copy of the map, which does not share any nodes with the original.
+func (m *Map[TKey, TValue]) Clone() *Map[TKey, TValue] {
+	return &Map[TKey, TValue]{
+		forwardMap:      *m.forwardMap.Clone(),
+		inverseMap:      *m.inverseMap.Clone(),
+		keyComparator:   m.keyComparator,
+		valueComparator: m.valueComparator,
+	}
+}
+
+// Equals returns true if both maps hold equal key/value pairs.
+// Keys are compared with the map's key comparator, values with valueComparator.
+func (m *Map[TKey, TValue]) Equals(other *Map[TKey, TValue], valueComparator utils.Comparator[TValue]) bool {
+	return m.forwardMap.Equals(&other.forwardMap, valueComparator)
+}
+
 //******************************************************************//
 //                         Ordered iterator                         //
 //******************************************************************//
@@ -196,3 +350,49 @@ func (m *Map[TKey, TValue]) OrderedFirst(comparator utils.Comparator[TKey]) ds.R
 func (m *Map[TKey, TValue]) OrderedLast(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
 	return m.NewOrderedIterator(m.Size()-1, m.Size())
 }
+
+//******************************************************************//
+//                         Reverse iterator                         //
+//******************************************************************//
+
+// ReverseBegin returns an initialized, reversed iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (m *Map[TKey, TValue]) ReverseBegin(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(m.OrderedEnd(comparator))
+}
+
+// ReverseEnd returns an initialized, reversed iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (m *Map[TKey, TValue]) ReverseEnd(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(m.OrderedBegin(comparator))
+}
+
+// ReverseFirst returns an initialized, reversed iterator, which points to it's first element.
+func (m *Map[TKey, TValue]) ReverseFirst(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(m.OrderedLast(comparator))
+}
+
+// ReverseLast returns an initialized, reversed iterator, which points to it's last element.
+func (m *Map[TKey, TValue]) ReverseLast(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(m.OrderedFirst(comparator))
+}
+
+// All returns a sequence over the key/value pairs of the map in key order.
+func (m *Map[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return m.forwardMap.All()
+}
+
+// Backward returns a sequence over the key/value pairs of the map in reverse key order.
+func (m *Map[TKey, TValue]) Backward() iter.Seq2[TKey, TValue] {
+	return m.forwardMap.Backward()
+}
+
+// Keys returns a sequence over the keys of the map in key order.
+func (m *Map[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return m.forwardMap.Keys()
+}
+
+// Values returns a sequence over the values of the map in key order.
+func (m *Map[TKey, TValue]) Values() iter.Seq[TValue] {
+	return m.forwardMap.Values()
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treebidimap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/maps"
+	"github.com/JonasMuehlmann/datastructures.go/trees/redblacktree"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Map implementation
+var _ maps.NavigableMap[string, string] = (*View[string, string])(nil)
+
+// View is a live view of the elements of a Map, whose keys lie within a range.
+// The view does not copy any elements, changes to the view are reflected in the map and vice versa.
+type View[TKey comparable, TValue comparable] struct {
+	m    *Map[TKey, TValue]
+	view redblacktree.View[TKey, TValue]
+}
+
+// Put inserts key-value pair into the underlying map, replacing pairs with the same key or value.
+// Panics if the key is outside the view's range.
+func (v *View[TKey, TValue]) Put(key TKey, value TValue) {
+	if !v.view.Contains(key) {
+		panic(maps.KeyOutOfViewRange)
+	}
+
+	v.m.Put(key, value)
+}
+
+// Get searches the element in the view by key and returns its value or nil if key is not found in the view.
+// Second return parameter is true if key was found, otherwise false.
+func (v *View[TKey, TValue]) Get(key TKey) (value TValue, found bool) {
+	if !v.view.Contains(key) {
+		return
+	}
+
+	return v.m.Get(key)
+}
+
+// Remove removes the element from the underlying map by key, if the key is inside the view's range.
+func (v *View[TKey, TValue]) Remove(comparator utils.Comparator[TKey], key TKey) {
+	if v.view.Contains(key) {
+		v.m.Remove(comparator, key)
+	}
+}
+
+// MergeWith inserts all elements of other into the underlying map.
+// If a key is contained in both the view and other, the map is left unchanged and false is returned.
+// Panics if a key of other is outside the view's range.
+func (v *View[TKey, TValue]) MergeWith(other *maps.Map[TKey, TValue]) bool {
+	if !v.view.ContainsAll((*other).GetKeys()) {
+		panic(maps.KeyOutOfViewRange)
+	}
+
+	return v.m.MergeWith(other)
+}
+
+// MergeWithSafe inserts all elements of other into the underlying map.
+// If a key is contained in both the view and other, other's value is used if overwriteOriginal is true, otherwise the original value is kept.
+// Panics if a key of other is outside the view's range.
+func (v *View[TKey, TValue]) MergeWithSafe(other *maps.Map[TKey, TValue], overwriteOriginal bool) {
+	if !v.view.ContainsAll((*other).GetKeys()) {
+		panic(maps.KeyOutOfViewRange)
+	}
+
+	v.m.MergeWithSafe(other, overwriteOriginal)
+}
+
+// Empty returns true if the view does not contain any elements.
+func (v *View[TKey, TValue]) IsEmpty() bool {
+	return v.view.IsEmpty()
+}
+
+// Size returns number of elements in the view.
+func (v *View[TKey, TValue]) Size() int {
+	return v.view.Size()
+}
+
+// GetKeys returns all keys in-order.
+func (v *View[TKey, TValue]) GetKeys() []TKey {
+	return v.view.GetKeys()
+}
+
+// Values returns all values in-order based on the key.
+func (v *View[TKey, TValue]) GetValues() []TValue {
+	return v.view.GetValues()
+}
+
+// Clear removes all elements of the view from the underlying map.
+func (v *View[TKey, TValue]) Clear() {
+	for _, key := range v.GetKeys() {
+		v.m.Remove(v.m.keyComparator, key)
+	}
+}
+
+// Lower finds the largest key in the view strictly smaller than the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+func (v *View[TKey, TValue]) Lower(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	return nodeEntry(v.view.Lower(key))
+}
+
+// Higher finds the smallest key in the view strictly larger than the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+func (v *View[TKey, TValue]) Higher(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	return nodeEntry(v.view.Higher(key))
+}
+
+// FloorEntry finds the largest key in the view smaller than or equal to the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+func (v *View[TKey, TValue]) FloorEntry(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	return nodeEntry(v.view.Floor(key))
+}
+
+// CeilingEntry finds the smallest key in the view larger than or equal to the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+func (v *View[TKey, TValue]) CeilingEntry(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	return nodeEntry(v.view.Ceiling(key))
+}
+
+// PollFirst removes the minimum key of the view and its value from the underlying map and returns them.
+// Third return parameter is false if the view is empty, otherwise true.
+func (v *View[TKey, TValue]) PollFirst() (key TKey, value TValue, found bool) {
+	key, value, found = nodeEntry(v.view.First())
+	if found {
+		v.m.Remove(v.m.keyComparator, key)
+	}
+
+	return
+}
+
+// PollLast removes the maximum key of the view and its value from the underlying map and returns them.
+// Third return parameter is false if the view is empty, otherwise true.
+func (v *View[TKey, TValue]) PollLast() (key TKey, value TValue, found bool) {
+	key, value, found = nodeEntry(v.view.Last())
+	if found {
+		v.m.Remove(v.m.keyComparator, key)
+	}
+
+	return
+}
+
+// SubMap returns a live view of the elements with keys between from and to, which are also inside the view's range.
+func (v *View[TKey, TValue]) SubMap(from TKey, fromInclusive bool, to TKey, toInclusive bool) maps.NavigableMap[TKey, TValue] {
+	return &View[TKey, TValue]{m: v.m, view: v.view.SubView(redblacktree.NewRange(from, fromInclusive, to, toInclusive))}
+}
+
+// HeadMap returns a live view of the elements with keys smaller than (or equal to) to, which are also inside the view's range.
+func (v *View[TKey, TValue]) HeadMap(to TKey, toInclusive bool) maps.NavigableMap[TKey, TValue] {
+	return &View[TKey, TValue]{m: v.m, view: v.view.SubView(redblacktree.NewHeadRange(to, toInclusive))}
+}
+
+// TailMap returns a live view of the elements with keys larger than (or equal to) from, which are also inside the view's range.
+func (v *View[TKey, TValue]) TailMap(from TKey, fromInclusive bool) maps.NavigableMap[TKey, TValue] {
+	return &View[TKey, TValue]{m: v.m, view: v.view.SubView(redblacktree.NewTailRange(from, fromInclusive))}
+}
+
+// String returns a string representation of container
+func (v *View[TKey, TValue]) ToString() string {
+	str := "TreeBidiMapView\nmap["
+	it := v.OrderedBegin(v.m.keyComparator)
+	for it.Next() {
+		key, _ := it.GetKey()
+		value, _ := it.Get()
+
+		str += fmt.Sprintf("%v:%v ", key, value)
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+//******************************************************************//
+//                         Ordered iterator                         //
+//******************************************************************//
+
+// NewOrderedIterator returns a stateful iterator over the view, which does not copy the view's elements.
+func (v *View[TKey, TValue]) NewOrderedIterator(position int) *OrderedIterator[TKey, TValue] {
+	return &OrderedIterator[TKey, TValue]{v.view.NewOrderedIterator(position), v.m}
+}
+
+// OrderedBegin returns an initialized iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (v *View[TKey, TValue]) OrderedBegin(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return v.NewOrderedIterator(-1)
+}
+
+// OrderedEnd returns an initialized iterator, which points to one element afrer it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (v *View[TKey, TValue]) OrderedEnd(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return v.NewOrderedIterator(v.Size())
+}
+
+// OrderedFirst returns an initialized iterator, which points to it's first element.
+func (v *View[TKey, TValue]) OrderedFirst(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return v.NewOrderedIterator(0)
+}
+
+// OrderedLast returns an initialized iterator, which points to it's last element.
+func (v *View[TKey, TValue]) OrderedLast(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return v.NewOrderedIterator(v.Size() - 1)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treebidimap
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeBidiMapView(t *testing.T) {
+	m := NewFromMap[int, string](utils.BasicComparator[int], utils.BasicComparator[string], map[int]string{0: "a", 2: "b", 4: "c", 6: "d", 8: "e"})
+	view := m.SubMap(2, true, 6, true)
+
+	assert.Equal(t, []int{2, 4, 6}, view.GetKeys())
+	assert.Equal(t, []string{"b", "c", "d"}, view.GetValues())
+
+	key, value, found := view.Lower(100)
+	assert.True(t, found)
+	assert.Equal(t, 6, key)
+	assert.Equal(t, "d", value)
+
+	view.Put(3, "a")
+	_, found = m.Get(0)
+	assert.False(t, found)
+	key, found = m.GetKey("a")
+	assert.True(t, found)
+	assert.Equal(t, 3, key)
+
+	key, value, found = view.PollFirst()
+	assert.True(t, found)
+	assert.Equal(t, 2, key)
+	assert.Equal(t, "b", value)
+	_, found = m.GetKey("b")
+	assert.False(t, found)
+
+	view.Clear()
+	assert.Equal(t, []int{8}, m.GetKeys())
+	assert.Equal(t, []string{"e"}, m.GetValues())
+
+	assert.Panics(t, func() { view.Put(8, "z") })
+}
+
+func TestTreeBidiMapPoll(t *testing.T) {
+	m := NewFromMap[int, string](utils.BasicComparator[int], utils.BasicComparator[string], map[int]string{1: "a", 2: "b", 3: "c"})
+
+	key, value, found := m.PollFirst()
+	assert.True(t, found)
+	assert.Equal(t, 1, key)
+	assert.Equal(t, "a", value)
+
+	key, value, found = m.PollLast()
+	assert.True(t, found)
+	assert.Equal(t, 3, key)
+	assert.Equal(t, "c", value)
+
+	assert.Equal(t, []int{2}, m.GetKeys())
+	assert.Equal(t, []string{"b"}, m.GetValues())
+
+	key, _, found = m.CeilingEntry(0)
+	assert.True(t, found)
+	assert.Equal(t, 2, key)
+
+	_, _, found = m.Higher(2)
+	assert.False(t, found)
+}
+
+func TestTreeBidiMapViewIteratorCompare(t *testing.T) {
+	m := NewFromMap[int, string](utils.BasicComparator[int], utils.BasicComparator[string], map[int]string{0: "a", 2: "b", 4: "c", 6: "d", 8: "e"})
+	comparator := utils.BasicComparator[int]
+
+	viewIt := m.TailMap(4, true).(*View[int, string]).OrderedFirst(comparator)
+	mapIt := m.OrderedFirst(comparator)
+
+	isEqual, err := ds.CheckedIsEqual(viewIt, mapIt)
+	assert.ErrorIs(t, err, ds.ErrForeignIterators)
+	assert.False(t, isEqual)
+	assert.Equal(t, 2, viewIt.DistanceTo(mapIt))
+
+	isEqual, err = ds.CheckedIsEqual(viewIt, m.TailMap(4, true).(*View[int, string]).OrderedFirst(comparator))
+	assert.NoError(t, err)
+	assert.True(t, isEqual)
+}
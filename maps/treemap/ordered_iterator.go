@@ -13,6 +13,16 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[string, any] = (*OrderedIterator[string, any])(nil)
 
+// Assert ErasableIterator and KeyedInsertableIterator implementation
+var _ ds.ErasableIterator = (*OrderedIterator[string, any])(nil)
+var _ ds.KeyedInsertableIterator[string, any] = (*OrderedIterator[string, any])(nil)
+
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[string, any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[string, any])(nil)
+
 // Iterator holding the iterator's state
 type OrderedIterator[TKey comparable, TValue any] struct {
 	*redblacktree.OrderedIterator[TKey, TValue]
@@ -27,6 +37,8 @@ func (list *Map[TKey, TValue]) NewOrderedIterator(index int, size int) *OrderedI
 
 // If other is of type IndexedIterator, IndexedIterator.Index() will be used, possibly executing in O(1)
 func (it *OrderedIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) int {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -36,6 +48,8 @@ func (it *OrderedIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) in
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -45,6 +59,8 @@ func (it *OrderedIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsBefore(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -54,6 +70,8 @@ func (it *OrderedIterator[TKey, TValue]) IsBefore(other ds.OrderedIterator) bool
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -61,3 +79,17 @@ func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bo
 
 	return it.DistanceTo(otherThis) == 0
 }
+
+// CanCompare checks if other is an iterator of the same map and key range.
+func (it *OrderedIterator[TKey, TValue]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := ds.BaseOf[TKey, TValue](other).(*OrderedIterator[TKey, TValue])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if it.OrderedIterator.CanCompare(otherThis.OrderedIterator) != nil {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package treemap
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
@@ -1020,3 +1021,272 @@ func TestTreeMapOrderedIteratorIsBeginEndFirstLast(t *testing.T) {
 		})
 	}
 }
+
+func TestTreeMapOrderedIteratorRemoveAndInsert(t *testing.T) {
+	m := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2, "c": 3, "d": 4})
+	it := m.OrderedBegin(utils.BasicComparator[string]).(*OrderedIterator[string, int])
+
+	// Remove all keys with even values in a single pass
+	for it.Next(); it.IsValid(); {
+		value, _ := it.Get()
+		if value%2 == 0 {
+			assert.True(t, it.Remove())
+		} else {
+			it.Next()
+		}
+	}
+
+	assert.Equal(t, []string{"a", "c"}, m.GetKeys())
+	assert.True(t, it.IsEnd())
+
+	var erasable ds.ErasableIterator = it
+	assert.False(t, erasable.Remove())
+
+	assert.True(t, it.Previous())
+	assert.False(t, it.InsertBefore("a", 0))
+	assert.True(t, it.InsertBefore("b", 2))
+	assert.True(t, it.InsertAfter("d", 4))
+
+	key, _ := it.GetKey()
+	assert.Equal(t, "c", key)
+	assert.Equal(t, 4, it.Size())
+	assert.Equal(t, []string{"a", "b", "c", "d"}, m.GetKeys())
+}
+
+func TestTreeMapOrderedIteratorCanCompare(t *testing.T) {
+	m := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2})
+	other := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2})
+
+	tests := []struct {
+		name  string
+		it    ds.OrderedIterator
+		other ds.OrderedIterator
+		err   error
+	}{
+		{
+			name:  "Same map",
+			it:    m.OrderedFirst(utils.BasicComparator[string]),
+			other: m.OrderedLast(utils.BasicComparator[string]),
+			err:   nil,
+		},
+		{
+			name:  "Other map",
+			it:    m.OrderedFirst(utils.BasicComparator[string]),
+			other: other.OrderedFirst(utils.BasicComparator[string]),
+			err:   ds.ErrForeignIterators,
+		},
+		{
+			name:  "Underlying tree iterator",
+			it:    m.OrderedFirst(utils.BasicComparator[string]),
+			other: m.tree.OrderedFirst(),
+			err:   ds.ErrIncompatibleIterators,
+		},
+		{
+			name:  "Other map type",
+			it:    m.OrderedFirst(utils.BasicComparator[string]),
+			other: hashmap.NewFromMap[string, int](map[string]int{"a": 1}).OrderedFirst(utils.BasicComparator[string]),
+			err:   ds.ErrIncompatibleIterators,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			isBefore, err := ds.CheckedIsBefore(test.it, test.other)
+			if test.err == nil {
+				assert.NoErrorf(t, err, test.name)
+				assert.Truef(t, isBefore, test.name)
+			} else {
+				assert.ErrorIsf(t, err, test.err, test.name)
+			}
+
+			// The unchecked comparison can not detect iterators of other maps
+			if test.err == ds.ErrIncompatibleIterators {
+				assert.Panicsf(t, func() { test.it.IsBefore(test.other) }, test.name)
+			}
+		})
+	}
+}
+
+func TestTreeMapReverseIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		container *Map[string, int]
+	}{
+		{
+			name:      "Empty",
+			container: New[string, int](utils.BasicComparator[string]),
+		},
+		{
+			name:      "One element",
+			container: NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1}),
+		},
+		{
+			name:      "3 elements",
+			container: NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2, "c": 3}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			values := []int{}
+			for it := test.container.OrderedBegin(utils.BasicComparator[string]); it.Next(); {
+				value, _ := it.Get()
+				values = append(values, value)
+			}
+
+			reversed := []int{}
+			for it := test.container.ReverseBegin(utils.BasicComparator[string]); it.Next(); {
+				value, _ := it.Get()
+				reversed = append(reversed, value)
+			}
+
+			backward := []int{}
+			for it := test.container.ReverseEnd(utils.BasicComparator[string]); it.Previous(); {
+				value, _ := it.Get()
+				backward = append(backward, value)
+			}
+
+			assert.Equalf(t, values, backward, test.name)
+
+			slices.Reverse(values)
+			assert.Equalf(t, values, reversed, test.name)
+
+			first, found := test.container.ReverseFirst(utils.BasicComparator[string]).Get()
+			last, _ := test.container.ReverseLast(utils.BasicComparator[string]).Get()
+			assert.Equalf(t, len(values) > 0, found, test.name)
+
+			if len(values) > 0 {
+				assert.Equalf(t, values[0], first, test.name)
+				assert.Equalf(t, values[len(values)-1], last, test.name)
+			}
+		})
+	}
+}
+
+func TestTreeMapReverseIteratorKeys(t *testing.T) {
+	m := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "c": 3, "e": 5})
+	it := m.ReverseBegin(utils.BasicComparator[string])
+
+	assert.False(t, it.MoveToKey("b"))
+	assert.True(t, it.MoveToKey("c"))
+	assert.True(t, it.Next())
+
+	key, _ := it.GetKey()
+	assert.Equal(t, "a", key)
+
+	// Overwriting a key through the iterator keeps it valid, inserting one does not
+	assert.True(t, it.SetAtKey("e", 50))
+	assert.NoError(t, it.(ds.FailFastIterator).Err())
+
+	value, found := it.GetAtKey("e")
+	assert.True(t, found)
+	assert.Equal(t, 50, value)
+
+	assert.True(t, it.SetAtKey("b", 2))
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.Equal(t, []string{"a", "b", "c", "e"}, m.GetKeys())
+}
+
+func TestTreeMapReverseIteratorCompare(t *testing.T) {
+	type iteratorInit func(*View[string, int]) ds.ReadWriteOrdCompBidRandCollIterator[string, int]
+
+	reversed := func(init iteratorInit) iteratorInit {
+		return func(v *View[string, int]) ds.ReadWriteOrdCompBidRandCollIterator[string, int] {
+			return ds.NewReverseIterator(init(v))
+		}
+	}
+
+	first := func(v *View[string, int]) ds.ReadWriteOrdCompBidRandCollIterator[string, int] {
+		return v.OrderedFirst(utils.BasicComparator[string])
+	}
+	last := func(v *View[string, int]) ds.ReadWriteOrdCompBidRandCollIterator[string, int] {
+		return v.OrderedLast(utils.BasicComparator[string])
+	}
+	end := func(v *View[string, int]) ds.ReadWriteOrdCompBidRandCollIterator[string, int] {
+		return v.OrderedEnd(utils.BasicComparator[string])
+	}
+
+	tests := []struct {
+		name     string
+		it       iteratorInit
+		other    iteratorInit
+		distance int
+	}{
+		{
+			name:     "Reversed last to reversed first",
+			it:       reversed(last),
+			other:    reversed(first),
+			distance: -2,
+		},
+		{
+			name:     "Reversed last to forward first",
+			it:       reversed(last),
+			other:    first,
+			distance: 2,
+		},
+		{
+			name:     "Reversed first to forward first",
+			it:       reversed(first),
+			other:    first,
+			distance: 0,
+		},
+		{
+			name:     "Reversed end to forward last",
+			it:       reversed(end),
+			other:    last,
+			distance: 1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			// The view starts behind a, so its positions must not be confused with those of the whole map
+			m := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5})
+			view := m.SubMap("b", true, "d", true).(*View[string, int])
+
+			it := test.it(view)
+			other := test.other(view)
+
+			distance, err := ds.CheckedDistanceTo(it, other)
+			assert.NoErrorf(t, err, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+			assert.Equalf(t, -test.distance, other.DistanceTo(it), test.name)
+			assert.Equalf(t, test.distance < 0, it.IsBefore(other), test.name)
+			assert.Equalf(t, test.distance > 0, other.IsBefore(it), test.name)
+			assert.Equalf(t, test.distance == 0, other.IsEqual(it), test.name)
+		})
+	}
+
+	m := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2, "c": 3})
+	view := m.TailMap("b", true).(*View[string, int])
+	assert.ErrorIs(t, ds.CanCompare(m.ReverseFirst(utils.BasicComparator[string]), view.OrderedLast(utils.BasicComparator[string])), ds.ErrForeignIterators)
+	assert.ErrorIs(t, ds.CanCompare(m.ReverseFirst(utils.BasicComparator[string]), m.Clone().OrderedFirst(utils.BasicComparator[string])), ds.ErrForeignIterators)
+}
+
+func TestTreeMapReverseIteratorFailFast(t *testing.T) {
+	m := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2, "c": 3})
+	view := m.HeadMap("b", true).(*View[string, int])
+	it := ds.NewReverseIterator(view.OrderedLast(utils.BasicComparator[string]))
+
+	// Removing a key outside of the view still restructures the underlying tree
+	m.Remove(utils.BasicComparator[string], "c")
+
+	assert.ErrorIs(t, it.Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Next())
+}
@@ -14,6 +14,7 @@ package treemap
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
@@ -24,6 +25,11 @@ import (
 
 // Assert Map implementation
 var _ maps.Map[string, any] = (*Map[string, any])(nil)
+var _ maps.NavigableMap[string, any] = (*Map[string, any])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Map[string, any]] = (*Map[string, any])(nil)
+var _ ds.Equatable[*Map[string, any], any] = (*Map[string, any])(nil)
 
 // Map holds the elements in a red-black tree
 type Map[TKey comparable, TValue any] struct {
@@ -75,12 +81,36 @@ func NewFromIterators[TKey comparable, TValue any](comparator utils.Comparator[T
 	return tree
 }
 
+// MergeWith inserts all elements of other into the map.
+// If a key is contained in both maps, the map is left unchanged and false is returned.
 func (m *Map[TKey, TValue]) MergeWith(other *maps.Map[TKey, TValue]) bool {
-	panic("Not implemented")
+	keys := (*other).GetKeys()
+
+	for _, key := range keys {
+		if m.tree.GetNode(key) != nil {
+			return false
+		}
+	}
+
+	for _, key := range keys {
+		value, _ := (*other).Get(key)
+		m.tree.Put(key, value)
+	}
+
+	return true
 }
 
+// MergeWithSafe inserts all elements of other into the map.
+// If a key is contained in both maps, other's value is used if overwriteOriginal is true, otherwise the original value is kept.
 func (m *Map[TKey, TValue]) MergeWithSafe(other *maps.Map[TKey, TValue], overwriteOriginal bool) {
-	panic("Not implemented")
+	for _, key := range (*other).GetKeys() {
+		if !overwriteOriginal && m.tree.GetNode(key) != nil {
+			continue
+		}
+
+		value, _ := (*other).Get(key)
+		m.tree.Put(key, value)
+	}
 }
 
 // Put inserts key-value pair into the map.
@@ -179,6 +209,91 @@ func (m *Map[TKey, TValue]) Ceiling(key TKey) (foundkey TKey, foundvalue TValue)
 	return
 }
 
+// Lower finds the largest key strictly smaller than the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) Lower(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	node, _ := m.tree.Lower(key)
+
+	return nodeEntry(node)
+}
+
+// Higher finds the smallest key strictly larger than the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) Higher(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	node, _ := m.tree.Higher(key)
+
+	return nodeEntry(node)
+}
+
+// FloorEntry finds the largest key smaller than or equal to the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) FloorEntry(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	node, _ := m.tree.Floor(key)
+
+	return nodeEntry(node)
+}
+
+// CeilingEntry finds the smallest key larger than or equal to the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) CeilingEntry(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	node, _ := m.tree.Ceiling(key)
+
+	return nodeEntry(node)
+}
+
+// PollFirst removes the minimum key and its value from the map and returns them.
+// Third return parameter is false if the map is empty, otherwise true.
+func (m *Map[TKey, TValue]) PollFirst() (key TKey, value TValue, found bool) {
+	key, value, found = nodeEntry(m.tree.Left())
+	if found {
+		m.tree.Remove(key)
+	}
+
+	return
+}
+
+// PollLast removes the maximum key and its value from the map and returns them.
+// Third return parameter is false if the map is empty, otherwise true.
+func (m *Map[TKey, TValue]) PollLast() (key TKey, value TValue, found bool) {
+	key, value, found = nodeEntry(m.tree.Right())
+	if found {
+		m.tree.Remove(key)
+	}
+
+	return
+}
+
+// SubMap returns a live view of the elements with keys between from and to.
+func (m *Map[TKey, TValue]) SubMap(from TKey, fromInclusive bool, to TKey, toInclusive bool) maps.NavigableMap[TKey, TValue] {
+	return &View[TKey, TValue]{m: m, view: m.tree.NewView(rbt.NewRange(from, fromInclusive, to, toInclusive))}
+}
+
+// HeadMap returns a live view of the elements with keys smaller than (or equal to) to.
+func (m *Map[TKey, TValue]) HeadMap(to TKey, toInclusive bool) maps.NavigableMap[TKey, TValue] {
+	return &View[TKey, TValue]{m: m, view: m.tree.NewView(rbt.NewHeadRange(to, toInclusive))}
+}
+
+// TailMap returns a live view of the elements with keys larger than (or equal to) from.
+func (m *Map[TKey, TValue]) TailMap(from TKey, fromInclusive bool) maps.NavigableMap[TKey, TValue] {
+	return &View[TKey, TValue]{m: m, view: m.tree.NewView(rbt.NewTailRange(from, fromInclusive))}
+}
+
+func nodeEntry[TKey comparable, TValue any](node *rbt.Node[TKey, TValue]) (key TKey, value TValue, found bool) {
+	if node == nil {
+		return
+	}
+
+	return node.Key, node.Value, true
+}
+
 // String returns a string representation of container
 func (m *Map[TKey, TValue]) ToString() string {
 	str := "TreeMap\nmap["
@@ -193,6 +308,17 @@ func (m *Map[TKey, TValue]) ToString() string {
 
 }
 
+// Clone returns a copy of the map, which does not share any nodes with the original.
+func (m *Map[TKey, TValue]) Clone() *Map[TKey, TValue] {
+	return &Map[TKey, TValue]{tree: m.tree.Clone()}
+}
+
+// Equals returns true if both maps hold equal key/value pairs.
+// Keys are compared with the map's comparator, values with valueComparator.
+func (m *Map[TKey, TValue]) Equals(other *Map[TKey, TValue], valueComparator utils.Comparator[TValue]) bool {
+	return m.tree.Equals(other.tree, valueComparator)
+}
+
 //******************************************************************//
 //                         Ordered iterator                         //
 //******************************************************************//
@@ -222,3 +348,49 @@ func (m *Map[TKey, TValue]) OrderedFirst(comparator utils.Comparator[TKey]) ds.R
 func (m *Map[TKey, TValue]) OrderedLast(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
 	return m.NewOrderedIterator(m.Size()-1, m.Size())
 }
+
+//******************************************************************//
+//                         Reverse iterator                         //
+//******************************************************************//
+
+// ReverseBegin returns an initialized, reversed iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (m *Map[TKey, TValue]) ReverseBegin(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(m.OrderedEnd(comparator))
+}
+
+// ReverseEnd returns an initialized, reversed iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (m *Map[TKey, TValue]) ReverseEnd(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(m.OrderedBegin(comparator))
+}
+
+// ReverseFirst returns an initialized, reversed iterator, which points to it's first element.
+func (m *Map[TKey, TValue]) ReverseFirst(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(m.OrderedLast(comparator))
+}
+
+// ReverseLast returns an initialized, reversed iterator, which points to it's last element.
+func (m *Map[TKey, TValue]) ReverseLast(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(m.OrderedFirst(comparator))
+}
+
+// All returns a sequence over the key/value pairs of the map in key order.
+func (m *Map[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return m.tree.All()
+}
+
+// Backward returns a sequence over the key/value pairs of the map in reverse key order.
+func (m *Map[TKey, TValue]) Backward() iter.Seq2[TKey, TValue] {
+	return m.tree.Backward()
+}
+
+// Keys returns a sequence over the keys of the map in key order.
+func (m *Map[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return m.tree.Keys()
+}
+
+// Values returns a sequence over the values of the map in key order.
+func (m *Map[TKey, TValue]) Values() iter.Seq[TValue] {
+	return m.tree.Values()
+}
@@ -6,10 +6,10 @@
 package treemap
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -392,6 +392,179 @@ func TestTreeMapNewFromIterators(t *testing.T) {
 
 }
 
+func TestTreeMapNavigation(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Map[int, string]
+		method      func(m *Map[int, string], key int) (int, string, bool)
+		key         int
+		foundKey    int
+		found       bool
+	}{
+		{
+			name:        "Lower, empty map",
+			originalMap: New[int, string](utils.BasicComparator[int]),
+			method:      (*Map[int, string]).Lower,
+			key:         1,
+			found:       false,
+		},
+		{
+			name:        "Lower, equal key",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).Lower,
+			key:         3,
+			foundKey:    1,
+			found:       true,
+		},
+		{
+			name:        "Lower, not found",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).Lower,
+			key:         1,
+			found:       false,
+		},
+		{
+			name:        "Higher, equal key",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).Higher,
+			key:         3,
+			foundKey:    5,
+			found:       true,
+		},
+		{
+			name:        "Higher, not found",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).Higher,
+			key:         5,
+			found:       false,
+		},
+		{
+			name:        "FloorEntry, equal key",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).FloorEntry,
+			key:         3,
+			foundKey:    3,
+			found:       true,
+		},
+		{
+			name:        "FloorEntry, missing key",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).FloorEntry,
+			key:         4,
+			foundKey:    3,
+			found:       true,
+		},
+		{
+			name:        "FloorEntry, not found",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).FloorEntry,
+			key:         0,
+			found:       false,
+		},
+		{
+			name:        "CeilingEntry, missing key",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).CeilingEntry,
+			key:         4,
+			foundKey:    5,
+			found:       true,
+		},
+		{
+			name:        "CeilingEntry, not found",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).CeilingEntry,
+			key:         6,
+			found:       false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			key, value, found := test.method(test.originalMap, test.key)
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.foundKey, key, test.name)
+			if test.found {
+				expectedValue, _ := test.originalMap.Get(test.foundKey)
+				assert.Equalf(t, expectedValue, value, test.name)
+			}
+		})
+	}
+}
+
+func TestTreeMapPoll(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Map[int, string]
+		first       bool
+		key         int
+		value       string
+		found       bool
+		keysAfter   []int
+	}{
+		{
+			name:        "PollFirst, empty map",
+			originalMap: New[int, string](utils.BasicComparator[int]),
+			first:       true,
+			found:       false,
+			keysAfter:   []int{},
+		},
+		{
+			name:        "PollFirst",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			first:       true,
+			key:         1,
+			value:       "foo",
+			found:       true,
+			keysAfter:   []int{3, 5},
+		},
+		{
+			name:        "PollLast, empty map",
+			originalMap: New[int, string](utils.BasicComparator[int]),
+			first:       false,
+			found:       false,
+			keysAfter:   []int{},
+		},
+		{
+			name:        "PollLast",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			first:       false,
+			key:         5,
+			value:       "baz",
+			found:       true,
+			keysAfter:   []int{1, 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			var key int
+			var value string
+			var found bool
+
+			if test.first {
+				key, value, found = test.originalMap.PollFirst()
+			} else {
+				key, value, found = test.originalMap.PollLast()
+			}
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.key, key, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+			assert.Equalf(t, test.keysAfter, test.originalMap.GetKeys(), test.name)
+		})
+	}
+}
+
 // TODO: Compare lists after operations, to require correctnes
 func BenchmarkHashMapRemove(b *testing.B) {
 	b.StopTimer()
@@ -507,3 +680,138 @@ func BenchmarkHashMapPut(b *testing.B) {
 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 	}
 }
+
+func TestTreeMapClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Map[string, int]
+	}{
+		{
+			name:     "empty",
+			original: NewFromMap[string, int](utils.BasicComparator[string], map[string]int{}),
+		},
+		{
+			name:     "3 items",
+			original: NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			size := test.original.Size()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+
+			// The clone must not share the tree's nodes, whose values are overwritten in place
+			values := test.original.GetValues()
+			for _, key := range test.original.GetKeys() {
+				value, _ := clone.Get(key)
+				clone.Put(key, value+10)
+			}
+
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+
+			clone.Put("qux", 4)
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, size, test.original.Size(), test.name)
+		})
+	}
+}
+
+func TestTreeMapEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Map[string, int]
+		b     *Map[string, int]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{}),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+			equal: true,
+		},
+		{
+			name:  "different values",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 3}),
+			equal: false,
+		},
+		{
+			name:  "different keys",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "qux": 2}),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2}),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[int]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	m := New[string, int](utils.BasicComparator[string])
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range m.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	keys = []string{}
+	values = []int{}
+	for key, value := range m.Backward() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"c", "b", "a"}, keys)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(m.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(m.Values()))
+
+	keys = []string{}
+	for key := range m.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
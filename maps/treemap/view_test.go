@@ -0,0 +1,226 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemap
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/maps"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestMap() *Map[int, string] {
+	return NewFromMap[int, string](utils.BasicComparator[int], map[int]string{0: "a", 2: "b", 4: "c", 6: "d", 8: "e"})
+}
+
+func TestTreeMapViewKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		view func(m *Map[int, string]) maps.NavigableMap[int, string]
+		keys []int
+	}{
+		{
+			name: "SubMap, inclusive",
+			view: func(m *Map[int, string]) maps.NavigableMap[int, string] { return m.SubMap(2, true, 6, true) },
+			keys: []int{2, 4, 6},
+		},
+		{
+			name: "SubMap, exclusive",
+			view: func(m *Map[int, string]) maps.NavigableMap[int, string] { return m.SubMap(2, false, 6, false) },
+			keys: []int{4},
+		},
+		{
+			name: "SubMap, empty",
+			view: func(m *Map[int, string]) maps.NavigableMap[int, string] { return m.SubMap(5, true, 5, true) },
+			keys: []int{},
+		},
+		{
+			name: "HeadMap",
+			view: func(m *Map[int, string]) maps.NavigableMap[int, string] { return m.HeadMap(4, false) },
+			keys: []int{0, 2},
+		},
+		{
+			name: "TailMap",
+			view: func(m *Map[int, string]) maps.NavigableMap[int, string] { return m.TailMap(4, true) },
+			keys: []int{4, 6, 8},
+		},
+		{
+			name: "nested views",
+			view: func(m *Map[int, string]) maps.NavigableMap[int, string] {
+				return m.TailMap(1, true).HeadMap(7, true).SubMap(0, true, 6, false)
+			},
+			keys: []int{2, 4},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			m := newTestMap()
+			view := test.view(m)
+
+			assert.Equalf(t, test.keys, view.GetKeys(), test.name)
+			assert.Equalf(t, len(test.keys), view.Size(), test.name)
+			assert.Equalf(t, len(test.keys) == 0, view.IsEmpty(), test.name)
+
+			values := make([]string, 0, len(test.keys))
+			for _, key := range test.keys {
+				value, _ := m.Get(key)
+				values = append(values, value)
+			}
+			assert.Equalf(t, values, view.GetValues(), test.name)
+
+			keys := []int{}
+			it := view.(*View[int, string]).OrderedBegin(utils.BasicComparator[int])
+			for it.Next() {
+				key, _ := it.GetKey()
+				keys = append(keys, key)
+			}
+			assert.Equalf(t, test.keys, keys, test.name)
+		})
+	}
+}
+
+func TestTreeMapViewIsLive(t *testing.T) {
+	m := newTestMap()
+	view := m.SubMap(2, true, 6, true)
+
+	m.Put(3, "x")
+	m.Remove(utils.BasicComparator[int], 4)
+	assert.Equal(t, []int{2, 3, 6}, view.GetKeys())
+
+	view.Put(5, "y")
+	value, found := m.Get(5)
+	assert.True(t, found)
+	assert.Equal(t, "y", value)
+
+	_, found = view.Get(8)
+	assert.False(t, found)
+
+	view.Remove(utils.BasicComparator[int], 8)
+	assert.Equal(t, []int{0, 2, 3, 5, 6, 8}, m.GetKeys())
+
+	assert.Panics(t, func() { view.Put(8, "z") })
+
+	view.Clear()
+	assert.True(t, view.IsEmpty())
+	assert.Equal(t, []int{0, 8}, m.GetKeys())
+}
+
+func TestTreeMapViewIteratorKeys(t *testing.T) {
+	m := newTestMap()
+	view := m.SubMap(2, true, 6, true).(*View[int, string])
+	it := view.OrderedBegin(utils.BasicComparator[int])
+
+	// Keys outside of the view are hidden and can not be set, like in View.Get and View.Put
+	_, found := it.GetAtKey(8)
+	assert.False(t, found)
+	assert.False(t, it.SetAtKey(8, "x"))
+	assert.False(t, it.SetAtKey(7, "x"))
+
+	value, found := it.GetAtKey(4)
+	assert.True(t, found)
+	assert.Equal(t, "c", value)
+	assert.True(t, it.SetAtKey(4, "y"))
+
+	assert.Equal(t, []string{"a", "b", "y", "d", "e"}, m.GetValues())
+}
+
+func TestTreeMapViewNavigation(t *testing.T) {
+	m := newTestMap()
+	view := m.SubMap(2, true, 6, false)
+
+	tests := []struct {
+		name     string
+		method   func(key int) (int, string, bool)
+		key      int
+		foundKey int
+		found    bool
+	}{
+		{name: "Lower, below range", method: view.Lower, key: 1, found: false},
+		{name: "Lower, in range", method: view.Lower, key: 4, foundKey: 2, found: true},
+		{name: "Lower, above range", method: view.Lower, key: 100, foundKey: 4, found: true},
+		{name: "Higher, below range", method: view.Higher, key: -1, foundKey: 2, found: true},
+		{name: "Higher, in range", method: view.Higher, key: 2, foundKey: 4, found: true},
+		{name: "Higher, last in range", method: view.Higher, key: 4, found: false},
+		{name: "FloorEntry, in range", method: view.FloorEntry, key: 5, foundKey: 4, found: true},
+		{name: "FloorEntry, excluded bound", method: view.FloorEntry, key: 6, foundKey: 4, found: true},
+		{name: "FloorEntry, below range", method: view.FloorEntry, key: 0, found: false},
+		{name: "CeilingEntry, in range", method: view.CeilingEntry, key: 3, foundKey: 4, found: true},
+		{name: "CeilingEntry, below range", method: view.CeilingEntry, key: 0, foundKey: 2, found: true},
+		{name: "CeilingEntry, above range", method: view.CeilingEntry, key: 5, found: false},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			defer testCommon.HandlePanic(t, test.name)
+			key, _, found := test.method(test.key)
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.foundKey, key, test.name)
+		})
+	}
+}
+
+func TestTreeMapViewPoll(t *testing.T) {
+	m := newTestMap()
+	view := m.SubMap(1, true, 7, true)
+
+	key, value, found := view.PollFirst()
+	assert.True(t, found)
+	assert.Equal(t, 2, key)
+	assert.Equal(t, "b", value)
+
+	key, value, found = view.PollLast()
+	assert.True(t, found)
+	assert.Equal(t, 6, key)
+	assert.Equal(t, "d", value)
+
+	assert.Equal(t, []int{0, 4, 8}, m.GetKeys())
+
+	view.PollFirst()
+	_, _, found = view.PollLast()
+	assert.False(t, found)
+	assert.Equal(t, []int{0, 8}, m.GetKeys())
+}
+
+func TestTreeMapViewIteratorCompare(t *testing.T) {
+	m := newTestMap()
+	comparator := utils.BasicComparator[int]
+
+	viewIt := m.SubMap(4, true, 8, true).(*View[int, string]).OrderedFirst(comparator)
+	mapIt := m.OrderedFirst(comparator)
+
+	isEqual, err := ds.CheckedIsEqual(mapIt, viewIt)
+	assert.ErrorIs(t, err, ds.ErrForeignIterators)
+	assert.False(t, isEqual)
+
+	_, err = ds.CheckedDistanceTo(viewIt, mapIt)
+	assert.ErrorIs(t, err, ds.ErrForeignIterators)
+
+	// Iterators over equal ranges of the same map can be compared
+	otherViewIt := m.SubMap(4, true, 8, true).(*View[int, string]).OrderedLast(comparator)
+	distance, err := ds.CheckedDistanceTo(otherViewIt, viewIt)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, distance)
+
+	// Unchecked comparisons use the positions within the whole map
+	assert.False(t, mapIt.IsEqual(viewIt))
+	assert.Equal(t, -2, mapIt.DistanceTo(viewIt))
+	assert.True(t, viewIt.IsAfter(mapIt))
+
+	mapIt.MoveTo(2)
+	assert.True(t, mapIt.IsEqual(viewIt))
+	assert.True(t, viewIt.IsEqual(mapIt))
+}
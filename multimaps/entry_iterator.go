@@ -0,0 +1,183 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multimaps
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Iterator implementation
+var _ ds.ReadCompForIndexIterator[string, any] = (*EntryIterator[string, any])(nil)
+var _ ds.OrderedIterator = (*EntryIterator[string, any])(nil)
+var _ ds.BidirectionalIterator = (*EntryIterator[string, any])(nil)
+
+// EntryIterator holding the iterator's state
+//
+// The iterator holds a snapshot of the entries, modifying the multimap does not affect it.
+type EntryIterator[TKey any, TValue any] struct {
+	entries []Entry[TKey, TValue]
+	index   int
+}
+
+// NewEntryIterator returns a stateful iterator over entries.
+func NewEntryIterator[TKey any, TValue any](entries []Entry[TKey, TValue], position int) *EntryIterator[TKey, TValue] {
+	it := &EntryIterator[TKey, TValue]{entries: entries}
+	it.MoveTo(position)
+
+	return it
+}
+
+func (it *EntryIterator[TKey, TValue]) IsBegin() bool {
+	return it.index <= -1
+}
+
+func (it *EntryIterator[TKey, TValue]) IsEnd() bool {
+	return len(it.entries) == 0 || it.index >= len(it.entries)
+}
+
+func (it *EntryIterator[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *EntryIterator[TKey, TValue]) IsLast() bool {
+	return it.index == len(it.entries)-1
+}
+
+func (it *EntryIterator[TKey, TValue]) IsValid() bool {
+	return len(it.entries) > 0 && !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *EntryIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bool {
+	otherThis, ok := other.(*EntryIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) == 0
+}
+
+func (it *EntryIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) int {
+	otherThis, ok := other.(*EntryIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.index - otherThis.index
+}
+
+func (it *EntryIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*EntryIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) > 0
+}
+
+func (it *EntryIterator[TKey, TValue]) IsBefore(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*EntryIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) < 0
+}
+
+func (it *EntryIterator[TKey, TValue]) Size() int {
+	return len(it.entries)
+}
+
+// Next moves the iterator to the next entry and returns true if there was a next entry.
+// If Next() was called for the first time, then it will point the iterator to the first entry if it exists.
+func (it *EntryIterator[TKey, TValue]) Next() bool {
+	return it.MoveTo(utils.Min(it.index+1, len(it.entries)))
+}
+
+func (it *EntryIterator[TKey, TValue]) NextN(n int) bool {
+	if n <= 0 {
+		return false
+	}
+
+	return it.MoveTo(utils.Min(it.index+n, len(it.entries)))
+}
+
+// Previous moves the iterator to the previous entry and returns true if there was a previous entry.
+func (it *EntryIterator[TKey, TValue]) Previous() bool {
+	return it.MoveTo(utils.Max(it.index-1, -1))
+}
+
+func (it *EntryIterator[TKey, TValue]) PreviousN(n int) bool {
+	if n <= 0 {
+		return false
+	}
+
+	return it.MoveTo(utils.Max(it.index-n, -1))
+}
+
+func (it *EntryIterator[TKey, TValue]) MoveBy(n int) bool {
+	if n > 0 {
+		return it.NextN(n)
+	} else if n < 0 {
+		return it.PreviousN(-n)
+	}
+
+	return it.IsValid()
+}
+
+func (it *EntryIterator[TKey, TValue]) MoveTo(n int) bool {
+	switch {
+	case n < 0:
+		it.index = -1
+
+		return false
+	case n >= len(it.entries):
+		it.index = len(it.entries)
+
+		return false
+	}
+
+	it.index = n
+
+	return true
+}
+
+// Get returns the current entry's value.
+func (it *EntryIterator[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.entries[it.index].Value, true
+}
+
+// GetEntry returns the current entry.
+func (it *EntryIterator[TKey, TValue]) GetEntry() (entry Entry[TKey, TValue], found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.entries[it.index], true
+}
+
+// Index returns the current entry's index.
+// Does not modify the state of the iterator.
+func (it *EntryIterator[TKey, TValue]) Index() (index int, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.index, true
+}
+
+// GetKey returns the current entry's key.
+// Does not modify the state of the iterator.
+func (it *EntryIterator[TKey, TValue]) GetKey() (key TKey, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.entries[it.index].Key, true
+}
@@ -0,0 +1,273 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hashmultimap implements a multimap backed by a hash map, which holds the values of each key in a hash set.
+//
+// A key-value pair can only be contained once and neither keys nor values are ordered.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Multimap
+package hashmultimap
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/multimaps"
+	"github.com/JonasMuehlmann/datastructures.go/sets/hashset"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Multimap implementation
+var _ multimaps.Multimap[string, string] = (*Multimap[string, string])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Multimap[string, string]] = (*Multimap[string, string])(nil)
+var _ ds.Equatable[*Multimap[string, string], string] = (*Multimap[string, string])(nil)
+
+// Multimap holds the values of each key in a hash set
+type Multimap[TKey comparable, TValue comparable] struct {
+	m    map[TKey]*hashset.Set[TValue]
+	size int
+}
+
+// New instantiates a new empty multimap.
+func New[TKey comparable, TValue comparable]() *Multimap[TKey, TValue] {
+	return &Multimap[TKey, TValue]{m: make(map[TKey]*hashset.Set[TValue])}
+}
+
+// NewFromMap instantiates a new multimap containing the entries of map_.
+func NewFromMap[TKey comparable, TValue comparable](map_ map[TKey][]TValue) *Multimap[TKey, TValue] {
+	m := New[TKey, TValue]()
+
+	for key, values := range map_ {
+		m.PutAll(key, values...)
+	}
+
+	return m
+}
+
+// NewFromIterator instantiates a new multimap containing the entries provided by the passed iterator.
+func NewFromIterator[TKey comparable, TValue comparable](begin ds.ReadForIndexIterator[TKey, TValue]) *Multimap[TKey, TValue] {
+	m := New[TKey, TValue]()
+
+	for begin.Next() {
+		key, _ := begin.GetKey()
+		value, _ := begin.Get()
+
+		m.Put(key, value)
+	}
+
+	return m
+}
+
+// Put inserts the entry of key and value, if it is not yet contained.
+func (m *Multimap[TKey, TValue]) Put(key TKey, value TValue) {
+	values, found := m.m[key]
+	if !found {
+		values = hashset.New[TValue]()
+		m.m[key] = values
+	}
+
+	if !values.Contains(value) {
+		values.Add(value)
+		m.size++
+	}
+}
+
+// PutAll inserts the entries of key and every passed value.
+func (m *Multimap[TKey, TValue]) PutAll(key TKey, values ...TValue) {
+	for _, value := range values {
+		m.Put(key, value)
+	}
+}
+
+// Get returns a copy of the values of key.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Multimap[TKey, TValue]) Get(key TKey) (values *hashset.Set[TValue], found bool) {
+	set, found := m.m[key]
+	if !found {
+		return
+	}
+
+	return hashset.New(set.GetValues()...), true
+}
+
+// RemoveValue removes the entry of key and value.
+func (m *Multimap[TKey, TValue]) RemoveValue(key TKey, value TValue) {
+	values, found := m.m[key]
+	if !found || !values.Contains(value) {
+		return
+	}
+
+	values.Remove(nil, value)
+	m.size--
+
+	if values.IsEmpty() {
+		delete(m.m, key)
+	}
+}
+
+// RemoveAll removes all entries of key.
+func (m *Multimap[TKey, TValue]) RemoveAll(key TKey) {
+	if values, found := m.m[key]; found {
+		m.size -= values.Size()
+		delete(m.m, key)
+	}
+}
+
+// ContainsKey returns true if at least one entry of key is contained.
+func (m *Multimap[TKey, TValue]) ContainsKey(key TKey) bool {
+	_, found := m.m[key]
+
+	return found
+}
+
+// ContainsEntry returns true if the entry of key and value is contained.
+func (m *Multimap[TKey, TValue]) ContainsEntry(key TKey, value TValue) bool {
+	values, found := m.m[key]
+
+	return found && values.Contains(value)
+}
+
+// GetKeys returns all distinct keys.
+func (m *Multimap[TKey, TValue]) GetKeys() []TKey {
+	keys := make([]TKey, 0, len(m.m))
+	for key := range m.m {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// KeyCount returns the number of distinct keys.
+func (m *Multimap[TKey, TValue]) KeyCount() int {
+	return len(m.m)
+}
+
+// Count returns the number of values of key.
+func (m *Multimap[TKey, TValue]) Count(key TKey) int {
+	if values, found := m.m[key]; found {
+		return values.Size()
+	}
+
+	return 0
+}
+
+// Entries returns an iterator over all entries, which points to one element before it's first.
+func (m *Multimap[TKey, TValue]) Entries() *multimaps.EntryIterator[TKey, TValue] {
+	entries := make([]multimaps.Entry[TKey, TValue], 0, m.size)
+
+	for key, values := range m.m {
+		for _, value := range values.GetValues() {
+			entries = append(entries, multimaps.Entry[TKey, TValue]{Key: key, Value: value})
+		}
+	}
+
+	return multimaps.NewEntryIterator(entries, -1)
+}
+
+// IsEmpty returns true if the multimap does not contain any entries.
+func (m *Multimap[TKey, TValue]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Size returns the number of entries.
+func (m *Multimap[TKey, TValue]) Size() int {
+	return m.size
+}
+
+// Clear removes all entries.
+func (m *Multimap[TKey, TValue]) Clear() {
+	m.m = make(map[TKey]*hashset.Set[TValue])
+	m.size = 0
+}
+
+// GetValues returns the values of all entries.
+func (m *Multimap[TKey, TValue]) GetValues() []TValue {
+	values := make([]TValue, 0, m.size)
+	for _, set := range m.m {
+		values = append(values, set.GetValues()...)
+	}
+
+	return values
+}
+
+// ToString returns a string representation of container
+func (m *Multimap[TKey, TValue]) ToString() string {
+	str := "HashMultimap\n"
+	entries := []string{}
+	for key, values := range m.m {
+		entries = append(entries, fmt.Sprintf("%v:%v", key, values.GetValues()))
+	}
+	str += strings.Join(entries, ", ")
+
+	return str
+}
+
+// Clone returns a copy of the multimap, which does not share any value sets with the original.
+func (m *Multimap[TKey, TValue]) Clone() *Multimap[TKey, TValue] {
+	clone := &Multimap[TKey, TValue]{m: make(map[TKey]*hashset.Set[TValue], len(m.m)), size: m.size}
+	for key, values := range m.m {
+		clone.m[key] = values.Clone()
+	}
+
+	return clone
+}
+
+// Equals returns true if both multimaps hold the same entries.
+// Keys and values are hashed, so the comparator is not used and may be nil.
+func (m *Multimap[TKey, TValue]) Equals(other *Multimap[TKey, TValue], _ utils.Comparator[TValue]) bool {
+	if m.size != other.size || len(m.m) != len(other.m) {
+		return false
+	}
+
+	for key, values := range m.m {
+		otherValues, found := other.m[key]
+		if !found || !values.Equals(otherValues) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// All returns a sequence over all key/value entries of the multimap in no particular order.
+// A key is yielded once for each of its values.
+func (m *Multimap[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		for key, values := range m.m {
+			for value := range values.All() {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Keys returns a sequence over the distinct keys of the multimap in no particular order.
+func (m *Multimap[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return func(yield func(TKey) bool) {
+		for key := range m.m {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of all entries of the multimap in no particular order.
+func (m *Multimap[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		for _, value := range m.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
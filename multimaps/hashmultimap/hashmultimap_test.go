@@ -0,0 +1,76 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashmultimap
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	m := NewFromMap(map[string][]int{"foo": {1, 2, 2}})
+
+	values, found := m.Get("foo")
+	assert.True(t, found)
+	assert.ElementsMatch(t, []int{1, 2}, values.GetValues())
+
+	// Modifying the copy does not modify the multimap
+	values.Add(3)
+	assert.False(t, m.ContainsEntry("foo", 3))
+
+	_, found = m.Get("bar")
+	assert.False(t, found)
+}
+
+func TestCloneAndEquals(t *testing.T) {
+	m := NewFromMap(map[string][]int{"foo": {1, 2}, "bar": {3}})
+	clone := m.Clone()
+
+	assert.True(t, clone.Equals(m, nil))
+	assert.True(t, NewFromMap(map[string][]int{"bar": {3}, "foo": {2, 1}}).Equals(m, nil))
+
+	// Modifying the clone does not modify the multimap
+	clone.Put("foo", 4)
+	assert.False(t, clone.Equals(m, nil))
+	assert.False(t, m.ContainsEntry("foo", 4))
+	assert.Equal(t, 3, m.Size())
+
+	clone.RemoveValue("foo", 4)
+	clone.RemoveAll("bar")
+	clone.Put("baz", 3)
+	assert.False(t, clone.Equals(m, nil))
+	assert.True(t, m.ContainsKey("bar"))
+}
+
+func TestSequences(t *testing.T) {
+	m := New[string, int]()
+	m.PutAll("b", 3, 2)
+	m.Put("a", 1)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range m.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.ElementsMatch(t, []string{"a", "b", "b"}, keys)
+	assert.ElementsMatch(t, []int{1, 2, 3}, values)
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, slices.Collect(m.Values()))
+	assert.ElementsMatch(t, []string{"a", "b"}, slices.Collect(m.Keys()))
+
+	keys = []string{}
+	for key := range m.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Len(t, keys, 2)
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package listmultimap implements a multimap backed by a hash map, which holds the values of each key in an array list.
+//
+// The values of a key keep their insertion order and the same key-value pair can be contained several times.
+// Keys are not ordered.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Multimap
+package listmultimap
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	"github.com/JonasMuehlmann/datastructures.go/multimaps"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Multimap implementation
+var _ multimaps.Multimap[string, string] = (*Multimap[string, string])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Multimap[string, string]] = (*Multimap[string, string])(nil)
+var _ ds.Equatable[*Multimap[string, string], string] = (*Multimap[string, string])(nil)
+
+// Multimap holds the values of each key in an array list
+type Multimap[TKey comparable, TValue comparable] struct {
+	m    map[TKey]*arraylist.List[TValue]
+	size int
+}
+
+// New instantiates a new empty multimap.
+func New[TKey comparable, TValue comparable]() *Multimap[TKey, TValue] {
+	return &Multimap[TKey, TValue]{m: make(map[TKey]*arraylist.List[TValue])}
+}
+
+// NewFromMap instantiates a new multimap containing the entries of map_.
+func NewFromMap[TKey comparable, TValue comparable](map_ map[TKey][]TValue) *Multimap[TKey, TValue] {
+	m := New[TKey, TValue]()
+
+	for key, values := range map_ {
+		m.PutAll(key, values...)
+	}
+
+	return m
+}
+
+// NewFromIterator instantiates a new multimap containing the entries provided by the passed iterator.
+func NewFromIterator[TKey comparable, TValue comparable](begin ds.ReadForIndexIterator[TKey, TValue]) *Multimap[TKey, TValue] {
+	m := New[TKey, TValue]()
+
+	for begin.Next() {
+		key, _ := begin.GetKey()
+		value, _ := begin.Get()
+
+		m.Put(key, value)
+	}
+
+	return m
+}
+
+// Put appends value to the values of key.
+func (m *Multimap[TKey, TValue]) Put(key TKey, value TValue) {
+	m.PutAll(key, value)
+}
+
+// PutAll appends the passed values to the values of key.
+func (m *Multimap[TKey, TValue]) PutAll(key TKey, values ...TValue) {
+	if len(values) == 0 {
+		return
+	}
+
+	list, found := m.m[key]
+	if !found {
+		list = arraylist.New[TValue]()
+		m.m[key] = list
+	}
+
+	list.PushBack(values...)
+	m.size += len(values)
+}
+
+// Get returns a copy of the values of key in insertion order.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Multimap[TKey, TValue]) Get(key TKey) (values *arraylist.List[TValue], found bool) {
+	list, found := m.m[key]
+	if !found {
+		return
+	}
+
+	return arraylist.New(list.GetValues()...), true
+}
+
+// RemoveValue removes the first entry of key and value.
+func (m *Multimap[TKey, TValue]) RemoveValue(key TKey, value TValue) {
+	list, found := m.m[key]
+	if !found {
+		return
+	}
+
+	index := indexOf(list, value)
+	if index == -1 {
+		return
+	}
+
+	list.RemoveStable(index)
+	m.size--
+
+	if list.IsEmpty() {
+		delete(m.m, key)
+	}
+}
+
+// RemoveAll removes all entries of key.
+func (m *Multimap[TKey, TValue]) RemoveAll(key TKey) {
+	if list, found := m.m[key]; found {
+		m.size -= list.Size()
+		delete(m.m, key)
+	}
+}
+
+// ContainsKey returns true if at least one entry of key is contained.
+func (m *Multimap[TKey, TValue]) ContainsKey(key TKey) bool {
+	_, found := m.m[key]
+
+	return found
+}
+
+// ContainsEntry returns true if an entry of key and value is contained.
+func (m *Multimap[TKey, TValue]) ContainsEntry(key TKey, value TValue) bool {
+	list, found := m.m[key]
+
+	return found && indexOf(list, value) != -1
+}
+
+// GetKeys returns all distinct keys.
+func (m *Multimap[TKey, TValue]) GetKeys() []TKey {
+	keys := make([]TKey, 0, len(m.m))
+	for key := range m.m {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// KeyCount returns the number of distinct keys.
+func (m *Multimap[TKey, TValue]) KeyCount() int {
+	return len(m.m)
+}
+
+// Count returns the number of values of key.
+func (m *Multimap[TKey, TValue]) Count(key TKey) int {
+	if list, found := m.m[key]; found {
+		return list.Size()
+	}
+
+	return 0
+}
+
+// Entries returns an iterator over all entries, which points to one element before it's first.
+// The entries of a key are in insertion order.
+func (m *Multimap[TKey, TValue]) Entries() *multimaps.EntryIterator[TKey, TValue] {
+	entries := make([]multimaps.Entry[TKey, TValue], 0, m.size)
+
+	for key, list := range m.m {
+		for _, value := range list.GetValues() {
+			entries = append(entries, multimaps.Entry[TKey, TValue]{Key: key, Value: value})
+		}
+	}
+
+	return multimaps.NewEntryIterator(entries, -1)
+}
+
+// IsEmpty returns true if the multimap does not contain any entries.
+func (m *Multimap[TKey, TValue]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Size returns the number of entries.
+func (m *Multimap[TKey, TValue]) Size() int {
+	return m.size
+}
+
+// Clear removes all entries.
+func (m *Multimap[TKey, TValue]) Clear() {
+	m.m = make(map[TKey]*arraylist.List[TValue])
+	m.size = 0
+}
+
+// GetValues returns the values of all entries.
+func (m *Multimap[TKey, TValue]) GetValues() []TValue {
+	values := make([]TValue, 0, m.size)
+	for _, list := range m.m {
+		values = append(values, list.GetValues()...)
+	}
+
+	return values
+}
+
+// ToString returns a string representation of container
+func (m *Multimap[TKey, TValue]) ToString() string {
+	str := "ListMultimap\n"
+	entries := []string{}
+	for key, list := range m.m {
+		entries = append(entries, fmt.Sprintf("%v:%v", key, list.GetValues()))
+	}
+	str += strings.Join(entries, ", ")
+
+	return str
+}
+
+// Clone returns a copy of the multimap, which does not share any value lists with the original.
+func (m *Multimap[TKey, TValue]) Clone() *Multimap[TKey, TValue] {
+	clone := &Multimap[TKey, TValue]{m: make(map[TKey]*arraylist.List[TValue], len(m.m)), size: m.size}
+	for key, list := range m.m {
+		clone.m[key] = list.Clone()
+	}
+
+	return clone
+}
+
+// Equals returns true if both multimaps hold the same keys and the values of each key are equal and in the same order.
+// Keys are hashed, values are compared with valueComparator.
+func (m *Multimap[TKey, TValue]) Equals(other *Multimap[TKey, TValue], valueComparator utils.Comparator[TValue]) bool {
+	if m.size != other.size || len(m.m) != len(other.m) {
+		return false
+	}
+
+	for key, list := range m.m {
+		otherList, found := other.m[key]
+		if !found || !list.Equals(otherList, valueComparator) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func indexOf[T comparable](list *arraylist.List[T], value T) int {
+	for i, element := range list.GetSlice() {
+		if element == value {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// All returns a sequence over all key/value entries of the multimap in no particular order.
+// A key is yielded once for each of its values.
+func (m *Multimap[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		for key, values := range m.m {
+			for value := range values.Values() {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Keys returns a sequence over the distinct keys of the multimap in no particular order.
+func (m *Multimap[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return func(yield func(TKey) bool) {
+		for key := range m.m {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of all entries of the multimap in no particular order.
+func (m *Multimap[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		for _, value := range m.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
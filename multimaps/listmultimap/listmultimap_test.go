@@ -0,0 +1,79 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package listmultimap
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	m := New[string, int]()
+	m.PutAll("foo", 3, 1, 3, 2)
+
+	values, found := m.Get("foo")
+	assert.True(t, found)
+	assert.Equal(t, []int{3, 1, 3, 2}, values.GetValues())
+
+	m.RemoveValue("foo", 3)
+	values, _ = m.Get("foo")
+	assert.Equal(t, []int{1, 3, 2}, values.GetValues())
+
+	// Modifying the copy does not modify the multimap
+	values.PushBack(4)
+	assert.False(t, m.ContainsEntry("foo", 4))
+
+	_, found = m.Get("bar")
+	assert.False(t, found)
+}
+
+func TestCloneAndEquals(t *testing.T) {
+	m := NewFromMap(map[string][]int{"foo": {3, 1, 3}, "bar": {2}})
+	clone := m.Clone()
+
+	assert.True(t, clone.Equals(m, utils.BasicComparator[int]))
+	assert.False(t, NewFromMap(map[string][]int{"foo": {1, 3, 3}, "bar": {2}}).Equals(m, utils.BasicComparator[int]))
+
+	// Modifying the clone does not modify the multimap
+	clone.Put("foo", 4)
+	assert.False(t, clone.Equals(m, utils.BasicComparator[int]))
+	assert.False(t, m.ContainsEntry("foo", 4))
+	assert.Equal(t, 4, m.Size())
+
+	clone.RemoveValue("foo", 4)
+	assert.True(t, clone.Equals(m, utils.BasicComparator[int]))
+}
+
+func TestSequences(t *testing.T) {
+	m := New[string, int]()
+	m.PutAll("b", 3, 2)
+	m.Put("a", 1)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range m.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.ElementsMatch(t, []string{"a", "b", "b"}, keys)
+	assert.ElementsMatch(t, []int{1, 3, 2}, values)
+
+	assert.ElementsMatch(t, []int{1, 3, 2}, slices.Collect(m.Values()))
+	assert.ElementsMatch(t, []string{"a", "b"}, slices.Collect(m.Keys()))
+
+	keys = []string{}
+	for key := range m.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Len(t, keys, 2)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package listmultimap
+
+import (
+	"encoding/json"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+)
+
+// Assert Serialization implementation
+var _ ds.JSONSerializer = (*Multimap[string, string])(nil)
+var _ ds.JSONDeserializer = (*Multimap[string, string])(nil)
+
+// ToJSON outputs the JSON representation of the multimap as an object mapping every key to its values.
+func (m *Multimap[TKey, TValue]) ToJSON() ([]byte, error) {
+	elements := make(map[TKey][]TValue, len(m.m))
+	for key, values := range m.m {
+		elements[key] = values.GetValues()
+	}
+
+	return json.Marshal(elements)
+}
+
+// FromJSON populates the multimap from the input JSON representation.
+func (m *Multimap[TKey, TValue]) FromJSON(data []byte) error {
+	elements := make(map[TKey][]TValue)
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		m.Clear()
+		for key, values := range elements {
+			m.PutAll(key, values...)
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (m *Multimap[TKey, TValue]) UnmarshalJSON(bytes []byte) error {
+	return m.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (m *Multimap[TKey, TValue]) MarshalJSON() ([]byte, error) {
+	return m.ToJSON()
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package multimaps provides an abstract Multimap interface.
+//
+// A multimap is a map, which associates a key with a collection of values instead of a single value.
+// Every key-value pair is called an entry, a key is contained as long as at least one entry with it is contained.
+//
+// Implementations additionally provide Get(key), which returns a copy of the key's values in their respective collection type.
+//
+// Reference: https://en.wikipedia.org/wiki/Multimap
+package multimaps
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+)
+
+// Multimap interface that all multimaps implement.
+type Multimap[TKey any, TValue any] interface {
+	Put(key TKey, value TValue)
+	PutAll(key TKey, values ...TValue)
+	// RemoveValue removes a single entry of key and value.
+	RemoveValue(key TKey, value TValue)
+	// RemoveAll removes all entries of key.
+	RemoveAll(key TKey)
+	ContainsKey(key TKey) bool
+	ContainsEntry(key TKey, value TValue) bool
+	GetKeys() []TKey
+	// KeyCount returns the number of distinct keys.
+	KeyCount() int
+	// Count returns the number of values of key.
+	Count(key TKey) int
+	// Entries returns an iterator over all entries, which points to one element before it's first.
+	Entries() *EntryIterator[TKey, TValue]
+
+	// Size returns the number of entries and GetValues returns the values of all entries.
+	ds.Container[TValue]
+}
+
+// Entry is a single key-value pair of a multimap.
+type Entry[TKey any, TValue any] struct {
+	Key   TKey
+	Value TValue
+}
@@ -0,0 +1,276 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multimaps_test
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/multimaps"
+	"github.com/JonasMuehlmann/datastructures.go/multimaps/hashmultimap"
+	"github.com/JonasMuehlmann/datastructures.go/multimaps/listmultimap"
+	"github.com/JonasMuehlmann/datastructures.go/multimaps/treemultimap"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type serializableMultimap interface {
+	multimaps.Multimap[string, int]
+	ds.JSONSerializer
+	ds.JSONDeserializer
+}
+
+type multimapConstructor struct {
+	name string
+	// Lists keep duplicate entries
+	allowsDuplicates bool
+	new              func(elements map[string][]int) serializableMultimap
+	fromIterator     func(begin ds.ReadForIndexIterator[string, int]) serializableMultimap
+}
+
+var multimapConstructors = []multimapConstructor{
+	{
+		name: "HashMultimap",
+		new: func(elements map[string][]int) serializableMultimap {
+			return hashmultimap.NewFromMap(elements)
+		},
+		fromIterator: func(begin ds.ReadForIndexIterator[string, int]) serializableMultimap {
+			return hashmultimap.NewFromIterator(begin)
+		},
+	},
+	{
+		name:             "ListMultimap",
+		allowsDuplicates: true,
+		new: func(elements map[string][]int) serializableMultimap {
+			return listmultimap.NewFromMap(elements)
+		},
+		fromIterator: func(begin ds.ReadForIndexIterator[string, int]) serializableMultimap {
+			return listmultimap.NewFromIterator(begin)
+		},
+	},
+	{
+		name: "TreeMultimap",
+		new: func(elements map[string][]int) serializableMultimap {
+			return treemultimap.NewFromMap(utils.BasicComparator[string], utils.BasicComparator[int], elements)
+		},
+		fromIterator: func(begin ds.ReadForIndexIterator[string, int]) serializableMultimap {
+			return treemultimap.NewFromIterator(utils.BasicComparator[string], utils.BasicComparator[int], begin)
+		},
+	},
+}
+
+// toNativeMap returns the entries of m with the values of each key sorted.
+func toNativeMap(m multimaps.Multimap[string, int]) map[string][]int {
+	elements := make(map[string][]int, m.KeyCount())
+
+	it := m.Entries()
+	for it.Next() {
+		entry, _ := it.GetEntry()
+		elements[entry.Key] = append(elements[entry.Key], entry.Value)
+	}
+
+	for _, values := range elements {
+		sort.Ints(values)
+	}
+
+	return elements
+}
+
+func TestMultimapPut(t *testing.T) {
+	tests := []struct {
+		name       string
+		original   map[string][]int
+		key        string
+		values     []int
+		result     map[string][]int
+		listResult map[string][]int
+	}{
+		{
+			name:     "new key",
+			original: map[string][]int{"foo": {1}},
+			key:      "bar",
+			values:   []int{2, 3},
+			result:   map[string][]int{"foo": {1}, "bar": {2, 3}},
+		},
+		{
+			name:     "existing key",
+			original: map[string][]int{"foo": {1}},
+			key:      "foo",
+			values:   []int{2},
+			result:   map[string][]int{"foo": {1, 2}},
+		},
+		{
+			name:       "existing entry",
+			original:   map[string][]int{"foo": {1, 2}},
+			key:        "foo",
+			values:     []int{2},
+			result:     map[string][]int{"foo": {1, 2}},
+			listResult: map[string][]int{"foo": {1, 2, 2}},
+		},
+		{
+			name:     "no values",
+			original: map[string][]int{"foo": {1}},
+			key:      "bar",
+			values:   []int{},
+			result:   map[string][]int{"foo": {1}},
+		},
+	}
+
+	for _, test := range tests {
+		for _, constructor := range multimapConstructors {
+			test := test
+			constructor := constructor
+			name := test.name + "/" + constructor.name
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				defer testCommon.HandlePanic(t, name)
+				m := constructor.new(test.original)
+				m.PutAll(test.key, test.values...)
+
+				result := test.result
+				if constructor.allowsDuplicates && test.listResult != nil {
+					result = test.listResult
+				}
+
+				size := 0
+				for _, values := range result {
+					size += len(values)
+				}
+
+				assert.Equalf(t, result, toNativeMap(m), name)
+				assert.Equalf(t, size, m.Size(), name)
+				assert.Equalf(t, len(result), m.KeyCount(), name)
+				assert.Equalf(t, len(result[test.key]), m.Count(test.key), name)
+			})
+		}
+	}
+}
+
+func TestMultimapRemove(t *testing.T) {
+	tests := []struct {
+		name      string
+		original  map[string][]int
+		key       string
+		value     int
+		removeAll bool
+		result    map[string][]int
+	}{
+		{
+			name:     "missing key",
+			original: map[string][]int{"foo": {1}},
+			key:      "bar",
+			value:    1,
+			result:   map[string][]int{"foo": {1}},
+		},
+		{
+			name:     "missing value",
+			original: map[string][]int{"foo": {1}},
+			key:      "foo",
+			value:    2,
+			result:   map[string][]int{"foo": {1}},
+		},
+		{
+			name:     "one of several values",
+			original: map[string][]int{"foo": {1, 2}},
+			key:      "foo",
+			value:    2,
+			result:   map[string][]int{"foo": {1}},
+		},
+		{
+			name:     "last value removes key",
+			original: map[string][]int{"foo": {1}, "bar": {2}},
+			key:      "foo",
+			value:    1,
+			result:   map[string][]int{"bar": {2}},
+		},
+		{
+			name:      "all values",
+			original:  map[string][]int{"foo": {1, 2, 3}, "bar": {2}},
+			key:       "foo",
+			removeAll: true,
+			result:    map[string][]int{"bar": {2}},
+		},
+	}
+
+	for _, test := range tests {
+		for _, constructor := range multimapConstructors {
+			test := test
+			constructor := constructor
+			name := test.name + "/" + constructor.name
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				defer testCommon.HandlePanic(t, name)
+				m := constructor.new(test.original)
+
+				if test.removeAll {
+					m.RemoveAll(test.key)
+				} else {
+					m.RemoveValue(test.key, test.value)
+				}
+
+				size := 0
+				for _, values := range test.result {
+					size += len(values)
+				}
+
+				assert.Equalf(t, test.result, toNativeMap(m), name)
+				assert.Equalf(t, size, m.Size(), name)
+				assert.Falsef(t, m.ContainsEntry(test.key, test.value), name)
+				_, keyKept := test.result[test.key]
+				assert.Equalf(t, keyKept, m.ContainsKey(test.key), name)
+			})
+		}
+	}
+}
+
+func TestMultimapEntries(t *testing.T) {
+	elements := map[string][]int{"foo": {1, 2}, "bar": {3}, "baz": {}}
+	expected := map[string][]int{"foo": {1, 2}, "bar": {3}}
+
+	for _, source := range multimapConstructors {
+		for _, target := range multimapConstructors {
+			source := source
+			target := target
+			name := source.name + " into " + target.name
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				defer testCommon.HandlePanic(t, name)
+				m := target.fromIterator(source.new(elements).Entries())
+
+				assert.Equalf(t, expected, toNativeMap(m), name)
+				assert.Equalf(t, 3, m.Size(), name)
+			})
+		}
+	}
+}
+
+func TestMultimapSerialization(t *testing.T) {
+	elements := map[string][]int{"foo": {1, 2}, "bar": {3}}
+
+	for _, constructor := range multimapConstructors {
+		constructor := constructor
+
+		t.Run(constructor.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, constructor.name)
+			m := constructor.new(elements)
+
+			data, err := json.Marshal(m)
+			assert.NoError(t, err)
+
+			deserialized := constructor.new(map[string][]int{"qux": {4}})
+			assert.NoError(t, json.Unmarshal(data, deserialized))
+
+			assert.Equal(t, elements, toNativeMap(deserialized))
+			assert.Equal(t, 3, deserialized.Size())
+		})
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package treemultimap implements a multimap backed by a tree map, which holds the values of each key in a tree set.
+//
+// A key-value pair can only be contained once, keys and the values of each key are ordered by their comparators.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Multimap
+package treemultimap
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/maps/treemap"
+	"github.com/JonasMuehlmann/datastructures.go/multimaps"
+	"github.com/JonasMuehlmann/datastructures.go/sets/treeset"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Multimap implementation
+var _ multimaps.Multimap[string, string] = (*Multimap[string, string])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Multimap[string, string]] = (*Multimap[string, string])(nil)
+var _ ds.Equatable[*Multimap[string, string], string] = (*Multimap[string, string])(nil)
+
+// Multimap holds the values of each key in a tree set
+type Multimap[TKey comparable, TValue comparable] struct {
+	m               *treemap.Map[TKey, *treeset.Set[TValue]]
+	KeyComparator   utils.Comparator[TKey]
+	ValueComparator utils.Comparator[TValue]
+	size            int
+}
+
+// New instantiates a new empty multimap with the custom comparators.
+func New[TKey comparable, TValue comparable](keyComparator utils.Comparator[TKey], valueComparator utils.Comparator[TValue]) *Multimap[TKey, TValue] {
+	return &Multimap[TKey, TValue]{
+		m:               treemap.New[TKey, *treeset.Set[TValue]](keyComparator),
+		KeyComparator:   keyComparator,
+		ValueComparator: valueComparator,
+	}
+}
+
+// NewFromMap instantiates a new multimap containing the entries of map_.
+func NewFromMap[TKey comparable, TValue comparable](keyComparator utils.Comparator[TKey], valueComparator utils.Comparator[TValue], map_ map[TKey][]TValue) *Multimap[TKey, TValue] {
+	m := New(keyComparator, valueComparator)
+
+	for key, values := range map_ {
+		m.PutAll(key, values...)
+	}
+
+	return m
+}
+
+// NewFromIterator instantiates a new multimap containing the entries provided by the passed iterator.
+func NewFromIterator[TKey comparable, TValue comparable](keyComparator utils.Comparator[TKey], valueComparator utils.Comparator[TValue], begin ds.ReadForIndexIterator[TKey, TValue]) *Multimap[TKey, TValue] {
+	m := New(keyComparator, valueComparator)
+
+	for begin.Next() {
+		key, _ := begin.GetKey()
+		value, _ := begin.Get()
+
+		m.Put(key, value)
+	}
+
+	return m
+}
+
+// Put inserts the entry of key and value, if it is not yet contained.
+func (m *Multimap[TKey, TValue]) Put(key TKey, value TValue) {
+	values, found := m.m.Get(key)
+	if !found {
+		values = treeset.New(m.ValueComparator)
+		m.m.Put(key, values)
+	}
+
+	if !values.Contains(value) {
+		values.Add(value)
+		m.size++
+	}
+}
+
+// PutAll inserts the entries of key and every passed value.
+func (m *Multimap[TKey, TValue]) PutAll(key TKey, values ...TValue) {
+	for _, value := range values {
+		m.Put(key, value)
+	}
+}
+
+// Get returns a copy of the values of key.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Multimap[TKey, TValue]) Get(key TKey) (values *treeset.Set[TValue], found bool) {
+	set, found := m.m.Get(key)
+	if !found {
+		return
+	}
+
+	return treeset.New(m.ValueComparator, set.GetValues()...), true
+}
+
+// RemoveValue removes the entry of key and value.
+func (m *Multimap[TKey, TValue]) RemoveValue(key TKey, value TValue) {
+	values, found := m.m.Get(key)
+	if !found || !values.Contains(value) {
+		return
+	}
+
+	values.Remove(m.ValueComparator, value)
+	m.size--
+
+	if values.IsEmpty() {
+		m.m.Remove(m.KeyComparator, key)
+	}
+}
+
+// RemoveAll removes all entries of key.
+func (m *Multimap[TKey, TValue]) RemoveAll(key TKey) {
+	if values, found := m.m.Get(key); found {
+		m.size -= values.Size()
+		m.m.Remove(m.KeyComparator, key)
+	}
+}
+
+// ContainsKey returns true if at least one entry of key is contained.
+func (m *Multimap[TKey, TValue]) ContainsKey(key TKey) bool {
+	_, found := m.m.Get(key)
+
+	return found
+}
+
+// ContainsEntry returns true if the entry of key and value is contained.
+func (m *Multimap[TKey, TValue]) ContainsEntry(key TKey, value TValue) bool {
+	values, found := m.m.Get(key)
+
+	return found && values.Contains(value)
+}
+
+// GetKeys returns all distinct keys in-order.
+func (m *Multimap[TKey, TValue]) GetKeys() []TKey {
+	return m.m.GetKeys()
+}
+
+// KeyCount returns the number of distinct keys.
+func (m *Multimap[TKey, TValue]) KeyCount() int {
+	return m.m.Size()
+}
+
+// Count returns the number of values of key.
+func (m *Multimap[TKey, TValue]) Count(key TKey) int {
+	if values, found := m.m.Get(key); found {
+		return values.Size()
+	}
+
+	return 0
+}
+
+// Entries returns an iterator over all entries ordered by key and then value, which points to one element before it's first.
+func (m *Multimap[TKey, TValue]) Entries() *multimaps.EntryIterator[TKey, TValue] {
+	entries := make([]multimaps.Entry[TKey, TValue], 0, m.size)
+
+	for _, key := range m.m.GetKeys() {
+		values, _ := m.m.Get(key)
+
+		for _, value := range values.GetValues() {
+			entries = append(entries, multimaps.Entry[TKey, TValue]{Key: key, Value: value})
+		}
+	}
+
+	return multimaps.NewEntryIterator(entries, -1)
+}
+
+// IsEmpty returns true if the multimap does not contain any entries.
+func (m *Multimap[TKey, TValue]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Size returns the number of entries.
+func (m *Multimap[TKey, TValue]) Size() int {
+	return m.size
+}
+
+// Clear removes all entries.
+func (m *Multimap[TKey, TValue]) Clear() {
+	m.m.Clear()
+	m.size = 0
+}
+
+// GetValues returns the values of all entries ordered by key and then value.
+func (m *Multimap[TKey, TValue]) GetValues() []TValue {
+	values := make([]TValue, 0, m.size)
+	for _, set := range m.m.GetValues() {
+		values = append(values, set.GetValues()...)
+	}
+
+	return values
+}
+
+// ToString returns a string representation of container
+func (m *Multimap[TKey, TValue]) ToString() string {
+	str := "TreeMultimap\n"
+	entries := []string{}
+	for _, key := range m.m.GetKeys() {
+		values, _ := m.m.Get(key)
+		entries = append(entries, fmt.Sprintf("%v:%v", key, values.GetValues()))
+	}
+	str += strings.Join(entries, ", ")
+
+	return str
+}
+
+// Clone returns a copy of the multimap, which does not share any value sets with the original.
+func (m *Multimap[TKey, TValue]) Clone() *Multimap[TKey, TValue] {
+	clone := New[TKey, TValue](m.KeyComparator, m.ValueComparator)
+	clone.size = m.size
+
+	for _, key := range m.m.GetKeys() {
+		values, _ := m.m.Get(key)
+		clone.m.Put(key, values.Clone())
+	}
+
+	return clone
+}
+
+// Equals returns true if both multimaps hold the same entries.
+// Keys and values are compared with the multimap's comparators, so the comparator is not used and may be nil.
+func (m *Multimap[TKey, TValue]) Equals(other *Multimap[TKey, TValue], _ utils.Comparator[TValue]) bool {
+	if m.size != other.size || m.KeyCount() != other.KeyCount() {
+		return false
+	}
+
+	keys := m.m.GetKeys()
+	otherKeys := other.m.GetKeys()
+
+	for i, key := range keys {
+		if m.KeyComparator(key, otherKeys[i]) != 0 {
+			return false
+		}
+
+		values, _ := m.m.Get(key)
+		otherValues, _ := other.m.Get(otherKeys[i])
+
+		if !values.Equals(otherValues) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// All returns a sequence over all key/value entries of the multimap ordered by key and then by value.
+// A key is yielded once for each of its values.
+func (m *Multimap[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		for key, values := range m.m.All() {
+			for value := range values.All() {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Backward returns a sequence over all key/value entries of the multimap in reverse order.
+func (m *Multimap[TKey, TValue]) Backward() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		for key, values := range m.m.Backward() {
+			for value := range values.Backward() {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Keys returns a sequence over the distinct keys of the multimap in order.
+func (m *Multimap[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return m.m.Keys()
+}
+
+// Values returns a sequence over the values of all entries of the multimap ordered by key and then by value.
+func (m *Multimap[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		for _, value := range m.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemultimap
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	m := New[string, int](utils.BasicComparator[string], utils.BasicComparator[int])
+	m.PutAll("foo", 3, 1, 3, 2)
+	m.Put("bar", 5)
+
+	values, found := m.Get("foo")
+	assert.True(t, found)
+	assert.Equal(t, []int{1, 2, 3}, values.GetValues())
+
+	// Modifying the copy does not modify the multimap
+	values.Add(4)
+	assert.False(t, m.ContainsEntry("foo", 4))
+
+	_, found = m.Get("baz")
+	assert.False(t, found)
+
+	assert.Equal(t, []string{"bar", "foo"}, m.GetKeys())
+	assert.Equal(t, []int{5, 1, 2, 3}, m.GetValues())
+}
+
+func TestCloneAndEquals(t *testing.T) {
+	m := NewFromMap(utils.BasicComparator[string], utils.BasicComparator[int], map[string][]int{"foo": {1, 2}, "bar": {3}})
+	clone := m.Clone()
+
+	assert.True(t, clone.Equals(m, nil))
+	assert.Equal(t, m.GetKeys(), clone.GetKeys())
+	assert.Equal(t, m.GetValues(), clone.GetValues())
+
+	// Modifying the clone does not modify the multimap
+	clone.Put("foo", 4)
+	assert.False(t, clone.Equals(m, nil))
+	assert.False(t, m.ContainsEntry("foo", 4))
+	assert.Equal(t, 3, m.Size())
+
+	clone.RemoveValue("foo", 4)
+	clone.RemoveAll("bar")
+	clone.Put("baz", 3)
+	assert.False(t, clone.Equals(m, nil))
+	assert.True(t, m.ContainsKey("bar"))
+}
+
+func TestSequences(t *testing.T) {
+	m := New[string, int](utils.BasicComparator[string], utils.BasicComparator[int])
+	m.PutAll("b", 3, 2)
+	m.Put("a", 1)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range m.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"a", "b", "b"}, keys)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	keys = []string{}
+	values = []int{}
+	for key, value := range m.Backward() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"b", "b", "a"}, keys)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []string{"a", "b"}, slices.Collect(m.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(m.Values()))
+
+	keys = []string{}
+	for key := range m.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hashmultiset implements a multiset backed by a hash map from the distinct elements to their counts.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Multiset
+package hashmultiset
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/multisets"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Multiset implementation
+var _ multisets.Multiset[string] = (*Multiset[string])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Multiset[string]] = (*Multiset[string])(nil)
+var _ ds.Equatable[*Multiset[string], string] = (*Multiset[string])(nil)
+
+// Multiset holds the counts of the elements in go's native map
+type Multiset[T comparable] struct {
+	counts map[T]int
+	size   int
+}
+
+// New instantiates a new empty multiset and adds the passed values, if any, to the multiset.
+func New[T comparable](values ...T) *Multiset[T] {
+	multiset := &Multiset[T]{counts: make(map[T]int)}
+	multiset.Add(values...)
+
+	return multiset
+}
+
+// NewFromSlice instantiates a new multiset containing every element of slice.
+func NewFromSlice[T comparable](slice []T) *Multiset[T] {
+	return New(slice...)
+}
+
+// NewFromMap instantiates a new multiset containing every key of counts as often as its value.
+func NewFromMap[T comparable](counts map[T]int) *Multiset[T] {
+	multiset := New[T]()
+	for element, count := range counts {
+		multiset.AddN(element, count)
+	}
+
+	return multiset
+}
+
+// NewFromIterator instantiates a new multiset containing the elements provided by the passed iterator.
+func NewFromIterator[T comparable](begin ds.ReadForIterator[T]) *Multiset[T] {
+	multiset := New[T]()
+
+	for begin.Next() {
+		value, _ := begin.Get()
+		multiset.Add(value)
+	}
+
+	return multiset
+}
+
+// Add adds a single occurrence of every passed element.
+func (multiset *Multiset[T]) Add(elements ...T) {
+	for _, element := range elements {
+		multiset.AddN(element, 1)
+	}
+}
+
+// AddN adds n occurrences of element, n of 0 or less is ignored.
+func (multiset *Multiset[T]) AddN(element T, n int) {
+	if n <= 0 {
+		return
+	}
+
+	multiset.counts[element] += n
+	multiset.size += n
+}
+
+// Remove removes a single occurrence of every passed element.
+func (multiset *Multiset[T]) Remove(elements ...T) {
+	for _, element := range elements {
+		multiset.RemoveN(element, 1)
+	}
+}
+
+// RemoveN removes up to n occurrences of element.
+func (multiset *Multiset[T]) RemoveN(element T, n int) {
+	if n <= 0 {
+		return
+	}
+
+	multiset.SetCount(element, multiset.counts[element]-n)
+}
+
+// Count returns the number of occurrences of element.
+func (multiset *Multiset[T]) Count(element T) int {
+	return multiset.counts[element]
+}
+
+// SetCount sets the number of occurrences of element, a count of 0 or less removes it.
+func (multiset *Multiset[T]) SetCount(element T, count int) {
+	count = utils.Max(count, 0)
+	multiset.size += count - multiset.counts[element]
+
+	if count == 0 {
+		delete(multiset.counts, element)
+	} else {
+		multiset.counts[element] = count
+	}
+}
+
+// Contains returns true if every passed element occurs at least once.
+// Returns true if no arguments are passed at all.
+func (multiset *Multiset[T]) Contains(elements ...T) bool {
+	for _, element := range elements {
+		if _, found := multiset.counts[element]; !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DistinctSize returns the number of distinct elements.
+func (multiset *Multiset[T]) DistinctSize() int {
+	return len(multiset.counts)
+}
+
+// GetDistinctValues returns every distinct element once.
+func (multiset *Multiset[T]) GetDistinctValues() []T {
+	values := make([]T, 0, len(multiset.counts))
+	for element := range multiset.counts {
+		values = append(values, element)
+	}
+
+	return values
+}
+
+// GetEntries returns every distinct element and its count.
+func (multiset *Multiset[T]) GetEntries() []multisets.Entry[T] {
+	entries := make([]multisets.Entry[T], 0, len(multiset.counts))
+	for element, count := range multiset.counts {
+		entries = append(entries, multisets.Entry[T]{Value: element, Count: count})
+	}
+
+	return entries
+}
+
+// MakeUnionWith returns a multiset, in which every element occurs as often as in the multiset or in other, whichever is more.
+func (multiset *Multiset[T]) MakeUnionWith(other multisets.Multiset[T]) multisets.Multiset[T] {
+	result := New[T]()
+
+	for element, count := range multiset.counts {
+		result.AddN(element, utils.Max(count, other.Count(element)))
+	}
+	for _, element := range other.GetDistinctValues() {
+		if !result.Contains(element) {
+			result.AddN(element, other.Count(element))
+		}
+	}
+
+	return result
+}
+
+// MakeIntersectionWith returns a multiset, in which every element occurs as often as in the multiset or in other, whichever is less.
+func (multiset *Multiset[T]) MakeIntersectionWith(other multisets.Multiset[T]) multisets.Multiset[T] {
+	result := New[T]()
+
+	for element, count := range multiset.counts {
+		result.AddN(element, utils.Min(count, other.Count(element)))
+	}
+
+	return result
+}
+
+// MakeSumWith returns a multiset, in which every element occurs as often as in the multiset and in other combined.
+func (multiset *Multiset[T]) MakeSumWith(other multisets.Multiset[T]) multisets.Multiset[T] {
+	result := New[T]()
+
+	for element, count := range multiset.counts {
+		result.AddN(element, count)
+	}
+	for _, element := range other.GetDistinctValues() {
+		result.AddN(element, other.Count(element))
+	}
+
+	return result
+}
+
+// MakeDifferenceWith returns a multiset, in which every element occurs as often as in the multiset minus its occurrences in other, if that is positive.
+func (multiset *Multiset[T]) MakeDifferenceWith(other multisets.Multiset[T]) multisets.Multiset[T] {
+	result := New[T]()
+
+	for element, count := range multiset.counts {
+		result.AddN(element, count-other.Count(element))
+	}
+
+	return result
+}
+
+// IsEmpty returns true if the multiset does not contain any elements.
+func (multiset *Multiset[T]) IsEmpty() bool {
+	return multiset.size == 0
+}
+
+// Size returns the number of occurrences of all elements.
+func (multiset *Multiset[T]) Size() int {
+	return multiset.size
+}
+
+// Clear removes all elements from the multiset.
+func (multiset *Multiset[T]) Clear() {
+	multiset.counts = make(map[T]int)
+	multiset.size = 0
+}
+
+// GetValues returns every occurrence of every element.
+func (multiset *Multiset[T]) GetValues() []T {
+	values := make([]T, 0, multiset.size)
+	for element, count := range multiset.counts {
+		for i := 0; i < count; i++ {
+			values = append(values, element)
+		}
+	}
+
+	return values
+}
+
+// ToString returns a string representation of container
+func (multiset *Multiset[T]) ToString() string {
+	str := "HashMultiset\n"
+	items := []string{}
+	for element, count := range multiset.counts {
+		items = append(items, fmt.Sprintf("%v:%v", element, count))
+	}
+	str += strings.Join(items, ", ")
+
+	return str
+}
+
+// Clone returns a copy of the multiset, which does not share its underlying map with the original.
+func (multiset *Multiset[T]) Clone() *Multiset[T] {
+	counts := make(map[T]int, len(multiset.counts))
+	for element, count := range multiset.counts {
+		counts[element] = count
+	}
+
+	return &Multiset[T]{counts: counts, size: multiset.size}
+}
+
+// Equals returns true if every element occurs equally often in both multisets.
+// Elements are hashed, so the comparator is not used and may be nil.
+func (multiset *Multiset[T]) Equals(other *Multiset[T], _ utils.Comparator[T]) bool {
+	return multiset.size == other.size && utils.MapsEqual(multiset.counts, other.counts, utils.BasicComparator[int])
+}
+
+// All returns a sequence over the distinct elements of the multiset and their counts in no particular order.
+func (multiset *Multiset[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for element, count := range multiset.counts {
+			if !yield(element, count) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the elements of the multiset in no particular order, where each element is repeated according to its count.
+func (multiset *Multiset[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for element, count := range multiset.All() {
+			for i := 0; i < count; i++ {
+				if !yield(element) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashmultiset
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/multisets"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEntries(t *testing.T) {
+	multiset := New("foo", "bar", "foo")
+
+	assert.ElementsMatch(t, []multisets.Entry[string]{{Value: "foo", Count: 2}, {Value: "bar", Count: 1}}, multiset.GetEntries())
+	assert.ElementsMatch(t, []string{"foo", "foo", "bar"}, multiset.GetValues())
+	assert.ElementsMatch(t, []string{"foo", "bar"}, multiset.GetDistinctValues())
+}
+
+func TestCloneAndEquals(t *testing.T) {
+	multiset := New("foo", "bar", "foo")
+	clone := multiset.Clone()
+
+	assert.True(t, clone.Equals(multiset, nil))
+	assert.True(t, New("bar", "foo", "foo").Equals(multiset, nil))
+	assert.False(t, New("bar", "bar", "foo").Equals(multiset, nil))
+
+	// Modifying the clone does not modify the multiset
+	clone.Add("foo")
+	assert.False(t, clone.Equals(multiset, nil))
+	assert.Equal(t, 2, multiset.Count("foo"))
+	assert.Equal(t, 3, multiset.Size())
+}
+
+func TestSequences(t *testing.T) {
+	multiset := New("b", "a", "b")
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range multiset.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.ElementsMatch(t, []string{"a", "b"}, keys)
+	assert.ElementsMatch(t, []int{1, 2}, values)
+
+	assert.ElementsMatch(t, []string{"a", "b", "b"}, slices.Collect(multiset.Values()))
+
+	keys = []string{}
+	for key := range multiset.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Len(t, keys, 2)
+}
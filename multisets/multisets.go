@@ -0,0 +1,54 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package multisets provides an abstract Multiset interface.
+//
+// A multiset, also called bag, is a set, which can contain the same element several times.
+// Instead of storing every occurrence, implementations store each distinct element together with its count.
+//
+// Reference: https://en.wikipedia.org/wiki/Multiset
+package multisets
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+)
+
+// Multiset interface that all multisets implement.
+type Multiset[T any] interface {
+	// Add adds a single occurrence of every passed element.
+	Add(elements ...T)
+	// AddN adds n occurrences of element.
+	AddN(element T, n int)
+	// Remove removes a single occurrence of every passed element.
+	Remove(elements ...T)
+	// RemoveN removes up to n occurrences of element.
+	RemoveN(element T, n int)
+	// Count returns the number of occurrences of element.
+	Count(element T) int
+	// SetCount sets the number of occurrences of element, a count of 0 or less removes it.
+	SetCount(element T, count int)
+	// Contains returns true if every passed element occurs at least once.
+	Contains(elements ...T) bool
+	// DistinctSize returns the number of distinct elements, while Size returns the number of all occurrences.
+	DistinctSize() int
+	GetDistinctValues() []T
+
+	// MakeUnionWith returns a multiset, in which every element occurs as often as in the multiset or in other, whichever is more.
+	MakeUnionWith(other Multiset[T]) Multiset[T]
+	// MakeIntersectionWith returns a multiset, in which every element occurs as often as in the multiset or in other, whichever is less.
+	MakeIntersectionWith(other Multiset[T]) Multiset[T]
+	// MakeSumWith returns a multiset, in which every element occurs as often as in the multiset and in other combined.
+	MakeSumWith(other Multiset[T]) Multiset[T]
+	// MakeDifferenceWith returns a multiset, in which every element occurs as often as in the multiset minus its occurrences in other, if that is positive.
+	MakeDifferenceWith(other Multiset[T]) Multiset[T]
+
+	// GetValues returns every occurrence of every element.
+	ds.Container[T]
+}
+
+// Entry is a distinct element of a multiset and its count.
+type Entry[T any] struct {
+	Value T
+	Count int
+}
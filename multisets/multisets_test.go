@@ -0,0 +1,265 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multisets_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	"github.com/JonasMuehlmann/datastructures.go/multisets"
+	"github.com/JonasMuehlmann/datastructures.go/multisets/hashmultiset"
+	"github.com/JonasMuehlmann/datastructures.go/multisets/treemultiset"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type serializableMultiset interface {
+	multisets.Multiset[string]
+	ds.JSONSerializer
+	ds.JSONDeserializer
+}
+
+type multisetConstructor struct {
+	name         string
+	new          func(counts map[string]int) serializableMultiset
+	fromIterator func(begin ds.ReadForIterator[string]) serializableMultiset
+}
+
+var multisetConstructors = []multisetConstructor{
+	{
+		name: "HashMultiset",
+		new: func(counts map[string]int) serializableMultiset {
+			return hashmultiset.NewFromMap(counts)
+		},
+		fromIterator: func(begin ds.ReadForIterator[string]) serializableMultiset {
+			return hashmultiset.NewFromIterator(begin)
+		},
+	},
+	{
+		name: "TreeMultiset",
+		new: func(counts map[string]int) serializableMultiset {
+			return treemultiset.NewFromMap(utils.BasicComparator[string], counts)
+		},
+		fromIterator: func(begin ds.ReadForIterator[string]) serializableMultiset {
+			return treemultiset.NewFromIterator(utils.BasicComparator[string], begin)
+		},
+	},
+}
+
+// toNativeMap returns the count of every distinct element of multiset.
+func toNativeMap(multiset multisets.Multiset[string]) map[string]int {
+	counts := make(map[string]int, multiset.DistinctSize())
+	for _, element := range multiset.GetDistinctValues() {
+		counts[element] = multiset.Count(element)
+	}
+
+	return counts
+}
+
+func sumCounts(counts map[string]int) int {
+	size := 0
+	for _, count := range counts {
+		size += count
+	}
+
+	return size
+}
+
+func TestMultisetModification(t *testing.T) {
+	tests := []struct {
+		name     string
+		original map[string]int
+		modify   func(multiset multisets.Multiset[string])
+		result   map[string]int
+	}{
+		{
+			name:     "add new",
+			original: map[string]int{"foo": 1},
+			modify:   func(multiset multisets.Multiset[string]) { multiset.Add("bar", "bar") },
+			result:   map[string]int{"foo": 1, "bar": 2},
+		},
+		{
+			name:     "add existing",
+			original: map[string]int{"foo": 1},
+			modify:   func(multiset multisets.Multiset[string]) { multiset.AddN("foo", 3) },
+			result:   map[string]int{"foo": 4},
+		},
+		{
+			name:     "add non-positive",
+			original: map[string]int{"foo": 1},
+			modify:   func(multiset multisets.Multiset[string]) { multiset.AddN("foo", -3) },
+			result:   map[string]int{"foo": 1},
+		},
+		{
+			name:     "remove one",
+			original: map[string]int{"foo": 2},
+			modify:   func(multiset multisets.Multiset[string]) { multiset.Remove("foo") },
+			result:   map[string]int{"foo": 1},
+		},
+		{
+			name:     "remove more than contained",
+			original: map[string]int{"foo": 2, "bar": 1},
+			modify:   func(multiset multisets.Multiset[string]) { multiset.RemoveN("foo", 5) },
+			result:   map[string]int{"bar": 1},
+		},
+		{
+			name:     "remove missing",
+			original: map[string]int{"foo": 2},
+			modify:   func(multiset multisets.Multiset[string]) { multiset.Remove("bar") },
+			result:   map[string]int{"foo": 2},
+		},
+		{
+			name:     "set count",
+			original: map[string]int{"foo": 2},
+			modify:   func(multiset multisets.Multiset[string]) { multiset.SetCount("foo", 7) },
+			result:   map[string]int{"foo": 7},
+		},
+		{
+			name:     "set count to zero",
+			original: map[string]int{"foo": 2, "bar": 1},
+			modify:   func(multiset multisets.Multiset[string]) { multiset.SetCount("foo", 0) },
+			result:   map[string]int{"bar": 1},
+		},
+	}
+
+	for _, test := range tests {
+		for _, constructor := range multisetConstructors {
+			test := test
+			constructor := constructor
+			name := test.name + "/" + constructor.name
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				defer testCommon.HandlePanic(t, name)
+				multiset := constructor.new(test.original)
+				test.modify(multiset)
+
+				assert.Equalf(t, test.result, toNativeMap(multiset), name)
+				assert.Equalf(t, sumCounts(test.result), multiset.Size(), name)
+				assert.Equalf(t, len(test.result), multiset.DistinctSize(), name)
+				assert.Lenf(t, multiset.GetValues(), sumCounts(test.result), name)
+			})
+		}
+	}
+}
+
+func TestMultisetOperations(t *testing.T) {
+	a := map[string]int{"foo": 3, "bar": 1, "baz": 2}
+	b := map[string]int{"foo": 1, "bar": 4, "qux": 1}
+
+	tests := []struct {
+		name      string
+		operation func(a multisets.Multiset[string], b multisets.Multiset[string]) multisets.Multiset[string]
+		result    map[string]int
+	}{
+		{
+			name:      "union",
+			operation: multisets.Multiset[string].MakeUnionWith,
+			result:    map[string]int{"foo": 3, "bar": 4, "baz": 2, "qux": 1},
+		},
+		{
+			name:      "intersection",
+			operation: multisets.Multiset[string].MakeIntersectionWith,
+			result:    map[string]int{"foo": 1, "bar": 1},
+		},
+		{
+			name:      "sum",
+			operation: multisets.Multiset[string].MakeSumWith,
+			result:    map[string]int{"foo": 4, "bar": 5, "baz": 2, "qux": 1},
+		},
+		{
+			name:      "difference",
+			operation: multisets.Multiset[string].MakeDifferenceWith,
+			result:    map[string]int{"foo": 2, "baz": 2},
+		},
+	}
+
+	for _, test := range tests {
+		for _, first := range multisetConstructors {
+			for _, second := range multisetConstructors {
+				test := test
+				first := first
+				second := second
+				name := test.name + "/" + first.name + " with " + second.name
+
+				t.Run(name, func(t *testing.T) {
+					t.Parallel()
+					defer testCommon.HandlePanic(t, name)
+					result := test.operation(first.new(a), second.new(b))
+
+					assert.Equalf(t, test.result, toNativeMap(result), name)
+					assert.Equalf(t, sumCounts(test.result), result.Size(), name)
+				})
+			}
+		}
+	}
+}
+
+func TestMultisetContains(t *testing.T) {
+	for _, constructor := range multisetConstructors {
+		constructor := constructor
+
+		t.Run(constructor.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, constructor.name)
+			multiset := constructor.new(map[string]int{"foo": 2, "bar": 1})
+
+			assert.True(t, multiset.Contains())
+			assert.True(t, multiset.Contains("foo", "bar"))
+			assert.False(t, multiset.Contains("foo", "baz"))
+			assert.Equal(t, 0, multiset.Count("baz"))
+
+			multiset.Clear()
+			assert.True(t, multiset.IsEmpty())
+			assert.Equal(t, 0, multiset.DistinctSize())
+		})
+	}
+}
+
+func TestMultisetNewFromIterator(t *testing.T) {
+	for _, source := range multisetConstructors {
+		for _, target := range multisetConstructors {
+			source := source
+			target := target
+			name := source.name + " into " + target.name
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				defer testCommon.HandlePanic(t, name)
+				multiset := source.new(map[string]int{"foo": 2, "bar": 1})
+				copied := target.fromIterator(arraylist.NewFromSlice(multiset.GetValues()).Begin())
+
+				assert.Equalf(t, toNativeMap(multiset), toNativeMap(copied), name)
+			})
+		}
+	}
+}
+
+func TestMultisetSerialization(t *testing.T) {
+	counts := map[string]int{"foo": 2, "bar": 1}
+
+	for _, constructor := range multisetConstructors {
+		constructor := constructor
+
+		t.Run(constructor.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, constructor.name)
+			multiset := constructor.new(counts)
+
+			data, err := json.Marshal(multiset)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"foo":2,"bar":1}`, string(data))
+
+			deserialized := constructor.new(map[string]int{"qux": 4})
+			assert.NoError(t, json.Unmarshal(data, deserialized))
+
+			assert.Equal(t, counts, toNativeMap(deserialized))
+			assert.Equal(t, 3, deserialized.Size())
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemultiset
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/maps/treemap"
+)
+
+// Assert Iterator implementation
+var _ ds.ReadWriteOrdCompBidRandCollIterator[string, int] = (*OrderedIterator[string])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[string])(nil)
+
+// Assert ErasableIterator and KeyedInsertableIterator implementation
+var _ ds.ErasableIterator = (*OrderedIterator[string])(nil)
+var _ ds.KeyedInsertableIterator[string, int] = (*OrderedIterator[string])(nil)
+
+// OrderedIterator holding the iterator's state
+//
+// The iterator visits every distinct element once, its keys are the elements and its values are their counts.
+type OrderedIterator[T comparable] struct {
+	*treemap.OrderedIterator[T, int]
+	multiset *Multiset[T]
+}
+
+// NewOrderedIterator returns a stateful iterator whose counts can be fetched by an index or element.
+func (multiset *Multiset[T]) NewOrderedIterator(index int, size int) *OrderedIterator[T] {
+	return &OrderedIterator[T]{multiset.counts.NewOrderedIterator(index, size), multiset}
+}
+
+// Set updates the count of the current element, counts of 0 or less are rejected.
+func (it *OrderedIterator[T]) Set(count int) bool {
+	old, found := it.Get()
+	if !found || count <= 0 {
+		return false
+	}
+
+	it.OrderedIterator.Set(count)
+	it.multiset.size += count - old
+
+	return true
+}
+
+// SetAt updates the count of the element at index i, counts of 0 or less are rejected.
+func (it *OrderedIterator[T]) SetAt(i int, count int) bool {
+	old, found := it.GetAt(i)
+	if !found || count <= 0 {
+		return false
+	}
+
+	it.OrderedIterator.SetAt(i, count)
+	it.multiset.size += count - old
+
+	return true
+}
+
+// SetAtKey sets the count of element, counts of 0 or less are rejected.
+// Adding a new element invalidates the iterator.
+func (it *OrderedIterator[T]) SetAtKey(element T, count int) bool {
+	if count <= 0 {
+		return false
+	}
+
+	it.multiset.SetCount(element, count)
+
+	return true
+}
+
+// Remove removes all occurrences of the current element in O(log n) and moves the iterator to the next element.
+func (it *OrderedIterator[T]) Remove() bool {
+	count, found := it.Get()
+	if !found || !it.OrderedIterator.Remove() {
+		return false
+	}
+
+	it.multiset.size -= count
+
+	return true
+}
+
+// InsertBefore adds count occurrences of element before the current element or at the end in O(log n).
+// Returns false if element is not between the previous and the current element, if it is outside of the iterator's range
+// or if count is 0 or less.
+func (it *OrderedIterator[T]) InsertBefore(element T, count int) bool {
+	if count <= 0 || !it.OrderedIterator.InsertBefore(element, count) {
+		return false
+	}
+
+	it.multiset.size += count
+
+	return true
+}
+
+// InsertAfter adds count occurrences of element after the current element or at the start in O(log n).
+// Returns false if element is not between the current and the next element, if it is outside of the iterator's range
+// or if count is 0 or less.
+func (it *OrderedIterator[T]) InsertAfter(element T, count int) bool {
+	if count <= 0 || !it.OrderedIterator.InsertAfter(element, count) {
+		return false
+	}
+
+	it.multiset.size += count
+
+	return true
+}
+
+// NOTE: The following methods need to be reimplemented because of the type assertions they contain
+
+func (it *OrderedIterator[T]) DistanceTo(other ds.OrderedIterator) int {
+	otherThis, ok := other.(*OrderedIterator[T])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.OrderedIterator.DistanceTo(otherThis.OrderedIterator)
+}
+
+func (it *OrderedIterator[T]) IsAfter(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*OrderedIterator[T])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) > 0
+}
+
+func (it *OrderedIterator[T]) IsBefore(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*OrderedIterator[T])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) < 0
+}
+
+func (it *OrderedIterator[T]) IsEqual(other ds.ComparableIterator) bool {
+	otherThis, ok := other.(*OrderedIterator[T])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) == 0
+}
+
+// CanCompare checks if other is an iterator of the same multiset.
+func (it *OrderedIterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*OrderedIterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if it.OrderedIterator.CanCompare(otherThis.OrderedIterator) != nil {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemultiset
+
+import (
+	"encoding/json"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+)
+
+// Assert Serialization implementation
+var _ ds.JSONSerializer = (*Multiset[string])(nil)
+var _ ds.JSONDeserializer = (*Multiset[string])(nil)
+
+// ToJSON outputs the JSON representation of the multiset as an object mapping every element to its count.
+func (multiset *Multiset[T]) ToJSON() ([]byte, error) {
+	elements := make(map[T]int, multiset.DistinctSize())
+	for _, entry := range multiset.GetEntries() {
+		elements[entry.Value] = entry.Count
+	}
+
+	return json.Marshal(elements)
+}
+
+// FromJSON populates the multiset from the input JSON representation.
+func (multiset *Multiset[T]) FromJSON(data []byte) error {
+	elements := make(map[T]int)
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		multiset.Clear()
+		for element, count := range elements {
+			multiset.AddN(element, count)
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (multiset *Multiset[T]) UnmarshalJSON(bytes []byte) error {
+	return multiset.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (multiset *Multiset[T]) MarshalJSON() ([]byte, error) {
+	return multiset.ToJSON()
+}
@@ -0,0 +1,333 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package treemultiset implements a multiset backed by a red-black tree map from the distinct elements to their counts.
+//
+// Distinct elements are ordered by the comparator.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Multiset
+package treemultiset
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/maps/treemap"
+	"github.com/JonasMuehlmann/datastructures.go/multisets"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Multiset implementation
+var _ multisets.Multiset[string] = (*Multiset[string])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Multiset[string]] = (*Multiset[string])(nil)
+var _ ds.Equatable[*Multiset[string], string] = (*Multiset[string])(nil)
+
+// Multiset holds the counts of the elements in a red-black tree map
+type Multiset[T comparable] struct {
+	counts     *treemap.Map[T, int]
+	Comparator utils.Comparator[T]
+	size       int
+}
+
+// New instantiates a new empty multiset with the custom comparator and adds the passed values, if any, to the multiset.
+func New[T comparable](comparator utils.Comparator[T], values ...T) *Multiset[T] {
+	multiset := &Multiset[T]{counts: treemap.New[T, int](comparator), Comparator: comparator}
+	multiset.Add(values...)
+
+	return multiset
+}
+
+// NewFromSlice instantiates a new multiset containing every element of slice.
+func NewFromSlice[T comparable](comparator utils.Comparator[T], slice []T) *Multiset[T] {
+	return New(comparator, slice...)
+}
+
+// NewFromMap instantiates a new multiset containing every key of counts as often as its value.
+func NewFromMap[T comparable](comparator utils.Comparator[T], counts map[T]int) *Multiset[T] {
+	multiset := New(comparator)
+	for element, count := range counts {
+		multiset.AddN(element, count)
+	}
+
+	return multiset
+}
+
+// NewFromIterator instantiates a new multiset containing the elements provided by the passed iterator.
+func NewFromIterator[T comparable](comparator utils.Comparator[T], begin ds.ReadForIterator[T]) *Multiset[T] {
+	multiset := New(comparator)
+
+	for begin.Next() {
+		value, _ := begin.Get()
+		multiset.Add(value)
+	}
+
+	return multiset
+}
+
+// Add adds a single occurrence of every passed element.
+func (multiset *Multiset[T]) Add(elements ...T) {
+	for _, element := range elements {
+		multiset.AddN(element, 1)
+	}
+}
+
+// AddN adds n occurrences of element, n of 0 or less is ignored.
+func (multiset *Multiset[T]) AddN(element T, n int) {
+	if n <= 0 {
+		return
+	}
+
+	multiset.SetCount(element, multiset.Count(element)+n)
+}
+
+// Remove removes a single occurrence of every passed element.
+func (multiset *Multiset[T]) Remove(elements ...T) {
+	for _, element := range elements {
+		multiset.RemoveN(element, 1)
+	}
+}
+
+// RemoveN removes up to n occurrences of element.
+func (multiset *Multiset[T]) RemoveN(element T, n int) {
+	if n <= 0 {
+		return
+	}
+
+	multiset.SetCount(element, multiset.Count(element)-n)
+}
+
+// Count returns the number of occurrences of element.
+func (multiset *Multiset[T]) Count(element T) int {
+	count, _ := multiset.counts.Get(element)
+
+	return count
+}
+
+// SetCount sets the number of occurrences of element, a count of 0 or less removes it.
+func (multiset *Multiset[T]) SetCount(element T, count int) {
+	count = utils.Max(count, 0)
+	multiset.size += count - multiset.Count(element)
+
+	if count == 0 {
+		multiset.counts.Remove(multiset.Comparator, element)
+	} else {
+		multiset.counts.Put(element, count)
+	}
+}
+
+// Contains returns true if every passed element occurs at least once.
+// Returns true if no arguments are passed at all.
+func (multiset *Multiset[T]) Contains(elements ...T) bool {
+	for _, element := range elements {
+		if _, found := multiset.counts.Get(element); !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DistinctSize returns the number of distinct elements.
+func (multiset *Multiset[T]) DistinctSize() int {
+	return multiset.counts.Size()
+}
+
+// GetDistinctValues returns every distinct element once in sorted order.
+func (multiset *Multiset[T]) GetDistinctValues() []T {
+	return multiset.counts.GetKeys()
+}
+
+// GetEntries returns every distinct element and its count in sorted order.
+func (multiset *Multiset[T]) GetEntries() []multisets.Entry[T] {
+	entries := make([]multisets.Entry[T], 0, multiset.counts.Size())
+
+	it := multiset.counts.OrderedBegin(multiset.Comparator)
+	for it.Next() {
+		element, _ := it.GetKey()
+		count, _ := it.Get()
+		entries = append(entries, multisets.Entry[T]{Value: element, Count: count})
+	}
+
+	return entries
+}
+
+// MostCommon returns the k distinct elements with the highest counts, ordered by descending count.
+// Elements with equal counts are ordered by the comparator.
+// If k is negative or larger than the number of distinct elements, all elements are returned.
+func (multiset *Multiset[T]) MostCommon(k int) []multisets.Entry[T] {
+	entries := multiset.GetEntries()
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Count > entries[j].Count
+	})
+
+	if k >= 0 && k < len(entries) {
+		entries = entries[:k]
+	}
+
+	return entries
+}
+
+// MakeUnionWith returns a multiset, in which every element occurs as often as in the multiset or in other, whichever is more.
+func (multiset *Multiset[T]) MakeUnionWith(other multisets.Multiset[T]) multisets.Multiset[T] {
+	result := New(multiset.Comparator)
+
+	for _, entry := range multiset.GetEntries() {
+		result.AddN(entry.Value, utils.Max(entry.Count, other.Count(entry.Value)))
+	}
+	for _, element := range other.GetDistinctValues() {
+		if !result.Contains(element) {
+			result.AddN(element, other.Count(element))
+		}
+	}
+
+	return result
+}
+
+// MakeIntersectionWith returns a multiset, in which every element occurs as often as in the multiset or in other, whichever is less.
+func (multiset *Multiset[T]) MakeIntersectionWith(other multisets.Multiset[T]) multisets.Multiset[T] {
+	result := New(multiset.Comparator)
+
+	for _, entry := range multiset.GetEntries() {
+		result.AddN(entry.Value, utils.Min(entry.Count, other.Count(entry.Value)))
+	}
+
+	return result
+}
+
+// MakeSumWith returns a multiset, in which every element occurs as often as in the multiset and in other combined.
+func (multiset *Multiset[T]) MakeSumWith(other multisets.Multiset[T]) multisets.Multiset[T] {
+	result := New(multiset.Comparator)
+
+	for _, entry := range multiset.GetEntries() {
+		result.AddN(entry.Value, entry.Count)
+	}
+	for _, element := range other.GetDistinctValues() {
+		result.AddN(element, other.Count(element))
+	}
+
+	return result
+}
+
+// MakeDifferenceWith returns a multiset, in which every element occurs as often as in the multiset minus its occurrences in other, if that is positive.
+func (multiset *Multiset[T]) MakeDifferenceWith(other multisets.Multiset[T]) multisets.Multiset[T] {
+	result := New(multiset.Comparator)
+
+	for _, entry := range multiset.GetEntries() {
+		result.AddN(entry.Value, entry.Count-other.Count(entry.Value))
+	}
+
+	return result
+}
+
+// IsEmpty returns true if the multiset does not contain any elements.
+func (multiset *Multiset[T]) IsEmpty() bool {
+	return multiset.size == 0
+}
+
+// Size returns the number of occurrences of all elements.
+func (multiset *Multiset[T]) Size() int {
+	return multiset.size
+}
+
+// Clear removes all elements from the multiset.
+func (multiset *Multiset[T]) Clear() {
+	multiset.counts.Clear()
+	multiset.size = 0
+}
+
+// GetValues returns every occurrence of every element in sorted order.
+func (multiset *Multiset[T]) GetValues() []T {
+	values := make([]T, 0, multiset.size)
+	for _, entry := range multiset.GetEntries() {
+		for i := 0; i < entry.Count; i++ {
+			values = append(values, entry.Value)
+		}
+	}
+
+	return values
+}
+
+// ToString returns a string representation of container
+func (multiset *Multiset[T]) ToString() string {
+	str := "TreeMultiset\n"
+	items := []string{}
+	for _, entry := range multiset.GetEntries() {
+		items = append(items, fmt.Sprintf("%v:%v", entry.Value, entry.Count))
+	}
+	str += strings.Join(items, ", ")
+
+	return str
+}
+
+// Clone returns a copy of the multiset, which does not share any nodes with the original.
+func (multiset *Multiset[T]) Clone() *Multiset[T] {
+	return &Multiset[T]{
+		counts:     multiset.counts.Clone(),
+		Comparator: multiset.Comparator,
+		size:       multiset.size,
+	}
+}
+
+// Equals returns true if every element occurs equally often in both multisets.
+// Elements are compared with the multiset's comparator, so the comparator is not used and may be nil.
+func (multiset *Multiset[T]) Equals(other *Multiset[T], _ utils.Comparator[T]) bool {
+	return multiset.size == other.size && multiset.counts.Equals(other.counts, utils.BasicComparator[int])
+}
+
+//******************************************************************//
+//                         Ordered iterator                         //
+//******************************************************************//
+
+// OrderedBegin returns an initialized iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (multiset *Multiset[T]) OrderedBegin(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[T, int] {
+	return multiset.NewOrderedIterator(-1, multiset.DistinctSize())
+}
+
+// OrderedEnd returns an initialized iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (multiset *Multiset[T]) OrderedEnd(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[T, int] {
+	return multiset.NewOrderedIterator(multiset.DistinctSize(), multiset.DistinctSize())
+}
+
+// OrderedFirst returns an initialized iterator, which points to it's first element.
+func (multiset *Multiset[T]) OrderedFirst(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[T, int] {
+	return multiset.NewOrderedIterator(0, multiset.DistinctSize())
+}
+
+// OrderedLast returns an initialized iterator, which points to it's last element.
+func (multiset *Multiset[T]) OrderedLast(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[T, int] {
+	return multiset.NewOrderedIterator(multiset.DistinctSize()-1, multiset.DistinctSize())
+}
+
+// All returns a sequence over the distinct elements of the multiset and their counts in order.
+func (multiset *Multiset[T]) All() iter.Seq2[T, int] {
+	return multiset.counts.All()
+}
+
+// Backward returns a sequence over the distinct elements of the multiset and their counts in reverse order.
+func (multiset *Multiset[T]) Backward() iter.Seq2[T, int] {
+	return multiset.counts.Backward()
+}
+
+// Values returns a sequence over the elements of the multiset in order, where each element is repeated according to its count.
+func (multiset *Multiset[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for element, count := range multiset.All() {
+			for i := 0; i < count; i++ {
+				if !yield(element) {
+					return
+				}
+			}
+		}
+	}
+}
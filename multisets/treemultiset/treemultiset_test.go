@@ -0,0 +1,169 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemultiset
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/multisets"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMostCommon(t *testing.T) {
+	multiset := New(utils.BasicComparator[string], "d", "b", "b", "a", "c", "c", "c", "a")
+
+	tests := []struct {
+		name    string
+		k       int
+		entries []multisets.Entry[string]
+	}{
+		{
+			name:    "none",
+			k:       0,
+			entries: []multisets.Entry[string]{},
+		},
+		{
+			name:    "ties ordered by comparator",
+			k:       3,
+			entries: []multisets.Entry[string]{{Value: "c", Count: 3}, {Value: "a", Count: 2}, {Value: "b", Count: 2}},
+		},
+		{
+			name:    "more than contained",
+			k:       10,
+			entries: []multisets.Entry[string]{{Value: "c", Count: 3}, {Value: "a", Count: 2}, {Value: "b", Count: 2}, {Value: "d", Count: 1}},
+		},
+		{
+			name:    "negative",
+			k:       -1,
+			entries: []multisets.Entry[string]{{Value: "c", Count: 3}, {Value: "a", Count: 2}, {Value: "b", Count: 2}, {Value: "d", Count: 1}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.entries, multiset.MostCommon(test.k), test.name)
+		})
+	}
+}
+
+func TestOrderedIterator(t *testing.T) {
+	multiset := New(utils.BasicComparator[string], "c", "a", "b", "a")
+	assert.Equal(t, []string{"a", "a", "b", "c"}, multiset.GetValues())
+
+	elements := []string{}
+	counts := []int{}
+
+	it := multiset.OrderedBegin(utils.BasicComparator[string])
+	for it.Next() {
+		element, _ := it.GetKey()
+		count, _ := it.Get()
+		elements = append(elements, element)
+		counts = append(counts, count)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, elements)
+	assert.Equal(t, []int{2, 1, 1}, counts)
+
+	it = multiset.OrderedFirst(utils.BasicComparator[string])
+	assert.True(t, it.Set(5))
+	assert.False(t, it.Set(0))
+	assert.True(t, it.SetAt(2, 3))
+	assert.True(t, it.SetAtKey("d", 2))
+	assert.False(t, it.SetAtKey("a", -1))
+
+	assert.Equal(t, 5, multiset.Count("a"))
+	assert.Equal(t, 3, multiset.Count("c"))
+	assert.Equal(t, 2, multiset.Count("d"))
+	assert.Equal(t, 11, multiset.Size())
+
+	assert.Panics(t, func() { it.IsEqual(multiset.counts.OrderedBegin(nil)) })
+	assert.True(t, it.IsBefore(multiset.OrderedLast(nil)))
+}
+
+func TestOrderedIteratorRemoveAndInsert(t *testing.T) {
+	multiset := New(utils.BasicComparator[string], "a", "b", "b", "c", "c", "c")
+	it := multiset.OrderedFirst(utils.BasicComparator[string]).(*OrderedIterator[string])
+
+	assert.True(t, it.Next())
+	assert.True(t, it.Remove())
+	assert.Equal(t, 4, multiset.Size())
+	assert.Equal(t, []string{"a", "c", "c", "c"}, multiset.GetValues())
+
+	element, _ := it.GetKey()
+	assert.Equal(t, "c", element)
+
+	assert.False(t, it.InsertBefore("b", 0))
+	assert.False(t, it.InsertBefore("b", -1))
+	assert.True(t, it.InsertBefore("b", 2))
+	assert.False(t, it.InsertAfter("d", 0))
+	assert.True(t, it.InsertAfter("d", 1))
+
+	assert.Equal(t, 7, multiset.Size())
+	assert.Equal(t, []string{"a", "b", "b", "c", "c", "c", "d"}, multiset.GetValues())
+
+	erasable := multiset.OrderedEnd(utils.BasicComparator[string]).(ds.ErasableIterator)
+	assert.False(t, erasable.Remove())
+	assert.Equal(t, 7, multiset.Size())
+}
+
+func TestCloneAndEquals(t *testing.T) {
+	multiset := New(utils.BasicComparator[string], "foo", "bar", "foo")
+	clone := multiset.Clone()
+
+	assert.True(t, clone.Equals(multiset, nil))
+	assert.Equal(t, multiset.GetValues(), clone.GetValues())
+	assert.True(t, New(utils.BasicComparator[string], "bar", "foo", "foo").Equals(multiset, nil))
+	assert.False(t, New(utils.BasicComparator[string], "bar", "bar", "foo").Equals(multiset, nil))
+
+	// Modifying the clone does not modify the multiset
+	clone.Add("foo")
+	assert.False(t, clone.Equals(multiset, nil))
+	assert.Equal(t, 2, multiset.Count("foo"))
+	assert.Equal(t, 3, multiset.Size())
+}
+
+func TestSequences(t *testing.T) {
+	multiset := New(utils.BasicComparator[string], "b", "a", "b")
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range multiset.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+	assert.Equal(t, []int{1, 2}, values)
+
+	keys = []string{}
+	values = []int{}
+	for key, value := range multiset.Backward() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"b", "a"}, keys)
+	assert.Equal(t, []int{2, 1}, values)
+
+	assert.Equal(t, []string{"a", "b", "b"}, slices.Collect(multiset.Values()))
+
+	keys = []string{}
+	for key := range multiset.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
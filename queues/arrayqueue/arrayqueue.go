@@ -11,16 +11,22 @@ package arrayqueue
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
 	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
 	"github.com/JonasMuehlmann/datastructures.go/queues"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
 )
 
 // Assert Queue implementation
 var _ queues.Queue[any] = (*Queue[any])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Queue[any]] = (*Queue[any])(nil)
+var _ ds.Equatable[*Queue[any], any] = (*Queue[any])(nil)
+
 // Queue holds elements in an array-list
 type Queue[T any] struct {
 	list *arraylist.List[T]
@@ -44,6 +50,7 @@ func NewFromIterator[T any](begin ds.ReadForIterator[T]) *Queue[T] {
 		length = sizedIterator.Size()
 	}
 
+	length = utils.Max(length, 0)
 	elements := make([]T, 0, length)
 
 	for begin.Next() {
@@ -133,6 +140,16 @@ func (queue *Queue[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the queue, which does not share any elements with the original.
+func (queue *Queue[T]) Clone() *Queue[T] {
+	return &Queue[T]{list: queue.list.Clone()}
+}
+
+// Equals returns true if both queues hold equal values in the same order.
+func (queue *Queue[T]) Equals(other *Queue[T], comparator utils.Comparator[T]) bool {
+	return queue.list.Equals(other.list, comparator)
+}
+
 // Check that the index is within bounds of the list
 func (queue *Queue[T]) withinRange(index int) bool {
 	return index >= 0 && index < queue.list.Size()
@@ -163,3 +180,62 @@ func (queue *Queue[T]) First() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 func (queue *Queue[T]) Last() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 	return queue.NewIterator(queue.list.Size()-1, queue.Size())
 }
+
+//******************************************************************//
+//                         Reverse iterator                         //
+//******************************************************************//
+
+// ReverseBegin returns an initialized, reversed iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (queue *Queue[T]) ReverseBegin() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(queue.End())
+}
+
+// ReverseEnd returns an initialized, reversed iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (queue *Queue[T]) ReverseEnd() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(queue.Begin())
+}
+
+// ReverseFirst returns an initialized, reversed iterator, which points to it's first element.
+func (queue *Queue[T]) ReverseFirst() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(queue.Last())
+}
+
+// ReverseLast returns an initialized, reversed iterator, which points to it's last element.
+func (queue *Queue[T]) ReverseLast() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(queue.First())
+}
+
+// All returns a sequence over the index/value pairs of the queue in iteration order.
+func (queue *Queue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range ds.Seq(queue.Begin()) {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns a sequence over the index/value pairs of the queue in reverse iteration order.
+func (queue *Queue[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := queue.Size() - 1
+		for value := range ds.BackwardSeq(queue.End()) {
+			if !yield(i, value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// Values returns a sequence over the values of the queue in iteration order.
+func (queue *Queue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(queue.Begin())(yield)
+	}
+}
@@ -6,12 +6,13 @@
 package arrayqueue
 
 import (
+	"slices"
 	"testing"
 
-	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/ds"
-
+	"github.com/JonasMuehlmann/datastructures.go/iterators"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -371,6 +372,40 @@ func TestArrayQueueNewFromIterator(t *testing.T) {
 
 }
 
+func TestArrayQueueNewFromUnsizedIterator(t *testing.T) {
+	values := []int{1, 2, 3, 4}
+
+	tests := []struct {
+		name     string
+		iterator ds.ReadForIterator[int]
+		values   []int
+	}{
+		{
+			name:     "filtered",
+			iterator: iterators.Filter[int](ds.NewSeqIterator(slices.Values(values)), func(value int) bool { return value%2 == 0 }),
+			values:   []int{2, 4},
+		},
+		{
+			name:     "sequence",
+			iterator: ds.NewSeqIterator(slices.Values(values)),
+			values:   values,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			newQueue := NewFromIterator[int](test.iterator)
+
+			assert.Equalf(t, New[int](test.values...).GetValues(), newQueue.GetValues(), test.name)
+		})
+	}
+}
+
 // NOTE: Missing test case: unordered iterator, which prevents preallocation
 func TestArrayQueueNewFromIterators(t *testing.T) {
 	tests := []struct {
@@ -467,3 +502,125 @@ func BenchmarkArrayQueueDequeue(b *testing.B) {
 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 	}
 }
+
+func TestArrayQueueClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Queue[string]
+	}{
+		{
+			name:     "empty",
+			original: NewFromSlice[string]([]string{}),
+		},
+		{
+			name:     "3 items",
+			original: NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			values := test.original.GetValues()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+
+			clone.Enqueue("qux")
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+		})
+	}
+}
+
+func TestArrayQueueEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Queue[string]
+		b     *Queue[string]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     NewFromSlice[string]([]string{}),
+			b:     NewFromSlice[string]([]string{}),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			b:     NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			equal: true,
+		},
+		{
+			name:  "different order",
+			a:     NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			b:     NewFromSlice[string]([]string{"baz", "bar", "foo"}),
+			equal: false,
+		},
+		{
+			name:  "different values",
+			a:     NewFromSlice[string]([]string{"foo", "bar"}),
+			b:     NewFromSlice[string]([]string{"foo", "qux"}),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     NewFromSlice[string]([]string{"foo"}),
+			b:     NewFromSlice[string]([]string{"foo", "bar"}),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[string]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	queue := New[int](1, 2, 3)
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range queue.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	indices = []int{}
+	values = []int{}
+	for i, value := range queue.Backward() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{2, 1, 0}, indices)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(queue.Values()))
+
+	values = []int{}
+	for value := range queue.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
@@ -13,6 +13,12 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[int, any] = (*Iterator[any])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*Iterator[any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*Iterator[any])(nil)
+
 // Iterator holding the iterator's state
 type Iterator[T any] struct {
 	*arraylist.Iterator[T]
@@ -25,6 +31,8 @@ func (list *Queue[T]) NewIterator(index int, size int) *Iterator[T] {
 
 // If other is of type IndexedIterator, IndexedIterator.Index() will be used, possibly executing in O(1)
 func (it *Iterator[T]) DistanceTo(other ds.OrderedIterator) int {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -37,6 +45,8 @@ func (it *Iterator[T]) DistanceTo(other ds.OrderedIterator) int {
 }
 
 func (it *Iterator[T]) IsAfter(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -46,6 +56,8 @@ func (it *Iterator[T]) IsAfter(other ds.OrderedIterator) bool {
 }
 
 func (it *Iterator[T]) IsBefore(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -55,6 +67,8 @@ func (it *Iterator[T]) IsBefore(other ds.OrderedIterator) bool {
 }
 
 func (it *Iterator[T]) IsEqual(other ds.ComparableIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -63,6 +77,20 @@ func (it *Iterator[T]) IsEqual(other ds.ComparableIterator) bool {
 	return it.DistanceTo(otherThis) == 0
 }
 
+// CanCompare checks if other is an iterator of the same queue.
+func (it *Iterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := ds.BaseOf[int, T](other).(*Iterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if it.Iterator.CanCompare(otherThis.Iterator) != nil {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 // // Iterator holding the iterator's state
 // type Iterator[T any] struct {
 // 	stack *Queue[T]
@@ -1,6 +1,7 @@
 package arrayqueue
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
@@ -1081,3 +1082,177 @@ func TestArrayQueueIteratorSize(t *testing.T) {
 		})
 	}
 }
+
+func TestArrayQueueReverseIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		container *Queue[int]
+	}{
+		{
+			name:      "Empty",
+			container: New[int](),
+		},
+		{
+			name:      "One element",
+			container: New[int](1),
+		},
+		{
+			name:      "3 elements",
+			container: New[int](1, 2, 3),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			values := []int{}
+			for it := test.container.Begin(); it.Next(); {
+				value, _ := it.Get()
+				values = append(values, value)
+			}
+
+			reversed := []int{}
+			for it := test.container.ReverseBegin(); it.Next(); {
+				value, _ := it.Get()
+				reversed = append(reversed, value)
+			}
+
+			backward := []int{}
+			for it := test.container.ReverseEnd(); it.Previous(); {
+				value, _ := it.Get()
+				backward = append(backward, value)
+			}
+
+			assert.Equalf(t, values, backward, test.name)
+
+			slices.Reverse(values)
+			assert.Equalf(t, values, reversed, test.name)
+
+			first, found := test.container.ReverseFirst().Get()
+			last, _ := test.container.ReverseLast().Get()
+			assert.Equalf(t, len(values) > 0, found, test.name)
+
+			if len(values) > 0 {
+				assert.Equalf(t, values[0], first, test.name)
+				assert.Equalf(t, values[len(values)-1], last, test.name)
+			}
+		})
+	}
+}
+
+func TestArrayQueueReverseIteratorAfterDequeue(t *testing.T) {
+	queue := New[int](1, 2, 3)
+	queue.Dequeue()
+	queue.Enqueue(4)
+
+	// The reversed iterator starts at the most recently enqueued element
+	it := queue.ReverseFirst()
+	value, _ := it.Get()
+	assert.Equal(t, 4, value)
+
+	index, _ := it.Index()
+	assert.Equal(t, queue.Size()-1, index)
+
+	values := []int{}
+	for it := queue.ReverseBegin(); it.Next(); {
+		value, _ := it.Get()
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{4, 3, 2}, values)
+
+	// Indices are relative to the current front, not to the dequeued elements
+	assert.True(t, it.MoveTo(0))
+	value, _ = it.Get()
+	front, _ := queue.Peek()
+	assert.Equal(t, front, value)
+
+	assert.True(t, it.SetAt(1, 30))
+	assert.Equal(t, []int{2, 30, 4}, queue.GetValues())
+}
+
+func TestArrayQueueReverseIteratorCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		it       func(*Queue[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+		other    func(*Queue[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+		distance int
+	}{
+		{
+			name:     "Back to front, reversed",
+			it:       (*Queue[int]).ReverseFirst,
+			other:    (*Queue[int]).ReverseLast,
+			distance: -2,
+		},
+		{
+			name:     "Reversed back to forward front",
+			it:       (*Queue[int]).ReverseFirst,
+			other:    (*Queue[int]).First,
+			distance: 2,
+		},
+		{
+			name:     "Reversed front to forward front",
+			it:       (*Queue[int]).ReverseLast,
+			other:    (*Queue[int]).First,
+			distance: 0,
+		},
+		{
+			name:     "Reversed begin to forward back",
+			it:       (*Queue[int]).ReverseBegin,
+			other:    (*Queue[int]).Last,
+			distance: 1,
+		},
+		{
+			name:     "Reversed end to forward front",
+			it:       (*Queue[int]).ReverseEnd,
+			other:    (*Queue[int]).First,
+			distance: -1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			// Dequeuing moves the front, so indices must not depend on the dequeued elements
+			queue := New[int](0, 1, 2)
+			queue.Dequeue()
+			queue.Enqueue(3)
+
+			it := test.it(queue)
+			other := test.other(queue)
+
+			distance, err := ds.CheckedDistanceTo(it, other)
+			assert.NoErrorf(t, err, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+			assert.Equalf(t, -test.distance, other.DistanceTo(it), test.name)
+			assert.Equalf(t, test.distance < 0, it.IsBefore(other), test.name)
+			assert.Equalf(t, test.distance > 0, other.IsBefore(it), test.name)
+			assert.Equalf(t, test.distance == 0, other.IsEqual(it), test.name)
+		})
+	}
+
+	queue := New[int](1, 2, 3)
+	other := queue.Clone()
+	assert.ErrorIs(t, ds.CanCompare(queue.First(), other.ReverseFirst()), ds.ErrForeignIterators)
+	assert.ErrorIs(t, ds.CanCompare(queue.ReverseFirst(), other.First()), ds.ErrForeignIterators)
+}
+
+func TestArrayQueueReverseIteratorFailFast(t *testing.T) {
+	queue := New[int](1, 2, 3)
+	it := queue.ReverseFirst()
+
+	// Enqueuing happens at the reversed iterator's start, so it is invalidated as well as by dequeuing
+	queue.Enqueue(4)
+
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Previous())
+}
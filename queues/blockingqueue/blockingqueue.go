@@ -0,0 +1,336 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package blockingqueue implements a bounded queue, which blocks producers while it is full and consumers while it is empty.
+//
+// The queue is backed by an array queue and is safe for concurrent use by multiple goroutines.
+// Blocking operations can be cancelled with a context or a timeout.
+// After the queue is closed, no more elements can be added, but the remaining elements can still be taken.
+//
+// Reference: https://en.wikipedia.org/wiki/Producer%E2%80%93consumer_problem
+package blockingqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/queues"
+	"github.com/JonasMuehlmann/datastructures.go/queues/arrayqueue"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Queue implementation
+var _ queues.Queue[any] = (*Queue[any])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Queue[any]] = (*Queue[any])(nil)
+var _ ds.Equatable[*Queue[any], any] = (*Queue[any])(nil)
+
+// ErrClosed is returned when adding to a closed queue or taking from a closed and drained queue.
+var ErrClosed = errors.New("queue is closed")
+
+// Queue holds elements in an array queue, whose size is limited by capacity.
+type Queue[T any] struct {
+	mutex    sync.Mutex
+	queue    *arrayqueue.Queue[T]
+	capacity int
+	closed   bool
+	// changed is closed and replaced whenever elements are added or removed or the queue is closed,
+	// waking up all goroutines waiting for a change.
+	changed chan struct{}
+}
+
+// New instantiates a new empty queue, which can hold at most capacity elements.
+func New[T any](capacity int) *Queue[T] {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+
+	return &Queue[T]{queue: arrayqueue.New[T](), capacity: capacity, changed: make(chan struct{})}
+}
+
+// Put adds a value to the end of the queue, blocking until space is available.
+// Returns ctx.Err() if ctx is done before space becomes available and ErrClosed if the queue is closed.
+func (queue *Queue[T]) Put(ctx context.Context, value T) error {
+	for {
+		queue.mutex.Lock()
+
+		if queue.closed {
+			queue.mutex.Unlock()
+
+			return ErrClosed
+		}
+
+		if queue.queue.Size() < queue.capacity {
+			queue.queue.Enqueue(value)
+			queue.notifyLocked()
+			queue.mutex.Unlock()
+
+			return nil
+		}
+
+		changed := queue.changed
+		queue.mutex.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Take removes the first element of the queue and returns it, blocking until an element is available.
+// Returns ctx.Err() if ctx is done before an element becomes available and ErrClosed if the queue is closed and drained.
+func (queue *Queue[T]) Take(ctx context.Context) (value T, err error) {
+	for {
+		queue.mutex.Lock()
+
+		if v, ok := queue.queue.Dequeue(); ok {
+			queue.notifyLocked()
+			queue.mutex.Unlock()
+
+			return v, nil
+		}
+
+		if queue.closed {
+			queue.mutex.Unlock()
+
+			return value, ErrClosed
+		}
+
+		changed := queue.changed
+		queue.mutex.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return value, ctx.Err()
+		}
+	}
+}
+
+// Offer adds a value to the end of the queue, waiting at most timeout for space to become available.
+// Returns true if the value was added, otherwise false.
+func (queue *Queue[T]) Offer(value T, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return queue.Put(ctx, value) == nil
+}
+
+// Poll removes the first element of the queue and returns it, waiting at most timeout for an element to become available.
+// Second return parameter is true if an element was removed, otherwise false.
+func (queue *Queue[T]) Poll(timeout time.Duration) (value T, ok bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	value, err := queue.Take(ctx)
+
+	return value, err == nil
+}
+
+// DrainTo removes up to n elements from the front of the queue without blocking and returns them.
+// All elements are removed if n is negative.
+func (queue *Queue[T]) DrainTo(n int) []T {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if n < 0 || n > queue.queue.Size() {
+		n = queue.queue.Size()
+	}
+
+	values := make([]T, 0, n)
+	for i := 0; i < n; i++ {
+		value, _ := queue.queue.Dequeue()
+		values = append(values, value)
+	}
+
+	if n > 0 {
+		queue.notifyLocked()
+	}
+
+	return values
+}
+
+// Close closes the queue, after which no more elements can be added.
+// Goroutines blocked in Put return ErrClosed, the remaining elements can still be taken.
+// Closing an already closed queue does nothing.
+func (queue *Queue[T]) Close() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if !queue.closed {
+		queue.closed = true
+		queue.notifyLocked()
+	}
+}
+
+// IsClosed returns true if the queue was closed.
+func (queue *Queue[T]) IsClosed() bool {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.closed
+}
+
+// Enqueue adds a value to the end of the queue without blocking.
+// The value is dropped if the queue is full or closed, use Put or Offer to wait for space instead.
+func (queue *Queue[T]) Enqueue(value T) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if queue.closed || queue.queue.Size() == queue.capacity {
+		return
+	}
+
+	queue.queue.Enqueue(value)
+	queue.notifyLocked()
+}
+
+// Dequeue removes first element of the queue and returns it without blocking, use Take or Poll to wait for an element instead.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue[T]) Dequeue() (value T, ok bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	value, ok = queue.queue.Dequeue()
+	if ok {
+		queue.notifyLocked()
+	}
+
+	return
+}
+
+// Peek returns first element of the queue without removing it.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) Peek() (value T, ok bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.queue.Peek()
+}
+
+// Empty returns true if queue does not contain any elements.
+func (queue *Queue[T]) IsEmpty() bool {
+	return queue.Size() == 0
+}
+
+// Full returns true if the queue holds capacity elements.
+func (queue *Queue[T]) Full() bool {
+	return queue.Size() == queue.capacity
+}
+
+// Size returns number of elements within the queue.
+func (queue *Queue[T]) Size() int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.queue.Size()
+}
+
+// Capacity returns the maximum number of elements the queue can hold.
+func (queue *Queue[T]) Capacity() int {
+	return queue.capacity
+}
+
+// RemainingCapacity returns the number of elements, which can be added without blocking.
+func (queue *Queue[T]) RemainingCapacity() int {
+	return queue.capacity - queue.Size()
+}
+
+// Clear removes all elements from the queue.
+func (queue *Queue[T]) Clear() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	queue.queue.Clear()
+	queue.notifyLocked()
+}
+
+// Values returns all elements in the queue (FIFO order).
+func (queue *Queue[T]) GetValues() []T {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.queue.GetValues()
+}
+
+// String returns a string representation of container
+func (queue *Queue[T]) ToString() string {
+	str := "BlockingQueue\n"
+	values := []string{}
+	for _, value := range queue.GetValues() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Clone returns a copy of the queue with the same capacity and closed state, which does not share any elements with the original.
+// Goroutines blocked on the original queue are not affected by the clone.
+func (queue *Queue[T]) Clone() *Queue[T] {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return &Queue[T]{queue: queue.queue.Clone(), capacity: queue.capacity, closed: queue.closed, changed: make(chan struct{})}
+}
+
+// Equals returns true if both queues have the same capacity and hold equal values in the same order.
+// The queues are locked one after another, so the result may be outdated, if either is modified concurrently.
+func (queue *Queue[T]) Equals(other *Queue[T], comparator utils.Comparator[T]) bool {
+	otherValues := other.GetValues()
+
+	return queue.capacity == other.capacity && utils.SlicesEqual(queue.GetValues(), otherValues, comparator)
+}
+
+// notifyLocked wakes up all goroutines waiting for a change of the queue.
+// Must be called while holding the mutex.
+func (queue *Queue[T]) notifyLocked() {
+	close(queue.changed)
+	queue.changed = make(chan struct{})
+}
+
+// All returns a sequence over the index/value pairs of the queue in index order.
+// The values are copied while holding the lock once ranging starts, the lock is not held while yielding.
+func (queue *Queue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, value := range queue.GetValues() {
+			if !yield(i, value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence over the index/value pairs of the queue in reverse index order.
+// The values are copied while holding the lock once ranging starts, the lock is not held while yielding.
+func (queue *Queue[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		values := queue.GetValues()
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(i, values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the queue in index order.
+// The values are copied while holding the lock once ranging starts, the lock is not held while yielding.
+func (queue *Queue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range queue.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
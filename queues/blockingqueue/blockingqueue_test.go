@@ -0,0 +1,420 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockingqueue
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFilled(capacity int, values ...int) *Queue[int] {
+	queue := New[int](capacity)
+	for _, value := range values {
+		queue.Enqueue(value)
+	}
+
+	return queue
+}
+
+func TestBlockingQueuePut(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalQueue *Queue[int]
+		closed        bool
+		err           error
+		newItems      []int
+	}{
+		{
+			name:          "empty queue",
+			originalQueue: New[int](1),
+			err:           nil,
+			newItems:      []int{1},
+		},
+		{
+			name:          "full queue",
+			originalQueue: newFilled(2, 5, 6),
+			err:           context.DeadlineExceeded,
+			newItems:      []int{5, 6},
+		},
+		{
+			name:          "closed queue",
+			originalQueue: New[int](1),
+			closed:        true,
+			err:           ErrClosed,
+			newItems:      []int{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			if test.closed {
+				test.originalQueue.Close()
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			err := test.originalQueue.Put(ctx, 1)
+
+			assert.ErrorIsf(t, err, test.err, test.name)
+			assert.Equalf(t, test.newItems, test.originalQueue.GetValues(), test.name)
+		})
+	}
+}
+
+func TestBlockingQueueTake(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalQueue *Queue[int]
+		closed        bool
+		value         int
+		err           error
+	}{
+		{
+			name:          "empty queue",
+			originalQueue: New[int](1),
+			err:           context.DeadlineExceeded,
+		},
+		{
+			name:          "2 items",
+			originalQueue: newFilled(2, 5, 6),
+			value:         5,
+			err:           nil,
+		},
+		{
+			name:          "closed queue with items",
+			originalQueue: newFilled(2, 5, 6),
+			closed:        true,
+			value:         5,
+			err:           nil,
+		},
+		{
+			name:          "closed and drained queue",
+			originalQueue: New[int](1),
+			closed:        true,
+			err:           ErrClosed,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			if test.closed {
+				test.originalQueue.Close()
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			value, err := test.originalQueue.Take(ctx)
+
+			assert.ErrorIsf(t, err, test.err, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+		})
+	}
+}
+
+func TestBlockingQueueOfferPoll(t *testing.T) {
+	queue := New[int](1)
+
+	assert.True(t, queue.Offer(1, time.Millisecond))
+	assert.False(t, queue.Offer(2, time.Millisecond))
+
+	value, ok := queue.Poll(time.Millisecond)
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	_, ok = queue.Poll(time.Millisecond)
+	assert.False(t, ok)
+}
+
+func TestBlockingQueueDrainTo(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalQueue *Queue[int]
+		n             int
+		drained       []int
+		newItems      []int
+	}{
+		{
+			name:          "empty queue",
+			originalQueue: New[int](1),
+			n:             1,
+			drained:       []int{},
+			newItems:      []int{},
+		},
+		{
+			name:          "fewer than n items",
+			originalQueue: newFilled(3, 1, 2),
+			n:             3,
+			drained:       []int{1, 2},
+			newItems:      []int{},
+		},
+		{
+			name:          "more than n items",
+			originalQueue: newFilled(3, 1, 2, 3),
+			n:             2,
+			drained:       []int{1, 2},
+			newItems:      []int{3},
+		},
+		{
+			name:          "negative n",
+			originalQueue: newFilled(3, 1, 2, 3),
+			n:             -1,
+			drained:       []int{1, 2, 3},
+			newItems:      []int{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			drained := test.originalQueue.DrainTo(test.n)
+
+			assert.Equalf(t, test.drained, drained, test.name)
+			assert.Equalf(t, test.newItems, test.originalQueue.GetValues(), test.name)
+		})
+	}
+}
+
+func TestBlockingQueueBlocksUntilAvailable(t *testing.T) {
+	queue := New[int](1)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		value, err := queue.Take(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, value)
+
+		value, err = queue.Take(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, value)
+	}()
+
+	assert.NoError(t, queue.Put(context.Background(), 1))
+	assert.NoError(t, queue.Put(context.Background(), 2))
+
+	<-done
+	assert.True(t, queue.IsEmpty())
+}
+
+func TestBlockingQueueEnqueueDequeueDoNotBlock(t *testing.T) {
+	queue := newFilled(2, 1, 2)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		queue.Enqueue(3)
+
+		value, ok := queue.Dequeue()
+		assert.True(t, ok)
+		assert.Equal(t, 1, value)
+
+		value, ok = queue.Dequeue()
+		assert.True(t, ok)
+		assert.Equal(t, 2, value)
+
+		_, ok = queue.Dequeue()
+		assert.False(t, ok)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Enqueue or Dequeue blocked")
+	}
+
+	assert.True(t, queue.IsEmpty())
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	queue := newFilled(1, 1)
+
+	putErr := make(chan error)
+	go func() {
+		putErr <- queue.Put(context.Background(), 2)
+	}()
+
+	queue.Close()
+	queue.Close()
+
+	assert.ErrorIs(t, <-putErr, ErrClosed)
+	assert.True(t, queue.IsClosed())
+
+	queue.Enqueue(3)
+	assert.Equal(t, []int{1}, queue.GetValues())
+
+	value, err := queue.Take(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	_, err = queue.Take(context.Background())
+	assert.ErrorIs(t, err, ErrClosed)
+}
+
+func TestBlockingQueueProducerConsumer(t *testing.T) {
+	queue := New[int](4)
+
+	var producers sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		producers.Add(1)
+		go func(i int) {
+			defer producers.Done()
+
+			for j := 0; j < 100; j++ {
+				assert.NoError(t, queue.Put(context.Background(), i*100+j))
+			}
+		}(i)
+	}
+
+	results := make(chan []int)
+	for i := 0; i < 4; i++ {
+		go func() {
+			taken := []int{}
+			for {
+				value, err := queue.Take(context.Background())
+				if err != nil {
+					results <- taken
+
+					return
+				}
+
+				taken = append(taken, value)
+			}
+		}()
+	}
+
+	producers.Wait()
+	queue.Close()
+
+	seen := make([]bool, 1000)
+	for i := 0; i < 4; i++ {
+		for _, value := range <-results {
+			assert.False(t, seen[value])
+			seen[value] = true
+		}
+	}
+
+	assert.NotContains(t, seen, false)
+}
+
+func TestBlockingQueueClone(t *testing.T) {
+	queue := newFilled(3, 1, 2)
+	queue.Close()
+
+	clone := queue.Clone()
+	assert.True(t, clone.Equals(queue, utils.BasicComparator[int]))
+	assert.True(t, clone.IsClosed())
+	assert.Equal(t, 3, clone.Capacity())
+
+	clone.Dequeue()
+	assert.False(t, clone.Equals(queue, utils.BasicComparator[int]))
+	assert.Equal(t, []int{1, 2}, queue.GetValues())
+}
+
+func TestBlockingQueueEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Queue[int]
+		b     *Queue[int]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     newFilled(3),
+			b:     newFilled(3),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     newFilled(3, 1, 2),
+			b:     newFilled(3, 1, 2),
+			equal: true,
+		},
+		{
+			name:  "different order",
+			a:     newFilled(3, 1, 2),
+			b:     newFilled(3, 2, 1),
+			equal: false,
+		},
+		{
+			name:  "different capacity",
+			a:     newFilled(3, 1, 2),
+			b:     newFilled(4, 1, 2),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[int]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	queue := New[int](3)
+	for _, value := range []int{1, 2, 3} {
+		queue.Enqueue(value)
+	}
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range queue.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	indices = []int{}
+	values = []int{}
+	for i, value := range queue.Backward() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{2, 1, 0}, indices)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(queue.Values()))
+
+	values = []int{}
+	for value := range queue.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
@@ -13,15 +13,21 @@ package circularbuffer
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
 	"github.com/JonasMuehlmann/datastructures.go/queues"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
 )
 
 // Assert Queue implementation
 var _ queues.Queue[any] = (*Queue[any])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Queue[any]] = (*Queue[any])(nil)
+var _ ds.Equatable[*Queue[any], any] = (*Queue[any])(nil)
+
 // Queue holds values in a slice.
 type Queue[T any] struct {
 	values  []T
@@ -30,6 +36,8 @@ type Queue[T any] struct {
 	full    bool
 	maxSize int
 	size    int
+
+	modifications ds.ModificationCounter
 }
 
 // New instantiates a new empty queue with the specified size of maximum number of elements that it can hold.
@@ -71,6 +79,7 @@ func NewFromIterator[T any](maxSize int, begin ds.ReadForIterator[T]) *Queue[T]
 		length = sizedIterator.Size()
 	}
 
+	length = utils.Max(length, 0)
 	elements := make([]T, 0, length)
 
 	for begin.Next() {
@@ -144,6 +153,7 @@ func (queue *Queue[T]) Enqueue(value T) {
 	}
 
 	queue.size = queue.calculateSize()
+	queue.modifications.Modified()
 }
 
 // Dequeue removes first element of the queue and returns it, or nil if queue is empty.
@@ -162,6 +172,7 @@ func (queue *Queue[T]) Dequeue() (value T, ok bool) {
 	queue.full = false
 
 	queue.size = queue.size - 1
+	queue.modifications.Modified()
 
 	return
 }
@@ -197,6 +208,7 @@ func (queue *Queue[T]) Clear() {
 	queue.end = 0
 	queue.full = false
 	queue.size = 0
+	queue.modifications.Modified()
 }
 
 // Values returns all elements in the queue (FIFO order).
@@ -208,7 +220,7 @@ func (queue *Queue[T]) GetValues() []T {
 	}
 
 	for i := 0; i < queue.Size(); i++ {
-		values = append(values, queue.values[(queue.start+i)%queue.maxSize])
+		values = append(values, queue.values[queue.physicalIndex(i)])
 	}
 
 	return values
@@ -226,11 +238,33 @@ func (queue *Queue[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the queue with the same maximum size, which does not share its buffer with the original.
+func (queue *Queue[T]) Clone() *Queue[T] {
+	return &Queue[T]{
+		values:  append(make([]T, 0, queue.maxSize), queue.values...),
+		start:   queue.start,
+		end:     queue.end,
+		full:    queue.full,
+		maxSize: queue.maxSize,
+		size:    queue.size,
+	}
+}
+
+// Equals returns true if both queues have the same maximum size and hold equal values in the same order.
+func (queue *Queue[T]) Equals(other *Queue[T], comparator utils.Comparator[T]) bool {
+	return queue.maxSize == other.maxSize && utils.SlicesEqual(queue.GetValues(), other.GetValues(), comparator)
+}
+
 // Check that the index is within bounds of the list
 func (queue *Queue[T]) withinRange(index int) bool {
 	return index >= 0 && index < queue.size
 }
 
+// physicalIndex maps the index of an element in FIFO order to its position in values.
+func (queue *Queue[T]) physicalIndex(index int) int {
+	return (queue.start + index) % queue.maxSize
+}
+
 func (queue *Queue[T]) calculateSize() int {
 	if queue.end < queue.start {
 		return queue.maxSize - queue.start + queue.end
@@ -251,21 +285,80 @@ func (queue *Queue[T]) calculateSize() int {
 // Begin returns an initialized iterator, which points to one element before it's first.
 // Unless Next() is called, the iterator is in an invalid state.
 func (queue *Queue[T]) Begin() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
-	return queue.NewIterator(queue.start-1, queue.Size())
+	return queue.NewIterator(-1, queue.Size())
 }
 
 // End returns an initialized iterator, which points to one element afrer it's last.
 // Unless Previous() is called, the iterator is in an invalid state.
 func (queue *Queue[T]) End() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
-	return queue.NewIterator(queue.end, queue.Size())
+	return queue.NewIterator(queue.Size(), queue.Size())
 }
 
 // First returns an initialized iterator, which points to it's first element.
 func (queue *Queue[T]) First() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
-	return queue.NewIterator(queue.start, queue.Size())
+	return queue.NewIterator(0, queue.Size())
 }
 
 // Last returns an initialized iterator, which points to it's last element.
 func (queue *Queue[T]) Last() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
-	return queue.NewIterator(queue.end-1, queue.Size())
+	return queue.NewIterator(queue.Size()-1, queue.Size())
+}
+
+//******************************************************************//
+//                         Reverse iterator                         //
+//******************************************************************//
+
+// ReverseBegin returns an initialized, reversed iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (queue *Queue[T]) ReverseBegin() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(queue.End())
+}
+
+// ReverseEnd returns an initialized, reversed iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (queue *Queue[T]) ReverseEnd() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(queue.Begin())
+}
+
+// ReverseFirst returns an initialized, reversed iterator, which points to it's first element.
+func (queue *Queue[T]) ReverseFirst() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(queue.Last())
+}
+
+// ReverseLast returns an initialized, reversed iterator, which points to it's last element.
+func (queue *Queue[T]) ReverseLast() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(queue.First())
+}
+
+// All returns a sequence over the index/value pairs of the queue in FIFO order.
+func (queue *Queue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < queue.Size(); i++ {
+			if !yield(i, queue.values[queue.physicalIndex(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence over the index/value pairs of the queue in LIFO order.
+func (queue *Queue[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := queue.Size() - 1; i >= 0; i-- {
+			if !yield(i, queue.values[queue.physicalIndex(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the queue in FIFO order.
+func (queue *Queue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range queue.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
 }
@@ -13,6 +13,15 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[int, any] = (*Iterator[any])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*Iterator[any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*Iterator[any])(nil)
+
+// Assert ConstantTimeAccessIterator implementation
+var _ ds.ConstantTimeAccessIterator = (*Iterator[any])(nil)
+
 // Iterator holding the iterator's state
 type Iterator[T any] struct {
 	stack *Queue[T]
@@ -20,11 +29,12 @@ type Iterator[T any] struct {
 	// Redundant but has better locality
 	value T
 	size  int
+	guard ds.ModificationGuard
 }
 
 // NewIterator returns a stateful iterator whose values can be fetched by an index.
 func (list *Queue[T]) NewIterator(index int, size int) *Iterator[T] {
-	it := &Iterator[T]{stack: list, index: 0, size: size}
+	it := &Iterator[T]{stack: list, index: 0, size: size, guard: list.modifications.Guard()}
 
 	it.MoveTo(index)
 
@@ -32,7 +42,12 @@ func (list *Queue[T]) NewIterator(index int, size int) *Iterator[T] {
 }
 
 func (it *Iterator[T]) IsValid() bool {
-	return it.size > 0 && !it.IsBegin() && !it.IsEnd()
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the queue was structurally modified after the iterator was created.
+func (it *Iterator[T]) Err() error {
+	return it.guard.Err()
 }
 
 func (it *Iterator[T]) Get() (value T, found bool) {
@@ -48,7 +63,7 @@ func (it *Iterator[T]) Set(value T) bool {
 		return false
 	}
 
-	it.stack.values[it.index] = value
+	it.stack.values[it.stack.physicalIndex(it.index)] = value
 	it.value = value
 
 	return true
@@ -56,6 +71,8 @@ func (it *Iterator[T]) Set(value T) bool {
 
 // If other is of type IndexedIterator, IndexedIterator.Index() will be used, possibly executing in O(1)
 func (it *Iterator[T]) DistanceTo(other ds.OrderedIterator) int {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -65,6 +82,8 @@ func (it *Iterator[T]) DistanceTo(other ds.OrderedIterator) int {
 }
 
 func (it *Iterator[T]) IsAfter(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -74,6 +93,8 @@ func (it *Iterator[T]) IsAfter(other ds.OrderedIterator) bool {
 }
 
 func (it *Iterator[T]) IsBefore(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -83,6 +104,8 @@ func (it *Iterator[T]) IsBefore(other ds.OrderedIterator) bool {
 }
 
 func (it *Iterator[T]) IsEqual(other ds.ComparableIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -91,6 +114,20 @@ func (it *Iterator[T]) IsEqual(other ds.ComparableIterator) bool {
 	return it.DistanceTo(otherThis) == 0
 }
 
+// CanCompare checks if other is an iterator of the same queue.
+func (it *Iterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := ds.BaseOf[int, T](other).(*Iterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.stack != it.stack {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 func (it *Iterator[T]) Next() bool {
 	it.index = utils.Min(it.index+1, it.size)
 
@@ -98,7 +135,7 @@ func (it *Iterator[T]) Next() bool {
 		return false
 	}
 
-	it.value = it.stack.values[it.index]
+	it.value = it.stack.values[it.stack.physicalIndex(it.index)]
 
 	return true
 }
@@ -110,7 +147,7 @@ func (it *Iterator[T]) NextN(i int) bool {
 		return false
 	}
 
-	it.value = it.stack.values[it.index]
+	it.value = it.stack.values[it.stack.physicalIndex(it.index)]
 
 	return true
 }
@@ -122,7 +159,7 @@ func (it *Iterator[T]) Previous() bool {
 		return false
 	}
 
-	it.value = it.stack.values[it.index]
+	it.value = it.stack.values[it.stack.physicalIndex(it.index)]
 
 	return true
 }
@@ -134,7 +171,7 @@ func (it *Iterator[T]) PreviousN(n int) bool {
 		return false
 	}
 
-	it.value = it.stack.values[it.index]
+	it.value = it.stack.values[it.stack.physicalIndex(it.index)]
 
 	return true
 }
@@ -184,11 +221,11 @@ func (it *Iterator[T]) IsLast() bool {
 }
 
 func (it *Iterator[T]) GetAt(i int) (value T, found bool) {
-	if !it.stack.withinRange(i) {
+	if !it.stack.withinRange(i) || it.guard.Err() != nil {
 		return
 	}
 
-	value = it.stack.values[i]
+	value = it.stack.values[it.stack.physicalIndex(i)]
 	found = it.stack.withinRange(i)
 
 	return
@@ -199,7 +236,7 @@ func (it *Iterator[T]) GetAtKey(i int) (value T, found bool) {
 }
 
 func (it *Iterator[T]) SetAt(i int, value T) bool {
-	if !it.stack.withinRange(i) {
+	if !it.stack.withinRange(i) || it.guard.Err() != nil {
 		return false
 	}
 
@@ -207,7 +244,7 @@ func (it *Iterator[T]) SetAt(i int, value T) bool {
 		return false
 	}
 
-	it.stack.values[i] = value
+	it.stack.values[it.stack.physicalIndex(i)] = value
 
 	return true
 }
@@ -215,3 +252,8 @@ func (it *Iterator[T]) SetAt(i int, value T) bool {
 func (it *Iterator[T]) SetAtKey(i int, value T) bool {
 	return it.SetAt(i, value)
 }
+
+// HasConstantTimeAccess returns true, since the elements are indexed directly.
+func (it *Iterator[T]) HasConstantTimeAccess() bool {
+	return true
+}
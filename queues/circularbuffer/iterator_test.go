@@ -1,6 +1,7 @@
 package circularbuffer
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
@@ -1079,3 +1080,187 @@ func TestCircularBufferIteratorSize(t *testing.T) {
 		})
 	}
 }
+
+func TestCircularBufferReverseIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		container *Queue[int]
+	}{
+		{
+			name:      "Empty",
+			container: New[int](3),
+		},
+		{
+			name:      "One element",
+			container: NewFromSlice[int](3, []int{1}),
+		},
+		{
+			name:      "3 elements",
+			container: NewFromSlice[int](3, []int{1, 2, 3}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			values := []int{}
+			for it := test.container.Begin(); it.Next(); {
+				value, _ := it.Get()
+				values = append(values, value)
+			}
+
+			reversed := []int{}
+			for it := test.container.ReverseBegin(); it.Next(); {
+				value, _ := it.Get()
+				reversed = append(reversed, value)
+			}
+
+			backward := []int{}
+			for it := test.container.ReverseEnd(); it.Previous(); {
+				value, _ := it.Get()
+				backward = append(backward, value)
+			}
+
+			assert.Equalf(t, values, backward, test.name)
+
+			slices.Reverse(values)
+			assert.Equalf(t, values, reversed, test.name)
+
+			first, found := test.container.ReverseFirst().Get()
+			last, _ := test.container.ReverseLast().Get()
+			assert.Equalf(t, len(values) > 0, found, test.name)
+
+			if len(values) > 0 {
+				assert.Equalf(t, values[0], first, test.name)
+				assert.Equalf(t, values[len(values)-1], last, test.name)
+			}
+		})
+	}
+}
+
+// newWrapped returns a full buffer of 1, 2, 3, whose elements wrap around the end of its storage.
+func newWrapped() *Queue[int] {
+	queue := New[int](3)
+	for i := -1; i <= 3; i++ {
+		queue.Enqueue(i)
+	}
+
+	return queue
+}
+
+func TestCircularBufferReverseIteratorWrapped(t *testing.T) {
+	queue := newWrapped()
+
+	values := []int{}
+	for it := queue.ReverseBegin(); it.Next(); {
+		value, _ := it.Get()
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	values = []int{}
+	for it := queue.ReverseEnd(); it.Previous(); {
+		value, _ := it.Get()
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	// Indices count from the oldest element, not from the start of the storage
+	it := queue.ReverseFirst()
+	index, _ := it.Index()
+	assert.Equal(t, 2, index)
+
+	value, _ := it.GetAt(0)
+	front, _ := queue.Peek()
+	assert.Equal(t, front, value)
+
+	assert.True(t, it.Set(30))
+	assert.True(t, it.SetAt(0, 10))
+	assert.Equal(t, []int{10, 2, 30}, queue.GetValues())
+}
+
+func TestCircularBufferReverseIteratorCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		it       func(*Queue[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+		other    func(*Queue[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+		distance int
+	}{
+		{
+			name:     "Newest to oldest, reversed",
+			it:       (*Queue[int]).ReverseFirst,
+			other:    (*Queue[int]).ReverseLast,
+			distance: -2,
+		},
+		{
+			name:     "Reversed newest to forward oldest",
+			it:       (*Queue[int]).ReverseFirst,
+			other:    (*Queue[int]).First,
+			distance: 2,
+		},
+		{
+			name:     "Reversed newest to forward newest",
+			it:       (*Queue[int]).ReverseFirst,
+			other:    (*Queue[int]).Last,
+			distance: 0,
+		},
+		{
+			name:     "Reversed begin to forward end",
+			it:       (*Queue[int]).ReverseBegin,
+			other:    (*Queue[int]).End,
+			distance: 0,
+		},
+		{
+			name:     "Reversed end to forward newest",
+			it:       (*Queue[int]).ReverseEnd,
+			other:    (*Queue[int]).Last,
+			distance: -3,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			queue := newWrapped()
+			it := test.it(queue)
+			other := test.other(queue)
+
+			distance, err := ds.CheckedDistanceTo(it, other)
+			assert.NoErrorf(t, err, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+			assert.Equalf(t, -test.distance, other.DistanceTo(it), test.name)
+			assert.Equalf(t, test.distance < 0, it.IsBefore(other), test.name)
+			assert.Equalf(t, test.distance > 0, other.IsBefore(it), test.name)
+			assert.Equalf(t, test.distance == 0, other.IsEqual(it), test.name)
+		})
+	}
+
+	queue := newWrapped()
+	other := queue.Clone()
+	assert.ErrorIs(t, ds.CanCompare(queue.First(), other.ReverseFirst()), ds.ErrForeignIterators)
+	assert.ErrorIs(t, ds.CanCompare(queue.ReverseFirst(), other.First()), ds.ErrForeignIterators)
+}
+
+func TestCircularBufferReverseIteratorFailFast(t *testing.T) {
+	queue := newWrapped()
+	it := queue.ReverseLast()
+
+	// Enqueuing into a full buffer silently drops the oldest element, which the reversed iterator points to
+	queue.Enqueue(4)
+
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.IsValid())
+
+	_, found := it.Get()
+	assert.False(t, found)
+}
@@ -13,6 +13,12 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompForRandCollIterator[int, any] = (*Iterator[any])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*Iterator[any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*Iterator[any])(nil)
+
 // Iterator holding the iterator's state
 type Iterator[T any] struct {
 	*singlylinkedlist.Iterator[T]
@@ -65,6 +71,20 @@ func (it *Iterator[T]) IsEqual(other ds.ComparableIterator) bool {
 	return it.DistanceTo(otherThis) == 0
 }
 
+// CanCompare checks if other is an iterator of the same queue.
+func (it *Iterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*Iterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if it.Iterator.CanCompare(otherThis.Iterator) != nil {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 // NOTE: Turns out that struct embedings remove the need for the redundant implementation below.
 // In case it is needed again, it will probably stay here
 
@@ -11,16 +11,22 @@ package linkedlistqueue
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
 	"github.com/JonasMuehlmann/datastructures.go/lists/singlylinkedlist"
 	"github.com/JonasMuehlmann/datastructures.go/queues"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
 )
 
 // Assert Queue implementation
 var _ queues.Queue[any] = (*Queue[any])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Queue[any]] = (*Queue[any])(nil)
+var _ ds.Equatable[*Queue[any], any] = (*Queue[any])(nil)
+
 // Queue holds elements in a singly-linked-list
 type Queue[T any] struct {
 	list *singlylinkedlist.List[T]
@@ -114,6 +120,16 @@ func (queue *Queue[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the queue, which does not share any elements with the original.
+func (queue *Queue[T]) Clone() *Queue[T] {
+	return &Queue[T]{list: queue.list.Clone()}
+}
+
+// Equals returns true if both queues hold equal values in the same order.
+func (queue *Queue[T]) Equals(other *Queue[T], comparator utils.Comparator[T]) bool {
+	return queue.list.Equals(other.list, comparator)
+}
+
 // Check that the index is within bounds of the list
 func (queue *Queue[T]) withinRange(index int) bool {
 	return index >= 0 && index < queue.list.Size()
@@ -144,3 +160,23 @@ func (stack *Queue[T]) First() ds.ReadWriteOrdCompForRandCollIterator[int, T] {
 func (stack *Queue[T]) Last() ds.ReadWriteOrdCompForRandCollIterator[int, T] {
 	return stack.NewIterator(stack.Size()-1, stack.Size())
 }
+
+// All returns a sequence over the index/value pairs of the queue in iteration order.
+func (queue *Queue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range ds.Seq(queue.Begin()) {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Values returns a sequence over the values of the queue in iteration order.
+func (queue *Queue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(queue.Begin())(yield)
+	}
+}
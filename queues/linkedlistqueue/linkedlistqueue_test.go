@@ -6,12 +6,12 @@
 package linkedlistqueue
 
 import (
+	"slices"
 	"testing"
 
-	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/ds"
-
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -465,3 +465,115 @@ func BenchmarkLinkedListQueueDequeue(b *testing.B) {
 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 	}
 }
+
+func TestLinkedListQueueClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Queue[string]
+	}{
+		{
+			name:     "empty",
+			original: NewFromSlice[string]([]string{}),
+		},
+		{
+			name:     "3 items",
+			original: NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			values := test.original.GetValues()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+
+			clone.Enqueue("qux")
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+		})
+	}
+}
+
+func TestLinkedListQueueEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Queue[string]
+		b     *Queue[string]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     NewFromSlice[string]([]string{}),
+			b:     NewFromSlice[string]([]string{}),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			b:     NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			equal: true,
+		},
+		{
+			name:  "different order",
+			a:     NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			b:     NewFromSlice[string]([]string{"baz", "bar", "foo"}),
+			equal: false,
+		},
+		{
+			name:  "different values",
+			a:     NewFromSlice[string]([]string{"foo", "bar"}),
+			b:     NewFromSlice[string]([]string{"foo", "qux"}),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     NewFromSlice[string]([]string{"foo"}),
+			b:     NewFromSlice[string]([]string{"foo", "bar"}),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[string]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	queue := New[int](1, 2, 3)
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range queue.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(queue.Values()))
+
+	values = []int{}
+	for value := range queue.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
@@ -13,6 +13,12 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[int, any] = (*OrderedIterator[any])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[any])(nil)
+
 // Iterator holding the iterator's state
 type OrderedIterator[T any] struct {
 	*binaryheap.OrderedIterator[T]
@@ -62,3 +68,17 @@ func (it *OrderedIterator[T]) IsEqual(other ds.ComparableIterator) bool {
 
 	return it.DistanceTo(otherThis) == 0
 }
+
+// CanCompare checks if other is an iterator of the same queue.
+func (it *OrderedIterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*OrderedIterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if it.OrderedIterator.CanCompare(otherThis.OrderedIterator) != nil {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ package priorityqueue
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
@@ -29,6 +30,10 @@ import (
 // Assert Queue implementation
 var _ queues.Queue[any] = (*Queue[any])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Queue[any]] = (*Queue[any])(nil)
+var _ ds.Equatable[*Queue[any], any] = (*Queue[any])(nil)
+
 // Queue holds elements in an array-queue
 type Queue[T any] struct {
 	heap       *binaryheap.Heap[T]
@@ -130,6 +135,16 @@ func (queue *Queue[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the queue with the same comparator, which does not share any elements with the original.
+func (queue *Queue[T]) Clone() *Queue[T] {
+	return &Queue[T]{heap: queue.heap.Clone(), Comparator: queue.Comparator}
+}
+
+// Equals returns true if both queues hold equal values, which are compared in the order they would be dequeued in.
+func (queue *Queue[T]) Equals(other *Queue[T], comparator utils.Comparator[T]) bool {
+	return queue.heap.Equals(other.heap, comparator)
+}
+
 //******************************************************************//
 //                             Iterator                             //
 //******************************************************************//
@@ -155,3 +170,36 @@ func (stack *Queue[T]) First() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 func (stack *Queue[T]) Last() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 	return stack.NewOrderedIterator(stack.Size()-1, stack.Size())
 }
+
+// All returns a sequence over the index/value pairs of the queue in iteration order.
+func (queue *Queue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range ds.Seq(queue.Begin()) {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns a sequence over the index/value pairs of the queue in reverse iteration order.
+func (queue *Queue[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := queue.Size() - 1
+		for value := range ds.BackwardSeq(queue.End()) {
+			if !yield(i, value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// Values returns a sequence over the values of the queue in iteration order.
+func (queue *Queue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(queue.Begin())(yield)
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fenwicktree implements a Fenwick tree (binary indexed tree) for prefix and range sums.
+//
+// Two trees are maintained, one over the differences of adjacent values and one over the differences scaled by their index,
+// so that adding to a whole range is as cheap as adding to a single value.
+//
+// Since the values themselves are not stored, the sequences returned by All, Backward and Values
+// reconstruct all of them once ranging starts and do not observe later modifications.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Fenwick_tree
+package fenwicktree
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/rangequeries"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert RangeQuerier implementation
+var _ rangequeries.RangeQuerier[int] = (*Tree[int])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Tree[int]] = (*Tree[int])(nil)
+var _ ds.Equatable[*Tree[int], int] = (*Tree[int])(nil)
+
+// Tree holds elements of the Fenwick tree
+type Tree[T rangequeries.Number] struct {
+	// 1-indexed trees over the differences d[i] = a[i] - a[i-1] and d[i] * (i-1)
+	differences []T
+	scaled      []T
+	size        int
+}
+
+// New instantiates a new tree holding the passed values, if any.
+func New[T rangequeries.Number](values ...T) *Tree[T] {
+	return NewFromSlice(values)
+}
+
+// NewFromSlice instantiates a new tree holding the values of slice in O(n).
+// The slice is not retained.
+func NewFromSlice[T rangequeries.Number](slice []T) *Tree[T] {
+	tree := &Tree[T]{
+		differences: make([]T, len(slice)+1),
+		scaled:      make([]T, len(slice)+1),
+		size:        len(slice),
+	}
+
+	previous := T(0)
+	for i, value := range slice {
+		tree.differences[i+1] = value - previous
+		tree.scaled[i+1] = (value - previous) * T(i)
+		previous = value
+	}
+
+	for i := 1; i <= tree.size; i++ {
+		parent := i + i&-i
+		if parent <= tree.size {
+			tree.differences[parent] += tree.differences[i]
+			tree.scaled[parent] += tree.scaled[i]
+		}
+	}
+
+	return tree
+}
+
+// NewFromIterator instantiates a new tree holding the elements provided by the passed iterator.
+func NewFromIterator[T rangequeries.Number](begin ds.ReadForIterator[T]) *Tree[T] {
+	length := 0
+	sizedIterator, ok := begin.(ds.SizedIterator)
+	if ok {
+		length = sizedIterator.Size()
+	}
+
+	length = utils.Max(length, 0)
+	values := make([]T, 0, length)
+
+	for begin.Next() {
+		value, _ := begin.Get()
+		values = append(values, value)
+	}
+
+	return NewFromSlice(values)
+}
+
+// Get returns the value at index in O(log n).
+// Second return parameter is true if index is within bounds, otherwise false.
+func (tree *Tree[T]) Get(index int) (value T, found bool) {
+	if !tree.withinRange(index) {
+		return
+	}
+
+	return tree.PrefixSum(index+1) - tree.PrefixSum(index), true
+}
+
+// Set replaces the value at index in O(log n).
+// Indices out of bounds are ignored.
+func (tree *Tree[T]) Set(index int, value T) {
+	old, found := tree.Get(index)
+	if !found {
+		return
+	}
+
+	tree.AddRange(index, index+1, value-old)
+}
+
+// Add adds delta to the value at index in O(log n).
+// Indices out of bounds are ignored.
+func (tree *Tree[T]) Add(index int, delta T) {
+	if !tree.withinRange(index) {
+		return
+	}
+
+	tree.AddRange(index, index+1, delta)
+}
+
+// AddRange adds delta to all values in [from, to) in O(log n).
+// The range is clamped to the bounds of the tree.
+func (tree *Tree[T]) AddRange(from int, to int, delta T) {
+	from, to = tree.clamp(from, to)
+	if from >= to {
+		return
+	}
+
+	// Translate to the 1-indexed closed range [from+1, to]
+	tree.add(tree.differences, from+1, delta)
+	tree.add(tree.differences, to+1, -delta)
+	tree.add(tree.scaled, from+1, delta*T(from))
+	tree.add(tree.scaled, to+1, -delta*T(to))
+}
+
+// PrefixSum returns the sum of the first n values in O(log n).
+func (tree *Tree[T]) PrefixSum(n int) T {
+	n = utils.Max(utils.Min(n, tree.size), 0)
+
+	return tree.sum(tree.differences, n)*T(n) - tree.sum(tree.scaled, n)
+}
+
+// Query returns the sum of the values in [from, to) in O(log n).
+// The range is clamped to the bounds of the tree.
+func (tree *Tree[T]) Query(from int, to int) T {
+	from, to = tree.clamp(from, to)
+	if from >= to {
+		return 0
+	}
+
+	return tree.PrefixSum(to) - tree.PrefixSum(from)
+}
+
+// IsEmpty returns true if the tree does not contain any values.
+func (tree *Tree[T]) IsEmpty() bool {
+	return tree.size == 0
+}
+
+// Size returns the number of values in the tree.
+func (tree *Tree[T]) Size() int {
+	return tree.size
+}
+
+// Clear removes all values from the tree.
+func (tree *Tree[T]) Clear() {
+	tree.differences = make([]T, 1)
+	tree.scaled = make([]T, 1)
+	tree.size = 0
+}
+
+// GetValues returns all values in index order.
+func (tree *Tree[T]) GetValues() []T {
+	values := make([]T, 0, tree.size)
+
+	previous := T(0)
+	for i := 1; i <= tree.size; i++ {
+		prefix := tree.PrefixSum(i)
+		values = append(values, prefix-previous)
+		previous = prefix
+	}
+
+	return values
+}
+
+// ToString returns a string representation of container
+func (tree *Tree[T]) ToString() string {
+	str := "FenwickTree\n"
+	values := []string{}
+	for _, value := range tree.GetValues() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+
+	return str
+}
+
+// Clone returns a copy of the tree, which does not share its underlying arrays with the original.
+func (tree *Tree[T]) Clone() *Tree[T] {
+	return &Tree[T]{
+		differences: append([]T{}, tree.differences...),
+		scaled:      append([]T{}, tree.scaled...),
+		size:        tree.size,
+	}
+}
+
+// Equals returns true if both trees hold equal values in the same order.
+func (tree *Tree[T]) Equals(other *Tree[T], comparator utils.Comparator[T]) bool {
+	return tree.size == other.size && utils.SlicesEqual(tree.GetValues(), other.GetValues(), comparator)
+}
+
+func (tree *Tree[T]) add(elements []T, i int, delta T) {
+	for ; i <= tree.size; i += i & -i {
+		elements[i] += delta
+	}
+}
+
+func (tree *Tree[T]) sum(elements []T, i int) T {
+	sum := T(0)
+	for ; i > 0; i -= i & -i {
+		sum += elements[i]
+	}
+
+	return sum
+}
+
+func (tree *Tree[T]) withinRange(index int) bool {
+	return index >= 0 && index < tree.size
+}
+
+func (tree *Tree[T]) clamp(from int, to int) (int, int) {
+	return utils.Max(from, 0), utils.Min(to, tree.size)
+}
+
+// All returns a sequence over the index/value pairs of the tree in index order.
+func (tree *Tree[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, value := range tree.GetValues() {
+			if !yield(i, value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence over the index/value pairs of the tree in reverse index order.
+func (tree *Tree[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		values := tree.GetValues()
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(i, values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the tree in index order.
+func (tree *Tree[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range tree.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fenwicktree
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name   string
+		tree   *Tree[int]
+		values []int
+	}{
+		{
+			name:   "empty",
+			tree:   New[int](),
+			values: []int{},
+		},
+		{
+			name:   "from values",
+			tree:   New(3, 1, 4, 1, 5),
+			values: []int{3, 1, 4, 1, 5},
+		},
+		{
+			name:   "from slice",
+			tree:   NewFromSlice([]int{3, 1, 4, 1, 5}),
+			values: []int{3, 1, 4, 1, 5},
+		},
+		{
+			name:   "from iterator",
+			tree:   NewFromIterator[int](arraylist.New(3, 1, 4, 1, 5).Begin()),
+			values: []int{3, 1, 4, 1, 5},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.values, test.tree.GetValues(), test.name)
+			assert.Equalf(t, len(test.values), test.tree.Size(), test.name)
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	tree := New(3, 1, 4, 1, 5, 9, 2, 6)
+
+	tests := []struct {
+		name string
+		from int
+		to   int
+		sum  int
+	}{
+		{
+			name: "empty range",
+			from: 3,
+			to:   3,
+			sum:  0,
+		},
+		{
+			name: "reversed range",
+			from: 5,
+			to:   3,
+			sum:  0,
+		},
+		{
+			name: "single value",
+			from: 4,
+			to:   5,
+			sum:  5,
+		},
+		{
+			name: "inner range",
+			from: 2,
+			to:   6,
+			sum:  19,
+		},
+		{
+			name: "clamped range",
+			from: -5,
+			to:   50,
+			sum:  31,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.sum, tree.Query(test.from, test.to), test.name)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	tree := New(3, 1, 4, 1, 5)
+
+	tree.Set(1, 10)
+	assert.Equal(t, []int{3, 10, 4, 1, 5}, tree.GetValues())
+
+	tree.Add(2, -4)
+	assert.Equal(t, []int{3, 10, 0, 1, 5}, tree.GetValues())
+
+	tree.AddRange(1, 4, 2)
+	assert.Equal(t, []int{3, 12, 2, 3, 5}, tree.GetValues())
+	assert.Equal(t, 17, tree.PrefixSum(3))
+
+	tree.Set(5, 10)
+	tree.Add(-1, 10)
+	assert.Equal(t, []int{3, 12, 2, 3, 5}, tree.GetValues())
+
+	_, found := tree.Get(5)
+	assert.False(t, found)
+
+	tree.Clear()
+	assert.True(t, tree.IsEmpty())
+	assert.Equal(t, 0, tree.Query(0, 5))
+}
+
+func TestRandomModification(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	values := make([]int, 200)
+	for i := range values {
+		values[i] = random.Intn(100)
+	}
+
+	tree := NewFromSlice(append([]int{}, values...))
+
+	for i := 0; i < 2000; i++ {
+		from := random.Intn(len(values))
+		to := from + random.Intn(len(values)-from) + 1
+		delta := random.Intn(21) - 10
+
+		switch random.Intn(3) {
+		case 0:
+			tree.Set(from, delta)
+			values[from] = delta
+		case 1:
+			tree.AddRange(from, to, delta)
+			for j := from; j < to; j++ {
+				values[j] += delta
+			}
+		case 2:
+			sum := 0
+			for j := from; j < to; j++ {
+				sum += values[j]
+			}
+
+			assert.Equal(t, sum, tree.Query(from, to))
+		}
+	}
+
+	assert.Equal(t, values, tree.GetValues())
+}
+
+func TestCloneAndEquals(t *testing.T) {
+	tree := New(3, 1, 4, 1, 5)
+	clone := tree.Clone()
+
+	assert.True(t, clone.Equals(tree, utils.BasicComparator[int]))
+	assert.False(t, New(3, 1, 4, 1).Equals(tree, utils.BasicComparator[int]))
+
+	// Modifying the clone does not modify the tree
+	clone.AddRange(0, 5, 1)
+	assert.False(t, clone.Equals(tree, utils.BasicComparator[int]))
+	assert.Equal(t, []int{4, 2, 5, 2, 6}, clone.GetValues())
+	assert.Equal(t, []int{3, 1, 4, 1, 5}, tree.GetValues())
+
+	assert.True(t, New[int]().Clone().Equals(New[int](), utils.BasicComparator[int]))
+}
+
+func TestSequences(t *testing.T) {
+	tree := New[int](1, 2, 3)
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range tree.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	indices = []int{}
+	values = []int{}
+	for i, value := range tree.Backward() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{2, 1, 0}, indices)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(tree.Values()))
+
+	values = []int{}
+	for value := range tree.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
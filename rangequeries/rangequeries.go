@@ -0,0 +1,33 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package rangequeries provides an abstract RangeQuerier interface.
+//
+// A range query structure stores a fixed sequence of values and answers aggregate queries like the sum or minimum over a range of consecutive indices,
+// while still allowing to update values, without recomputing the aggregate from scratch.
+//
+// Ranges are half-open, so that [from, to) includes the index from, but not the index to.
+//
+// Reference: https://en.wikipedia.org/wiki/Range_query_(data_structures)
+package rangequeries
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"golang.org/x/exp/constraints"
+)
+
+// Number is the constraint for values, which can be summed up.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// RangeQuerier interface that all range query structures implement.
+type RangeQuerier[T any] interface {
+	Get(index int) (value T, found bool)
+	Set(index int, value T)
+	// Query returns the aggregate of the values in [from, to).
+	Query(from int, to int) T
+
+	ds.Container[T]
+}
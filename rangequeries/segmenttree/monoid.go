@@ -0,0 +1,74 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package segmenttree
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/rangequeries"
+	"golang.org/x/exp/constraints"
+)
+
+// Monoid describes how the values of a range are aggregated.
+type Monoid[T any] struct {
+	// Combine aggregates two values and must be associative.
+	Combine func(a T, b T) T
+	// Identity must not change a value it is combined with, it is the aggregate of an empty range.
+	Identity T
+}
+
+// Sum returns a monoid aggregating values by their sum.
+func Sum[T rangequeries.Number]() Monoid[T] {
+	return Monoid[T]{
+		Combine:  func(a T, b T) T { return a + b },
+		Identity: 0,
+	}
+}
+
+// Min returns a monoid aggregating values by their minimum.
+// identity must not be smaller than any value, e.g. math.MaxInt.
+func Min[T constraints.Ordered](identity T) Monoid[T] {
+	return Monoid[T]{
+		Combine: func(a T, b T) T {
+			if b < a {
+				return b
+			}
+
+			return a
+		},
+		Identity: identity,
+	}
+}
+
+// Max returns a monoid aggregating values by their maximum.
+// identity must not be larger than any value, e.g. math.MinInt.
+func Max[T constraints.Ordered](identity T) Monoid[T] {
+	return Monoid[T]{
+		Combine: func(a T, b T) T {
+			if b > a {
+				return b
+			}
+
+			return a
+		},
+		Identity: identity,
+	}
+}
+
+// GCD returns a monoid aggregating values by their greatest common divisor.
+func GCD[T constraints.Integer]() Monoid[T] {
+	return Monoid[T]{
+		Combine: func(a T, b T) T {
+			for b != 0 {
+				a, b = b, a%b
+			}
+
+			if a < 0 {
+				return -a
+			}
+
+			return a
+		},
+		Identity: 0,
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package segmenttree implements a segment tree with lazy propagation over an arbitrary monoid.
+//
+// Every node stores the aggregate of its segment, so that any range is covered by O(log n) nodes.
+// Assigning a value to a whole range is recorded at the covering nodes and only pushed down to their children,
+// once a later operation needs to descend into them.
+//
+// The sequences returned by All, Backward and Values push all pending assignments down and collect the values
+// once ranging starts, so they iterate a snapshot of the tree.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Segment_tree
+package segmenttree
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/rangequeries"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert RangeQuerier implementation
+var _ rangequeries.RangeQuerier[int] = (*Tree[int])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Tree[int]] = (*Tree[int])(nil)
+var _ ds.Equatable[*Tree[int], int] = (*Tree[int])(nil)
+
+// Tree holds elements of the segment tree
+type Tree[T any] struct {
+	Monoid Monoid[T]
+	// Heap-ordered nodes, where the root is at index 1 and covers [0, size)
+	aggregates []T
+	// Values assigned to the whole segment of a node, which were not yet pushed down to its children
+	pending    []T
+	hasPending []bool
+	size       int
+}
+
+// New instantiates a new tree aggregating the passed values, if any, with monoid.
+func New[T any](monoid Monoid[T], values ...T) *Tree[T] {
+	return NewFromSlice(monoid, values)
+}
+
+// NewFromSlice instantiates a new tree aggregating the values of slice with monoid in O(n).
+// The slice is not retained.
+func NewFromSlice[T any](monoid Monoid[T], slice []T) *Tree[T] {
+	tree := &Tree[T]{Monoid: monoid}
+	tree.init(len(slice))
+
+	if tree.size > 0 {
+		tree.build(1, 0, tree.size, slice)
+	}
+
+	return tree
+}
+
+// NewFromIterator instantiates a new tree aggregating the elements provided by the passed iterator with monoid.
+func NewFromIterator[T any](monoid Monoid[T], begin ds.ReadForIterator[T]) *Tree[T] {
+	length := 0
+	sizedIterator, ok := begin.(ds.SizedIterator)
+	if ok {
+		length = sizedIterator.Size()
+	}
+
+	length = utils.Max(length, 0)
+	values := make([]T, 0, length)
+
+	for begin.Next() {
+		value, _ := begin.Get()
+		values = append(values, value)
+	}
+
+	return NewFromSlice(monoid, values)
+}
+
+// Get returns the value at index in O(log n).
+// Second return parameter is true if index is within bounds, otherwise false.
+func (tree *Tree[T]) Get(index int) (value T, found bool) {
+	if !tree.withinRange(index) {
+		return
+	}
+
+	return tree.Query(index, index+1), true
+}
+
+// Set replaces the value at index in O(log n).
+// Indices out of bounds are ignored.
+func (tree *Tree[T]) Set(index int, value T) {
+	if !tree.withinRange(index) {
+		return
+	}
+
+	tree.update(1, 0, tree.size, index, index+1, value)
+}
+
+// SetRange replaces all values in [from, to) with value in O(log² n).
+// The range is clamped to the bounds of the tree.
+func (tree *Tree[T]) SetRange(from int, to int, value T) {
+	from, to = tree.clamp(from, to)
+	if from >= to {
+		return
+	}
+
+	tree.update(1, 0, tree.size, from, to, value)
+}
+
+// Query returns the aggregate of the values in [from, to) in O(log n).
+// The range is clamped to the bounds of the tree, empty ranges result in the monoid's identity.
+func (tree *Tree[T]) Query(from int, to int) T {
+	from, to = tree.clamp(from, to)
+	if from >= to {
+		return tree.Monoid.Identity
+	}
+
+	return tree.query(1, 0, tree.size, from, to)
+}
+
+// IsEmpty returns true if the tree does not contain any values.
+func (tree *Tree[T]) IsEmpty() bool {
+	return tree.size == 0
+}
+
+// Size returns the number of values in the tree.
+func (tree *Tree[T]) Size() int {
+	return tree.size
+}
+
+// Clear removes all values from the tree.
+func (tree *Tree[T]) Clear() {
+	tree.init(0)
+}
+
+// GetValues returns all values in index order.
+func (tree *Tree[T]) GetValues() []T {
+	values := make([]T, 0, tree.size)
+	if tree.size > 0 {
+		tree.collect(1, 0, tree.size, &values)
+	}
+
+	return values
+}
+
+// ToString returns a string representation of container
+func (tree *Tree[T]) ToString() string {
+	str := "SegmentTree\n"
+	values := []string{}
+	for _, value := range tree.GetValues() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+
+	return str
+}
+
+// Clone returns a copy of the tree with the same monoid, including assignments which were not yet pushed down.
+// The clone does not share its underlying arrays with the original.
+func (tree *Tree[T]) Clone() *Tree[T] {
+	return &Tree[T]{
+		Monoid:     tree.Monoid,
+		aggregates: append([]T{}, tree.aggregates...),
+		pending:    append([]T{}, tree.pending...),
+		hasPending: append([]bool{}, tree.hasPending...),
+		size:       tree.size,
+	}
+}
+
+// Equals returns true if both trees hold equal values in the same order.
+// The monoids are not compared.
+func (tree *Tree[T]) Equals(other *Tree[T], comparator utils.Comparator[T]) bool {
+	return tree.size == other.size && utils.SlicesEqual(tree.GetValues(), other.GetValues(), comparator)
+}
+
+func (tree *Tree[T]) init(size int) {
+	tree.size = size
+	tree.aggregates = make([]T, 4*size)
+	tree.pending = make([]T, 4*size)
+	tree.hasPending = make([]bool, 4*size)
+}
+
+func (tree *Tree[T]) build(node int, lo int, hi int, values []T) {
+	if hi-lo == 1 {
+		tree.aggregates[node] = values[lo]
+
+		return
+	}
+
+	mid := lo + (hi-lo)/2
+	tree.build(2*node, lo, mid, values)
+	tree.build(2*node+1, mid, hi, values)
+	tree.pull(node)
+}
+
+func (tree *Tree[T]) update(node int, lo int, hi int, from int, to int, value T) {
+	if to <= lo || hi <= from {
+		return
+	}
+
+	if from <= lo && hi <= to {
+		tree.assign(node, lo, hi, value)
+
+		return
+	}
+
+	mid := lo + (hi-lo)/2
+	tree.push(node, lo, mid, hi)
+	tree.update(2*node, lo, mid, from, to, value)
+	tree.update(2*node+1, mid, hi, from, to, value)
+	tree.pull(node)
+}
+
+func (tree *Tree[T]) query(node int, lo int, hi int, from int, to int) T {
+	if to <= lo || hi <= from {
+		return tree.Monoid.Identity
+	}
+
+	if from <= lo && hi <= to {
+		return tree.aggregates[node]
+	}
+
+	mid := lo + (hi-lo)/2
+	tree.push(node, lo, mid, hi)
+
+	return tree.Monoid.Combine(tree.query(2*node, lo, mid, from, to), tree.query(2*node+1, mid, hi, from, to))
+}
+
+func (tree *Tree[T]) collect(node int, lo int, hi int, values *[]T) {
+	if hi-lo == 1 {
+		*values = append(*values, tree.aggregates[node])
+
+		return
+	}
+
+	mid := lo + (hi-lo)/2
+	tree.push(node, lo, mid, hi)
+	tree.collect(2*node, lo, mid, values)
+	tree.collect(2*node+1, mid, hi, values)
+}
+
+// assign replaces all values in the segment [lo, hi) of node with value and defers updating its children.
+func (tree *Tree[T]) assign(node int, lo int, hi int, value T) {
+	tree.aggregates[node] = tree.repeat(value, hi-lo)
+
+	if hi-lo > 1 {
+		tree.pending[node] = value
+		tree.hasPending[node] = true
+	}
+}
+
+// push propagates a pending assignment of node to its children.
+func (tree *Tree[T]) push(node int, lo int, mid int, hi int) {
+	if !tree.hasPending[node] {
+		return
+	}
+
+	tree.assign(2*node, lo, mid, tree.pending[node])
+	tree.assign(2*node+1, mid, hi, tree.pending[node])
+	tree.hasPending[node] = false
+}
+
+func (tree *Tree[T]) pull(node int) {
+	tree.aggregates[node] = tree.Monoid.Combine(tree.aggregates[2*node], tree.aggregates[2*node+1])
+}
+
+// repeat returns the aggregate of n copies of value in O(log n) by repeated squaring.
+func (tree *Tree[T]) repeat(value T, n int) T {
+	result := tree.Monoid.Identity
+
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = tree.Monoid.Combine(result, value)
+		}
+
+		value = tree.Monoid.Combine(value, value)
+	}
+
+	return result
+}
+
+func (tree *Tree[T]) withinRange(index int) bool {
+	return index >= 0 && index < tree.size
+}
+
+func (tree *Tree[T]) clamp(from int, to int) (int, int) {
+	return utils.Max(from, 0), utils.Min(to, tree.size)
+}
+
+// All returns a sequence over the index/value pairs of the tree in index order.
+func (tree *Tree[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, value := range tree.GetValues() {
+			if !yield(i, value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence over the index/value pairs of the tree in reverse index order.
+func (tree *Tree[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		values := tree.GetValues()
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(i, values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the tree in index order.
+func (tree *Tree[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range tree.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package segmenttree
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name   string
+		tree   *Tree[int]
+		values []int
+	}{
+		{
+			name:   "empty",
+			tree:   New(Sum[int]()),
+			values: []int{},
+		},
+		{
+			name:   "from values",
+			tree:   New(Sum[int](), 3, 1, 4, 1, 5),
+			values: []int{3, 1, 4, 1, 5},
+		},
+		{
+			name:   "from slice",
+			tree:   NewFromSlice(Sum[int](), []int{3, 1, 4, 1, 5}),
+			values: []int{3, 1, 4, 1, 5},
+		},
+		{
+			name:   "from iterator",
+			tree:   NewFromIterator[int](Sum[int](), arraylist.New(3, 1, 4, 1, 5).Begin()),
+			values: []int{3, 1, 4, 1, 5},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.values, test.tree.GetValues(), test.name)
+			assert.Equalf(t, len(test.values), test.tree.Size(), test.name)
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	values := []int{12, 18, 4, 6, 30, 9}
+
+	tests := []struct {
+		name      string
+		monoid    Monoid[int]
+		from      int
+		to        int
+		aggregate int
+	}{
+		{
+			name:      "sum",
+			monoid:    Sum[int](),
+			from:      1,
+			to:        4,
+			aggregate: 28,
+		},
+		{
+			name:      "min",
+			monoid:    Min(math.MaxInt),
+			from:      3,
+			to:        6,
+			aggregate: 6,
+		},
+		{
+			name:      "max",
+			monoid:    Max(math.MinInt),
+			from:      0,
+			to:        4,
+			aggregate: 18,
+		},
+		{
+			name:      "gcd",
+			monoid:    GCD[int](),
+			from:      0,
+			to:        2,
+			aggregate: 6,
+		},
+		{
+			name:      "empty range",
+			monoid:    Min(math.MaxInt),
+			from:      2,
+			to:        2,
+			aggregate: math.MaxInt,
+		},
+		{
+			name:      "clamped range",
+			monoid:    Sum[int](),
+			from:      -3,
+			to:        30,
+			aggregate: 79,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			tree := NewFromSlice(test.monoid, values)
+
+			assert.Equalf(t, test.aggregate, tree.Query(test.from, test.to), test.name)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	tree := New(Sum[int](), 3, 1, 4, 1, 5)
+
+	tree.Set(1, 10)
+	assert.Equal(t, []int{3, 10, 4, 1, 5}, tree.GetValues())
+
+	tree.SetRange(1, 4, 2)
+	assert.Equal(t, 6, tree.Query(1, 4))
+	assert.Equal(t, 4, tree.Query(2, 4))
+
+	tree.Set(2, 7)
+	assert.Equal(t, []int{3, 2, 7, 2, 5}, tree.GetValues())
+
+	tree.Set(5, 10)
+	tree.SetRange(-2, 0, 10)
+	assert.Equal(t, []int{3, 2, 7, 2, 5}, tree.GetValues())
+
+	_, found := tree.Get(-1)
+	assert.False(t, found)
+
+	tree.Clear()
+	assert.True(t, tree.IsEmpty())
+	assert.Equal(t, 0, tree.Query(0, 5))
+}
+
+func TestRandomModification(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	values := make([]int, 200)
+	for i := range values {
+		values[i] = random.Intn(100)
+	}
+
+	sum := New(Sum[int](), values...)
+	min := New(Min(math.MaxInt), values...)
+
+	for i := 0; i < 2000; i++ {
+		from := random.Intn(len(values))
+		to := from + random.Intn(len(values)-from) + 1
+		value := random.Intn(100)
+
+		switch random.Intn(3) {
+		case 0:
+			sum.Set(from, value)
+			min.Set(from, value)
+			values[from] = value
+		case 1:
+			sum.SetRange(from, to, value)
+			min.SetRange(from, to, value)
+			for j := from; j < to; j++ {
+				values[j] = value
+			}
+		case 2:
+			expectedSum, expectedMin := 0, math.MaxInt
+			for j := from; j < to; j++ {
+				expectedSum += values[j]
+				if values[j] < expectedMin {
+					expectedMin = values[j]
+				}
+			}
+
+			assert.Equal(t, expectedSum, sum.Query(from, to))
+			assert.Equal(t, expectedMin, min.Query(from, to))
+		}
+	}
+
+	assert.Equal(t, values, sum.GetValues())
+	assert.Equal(t, values, min.GetValues())
+}
+
+func TestCloneAndEquals(t *testing.T) {
+	tree := New(Sum[int](), 3, 1, 4, 1, 5)
+	tree.SetRange(0, 4, 2)
+	clone := tree.Clone()
+
+	assert.True(t, clone.Equals(tree, utils.BasicComparator[int]))
+	assert.True(t, New(Max(math.MinInt), 2, 2, 2, 2, 5).Equals(tree, utils.BasicComparator[int]))
+	assert.False(t, New(Sum[int](), 2, 2, 2, 2).Equals(tree, utils.BasicComparator[int]))
+
+	// Modifying the clone does not modify the tree
+	clone.Set(1, 10)
+	assert.False(t, clone.Equals(tree, utils.BasicComparator[int]))
+	assert.Equal(t, 16, clone.Query(0, 4))
+	assert.Equal(t, 8, tree.Query(0, 4))
+	assert.Equal(t, []int{2, 2, 2, 2, 5}, tree.GetValues())
+}
+
+func TestSequences(t *testing.T) {
+	tree := New(Sum[int](), 1, 2, 3)
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range tree.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	indices = []int{}
+	values = []int{}
+	for i, value := range tree.Backward() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{2, 1, 0}, indices)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(tree.Values()))
+
+	values = []int{}
+	for value := range tree.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
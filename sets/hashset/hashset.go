@@ -12,6 +12,7 @@ package hashset
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
@@ -22,9 +23,14 @@ import (
 // Assert Set implementation
 var _ sets.Set[string] = (*Set[string])(nil)
 
+// Assert Cloneable implementation
+var _ ds.Cloneable[*Set[string]] = (*Set[string])(nil)
+
 // Set holds elements in go's native map
 type Set[T comparable] struct {
 	items map[T]struct{}
+
+	modifications ds.ModificationCounter
 }
 
 var itemExists = struct{}{}
@@ -78,16 +84,28 @@ func NewFromIterators[T comparable](begin ds.ReadCompForIndexIterator[int, T], e
 
 // Add adds the items (one or more) to the set.
 func (set *Set[T]) Add(items ...T) {
+	size := len(set.items)
+
 	for _, item := range items {
 		set.items[item] = itemExists
 	}
+
+	if len(set.items) != size {
+		set.modifications.Modified()
+	}
 }
 
 // Remove removes the items (one or more) from the set.
 func (set *Set[T]) Remove(_ utils.Comparator[T], items ...T) {
+	size := len(set.items)
+
 	for _, item := range items {
 		delete(set.items, item)
 	}
+
+	if len(set.items) != size {
+		set.modifications.Modified()
+	}
 }
 
 // Contains check if items (one or more) are present in the set.
@@ -115,6 +133,7 @@ func (set *Set[T]) Size() int {
 // Clear clears all values in the set.
 func (set *Set[T]) Clear() {
 	set.items = make(map[T]struct{})
+	set.modifications.Modified()
 }
 
 // Values returns all items in the set.
@@ -139,22 +158,31 @@ func (set *Set[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the set, which does not share its underlying map with the original.
+func (set *Set[T]) Clone() *Set[T] {
+	items := make(map[T]struct{}, len(set.items))
+	for item := range set.items {
+		items[item] = itemExists
+	}
+
+	return &Set[T]{items: items}
+}
+
 // Intersection returns the intersection between two sets.
 // The new set consists of all elements that are both in "set" and "other".
 // Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
 func (set *Set[T]) MakeIntersectionWith(other sets.Set[T]) sets.Set[T] {
 	result := New[T]()
-	concrete := other.(*Set[T])
 
 	// Iterate over smaller set (optimization)
 	if set.Size() <= other.Size() {
 		for item := range set.items {
-			if _, contains := concrete.items[item]; contains {
+			if other.Contains(item) {
 				result.Add(item)
 			}
 		}
 	} else {
-		for item := range concrete.items {
+		for _, item := range other.GetValues() {
 			if _, contains := set.items[item]; contains {
 				result.Add(item)
 			}
@@ -169,14 +197,11 @@ func (set *Set[T]) MakeIntersectionWith(other sets.Set[T]) sets.Set[T] {
 // Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
 func (set *Set[T]) MakeUnionWith(other sets.Set[T]) sets.Set[T] {
 	result := New[T]()
-	concrete := other.(*Set[T])
 
 	for item := range set.items {
 		result.Add(item)
 	}
-	for item := range concrete.items {
-		result.Add(item)
-	}
+	result.Add(other.GetValues()...)
 
 	return result
 }
@@ -186,10 +211,9 @@ func (set *Set[T]) MakeUnionWith(other sets.Set[T]) sets.Set[T] {
 // Ref: https://proofwiki.org/wiki/Definition:Set_Difference
 func (set *Set[T]) MakeDifferenceWith(other sets.Set[T]) sets.Set[T] {
 	result := New[T]()
-	concrete := other.(*Set[T])
 
 	for item := range set.items {
-		if _, contains := concrete.items[item]; !contains {
+		if !other.Contains(item) {
 			result.Add(item)
 		}
 	}
@@ -197,6 +221,108 @@ func (set *Set[T]) MakeDifferenceWith(other sets.Set[T]) sets.Set[T] {
 	return result
 }
 
+// SymmetricDifference returns the symmetric difference between two sets.
+// The new set consists of all elements that are either in "set" or in "other", but not in both.
+// Ref: https://en.wikipedia.org/wiki/Symmetric_difference
+func (set *Set[T]) MakeSymmetricDifferenceWith(other sets.Set[T]) sets.Set[T] {
+	result := New[T]()
+
+	for item := range set.items {
+		if !other.Contains(item) {
+			result.Add(item)
+		}
+	}
+	for _, item := range other.GetValues() {
+		if _, contains := set.items[item]; !contains {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
+
+// IsSubsetOf returns true if all elements of the set are contained in other.
+func (set *Set[T]) IsSubsetOf(other sets.Set[T]) bool {
+	if set.Size() > other.Size() {
+		return false
+	}
+
+	for item := range set.items {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSupersetOf returns true if all elements of other are contained in the set.
+func (set *Set[T]) IsSupersetOf(other sets.Set[T]) bool {
+	if set.Size() < other.Size() {
+		return false
+	}
+
+	return set.Contains(other.GetValues()...)
+}
+
+// IsDisjointWith returns true if the set and other have no elements in common.
+func (set *Set[T]) IsDisjointWith(other sets.Set[T]) bool {
+	// Iterate over smaller set (optimization)
+	if set.Size() <= other.Size() {
+		for item := range set.items {
+			if other.Contains(item) {
+				return false
+			}
+		}
+	} else {
+		for _, item := range other.GetValues() {
+			if _, contains := set.items[item]; contains {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if the set and other contain the same elements.
+func (set *Set[T]) Equals(other sets.Set[T]) bool {
+	return set.Size() == other.Size() && set.IsSubsetOf(other)
+}
+
+// UnionInPlace adds all elements of other to the set.
+func (set *Set[T]) UnionInPlace(other sets.Set[T]) {
+	set.Add(other.GetValues()...)
+}
+
+// RetainAll removes all elements from the set, which are not contained in other.
+func (set *Set[T]) RetainAll(other sets.Set[T]) {
+	size := len(set.items)
+
+	for item := range set.items {
+		if !other.Contains(item) {
+			delete(set.items, item)
+		}
+	}
+
+	if len(set.items) != size {
+		set.modifications.Modified()
+	}
+}
+
+// RemoveAll removes all elements from the set, which are contained in other.
+func (set *Set[T]) RemoveAll(other sets.Set[T]) {
+	size := len(set.items)
+
+	for _, item := range other.GetValues() {
+		delete(set.items, item)
+	}
+
+	if len(set.items) != size {
+		set.modifications.Modified()
+	}
+}
+
 //******************************************************************//
 //                         Ordered iterator                         //
 //******************************************************************//
@@ -222,3 +348,14 @@ func (s *Set[T]) OrderedFirst(comparator utils.Comparator[T]) ds.ReadWriteOrdCom
 func (s *Set[T]) OrderedLast(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 	return s.NewOrderedIterator(len(s.items)-1, s.Size(), comparator)
 }
+
+// All returns a sequence over the items of the set in no particular order.
+func (set *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range set.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
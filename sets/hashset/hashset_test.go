@@ -6,10 +6,10 @@
 package hashset
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/exp/maps"
@@ -624,3 +624,54 @@ func BenchmarkHashSetGetValues(b *testing.B) {
 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 	}
 }
+
+func TestHashSetClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Set[string]
+	}{
+		{
+			name:     "empty",
+			original: New[string](),
+		},
+		{
+			name:     "3 items",
+			original: New[string]("foo", "bar", "baz"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			values := test.original.GetValues()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original), test.name)
+			assert.ElementsMatchf(t, values, clone.GetValues(), test.name)
+
+			clone.Add("qux")
+
+			assert.Falsef(t, clone.Equals(test.original), test.name)
+			assert.ElementsMatchf(t, values, test.original.GetValues(), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	set := New("c", "a", "b")
+
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, slices.Collect(set.All()))
+
+	items := []string{}
+	for item := range set.All() {
+		items = append(items, item)
+		if len(items) == 2 {
+			break
+		}
+	}
+
+	assert.Len(t, items, 2)
+}
@@ -13,6 +13,12 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[int, string] = (*OrderedIterator[string])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[string])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[string])(nil)
+
 type OrderedIterator[T comparable] struct {
 	s          *Set[T]
 	values     []T
@@ -21,6 +27,7 @@ type OrderedIterator[T comparable] struct {
 	// Redundant but has better locality
 	value T
 	size  int
+	guard ds.ModificationGuard
 }
 
 func (s *Set[T]) NewOrderedIterator(position int, size int, comparator utils.Comparator[T]) *OrderedIterator[T] {
@@ -33,6 +40,7 @@ func (s *Set[T]) NewOrderedIterator(position int, size int, comparator utils.Com
 		index:      position,
 		comparator: comparator,
 		size:       size,
+		guard:      s.modifications.Guard(),
 	}
 
 	it.size = utils.Min(s.Size(), size)
@@ -62,7 +70,13 @@ func (it *OrderedIterator[T]) IsLast() bool {
 }
 
 func (it *OrderedIterator[T]) IsValid() bool {
-	return it.size > 0 && !it.IsBegin() && !it.IsEnd()
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if items were added to or removed from the set after the iterator was created.
+// The iterator holds a sorted snapshot of the items, which is outdated afterwards.
+func (it *OrderedIterator[T]) Err() error {
+	return it.guard.Err()
 }
 
 func (it *OrderedIterator[T]) IsEqual(other ds.ComparableIterator) bool {
@@ -74,6 +88,20 @@ func (it *OrderedIterator[T]) IsEqual(other ds.ComparableIterator) bool {
 	return it.DistanceTo(otherThis) == 0
 }
 
+// CanCompare checks if other is an iterator of the same set.
+func (it *OrderedIterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*OrderedIterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.s != it.s {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 func (it *OrderedIterator[T]) DistanceTo(other ds.OrderedIterator) int {
 	otherThis, ok := other.(*OrderedIterator[T])
 	if !ok {
@@ -196,7 +224,7 @@ func (it *OrderedIterator[T]) Get() (value T, found bool) {
 }
 
 func (it *OrderedIterator[T]) GetAt(i int) (value T, found bool) {
-	if i < 0 || i >= it.size {
+	if i < 0 || i >= it.size || it.guard.Err() != nil {
 		return
 	}
 
@@ -219,7 +247,7 @@ func (it *OrderedIterator[T]) Set(value T) bool {
 }
 
 func (it *OrderedIterator[T]) SetAt(i int, value T) bool {
-	if i < 0 || i >= it.size {
+	if i < 0 || i >= it.size || it.guard.Err() != nil {
 		return false
 	}
 
@@ -14,6 +14,12 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[int, string] = (*Iterator[string])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*Iterator[string])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*Iterator[string])(nil)
+
 type Iterator[T comparable] struct {
 	s             *Set[T]
 	orderIterator *doublylinkedlist.Iterator[T]
@@ -57,10 +63,17 @@ func (it *Iterator[T]) IsLast() bool {
 }
 
 func (it *Iterator[T]) IsValid() bool {
-	return it.size > 0 && !it.IsBegin() && !it.IsEnd()
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the set was structurally modified, other than through the iterator.
+func (it *Iterator[T]) Err() error {
+	return it.orderIterator.Err()
 }
 
 func (it *Iterator[T]) IsEqual(other ds.ComparableIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -70,7 +83,23 @@ func (it *Iterator[T]) IsEqual(other ds.ComparableIterator) bool {
 
 }
 
+// CanCompare checks if other is an iterator of the same set.
+func (it *Iterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := ds.BaseOf[int, T](other).(*Iterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.s != it.s {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 func (it *Iterator[T]) DistanceTo(other ds.OrderedIterator) int {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -80,6 +109,8 @@ func (it *Iterator[T]) DistanceTo(other ds.OrderedIterator) int {
 }
 
 func (it *Iterator[T]) IsAfter(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -89,6 +120,8 @@ func (it *Iterator[T]) IsAfter(other ds.OrderedIterator) bool {
 }
 
 func (it *Iterator[T]) IsBefore(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*Iterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -185,11 +218,8 @@ func (it *Iterator[T]) Set(value T) bool {
 		return false
 	}
 
-	it.orderIterator.Set(value)
-
-	valueToRemove, _ := it.Get()
-	it.s.Remove(it.comparator, valueToRemove)
-	it.s.table[value] = itemExists
+	valueToReplace, _ := it.Get()
+	it.s.replace(valueToReplace, value)
 	it.value = value
 
 	return true
@@ -200,9 +230,16 @@ func (it *Iterator[T]) SetAt(i int, value T) bool {
 		return false
 	}
 
-	valueToRemove, _ := it.Get()
-	delete(it.s.table, valueToRemove)
-	it.s.table[value] = itemExists
+	valueToReplace, found := it.orderIterator.GetAt(i)
+	if !found {
+		return false
+	}
+
+	it.s.replace(valueToReplace, value)
+
+	if i == it.index {
+		it.value = value
+	}
 
 	return true
 }
@@ -1,6 +1,7 @@
 package linkedhashset
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
@@ -806,6 +807,47 @@ func TestLinkedHashSetIteratorSet(t *testing.T) {
 	}
 }
 
+func TestLinkedHashSetIteratorSetReplacesValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      *Set[int]
+		position int
+		value    int
+		values   []int
+	}{
+		{
+			name:     "new value",
+			set:      New[int](1, 2, 3),
+			position: 1,
+			value:    5,
+			values:   []int{1, 5, 3},
+		},
+		{
+			name:     "existing value",
+			set:      New[int](1, 2, 3),
+			position: 0,
+			value:    3,
+			values:   []int{3, 2},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.set.First(utils.BasicComparator[int])
+			it.MoveTo(test.position)
+
+			assert.Truef(t, it.Set(test.value), test.name)
+			assert.Equalf(t, test.values, test.set.GetValues(), test.name)
+			assert.Truef(t, test.set.Contains(test.values...), test.name)
+			assert.Equalf(t, len(test.values), test.set.Size(), test.name)
+		})
+	}
+}
+
 func TestLinkedHashSetIteratorGetAt(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1165,3 +1207,191 @@ func TestLinkedHashSetIteratorSize(t *testing.T) {
 		})
 	}
 }
+
+func TestLinkedHashSetReverseIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		container *Set[int]
+	}{
+		{
+			name:      "Empty",
+			container: New[int](),
+		},
+		{
+			name:      "One element",
+			container: New[int](1),
+		},
+		{
+			name:      "3 elements",
+			container: New[int](3, 1, 2),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			values := []int{}
+			for it := test.container.Begin(utils.BasicComparator[int]); it.Next(); {
+				value, _ := it.Get()
+				values = append(values, value)
+			}
+
+			reversed := []int{}
+			for it := test.container.ReverseBegin(utils.BasicComparator[int]); it.Next(); {
+				value, _ := it.Get()
+				reversed = append(reversed, value)
+			}
+
+			backward := []int{}
+			for it := test.container.ReverseEnd(utils.BasicComparator[int]); it.Previous(); {
+				value, _ := it.Get()
+				backward = append(backward, value)
+			}
+
+			assert.Equalf(t, values, backward, test.name)
+
+			slices.Reverse(values)
+			assert.Equalf(t, values, reversed, test.name)
+
+			first, found := test.container.ReverseFirst(utils.BasicComparator[int]).Get()
+			last, _ := test.container.ReverseLast(utils.BasicComparator[int]).Get()
+			assert.Equalf(t, len(values) > 0, found, test.name)
+
+			if len(values) > 0 {
+				assert.Equalf(t, values[0], first, test.name)
+				assert.Equalf(t, values[len(values)-1], last, test.name)
+			}
+		})
+	}
+}
+
+func TestLinkedHashSetReverseIteratorSet(t *testing.T) {
+	set := New[int](1, 2, 3)
+	it := set.ReverseFirst(utils.BasicComparator[int])
+
+	// Replacing an item keeps its position in the insertion order
+	assert.True(t, it.Set(30))
+	assert.True(t, it.SetAt(0, 10))
+	assert.Equal(t, []int{10, 2, 30}, set.GetValues())
+	assert.False(t, set.Contains(1, 3))
+	assert.NoError(t, it.(ds.FailFastIterator).Err())
+
+	assert.True(t, it.Next())
+
+	value, _ := it.Get()
+	assert.Equal(t, 2, value)
+
+	// Replacing an item by one contained elsewhere removes the other occurrence, which invalidates the iterator
+	assert.True(t, it.Set(10))
+	assert.Equal(t, []int{10, 30}, set.GetValues())
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+}
+
+func TestLinkedHashSetReverseIteratorCompare(t *testing.T) {
+	tests := []struct {
+		name          string
+		item          int
+		reversed      bool
+		otherItem     int
+		otherReversed bool
+		distance      int
+	}{
+		{
+			name:          "Reversed items",
+			item:          4,
+			reversed:      true,
+			otherItem:     1,
+			otherReversed: true,
+			distance:      -2,
+		},
+		{
+			name:          "Reversed item to forward item",
+			item:          4,
+			reversed:      true,
+			otherItem:     1,
+			otherReversed: false,
+			distance:      2,
+		},
+		{
+			name:          "Reversed item to same forward item",
+			item:          3,
+			reversed:      true,
+			otherItem:     3,
+			otherReversed: false,
+			distance:      0,
+		},
+		{
+			name:          "Forward item to reversed item",
+			item:          1,
+			reversed:      false,
+			otherItem:     3,
+			otherReversed: true,
+			distance:      -1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			// Removing 2 from the middle closes the gap, so the order is 1, 3, 4
+			set := New[int](1, 2, 3, 4)
+			set.Remove(nil, 2)
+
+			it := atItem(set, test.reversed, test.item)
+			other := atItem(set, test.otherReversed, test.otherItem)
+
+			distance, err := ds.CheckedDistanceTo(it, other)
+			assert.NoErrorf(t, err, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+			assert.Equalf(t, -test.distance, other.DistanceTo(it), test.name)
+			assert.Equalf(t, test.distance < 0, it.IsBefore(other), test.name)
+			assert.Equalf(t, test.distance > 0, other.IsBefore(it), test.name)
+			assert.Equalf(t, test.distance == 0, other.IsEqual(it), test.name)
+		})
+	}
+
+	set := New[int](1, 2, 3)
+	other := set.Clone()
+	assert.ErrorIs(t, ds.CanCompare(set.First(utils.BasicComparator[int]), other.ReverseFirst(utils.BasicComparator[int])), ds.ErrForeignIterators)
+	assert.ErrorIs(t, ds.CanCompare(set.ReverseFirst(utils.BasicComparator[int]), other.First(utils.BasicComparator[int])), ds.ErrForeignIterators)
+}
+
+func TestLinkedHashSetReverseIteratorFailFast(t *testing.T) {
+	set := New[int](1, 2, 3)
+	it := set.ReverseFirst(utils.BasicComparator[int])
+
+	// Re-adding an item does not change the insertion order
+	set.Add(1)
+
+	assert.NoError(t, it.(ds.FailFastIterator).Err())
+
+	set.InsertBefore(3, 4)
+
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Next())
+}
+
+// atItem returns a forward or reversed iterator of set, which points to item.
+func atItem(set *Set[int], reversed bool, item int) ds.ReadWriteOrdCompBidRandCollIterator[int, int] {
+	it := set.Begin(utils.BasicComparator[int])
+	if reversed {
+		it = set.ReverseBegin(utils.BasicComparator[int])
+	}
+
+	for it.Next() {
+		if value, _ := it.Get(); value == item {
+			break
+		}
+	}
+
+	return it
+}
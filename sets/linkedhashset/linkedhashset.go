@@ -6,6 +6,7 @@
 // Package linkedhashset is a set that preserves insertion-order.
 //
 // It is backed by a hash table to store values and doubly-linked list to store ordering.
+// The hash table also stores each value's node of the ordering, so that removing and moving values runs in O(1).
 //
 // Note that insertion-order is not affected if an element is re-inserted into the set.
 //
@@ -16,6 +17,7 @@ package linkedhashset
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
@@ -27,19 +29,20 @@ import (
 // Assert Set implementation
 var _ sets.Set[string] = (*Set[string])(nil)
 
+// Assert Cloneable implementation
+var _ ds.Cloneable[*Set[string]] = (*Set[string])(nil)
+
 // Set holds elements in go's native map
 type Set[T comparable] struct {
-	table      map[T]struct{}
+	table      map[T]*doublylinkedlist.Element[T]
 	ordering   *doublylinkedlist.List[T]
 	comparator utils.Comparator[T]
 }
 
-var itemExists = struct{}{}
-
 // New instantiates a new empty set and adds the passed values, if any, to the set
 func New[T comparable](values ...T) *Set[T] {
 	set := &Set[T]{
-		table:    make(map[T]struct{}),
+		table:    make(map[T]*doublylinkedlist.Element[T]),
 		ordering: doublylinkedlist.New[T](),
 	}
 	if len(values) > 0 {
@@ -50,25 +53,21 @@ func New[T comparable](values ...T) *Set[T] {
 
 // NewFromMap instantiates a new  set from the provided slice.
 func NewFromSlice[T comparable](slice []T) *Set[T] {
-	s := &Set[T]{table: make(map[T]struct{}), ordering: doublylinkedlist.New[T]()}
+	s := New[T]()
 
-	for _, item := range slice {
-		s.table[item] = itemExists
-		s.ordering.PushBack(item)
-	}
+	s.Add(slice...)
 
 	return s
 }
 
 // NewFromIterator instantiates a new set containing the elements provided by the passed iterator.
 func NewFromIterator[T comparable](begin ds.ReadForIndexIterator[int, T]) *Set[T] {
-	s := &Set[T]{table: make(map[T]struct{}), ordering: doublylinkedlist.New[T]()}
+	s := New[T]()
 
 	for begin.Next() {
 		newValue, _ := begin.Get()
 
-		s.table[newValue] = itemExists
-		s.ordering.PushBack(newValue)
+		s.Add(newValue)
 	}
 
 	return s
@@ -77,13 +76,12 @@ func NewFromIterator[T comparable](begin ds.ReadForIndexIterator[int, T]) *Set[T
 // NewFromIterators instantiates a new set containing the elements provided by first, until it is equal to end.
 // end is a sentinel and not included.
 func NewFromIterators[T comparable](begin ds.ReadCompForIndexIterator[int, T], end ds.CompIndexIterator[int]) *Set[T] {
-	s := &Set[T]{table: make(map[T]struct{}), ordering: doublylinkedlist.New[T]()}
+	s := New[T]()
 
 	for !begin.IsEqual(end) && begin.Next() {
 		newValue, _ := begin.Get()
 
-		s.table[newValue] = itemExists
-		s.ordering.PushBack(newValue)
+		s.Add(newValue)
 	}
 
 	return s
@@ -94,24 +92,100 @@ func NewFromIterators[T comparable](begin ds.ReadCompForIndexIterator[int, T], e
 func (set *Set[T]) Add(items ...T) {
 	for _, item := range items {
 		if _, contains := set.table[item]; !contains {
-			set.table[item] = itemExists
-			set.ordering.PushBack(item)
+			set.table[item] = set.ordering.PushBackElement(item)
 		}
 	}
 }
 
-// Remove removes the items (one or more) from the set.
-// Slow operation, worst-case O(n^2).
+// Remove removes the items (one or more) from the set in O(1) per item.
+// The comparator is not used and may be nil.
 func (set *Set[T]) Remove(comparator utils.Comparator[T], items ...T) {
 	for _, item := range items {
-		if _, contains := set.table[item]; contains {
+		if element, contains := set.table[item]; contains {
 			delete(set.table, item)
-			index := set.ordering.IndexOf(comparator, item)
-			set.ordering.Remove(index)
+			set.ordering.RemoveElement(element)
 		}
 	}
 }
 
+// MoveToFront moves item to the start of the ordering in O(1).
+// Returns false if item is not found, otherwise true.
+func (set *Set[T]) MoveToFront(item T) bool {
+	element, contains := set.table[item]
+	if !contains {
+		return false
+	}
+
+	set.ordering.MoveToFront(element)
+
+	return true
+}
+
+// MoveToBack moves item to the end of the ordering in O(1).
+// Returns false if item is not found, otherwise true.
+func (set *Set[T]) MoveToBack(item T) bool {
+	element, contains := set.table[item]
+	if !contains {
+		return false
+	}
+
+	set.ordering.MoveToBack(element)
+
+	return true
+}
+
+// InsertBefore inserts newItem directly before item in O(1).
+// If newItem is already contained, it is moved before item.
+// Returns false if item is not found, otherwise true.
+func (set *Set[T]) InsertBefore(item T, newItem T) bool {
+	mark, contains := set.table[item]
+	if !contains {
+		return false
+	}
+
+	if element, contains := set.table[newItem]; contains {
+		set.ordering.MoveBefore(element, mark)
+	} else {
+		set.table[newItem] = set.ordering.InsertBeforeElement(newItem, mark)
+	}
+
+	return true
+}
+
+// InsertAfter inserts newItem directly after item in O(1).
+// If newItem is already contained, it is moved after item.
+// Returns false if item is not found, otherwise true.
+func (set *Set[T]) InsertAfter(item T, newItem T) bool {
+	mark, contains := set.table[item]
+	if !contains {
+		return false
+	}
+
+	if element, contains := set.table[newItem]; contains {
+		set.ordering.MoveAfter(element, mark)
+	} else {
+		set.table[newItem] = set.ordering.InsertAfterElement(newItem, mark)
+	}
+
+	return true
+}
+
+// replace replaces oldItem by newItem, keeping oldItem's position in the ordering.
+// If newItem is already contained elsewhere, that occurrence is removed.
+func (set *Set[T]) replace(oldItem T, newItem T) {
+	if oldItem == newItem {
+		return
+	}
+
+	element := set.table[oldItem]
+
+	set.Remove(nil, newItem)
+	delete(set.table, oldItem)
+
+	element.SetValue(newItem)
+	set.table[newItem] = element
+}
+
 // Contains check if items (one or more) are present in the set.
 // All items have to be present in the set for the method to return true.
 // Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
@@ -136,7 +210,7 @@ func (set *Set[T]) Size() int {
 
 // Clear clears all values in the set.
 func (set *Set[T]) Clear() {
-	set.table = make(map[T]struct{})
+	set.table = make(map[T]*doublylinkedlist.Element[T])
 	set.ordering.Clear()
 }
 
@@ -167,25 +241,23 @@ func (set *Set[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the set with the same ordering, which does not share any internal state with the original.
+func (set *Set[T]) Clone() *Set[T] {
+	clone := New(set.ordering.GetValues()...)
+	clone.comparator = set.comparator
+
+	return clone
+}
+
 // Intersection returns the intersection between two sets.
-// The new set consists of all elements that are both in "set" and "other".
+// The new set consists of all elements that are both in "set" and "other" in the order of "set".
 // Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
 func (set *Set[T]) MakeIntersectionWith(other sets.Set[T]) sets.Set[T] {
 	result := New[T]()
-	concrete := other.(*Set[T])
 
-	// Iterate over smaller set (optimization)
-	if set.Size() <= other.Size() {
-		for item := range set.table {
-			if _, contains := concrete.table[item]; contains {
-				result.Add(item)
-			}
-		}
-	} else {
-		for item := range concrete.table {
-			if _, contains := set.table[item]; contains {
-				result.Add(item)
-			}
+	for _, item := range set.GetValues() {
+		if other.Contains(item) {
+			result.Add(item)
 		}
 	}
 
@@ -194,30 +266,44 @@ func (set *Set[T]) MakeIntersectionWith(other sets.Set[T]) sets.Set[T] {
 
 // Union returns the union of two sets.
 // The new set consists of all elements that are in "set" or "other" (possibly both).
+// The elements of "set" come first, followed by the remaining elements of "other".
 // Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
 func (set *Set[T]) MakeUnionWith(other sets.Set[T]) sets.Set[T] {
-	result := New[T]()
-	concrete := other.(*Set[T])
-
-	for item := range set.table {
-		result.Add(item)
-	}
-	for item := range concrete.table {
-		result.Add(item)
-	}
+	result := New(set.GetValues()...)
+	result.Add(other.GetValues()...)
 
 	return result
 }
 
 // Difference returns the difference between two sets.
-// The new set consists of all elements that are in "set" but not in "other".
+// The new set consists of all elements that are in "set" but not in "other" in the order of "set".
 // Ref: https://proofwiki.org/wiki/Definition:Set_Difference
 func (set *Set[T]) MakeDifferenceWith(other sets.Set[T]) sets.Set[T] {
 	result := New[T]()
-	concrete := other.(*Set[T])
 
-	for item := range set.table {
-		if _, contains := concrete.table[item]; !contains {
+	for _, item := range set.GetValues() {
+		if !other.Contains(item) {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifference returns the symmetric difference between two sets.
+// The new set consists of all elements that are either in "set" or in "other", but not in both.
+// The elements of "set" come first, followed by the ones of "other".
+// Ref: https://en.wikipedia.org/wiki/Symmetric_difference
+func (set *Set[T]) MakeSymmetricDifferenceWith(other sets.Set[T]) sets.Set[T] {
+	result := New[T]()
+
+	for _, item := range set.GetValues() {
+		if !other.Contains(item) {
+			result.Add(item)
+		}
+	}
+	for _, item := range other.GetValues() {
+		if !set.Contains(item) {
 			result.Add(item)
 		}
 	}
@@ -225,6 +311,74 @@ func (set *Set[T]) MakeDifferenceWith(other sets.Set[T]) sets.Set[T] {
 	return result
 }
 
+// IsSubsetOf returns true if all elements of the set are contained in other.
+func (set *Set[T]) IsSubsetOf(other sets.Set[T]) bool {
+	if set.Size() > other.Size() {
+		return false
+	}
+
+	for item := range set.table {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSupersetOf returns true if all elements of other are contained in the set.
+func (set *Set[T]) IsSupersetOf(other sets.Set[T]) bool {
+	if set.Size() < other.Size() {
+		return false
+	}
+
+	return set.Contains(other.GetValues()...)
+}
+
+// IsDisjointWith returns true if the set and other have no elements in common.
+func (set *Set[T]) IsDisjointWith(other sets.Set[T]) bool {
+	// Iterate over smaller set (optimization)
+	if set.Size() <= other.Size() {
+		for item := range set.table {
+			if other.Contains(item) {
+				return false
+			}
+		}
+	} else {
+		for _, item := range other.GetValues() {
+			if _, contains := set.table[item]; contains {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if the set and other contain the same elements, regardless of their order.
+func (set *Set[T]) Equals(other sets.Set[T]) bool {
+	return set.Size() == other.Size() && set.IsSubsetOf(other)
+}
+
+// UnionInPlace appends all elements of other, which are not yet contained, to the set.
+func (set *Set[T]) UnionInPlace(other sets.Set[T]) {
+	set.Add(other.GetValues()...)
+}
+
+// RetainAll removes all elements from the set, which are not contained in other.
+func (set *Set[T]) RetainAll(other sets.Set[T]) {
+	for item := range set.table {
+		if !other.Contains(item) {
+			set.Remove(nil, item)
+		}
+	}
+}
+
+// RemoveAll removes all elements from the set, which are contained in other.
+func (set *Set[T]) RemoveAll(other sets.Set[T]) {
+	set.Remove(nil, other.GetValues()...)
+}
+
 //******************************************************************//
 //                             iterator                             //
 //******************************************************************//
@@ -250,3 +404,45 @@ func (s *Set[T]) First(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRan
 func (s *Set[T]) Last(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 	return s.NewIterator(s.Size()-1, s.Size(), comparator)
 }
+
+//******************************************************************//
+//                         Reverse iterator                         //
+//******************************************************************//
+
+// ReverseBegin returns an initialized, reversed iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (s *Set[T]) ReverseBegin(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(s.End(comparator))
+}
+
+// ReverseEnd returns an initialized, reversed iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (s *Set[T]) ReverseEnd(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(s.Begin(comparator))
+}
+
+// ReverseFirst returns an initialized, reversed iterator, which points to it's first element.
+func (s *Set[T]) ReverseFirst(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(s.Last(comparator))
+}
+
+// ReverseLast returns an initialized, reversed iterator, which points to it's last element.
+func (s *Set[T]) ReverseLast(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(s.First(comparator))
+}
+
+// All returns a sequence over the items of the set in insertion order.
+func (set *Set[T]) All() iter.Seq[T] {
+	return set.ordering.Values()
+}
+
+// Backward returns a sequence over the items of the set in reverse insertion order.
+func (set *Set[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range set.ordering.Backward() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
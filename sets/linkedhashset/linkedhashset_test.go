@@ -6,10 +6,10 @@
 package linkedhashset
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/exp/maps"
@@ -62,6 +62,180 @@ func TestLinkedHashSetRemove(t *testing.T) {
 	}
 }
 
+func TestLinkedHashSetRemoveKeepsOrdering(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalSet *Set[string]
+		toRemove    []string
+		values      []string
+	}{
+		{
+			name:        "first",
+			originalSet: NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			toRemove:    []string{"foo"},
+			values:      []string{"bar", "baz"},
+		},
+		{
+			name:        "last",
+			originalSet: NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			toRemove:    []string{"baz"},
+			values:      []string{"foo", "bar"},
+		},
+		{
+			name:        "all, then re-add",
+			originalSet: NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			toRemove:    []string{"foo", "bar", "baz"},
+			values:      []string{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.originalSet.Remove(nil, test.toRemove...)
+
+			assert.Equalf(t, test.values, test.originalSet.GetValues(), test.name)
+			assert.Equalf(t, len(test.values), test.originalSet.Size(), test.name)
+
+			test.originalSet.Add("qux")
+
+			assert.Equalf(t, append(test.values, "qux"), test.originalSet.GetValues(), test.name)
+		})
+	}
+}
+
+func TestLinkedHashSetMove(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalSet *Set[string]
+		item        string
+		toFront     bool
+		moved       bool
+		values      []string
+	}{
+		{
+			name:        "empty set",
+			originalSet: New[string](),
+			item:        "foo",
+			toFront:     true,
+			moved:       false,
+			values:      []string{},
+		},
+		{
+			name:        "last to front",
+			originalSet: NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			item:        "baz",
+			toFront:     true,
+			moved:       true,
+			values:      []string{"baz", "foo", "bar"},
+		},
+		{
+			name:        "first to back",
+			originalSet: NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			item:        "foo",
+			toFront:     false,
+			moved:       true,
+			values:      []string{"bar", "baz", "foo"},
+		},
+		{
+			name:        "missing item",
+			originalSet: NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			item:        "qux",
+			toFront:     false,
+			moved:       false,
+			values:      []string{"foo", "bar", "baz"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			var moved bool
+			if test.toFront {
+				moved = test.originalSet.MoveToFront(test.item)
+			} else {
+				moved = test.originalSet.MoveToBack(test.item)
+			}
+
+			assert.Equalf(t, test.moved, moved, test.name)
+			assert.Equalf(t, test.values, test.originalSet.GetValues(), test.name)
+		})
+	}
+}
+
+func TestLinkedHashSetInsert(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalSet *Set[string]
+		item        string
+		newItem     string
+		before      bool
+		inserted    bool
+		values      []string
+	}{
+		{
+			name:        "empty set",
+			originalSet: New[string](),
+			item:        "foo",
+			newItem:     "qux",
+			before:      true,
+			inserted:    false,
+			values:      []string{},
+		},
+		{
+			name:        "before first",
+			originalSet: NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			item:        "foo",
+			newItem:     "qux",
+			before:      true,
+			inserted:    true,
+			values:      []string{"qux", "foo", "bar", "baz"},
+		},
+		{
+			name:        "after middle",
+			originalSet: NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			item:        "bar",
+			newItem:     "qux",
+			before:      false,
+			inserted:    true,
+			values:      []string{"foo", "bar", "qux", "baz"},
+		},
+		{
+			name:        "existing item before",
+			originalSet: NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			item:        "foo",
+			newItem:     "baz",
+			before:      true,
+			inserted:    true,
+			values:      []string{"baz", "foo", "bar"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			var inserted bool
+			if test.before {
+				inserted = test.originalSet.InsertBefore(test.item, test.newItem)
+			} else {
+				inserted = test.originalSet.InsertAfter(test.item, test.newItem)
+			}
+
+			assert.Equalf(t, test.inserted, inserted, test.name)
+			assert.Equalf(t, test.values, test.originalSet.GetValues(), test.name)
+		})
+	}
+}
+
 func TestLinkedHashSetAdd(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -624,3 +798,55 @@ func BenchmarkLinkedHashSetGetValues(b *testing.B) {
 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 	}
 }
+
+func TestLinkedHashSetClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Set[string]
+	}{
+		{
+			name:     "empty",
+			original: New[string](),
+		},
+		{
+			name:     "3 items",
+			original: New[string]("foo", "bar", "baz"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			values := test.original.GetValues()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original), test.name)
+			assert.Equalf(t, values, clone.GetValues(), test.name)
+
+			clone.Add("qux")
+
+			assert.Falsef(t, clone.Equals(test.original), test.name)
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	set := New("c", "a", "b")
+
+	assert.Equal(t, []string{"c", "a", "b"}, slices.Collect(set.All()))
+	assert.Equal(t, []string{"b", "a", "c"}, slices.Collect(set.Backward()))
+
+	items := []string{}
+	for item := range set.All() {
+		items = append(items, item)
+		if len(items) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"c", "a"}, items)
+}
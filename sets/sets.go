@@ -23,6 +23,19 @@ type Set[T any] interface {
 	MakeIntersectionWith(other Set[T]) Set[T]
 	MakeUnionWith(other Set[T]) Set[T]
 	MakeDifferenceWith(other Set[T]) Set[T]
+	MakeSymmetricDifferenceWith(other Set[T]) Set[T]
+
+	IsSubsetOf(other Set[T]) bool
+	IsSupersetOf(other Set[T]) bool
+	IsDisjointWith(other Set[T]) bool
+	Equals(other Set[T]) bool
+
+	// UnionInPlace adds all elements of other to the set.
+	UnionInPlace(other Set[T])
+	// RetainAll removes all elements from the set, which are not contained in other.
+	RetainAll(other Set[T])
+	// RemoveAll removes all elements from the set, which are contained in other.
+	RemoveAll(other Set[T])
 
 	ds.Container[T]
 	// IsEmpty() bool
@@ -0,0 +1,248 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sets_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/sets"
+	"github.com/JonasMuehlmann/datastructures.go/sets/hashset"
+	"github.com/JonasMuehlmann/datastructures.go/sets/linkedhashset"
+	"github.com/JonasMuehlmann/datastructures.go/sets/treeset"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type setConstructor struct {
+	name string
+	new  func(values ...int) sets.Set[int]
+}
+
+var setConstructors = []setConstructor{
+	{
+		name: "HashSet",
+		new:  func(values ...int) sets.Set[int] { return hashset.New(values...) },
+	},
+	{
+		name: "LinkedHashSet",
+		new:  func(values ...int) sets.Set[int] { return linkedhashset.New(values...) },
+	},
+	{
+		name: "TreeSet",
+		new:  func(values ...int) sets.Set[int] { return treeset.New(utils.BasicComparator[int], values...) },
+	},
+}
+
+func sortedValues(set sets.Set[int]) []int {
+	values := set.GetValues()
+	sort.Ints(values)
+
+	return values
+}
+
+// forEachCombination runs f for every pair of set implementations.
+func forEachCombination(t *testing.T, testName string, f func(t *testing.T, name string, first setConstructor, second setConstructor)) {
+	for _, first := range setConstructors {
+		for _, second := range setConstructors {
+			first := first
+			second := second
+			name := testName + "/" + first.name + " with " + second.name
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				defer testCommon.HandlePanic(t, name)
+				f(t, name, first, second)
+			})
+		}
+	}
+}
+
+func TestSetMakeOperations(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         []int
+		b         []int
+		operation func(a sets.Set[int], b sets.Set[int]) sets.Set[int]
+		result    []int
+	}{
+		{
+			name:      "intersection",
+			a:         []int{1, 2, 3, 5},
+			b:         []int{2, 4, 5, 6},
+			operation: sets.Set[int].MakeIntersectionWith,
+			result:    []int{2, 5},
+		},
+		{
+			name:      "union",
+			a:         []int{1, 2, 3, 5},
+			b:         []int{2, 4, 5, 6},
+			operation: sets.Set[int].MakeUnionWith,
+			result:    []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:      "difference",
+			a:         []int{1, 2, 3, 5},
+			b:         []int{2, 4, 5, 6},
+			operation: sets.Set[int].MakeDifferenceWith,
+			result:    []int{1, 3},
+		},
+		{
+			name:      "symmetric difference",
+			a:         []int{1, 2, 3, 5},
+			b:         []int{2, 4, 5, 6},
+			operation: sets.Set[int].MakeSymmetricDifferenceWith,
+			result:    []int{1, 3, 4, 6},
+		},
+		{
+			name:      "symmetric difference with empty",
+			a:         []int{1, 2},
+			b:         []int{},
+			operation: sets.Set[int].MakeSymmetricDifferenceWith,
+			result:    []int{1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		forEachCombination(t, test.name, func(t *testing.T, name string, first setConstructor, second setConstructor) {
+			a := first.new(test.a...)
+			b := second.new(test.b...)
+
+			assert.Equalf(t, test.result, sortedValues(test.operation(a, b)), name)
+			assert.Equalf(t, test.a, sortedValues(a), name)
+			assert.Equalf(t, test.b, sortedValues(b), name)
+		})
+	}
+}
+
+func TestSetRelations(t *testing.T) {
+	tests := []struct {
+		name       string
+		a          []int
+		b          []int
+		isSubset   bool
+		isSuperset bool
+		isDisjoint bool
+		equals     bool
+	}{
+		{
+			name:       "both empty",
+			a:          []int{},
+			b:          []int{},
+			isSubset:   true,
+			isSuperset: true,
+			isDisjoint: true,
+			equals:     true,
+		},
+		{
+			name:       "equal",
+			a:          []int{1, 2, 3},
+			b:          []int{3, 2, 1},
+			isSubset:   true,
+			isSuperset: true,
+			equals:     true,
+		},
+		{
+			name:     "proper subset",
+			a:        []int{1, 3},
+			b:        []int{1, 2, 3},
+			isSubset: true,
+		},
+		{
+			name:       "proper superset",
+			a:          []int{1, 2, 3, 4},
+			b:          []int{2, 4},
+			isSuperset: true,
+		},
+		{
+			name:       "disjoint",
+			a:          []int{1, 3},
+			b:          []int{2, 4, 6},
+			isDisjoint: true,
+		},
+		{
+			name: "overlapping",
+			a:    []int{1, 2, 3},
+			b:    []int{3, 4, 5},
+		},
+		{
+			name:       "empty subset",
+			a:          []int{},
+			b:          []int{1},
+			isSubset:   true,
+			isDisjoint: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		forEachCombination(t, test.name, func(t *testing.T, name string, first setConstructor, second setConstructor) {
+			a := first.new(test.a...)
+			b := second.new(test.b...)
+
+			assert.Equalf(t, test.isSubset, a.IsSubsetOf(b), name)
+			assert.Equalf(t, test.isSuperset, a.IsSupersetOf(b), name)
+			assert.Equalf(t, test.isDisjoint, a.IsDisjointWith(b), name)
+			assert.Equalf(t, test.equals, a.Equals(b), name)
+		})
+	}
+}
+
+func TestSetInPlaceOperations(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         []int
+		b         []int
+		operation func(a sets.Set[int], b sets.Set[int])
+		result    []int
+	}{
+		{
+			name:      "union",
+			a:         []int{1, 3},
+			b:         []int{2, 3},
+			operation: sets.Set[int].UnionInPlace,
+			result:    []int{1, 2, 3},
+		},
+		{
+			name:      "retain all",
+			a:         []int{1, 2, 3, 4},
+			b:         []int{2, 4, 6},
+			operation: sets.Set[int].RetainAll,
+			result:    []int{2, 4},
+		},
+		{
+			name:      "retain none",
+			a:         []int{1, 2},
+			b:         []int{},
+			operation: sets.Set[int].RetainAll,
+			result:    []int{},
+		},
+		{
+			name:      "remove all",
+			a:         []int{1, 2, 3, 4},
+			b:         []int{2, 4, 6},
+			operation: sets.Set[int].RemoveAll,
+			result:    []int{1, 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		forEachCombination(t, test.name, func(t *testing.T, name string, first setConstructor, second setConstructor) {
+			a := first.new(test.a...)
+			b := second.new(test.b...)
+			test.operation(a, b)
+
+			assert.Equalf(t, test.result, sortedValues(a), name)
+			assert.Equalf(t, len(test.result), a.Size(), name)
+			assert.Equalf(t, test.b, sortedValues(b), name)
+		})
+	}
+}
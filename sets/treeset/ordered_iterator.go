@@ -13,6 +13,12 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[int, string] = (*OrderedIterator[string])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[string])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[string])(nil)
+
 // Iterator holding the iterator's state
 type OrderedIterator[T comparable] struct {
 	*redblacktree.OrderedIterator[T, struct{}]
@@ -28,6 +34,8 @@ func (set *Set[T]) NewOrderedIterator(index int, size int) *OrderedIterator[T] {
 
 // If other is of type IndexedIterator, IndexedIterator.Index() will be used, possibly executing in O(1)
 func (it *OrderedIterator[T]) DistanceTo(other ds.OrderedIterator) int {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*OrderedIterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -42,6 +50,8 @@ func (it *OrderedIterator[T]) DistanceTo(other ds.OrderedIterator) int {
 }
 
 func (it *OrderedIterator[T]) IsAfter(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*OrderedIterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -51,6 +61,8 @@ func (it *OrderedIterator[T]) IsAfter(other ds.OrderedIterator) bool {
 }
 
 func (it *OrderedIterator[T]) IsBefore(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*OrderedIterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -60,6 +72,8 @@ func (it *OrderedIterator[T]) IsBefore(other ds.OrderedIterator) bool {
 }
 
 func (it *OrderedIterator[T]) IsEqual(other ds.ComparableIterator) bool {
+	other = ds.BaseOf[int, T](other)
+
 	otherThis, ok := other.(*OrderedIterator[T])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -68,6 +82,20 @@ func (it *OrderedIterator[T]) IsEqual(other ds.ComparableIterator) bool {
 	return it.DistanceTo(otherThis) == 0
 }
 
+// CanCompare checks if other is an iterator of the same set.
+func (it *OrderedIterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := ds.BaseOf[int, T](other).(*OrderedIterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if it.OrderedIterator.CanCompare(otherThis.OrderedIterator) != nil {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 // PERF: These methods are inefficient, but the API is limiting here
 func (it *OrderedIterator[T]) Get() (value T, found bool) {
 	return it.OrderedIterator.GetKey()
@@ -83,9 +111,9 @@ func (it *OrderedIterator[T]) Set(value T) bool {
 	}
 
 	curKey, _ := it.OrderedIterator.GetKey()
-	// FIX: This probably does not change the cached value in the tree iterator
 	it.set.tree.Remove(curKey)
 	it.set.tree.Put(value, struct{}{})
+	it.reset(value, true)
 
 	return true
 }
@@ -114,14 +142,31 @@ func (it *OrderedIterator[T]) SetAt(i int, value T) bool {
 	}
 
 	keyToRemove, _ := treeIteratorCopy.GetKey()
+	curKey, valid := it.OrderedIterator.GetKey()
 
-	// FIX: This probably does not change the cached value in the tree iterator
 	it.set.tree.Remove(keyToRemove)
 	it.set.tree.Put(value, struct{}{})
 
+	if valid && curKey == keyToRemove {
+		curKey = value
+	}
+
+	it.reset(curKey, valid)
+
 	return true
 }
 
+// reset recreates the tree iterator after the set was modified through the iterator,
+// since the removed node may have been reused by the tree.
+// The iterator is moved to item, if valid is true, otherwise to before the first element.
+func (it *OrderedIterator[T]) reset(item T, valid bool) {
+	it.OrderedIterator = it.set.tree.NewOrderedIterator(-1, it.set.tree.Size())
+
+	if valid {
+		it.OrderedIterator.MoveToKey(item)
+	}
+}
+
 func (it *OrderedIterator[T]) SetAtKey(i int, value T) bool {
 	return it.SetAt(i, value)
 }
@@ -1,6 +1,7 @@
 package treeset
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
@@ -1089,3 +1090,213 @@ func TestTreeIteratorSize(t *testing.T) {
 		})
 	}
 }
+
+func TestTreeSetIteratorFailFast(t *testing.T) {
+	set := New[int](utils.BasicComparator[int], 1, 2, 3, 4)
+	it := set.OrderedFirst(utils.BasicComparator[int]).(*OrderedIterator[int])
+
+	// Removing an element through the set instead of the iterator stops the iteration
+	for ; it.IsValid(); it.Next() {
+		value, _ := it.Get()
+		if value%2 == 0 {
+			set.Remove(utils.BasicComparator[int], value)
+		}
+	}
+
+	assert.ErrorIs(t, it.Err(), ds.ErrConcurrentModification)
+	assert.Equal(t, []int{1, 3, 4}, set.GetValues())
+}
+
+func TestTreeSetIteratorFailFastOwnModifications(t *testing.T) {
+	set := New[int](utils.BasicComparator[int], 1, 2, 3)
+	it := set.OrderedFirst(utils.BasicComparator[int]).(*OrderedIterator[int])
+
+	assert.True(t, it.Set(4))
+	assert.NoError(t, it.Err())
+
+	value, found := it.Get()
+	assert.True(t, found)
+	assert.Equal(t, 4, value)
+
+	assert.True(t, it.SetAt(0, 0))
+	assert.NoError(t, it.Err())
+
+	value, found = it.Get()
+	assert.True(t, found)
+	assert.Equal(t, 4, value)
+	assert.Equal(t, []int{0, 3, 4}, set.GetValues())
+
+	set.Add(5)
+	assert.ErrorIs(t, it.Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.Set(6))
+}
+
+func TestTreeSetReverseIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		container *Set[int]
+	}{
+		{
+			name:      "Empty",
+			container: New[int](utils.BasicComparator[int]),
+		},
+		{
+			name:      "One element",
+			container: New[int](utils.BasicComparator[int], 1),
+		},
+		{
+			name:      "3 elements",
+			container: New[int](utils.BasicComparator[int], 3, 1, 2),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			values := []int{}
+			for it := test.container.OrderedBegin(utils.BasicComparator[int]); it.Next(); {
+				value, _ := it.Get()
+				values = append(values, value)
+			}
+
+			reversed := []int{}
+			for it := test.container.ReverseBegin(utils.BasicComparator[int]); it.Next(); {
+				value, _ := it.Get()
+				reversed = append(reversed, value)
+			}
+
+			backward := []int{}
+			for it := test.container.ReverseEnd(utils.BasicComparator[int]); it.Previous(); {
+				value, _ := it.Get()
+				backward = append(backward, value)
+			}
+
+			assert.Equalf(t, values, backward, test.name)
+
+			slices.Reverse(values)
+			assert.Equalf(t, values, reversed, test.name)
+
+			first, found := test.container.ReverseFirst(utils.BasicComparator[int]).Get()
+			last, _ := test.container.ReverseLast(utils.BasicComparator[int]).Get()
+			assert.Equalf(t, len(values) > 0, found, test.name)
+
+			if len(values) > 0 {
+				assert.Equalf(t, values[0], first, test.name)
+				assert.Equalf(t, values[len(values)-1], last, test.name)
+			}
+		})
+	}
+}
+
+func TestTreeSetReverseIteratorSet(t *testing.T) {
+	set := New[int](utils.BasicComparator[int], 1, 2, 3)
+	it := set.ReverseFirst(utils.BasicComparator[int])
+
+	// Replacing an item re-sorts it, the iterator follows it to its new position
+	assert.True(t, it.Set(0))
+	assert.Equal(t, []int{0, 1, 2}, set.GetValues())
+
+	value, _ := it.Get()
+	assert.Equal(t, 0, value)
+
+	index, _ := it.Index()
+	assert.Equal(t, 0, index)
+	assert.False(t, it.Next())
+
+	it = set.ReverseFirst(utils.BasicComparator[int])
+	assert.True(t, it.SetAt(0, 5))
+	assert.Equal(t, []int{1, 2, 5}, set.GetValues())
+
+	// The iterator keeps pointing to 2, which moved from the end to the middle
+	value, _ = it.Get()
+	assert.Equal(t, 2, value)
+	assert.True(t, it.Next())
+
+	value, _ = it.Get()
+	assert.Equal(t, 1, value)
+}
+
+func TestTreeSetReverseIteratorCompare(t *testing.T) {
+	type iteratorInit func(*Set[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+
+	tests := []struct {
+		name     string
+		it       iteratorInit
+		other    iteratorInit
+		distance int
+		value    int
+	}{
+		{
+			name:     "Reversed first to reversed last",
+			it:       func(set *Set[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int] { return set.ReverseFirst(nil) },
+			other:    func(set *Set[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int] { return set.ReverseLast(nil) },
+			distance: -2,
+			value:    1,
+		},
+		{
+			name:     "Reversed first to forward first",
+			it:       func(set *Set[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int] { return set.ReverseFirst(nil) },
+			other:    func(set *Set[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int] { return set.OrderedFirst(nil) },
+			distance: 2,
+			value:    1,
+		},
+		{
+			name:     "Reversed last to forward first",
+			it:       func(set *Set[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int] { return set.ReverseLast(nil) },
+			other:    func(set *Set[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int] { return set.OrderedFirst(nil) },
+			distance: 0,
+			value:    3,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			// With a descending comparator, the reversed iterators visit the items in ascending order
+			set := New[int](func(a, b int) int { return utils.BasicComparator(b, a) }, 1, 2, 3)
+
+			it := test.it(set)
+			other := test.other(set)
+
+			value, _ := it.Get()
+			assert.Equalf(t, test.value, value, test.name)
+
+			distance, err := ds.CheckedDistanceTo(it, other)
+			assert.NoErrorf(t, err, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+			assert.Equalf(t, -test.distance, other.DistanceTo(it), test.name)
+			assert.Equalf(t, test.distance < 0, it.IsBefore(other), test.name)
+			assert.Equalf(t, test.distance > 0, other.IsBefore(it), test.name)
+			assert.Equalf(t, test.distance == 0, other.IsEqual(it), test.name)
+		})
+	}
+
+	set := New[int](utils.BasicComparator[int], 1, 2, 3)
+	other := set.Clone()
+	assert.ErrorIs(t, ds.CanCompare(set.OrderedFirst(nil), other.ReverseFirst(nil)), ds.ErrForeignIterators)
+	assert.ErrorIs(t, ds.CanCompare(set.ReverseFirst(nil), other.OrderedFirst(nil)), ds.ErrForeignIterators)
+}
+
+func TestTreeSetReverseIteratorFailFast(t *testing.T) {
+	set := New[int](utils.BasicComparator[int], 3, 1, 2)
+	it := set.ReverseFirst(utils.BasicComparator[int])
+
+	// Adding a contained item does not change the set
+	set.Add(3)
+
+	assert.NoError(t, it.(ds.FailFastIterator).Err())
+
+	set.Add(4)
+
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Next())
+}
@@ -12,6 +12,7 @@ package treeset
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
@@ -23,25 +24,55 @@ import (
 // Assert Set implementation
 var _ sets.Set[string] = (*Set[string])(nil)
 
+// Assert Cloneable implementation
+var _ ds.Cloneable[*Set[string]] = (*Set[string])(nil)
+
 // Set holds elements in a red-black tree
 type Set[T comparable] struct {
 	tree *rbt.Tree[T, struct{}]
+	// order is shared by the sets, which are known to use the same comparator, see mergeable.
+	order *Order[T]
+}
+
+// Order is a handle to a comparator, which lets sets declare that they use the same ordering.
+// Since functions can not be compared, set operations can only tell that two sets are ordered alike, if they share an Order.
+type Order[T comparable] struct {
+	comparator utils.Comparator[T]
+}
+
+// NewOrder instantiates a new handle to comparator, which can be shared by sets created with NewWithOrder.
+func NewOrder[T comparable](comparator utils.Comparator[T]) *Order[T] {
+	return &Order[T]{comparator: comparator}
 }
 
 var itemExists = struct{}{}
 
 // New instantiates a new empty set with the custom comparator.
+// The set does not share its order with any other set, so set operations with independently created sets
+// look up elements one by one, even if they use the same comparator. Use NewWithOrder to share an order.
 func New[T comparable](comparator utils.Comparator[T], values ...T) *Set[T] {
-	set := &Set[T]{tree: rbt.New[T, struct{}](comparator)}
+	return NewWithOrder(NewOrder(comparator), values...)
+}
+
+// NewWithOrder instantiates a new set ordered by order's comparator.
+// Set operations between sets sharing an order run as O(n+m) merge walks.
+func NewWithOrder[T comparable](order *Order[T], values ...T) *Set[T] {
+	set := &Set[T]{tree: rbt.New[T, struct{}](order.comparator), order: order}
 	if len(values) > 0 {
 		set.Add(values...)
 	}
 	return set
 }
 
+// NewWithOrderOf instantiates a new set, which shares the order of set.
+// Set operations between sets sharing an order run as O(n+m) merge walks.
+func NewWithOrderOf[T comparable](set *Set[T], values ...T) *Set[T] {
+	return NewWithOrder(set.order, values...)
+}
+
 // NewFromMap instantiates a new  set from the provided slice.
 func NewFromSlice[T comparable](comparator utils.Comparator[T], slice []T) *Set[T] {
-	set := &Set[T]{tree: rbt.New[T, struct{}](comparator)}
+	set := New(comparator)
 
 	for _, value := range slice {
 		set.Add(value)
@@ -52,7 +83,7 @@ func NewFromSlice[T comparable](comparator utils.Comparator[T], slice []T) *Set[
 
 // NewFromIterator instantiates a new set containing the elements provided by the passed iterator.
 func NewFromIterator[T comparable](comparator utils.Comparator[T], begin ds.ReadForIndexIterator[int, T]) *Set[T] {
-	set := &Set[T]{tree: rbt.New[T, struct{}](comparator)}
+	set := New(comparator)
 
 	for begin.Next() {
 		newValue, _ := begin.Get()
@@ -66,7 +97,7 @@ func NewFromIterator[T comparable](comparator utils.Comparator[T], begin ds.Read
 // NewFromIterators instantiates a new set containing the elements provided by first, until it is equal to end.
 // end is a sentinel and not included.
 func NewFromIterators[T comparable](comparator utils.Comparator[T], begin ds.ReadCompForIndexIterator[int, T], end ds.CompIndexIterator[int]) *Set[T] {
-	set := &Set[T]{tree: rbt.New[T, struct{}](comparator)}
+	set := New(comparator)
 
 	for !begin.IsEqual(end) && begin.Next() {
 		newValue, _ := begin.Get()
@@ -134,13 +165,29 @@ func (set *Set[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the set, which does not share any nodes with the original.
+func (set *Set[T]) Clone() *Set[T] {
+	return &Set[T]{tree: set.tree.Clone(), order: set.order}
+}
+
 // Intersection returns the intersection between two sets.
 // The new set consists of all elements that are both in "set" and "other".
-// The two sets should have the same comparators, otherwise the result is empty set.
+// If "other" is a tree set sharing set's order, this runs in O(n+m), otherwise the elements are looked up one by one.
 // Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
 func (set *Set[T]) MakeIntersectionWith(other sets.Set[T]) sets.Set[T] {
-	result := New(set.tree.Comparator)
-	concrete := other.(*Set[T])
+	result := NewWithOrderOf(set)
+
+	if concrete, ok := set.mergeable(other); ok {
+		set.walk(concrete, func(item T, inSet bool, inOther bool) bool {
+			if inSet && inOther {
+				result.Add(item)
+			}
+
+			return true
+		})
+
+		return result
+	}
 
 	// Iterate over smaller set (optimization)
 	if set.Size() <= other.Size() {
@@ -152,9 +199,7 @@ func (set *Set[T]) MakeIntersectionWith(other sets.Set[T]) sets.Set[T] {
 			}
 		}
 	} else {
-		it := concrete.OrderedBegin(concrete.tree.Comparator)
-		for it.Next() {
-			value, _ := it.Get()
+		for _, value := range other.GetValues() {
 			if set.Contains(value) {
 				result.Add(value)
 			}
@@ -166,44 +211,238 @@ func (set *Set[T]) MakeIntersectionWith(other sets.Set[T]) sets.Set[T] {
 
 // Union returns the union of two sets.
 // The new set consists of all elements that are in "set" or "other" (possibly both).
-// The two sets should have the same comparators, otherwise the result is empty set.
 // Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
 func (set *Set[T]) MakeUnionWith(other sets.Set[T]) sets.Set[T] {
-	result := New(set.tree.Comparator)
-	concrete := other.(*Set[T])
+	result := NewWithOrderOf(set, set.GetValues()...)
+	result.Add(other.GetValues()...)
+
+	return result
+}
+
+// Difference returns the difference between two sets.
+// The new set consists of all elements that are in "set" but not in "other".
+// If "other" is a tree set sharing set's order, this runs in O(n+m), otherwise the elements are looked up one by one.
+// Ref: https://proofwiki.org/wiki/Definition:Set_Difference
+func (set *Set[T]) MakeDifferenceWith(other sets.Set[T]) sets.Set[T] {
+	result := NewWithOrderOf(set)
+
+	if concrete, ok := set.mergeable(other); ok {
+		set.walk(concrete, func(item T, inSet bool, inOther bool) bool {
+			if inSet && !inOther {
+				result.Add(item)
+			}
+
+			return true
+		})
+
+		return result
+	}
 
 	it := set.OrderedBegin(set.tree.Comparator)
 	for it.Next() {
 		value, _ := it.Get()
-		result.Add(value)
+		if !other.Contains(value) {
+			result.Add(value)
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifference returns the symmetric difference between two sets.
+// The new set consists of all elements that are either in "set" or in "other", but not in both.
+// If "other" is a tree set sharing set's order, this runs in O(n+m), otherwise the elements are looked up one by one.
+// Ref: https://en.wikipedia.org/wiki/Symmetric_difference
+func (set *Set[T]) MakeSymmetricDifferenceWith(other sets.Set[T]) sets.Set[T] {
+	result := NewWithOrderOf(set)
+
+	if concrete, ok := set.mergeable(other); ok {
+		set.walk(concrete, func(item T, inSet bool, inOther bool) bool {
+			if inSet != inOther {
+				result.Add(item)
+			}
+
+			return true
+		})
+
+		return result
 	}
 
-	it = concrete.OrderedBegin(concrete.tree.Comparator)
+	it := set.OrderedBegin(set.tree.Comparator)
 	for it.Next() {
 		value, _ := it.Get()
-		result.Add(value)
+		if !other.Contains(value) {
+			result.Add(value)
+		}
+	}
+	for _, value := range other.GetValues() {
+		if !set.Contains(value) {
+			result.Add(value)
+		}
 	}
 
 	return result
 }
 
-// Difference returns the difference between two sets.
-// The two sets should have the same comparators, otherwise the result is empty set.
-// The new set consists of all elements that are in "set" but not in "other".
-// Ref: https://proofwiki.org/wiki/Definition:Set_Difference
-func (set *Set[T]) MakeDifferenceWith(other sets.Set[T]) sets.Set[T] {
-	result := New(set.tree.Comparator)
-	concrete := other.(*Set[T])
+// IsSubsetOf returns true if all elements of the set are contained in other.
+// If "other" is a tree set sharing set's order, this runs in O(n+m), otherwise the elements are looked up one by one.
+func (set *Set[T]) IsSubsetOf(other sets.Set[T]) bool {
+	if set.Size() > other.Size() {
+		return false
+	}
+
+	if concrete, ok := set.mergeable(other); ok {
+		return set.all(concrete, func(inSet bool, inOther bool) bool {
+			return !inSet || inOther
+		})
+	}
 
 	it := set.OrderedBegin(set.tree.Comparator)
 	for it.Next() {
 		value, _ := it.Get()
-		if !concrete.Contains(value) {
-			result.Add(value)
+		if !other.Contains(value) {
+			return false
 		}
 	}
 
-	return result
+	return true
+}
+
+// IsSupersetOf returns true if all elements of other are contained in the set.
+// If "other" is a tree set sharing set's order, this runs in O(n+m), otherwise the elements are looked up one by one.
+func (set *Set[T]) IsSupersetOf(other sets.Set[T]) bool {
+	if set.Size() < other.Size() {
+		return false
+	}
+
+	if concrete, ok := set.mergeable(other); ok {
+		return set.all(concrete, func(inSet bool, inOther bool) bool {
+			return inSet || !inOther
+		})
+	}
+
+	return set.Contains(other.GetValues()...)
+}
+
+// IsDisjointWith returns true if the set and other have no elements in common.
+// If "other" is a tree set sharing set's order, this runs in O(n+m), otherwise the elements are looked up one by one.
+func (set *Set[T]) IsDisjointWith(other sets.Set[T]) bool {
+	if concrete, ok := set.mergeable(other); ok {
+		return set.all(concrete, func(inSet bool, inOther bool) bool {
+			return !inSet || !inOther
+		})
+	}
+
+	for _, value := range other.GetValues() {
+		if set.Contains(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if the set and other contain the same elements.
+// If "other" is a tree set sharing set's order, this runs in O(n+m), otherwise the elements are looked up one by one.
+func (set *Set[T]) Equals(other sets.Set[T]) bool {
+	return set.Size() == other.Size() && set.IsSubsetOf(other)
+}
+
+// UnionInPlace adds all elements of other to the set.
+func (set *Set[T]) UnionInPlace(other sets.Set[T]) {
+	set.Add(other.GetValues()...)
+}
+
+// RetainAll removes all elements from the set, which are not contained in other.
+// If "other" is a tree set sharing set's order, the elements to remove are found in O(n+m),
+// otherwise the elements are looked up one by one.
+func (set *Set[T]) RetainAll(other sets.Set[T]) {
+	removed := []T{}
+
+	if concrete, ok := set.mergeable(other); ok {
+		set.walk(concrete, func(item T, inSet bool, inOther bool) bool {
+			if inSet && !inOther {
+				removed = append(removed, item)
+			}
+
+			return true
+		})
+	} else {
+		it := set.OrderedBegin(set.tree.Comparator)
+		for it.Next() {
+			value, _ := it.Get()
+			if !other.Contains(value) {
+				removed = append(removed, value)
+			}
+		}
+	}
+
+	set.Remove(set.tree.Comparator, removed...)
+}
+
+// RemoveAll removes all elements from the set, which are contained in other.
+func (set *Set[T]) RemoveAll(other sets.Set[T]) {
+	set.Remove(set.tree.Comparator, other.GetValues()...)
+}
+
+// mergeable returns other as a tree set, if it can be merged with set by walk.
+// Sets share an order, if they were created by NewWithOrder with the same Order
+// or one was derived from the other by NewWithOrderOf, Clone or a set operation.
+func (set *Set[T]) mergeable(other sets.Set[T]) (*Set[T], bool) {
+	concrete, ok := other.(*Set[T])
+
+	return concrete, ok && concrete.order == set.order
+}
+
+// walk merges the ordered iterators of set and other in O(n+m) and calls visit for every element of either set,
+// indicating which of them contain it. The walk stops early, if visit returns false.
+// Both sets must use the same comparator, otherwise their orders do not match, see mergeable.
+func (set *Set[T]) walk(other *Set[T], visit func(item T, inSet bool, inOther bool) bool) {
+	it := set.OrderedBegin(set.tree.Comparator)
+	otherIt := other.OrderedBegin(other.tree.Comparator)
+
+	hasNext := it.Next()
+	otherHasNext := otherIt.Next()
+
+	for hasNext || otherHasNext {
+		value, _ := it.Get()
+		otherValue, _ := otherIt.Get()
+
+		switch {
+		case !otherHasNext || (hasNext && set.tree.Comparator(value, otherValue) < 0):
+			if !visit(value, true, false) {
+				return
+			}
+
+			hasNext = it.Next()
+		case !hasNext || set.tree.Comparator(value, otherValue) > 0:
+			if !visit(otherValue, false, true) {
+				return
+			}
+
+			otherHasNext = otherIt.Next()
+		default:
+			if !visit(value, true, true) {
+				return
+			}
+
+			hasNext = it.Next()
+			otherHasNext = otherIt.Next()
+		}
+	}
+}
+
+// all returns true if predicate holds for every element of either set, given which of them contain it.
+func (set *Set[T]) all(other *Set[T], predicate func(inSet bool, inOther bool) bool) bool {
+	holds := true
+
+	set.walk(other, func(_ T, inSet bool, inOther bool) bool {
+		holds = predicate(inSet, inOther)
+
+		return holds
+	})
+
+	return holds
 }
 
 //******************************************************************//
@@ -231,3 +470,45 @@ func (s *Set[T]) OrderedFirst(comparator utils.Comparator[T]) ds.ReadWriteOrdCom
 func (s *Set[T]) OrderedLast(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 	return s.NewOrderedIterator(s.Size()-1, s.Size())
 }
+
+//******************************************************************//
+//                         Reverse iterator                         //
+//******************************************************************//
+
+// ReverseBegin returns an initialized, reversed iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (s *Set[T]) ReverseBegin(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(s.OrderedEnd(comparator))
+}
+
+// ReverseEnd returns an initialized, reversed iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (s *Set[T]) ReverseEnd(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(s.OrderedBegin(comparator))
+}
+
+// ReverseFirst returns an initialized, reversed iterator, which points to it's first element.
+func (s *Set[T]) ReverseFirst(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(s.OrderedLast(comparator))
+}
+
+// ReverseLast returns an initialized, reversed iterator, which points to it's last element.
+func (s *Set[T]) ReverseLast(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(s.OrderedFirst(comparator))
+}
+
+// All returns a sequence over the items of the set in order.
+func (set *Set[T]) All() iter.Seq[T] {
+	return set.tree.Keys()
+}
+
+// Backward returns a sequence over the items of the set in reverse order.
+func (set *Set[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range set.tree.Backward() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
@@ -6,10 +6,10 @@
 package treeset
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -396,6 +396,77 @@ func TestTreeSetMakeIntersectionWith(t *testing.T) {
 	}
 }
 
+func TestTreeSetMixedComparators(t *testing.T) {
+	ascending := New[int](utils.BasicComparator[int], 1, 2, 3)
+	descending := New[int](func(a, b int) int { return utils.BasicComparator(b, a) }, 1, 2, 3)
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, ascending.MakeIntersectionWith(descending).GetValues())
+	assert.Empty(t, ascending.MakeDifferenceWith(descending).GetValues())
+	assert.Empty(t, descending.MakeSymmetricDifferenceWith(ascending).GetValues())
+	assert.True(t, ascending.Equals(descending))
+	assert.True(t, descending.IsSubsetOf(ascending))
+	assert.False(t, ascending.IsDisjointWith(descending))
+
+	descending.RetainAll(New[int](utils.BasicComparator[int], 2))
+	assert.Equal(t, []int{2}, descending.GetValues())
+}
+
+func TestTreeSetClosureComparators(t *testing.T) {
+	// Closures of the same function literal only differ in their captured state
+	newComparator := func(reversed bool) utils.Comparator[int] {
+		return func(a, b int) int {
+			if reversed {
+				return utils.BasicComparator(b, a)
+			}
+
+			return utils.BasicComparator(a, b)
+		}
+	}
+
+	ascending := New[int](newComparator(false), 1, 2, 3, 4)
+	descending := New[int](newComparator(true), 3, 4, 5)
+
+	_, mergeable := ascending.mergeable(descending)
+	assert.False(t, mergeable)
+
+	assert.ElementsMatch(t, []int{3, 4}, ascending.MakeIntersectionWith(descending).GetValues())
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, ascending.MakeUnionWith(descending).GetValues())
+	assert.ElementsMatch(t, []int{1, 2}, ascending.MakeDifferenceWith(descending).GetValues())
+	assert.ElementsMatch(t, []int{1, 2, 5}, descending.MakeSymmetricDifferenceWith(ascending).GetValues())
+}
+
+func TestTreeSetSharedComparators(t *testing.T) {
+	set := New[int](utils.BasicComparator[int], 1, 2, 3)
+
+	derived := []*Set[int]{
+		NewWithOrderOf(set, 2, 3, 4),
+		set.Clone(),
+		set.MakeUnionWith(New[int](utils.BasicComparator[int], 4)).(*Set[int]),
+	}
+
+	for _, other := range derived {
+		_, mergeable := set.mergeable(other)
+		assert.True(t, mergeable)
+	}
+
+	// Sets with the same comparator, which were created independently, are not merged
+	_, mergeable := set.mergeable(New[int](utils.BasicComparator[int]))
+	assert.False(t, mergeable)
+
+	// Sets created independently are merged, if they declare a shared order
+	order := NewOrder(utils.BasicComparator[int])
+	first := NewWithOrder(order, 1, 2, 3)
+	second := NewWithOrder(order, 3, 4)
+
+	_, mergeable = first.mergeable(second)
+	assert.True(t, mergeable)
+	assert.Equal(t, []int{3}, first.MakeIntersectionWith(second).GetValues())
+	assert.True(t, first.IsDisjointWith(NewWithOrder(order, 4, 5)))
+
+	assert.Equal(t, []int{2, 3}, set.MakeIntersectionWith(derived[0]).GetValues())
+	assert.Equal(t, []int{1}, set.MakeDifferenceWith(derived[0]).GetValues())
+}
+
 func TestTreeSetMakeUnionWith(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -511,3 +582,55 @@ func TestTreeSetMakeDifferenceWith(t *testing.T) {
 		})
 	}
 }
+
+func TestTreeSetClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Set[string]
+	}{
+		{
+			name:     "empty",
+			original: New[string](utils.BasicComparator[string]),
+		},
+		{
+			name:     "3 items",
+			original: New[string](utils.BasicComparator[string], "foo", "bar", "baz"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			values := test.original.GetValues()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original), test.name)
+			assert.Equalf(t, values, clone.GetValues(), test.name)
+
+			clone.Add("qux")
+
+			assert.Falsef(t, clone.Equals(test.original), test.name)
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	set := New(utils.BasicComparator[string], "c", "a", "b")
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(set.All()))
+	assert.Equal(t, []string{"c", "b", "a"}, slices.Collect(set.Backward()))
+
+	items := []string{}
+	for item := range set.All() {
+		items = append(items, item)
+		if len(items) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, items)
+}
@@ -0,0 +1,322 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/maps"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Map implementation
+var _ maps.Map[string, any] = (*Map[string, any])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Map[string, any]] = (*Map[string, any])(nil)
+var _ ds.Equatable[*Map[string, any], any] = (*Map[string, any])(nil)
+
+// Map holds the elements in a skip list, ordered by key.
+type Map[TKey comparable, TValue any] struct {
+	list *SkipList[TKey, TValue]
+}
+
+// NewMap instantiates a skip list map with the custom comparator.
+func NewMap[TKey comparable, TValue any](comparator utils.Comparator[TKey]) *Map[TKey, TValue] {
+	return &Map[TKey, TValue]{list: New[TKey, TValue](comparator)}
+}
+
+// NewMapFromMap instantiates a new skip list map containing the provided map.
+func NewMapFromMap[TKey comparable, TValue any](comparator utils.Comparator[TKey], map_ map[TKey]TValue) *Map[TKey, TValue] {
+	m := NewMap[TKey, TValue](comparator)
+
+	for k, v := range map_ {
+		m.Put(k, v)
+	}
+
+	return m
+}
+
+// NewMapFromIterator instantiates a new skip list map containing the elements provided by the passed iterator.
+func NewMapFromIterator[TKey comparable, TValue any](comparator utils.Comparator[TKey], begin ds.ReadForIndexIterator[TKey, TValue]) *Map[TKey, TValue] {
+	m := NewMap[TKey, TValue](comparator)
+
+	for begin.Next() {
+		newKey, _ := begin.GetKey()
+		newValue, _ := begin.Get()
+
+		m.Put(newKey, newValue)
+	}
+
+	return m
+}
+
+// NewMapFromIterators instantiates a new skip list map containing the elements provided by first, until it is equal to end.
+// end is a sentinel and not included.
+func NewMapFromIterators[TKey comparable, TValue any](comparator utils.Comparator[TKey], begin ds.ReadCompForIndexIterator[TKey, TValue], end ds.CompIndexIterator[TKey]) *Map[TKey, TValue] {
+	m := NewMap[TKey, TValue](comparator)
+
+	for !begin.IsEqual(end) && begin.Next() {
+		newKey, _ := begin.GetKey()
+		newValue, _ := begin.Get()
+
+		m.Put(newKey, newValue)
+	}
+
+	return m
+}
+
+// MergeWith inserts all elements of other into the map.
+// If a key is contained in both maps, the map is left unchanged and false is returned.
+func (m *Map[TKey, TValue]) MergeWith(other *maps.Map[TKey, TValue]) bool {
+	keys := (*other).GetKeys()
+
+	for _, key := range keys {
+		if m.list.GetNode(key) != nil {
+			return false
+		}
+	}
+
+	for _, key := range keys {
+		value, _ := (*other).Get(key)
+		m.list.Put(key, value)
+	}
+
+	return true
+}
+
+// MergeWithSafe inserts all elements of other into the map.
+// If a key is contained in both maps, other's value is used if overwriteOriginal is true, otherwise the original value is kept.
+func (m *Map[TKey, TValue]) MergeWithSafe(other *maps.Map[TKey, TValue], overwriteOriginal bool) {
+	for _, key := range (*other).GetKeys() {
+		if !overwriteOriginal && m.list.GetNode(key) != nil {
+			continue
+		}
+
+		value, _ := (*other).Get(key)
+		m.list.Put(key, value)
+	}
+}
+
+// Put inserts key-value pair into the map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) Put(key TKey, value TValue) {
+	m.list.Put(key, value)
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in the map.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) Get(key TKey) (value TValue, found bool) {
+	return m.list.Get(key)
+}
+
+// GetAt returns the element with the i-th smallest key (starting at 0) in expected O(log n).
+// Third return parameter is true if i is within bounds, otherwise false.
+func (m *Map[TKey, TValue]) GetAt(i int) (key TKey, value TValue, found bool) {
+	node, _ := m.list.Select(i)
+
+	return nodeEntry(node)
+}
+
+// Remove removes the element from the map by key.
+// The comparator is not used and may be nil, the map's own comparator is used instead.
+func (m *Map[TKey, TValue]) Remove(comparator utils.Comparator[TKey], key TKey) {
+	m.list.Remove(key)
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[TKey, TValue]) IsEmpty() bool {
+	return m.list.IsEmpty()
+}
+
+// Size returns number of elements in the map.
+func (m *Map[TKey, TValue]) Size() int {
+	return m.list.Size()
+}
+
+// GetKeys returns all keys in-order
+func (m *Map[TKey, TValue]) GetKeys() []TKey {
+	return m.list.GetKeys()
+}
+
+// Values returns all values in-order based on the key.
+func (m *Map[TKey, TValue]) GetValues() []TValue {
+	return m.list.GetValues()
+}
+
+// Clear removes all elements from the map.
+func (m *Map[TKey, TValue]) Clear() {
+	m.list.Clear()
+}
+
+// Min returns the minimum key and its value from the map.
+// Third return parameter is false if the map is empty, otherwise true.
+func (m *Map[TKey, TValue]) Min() (key TKey, value TValue, found bool) {
+	return nodeEntry(m.list.First())
+}
+
+// Max returns the maximum key and its value from the map.
+// Third return parameter is false if the map is empty, otherwise true.
+func (m *Map[TKey, TValue]) Max() (key TKey, value TValue, found bool) {
+	return nodeEntry(m.list.Last())
+}
+
+// Floor finds the largest key smaller than or equal to the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) Floor(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	node, _ := m.list.Floor(key)
+
+	return nodeEntry(node)
+}
+
+// Ceiling finds the smallest key larger than or equal to the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) Ceiling(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	node, _ := m.list.Ceiling(key)
+
+	return nodeEntry(node)
+}
+
+// Lower finds the largest key strictly smaller than the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) Lower(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	node, _ := m.list.Lower(key)
+
+	return nodeEntry(node)
+}
+
+// Higher finds the smallest key strictly larger than the input key and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[TKey, TValue]) Higher(key TKey) (foundKey TKey, foundValue TValue, found bool) {
+	node, _ := m.list.Higher(key)
+
+	return nodeEntry(node)
+}
+
+// PollFirst removes the minimum key and its value from the map and returns them.
+// Third return parameter is false if the map is empty, otherwise true.
+func (m *Map[TKey, TValue]) PollFirst() (key TKey, value TValue, found bool) {
+	key, value, found = nodeEntry(m.list.First())
+	if found {
+		m.list.Remove(key)
+	}
+
+	return
+}
+
+// PollLast removes the maximum key and its value from the map and returns them.
+// Third return parameter is false if the map is empty, otherwise true.
+func (m *Map[TKey, TValue]) PollLast() (key TKey, value TValue, found bool) {
+	key, value, found = nodeEntry(m.list.Last())
+	if found {
+		m.list.Remove(key)
+	}
+
+	return
+}
+
+// Rank returns the number of keys in the map, which are strictly smaller than key.
+// Runs in expected O(log n).
+func (m *Map[TKey, TValue]) Rank(key TKey) int {
+	return m.list.Rank(key)
+}
+
+// CountRange returns the number of keys in the map, which are larger than or equal to lo and smaller than or equal to hi.
+// Runs in expected O(log n).
+func (m *Map[TKey, TValue]) CountRange(lo TKey, hi TKey) int {
+	return m.list.CountRange(lo, hi)
+}
+
+// Range returns an iterator over the elements with keys between from and to, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (m *Map[TKey, TValue]) Range(from TKey, fromInclusive bool, to TKey, toInclusive bool) *OrderedIterator[TKey, TValue] {
+	return m.list.NewRangeOrderedIterator(from, fromInclusive, to, toInclusive, -1)
+}
+
+// String returns a string representation of container
+func (m *Map[TKey, TValue]) ToString() string {
+	str := "SkipListMap\nmap["
+	for node := m.list.First(); node != nil; node = node.Next() {
+		str += fmt.Sprintf("%v:%v ", node.Key, node.Value)
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// Clone returns a copy of the map, which does not share any nodes with the original.
+func (m *Map[TKey, TValue]) Clone() *Map[TKey, TValue] {
+	return &Map[TKey, TValue]{list: m.list.Clone()}
+}
+
+// Equals returns true if both maps hold equal key/value pairs.
+// Keys are compared with the map's comparator, values with valueComparator.
+func (m *Map[TKey, TValue]) Equals(other *Map[TKey, TValue], valueComparator utils.Comparator[TValue]) bool {
+	return m.list.Equals(other.list, valueComparator)
+}
+
+func nodeEntry[TKey any, TValue any](node *Node[TKey, TValue]) (key TKey, value TValue, found bool) {
+	if node == nil {
+		return
+	}
+
+	return node.Key, node.Value, true
+}
+
+//******************************************************************//
+//                         Ordered iterator                         //
+//******************************************************************//
+
+// OrderedBegin returns an initialized iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (m *Map[TKey, TValue]) OrderedBegin(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return m.list.OrderedBegin()
+}
+
+// OrderedEnd returns an initialized iterator, which points to one element afrer it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (m *Map[TKey, TValue]) OrderedEnd(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return m.list.OrderedEnd()
+}
+
+// OrderedFirst returns an initialized iterator, which points to it's first element.
+func (m *Map[TKey, TValue]) OrderedFirst(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return m.list.OrderedFirst()
+}
+
+// OrderedLast returns an initialized iterator, which points to it's last element.
+func (m *Map[TKey, TValue]) OrderedLast(comparator utils.Comparator[TKey]) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return m.list.OrderedLast()
+}
+
+// All returns a sequence over the key/value pairs of the map in key order.
+func (m *Map[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return m.list.All()
+}
+
+// Backward returns a sequence over the key/value pairs of the map in reverse key order.
+func (m *Map[TKey, TValue]) Backward() iter.Seq2[TKey, TValue] {
+	return m.list.Backward()
+}
+
+// Keys returns a sequence over the keys of the map in key order.
+func (m *Map[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return m.list.Keys()
+}
+
+// Values returns a sequence over the values of the map in key order.
+func (m *Map[TKey, TValue]) Values() iter.Seq[TValue] {
+	return m.list.Values()
+}
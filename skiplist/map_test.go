@@ -0,0 +1,264 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"slices"
+	"testing"
+
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkipListMapNavigation(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Map[int, string]
+		method      func(m *Map[int, string], key int) (int, string, bool)
+		key         int
+		foundKey    int
+		found       bool
+	}{
+		{
+			name:        "Floor, empty map",
+			originalMap: NewMap[int, string](utils.BasicComparator[int]),
+			method:      (*Map[int, string]).Floor,
+			key:         1,
+			found:       false,
+		},
+		{
+			name:        "Floor, missing key",
+			originalMap: NewMapFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).Floor,
+			key:         4,
+			foundKey:    3,
+			found:       true,
+		},
+		{
+			name:        "Ceiling, equal key",
+			originalMap: NewMapFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).Ceiling,
+			key:         3,
+			foundKey:    3,
+			found:       true,
+		},
+		{
+			name:        "Ceiling, not found",
+			originalMap: NewMapFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).Ceiling,
+			key:         6,
+			found:       false,
+		},
+		{
+			name:        "Lower, equal key",
+			originalMap: NewMapFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).Lower,
+			key:         3,
+			foundKey:    1,
+			found:       true,
+		},
+		{
+			name:        "Higher, not found",
+			originalMap: NewMapFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			method:      (*Map[int, string]).Higher,
+			key:         5,
+			found:       false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			key, value, found := test.method(test.originalMap, test.key)
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.foundKey, key, test.name)
+			if test.found {
+				expectedValue, _ := test.originalMap.Get(test.foundKey)
+				assert.Equalf(t, expectedValue, value, test.name)
+			}
+		})
+	}
+}
+
+func TestSkipListMapPoll(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Map[int, string]
+		first       bool
+		key         int
+		value       string
+		found       bool
+		keysAfter   []int
+	}{
+		{
+			name:        "PollFirst, empty map",
+			originalMap: NewMap[int, string](utils.BasicComparator[int]),
+			first:       true,
+			found:       false,
+			keysAfter:   []int{},
+		},
+		{
+			name:        "PollFirst",
+			originalMap: NewMapFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			first:       true,
+			key:         1,
+			value:       "foo",
+			found:       true,
+			keysAfter:   []int{3, 5},
+		},
+		{
+			name:        "PollLast",
+			originalMap: NewMapFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			first:       false,
+			key:         5,
+			value:       "baz",
+			found:       true,
+			keysAfter:   []int{1, 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			var key int
+			var value string
+			var found bool
+
+			if test.first {
+				key, value, found = test.originalMap.PollFirst()
+			} else {
+				key, value, found = test.originalMap.PollLast()
+			}
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.key, key, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+			assert.Equalf(t, test.keysAfter, test.originalMap.GetKeys(), test.name)
+		})
+	}
+}
+
+func TestSkipListMapGetAt(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Map[int, string]
+		index       int
+		key         int
+		value       string
+		found       bool
+	}{
+		{
+			name:        "empty map",
+			originalMap: NewMap[int, string](utils.BasicComparator[int]),
+			index:       0,
+			found:       false,
+		},
+		{
+			name:        "middle",
+			originalMap: NewMapFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			index:       1,
+			key:         3,
+			value:       "bar",
+			found:       true,
+		},
+		{
+			name:        "negative index",
+			originalMap: NewMapFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			index:       -1,
+			found:       false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			key, value, found := test.originalMap.GetAt(test.index)
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.key, key, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+		})
+	}
+}
+
+func TestSkipListMapRange(t *testing.T) {
+	m := NewMapFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz", 7: "qux"})
+
+	values := []string{}
+	it := m.Range(2, true, 7, false)
+	for it.Next() {
+		value, _ := it.Get()
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"bar", "baz"}, values)
+	assert.Equal(t, 2, m.CountRange(2, 6))
+	assert.Equal(t, 2, m.Rank(4))
+}
+
+func TestSkipListMapSerialization(t *testing.T) {
+	m := NewMapFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2})
+
+	json, err := m.ToJSON()
+	assert.NoError(t, err)
+
+	deserialized := NewMap[string, int](utils.BasicComparator[string])
+	assert.NoError(t, deserialized.FromJSON(json))
+
+	assert.Equal(t, m.GetKeys(), deserialized.GetKeys())
+	assert.Equal(t, m.GetValues(), deserialized.GetValues())
+}
+
+func TestMapSequences(t *testing.T) {
+	m := NewMap[string, int](utils.BasicComparator[string])
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range m.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	keys = []string{}
+	values = []int{}
+	for key, value := range m.Backward() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"c", "b", "a"}, keys)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(m.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(m.Values()))
+
+	keys = []string{}
+	for key := range m.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
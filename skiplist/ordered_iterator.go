@@ -0,0 +1,339 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Iterator implementation
+var _ ds.ReadWriteOrdCompBidRandCollIterator[string, any] = (*OrderedIterator[string, any])(nil)
+
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[string, any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[string, any])(nil)
+
+// OrderedIterator holding the iterator's state
+type OrderedIterator[TKey comparable, TValue any] struct {
+	list  *SkipList[TKey, TValue]
+	node  *Node[TKey, TValue]
+	index int
+	// Number of keys in the skip list, which come before the iterator's first element
+	offset int
+	size   int
+	guard  ds.ModificationGuard
+}
+
+// NewOrderedIterator returns a stateful iterator whose elements are key/value pairs.
+func (list *SkipList[TKey, TValue]) NewOrderedIterator(position int, size int) *OrderedIterator[TKey, TValue] {
+	it := &OrderedIterator[TKey, TValue]{
+		list:  list,
+		size:  utils.Min(list.Size(), utils.Max(size, 0)),
+		guard: list.modifications.Guard(),
+	}
+
+	it.MoveTo(position)
+
+	return it
+}
+
+// NewRangeOrderedIterator returns a stateful iterator, which only iterates the key/value pairs with keys between from and to.
+func (list *SkipList[TKey, TValue]) NewRangeOrderedIterator(from TKey, fromInclusive bool, to TKey, toInclusive bool, position int) *OrderedIterator[TKey, TValue] {
+	start, end := list.rangeBounds(from, fromInclusive, to, toInclusive)
+
+	it := &OrderedIterator[TKey, TValue]{
+		list:   list,
+		offset: start,
+		size:   end - start,
+		guard:  list.modifications.Guard(),
+	}
+
+	it.MoveTo(position)
+
+	return it
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsBegin() bool {
+	return it.index <= -1
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsEnd() bool {
+	return it.size == 0 || it.index >= it.size
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsLast() bool {
+	return it.index == it.size-1
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsValid() bool {
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the skip list was structurally modified, other than through the iterator.
+func (it *OrderedIterator[TKey, TValue]) Err() error {
+	return it.guard.Err()
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bool {
+	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) == 0
+}
+
+// CanCompare checks if other is an iterator of the same skip list.
+func (it *OrderedIterator[TKey, TValue]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.list != it.list {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
+func (it *OrderedIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) int {
+	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.index - otherThis.index
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) > 0
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsBefore(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) < 0
+}
+
+func (it *OrderedIterator[TKey, TValue]) Size() int {
+	return it.size
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Runs in O(1), unless the iterator was before it's first element.
+func (it *OrderedIterator[TKey, TValue]) Next() bool {
+	if it.guard.Err() != nil {
+		return false
+	}
+
+	if it.IsLast() || it.IsEnd() {
+		it.index = it.size
+
+		return false
+	}
+
+	if it.IsBegin() || it.node == nil {
+		return it.MoveTo(it.index + 1)
+	}
+
+	it.index++
+	it.node = it.node.Next()
+
+	return true
+}
+
+func (it *OrderedIterator[TKey, TValue]) NextN(n int) bool {
+	if n <= 0 {
+		return false
+	}
+
+	return it.MoveTo(utils.Min(it.index+n, it.size))
+}
+
+// Previous moves the iterator to the previous element and returns true if there was a previous element in the container.
+// Runs in O(1), unless the iterator was after it's last element.
+func (it *OrderedIterator[TKey, TValue]) Previous() bool {
+	if it.guard.Err() != nil {
+		return false
+	}
+
+	if it.IsFirst() || it.IsBegin() {
+		it.index = -1
+
+		return false
+	}
+
+	if it.IsEnd() || it.node == nil {
+		return it.MoveTo(it.index - 1)
+	}
+
+	it.index--
+	it.node = it.node.Previous()
+
+	return true
+}
+
+func (it *OrderedIterator[TKey, TValue]) PreviousN(n int) bool {
+	if n <= 0 {
+		return false
+	}
+
+	return it.MoveTo(utils.Max(it.index-n, -1))
+}
+
+func (it *OrderedIterator[TKey, TValue]) MoveBy(n int) bool {
+	if n > 0 {
+		return it.NextN(n)
+	} else if n < 0 {
+		return it.PreviousN(-n)
+	}
+
+	return it.IsValid()
+}
+
+// MoveTo moves the iterator to the n-th element in expected O(log n).
+func (it *OrderedIterator[TKey, TValue]) MoveTo(n int) bool {
+	if it.guard.Err() != nil {
+		return false
+	}
+
+	switch {
+	case n < 0:
+		it.index = -1
+		it.node = nil
+
+		return false
+	case n >= it.size:
+		it.index = it.size
+		it.node = nil
+
+		return false
+	}
+
+	node, found := it.list.Select(it.offset + n)
+	if !found {
+		return false
+	}
+
+	it.index = n
+	it.node = node
+
+	return true
+}
+
+// MoveToKey moves the iterator to the element with the given key in expected O(log n).
+func (it *OrderedIterator[TKey, TValue]) MoveToKey(key TKey) (found bool) {
+	if it.guard.Err() != nil {
+		return false
+	}
+
+	node := it.list.GetNode(key)
+	if node == nil {
+		return false
+	}
+
+	index := it.list.Rank(key) - it.offset
+	if index < 0 || index >= it.size {
+		return false
+	}
+
+	it.index = index
+	it.node = node
+
+	return true
+}
+
+func (it *OrderedIterator[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.node.Value, true
+}
+
+func (it *OrderedIterator[TKey, TValue]) Set(value TValue) bool {
+	if !it.IsValid() {
+		return false
+	}
+
+	it.node.Value = value
+
+	return true
+}
+
+func (it *OrderedIterator[TKey, TValue]) GetAt(i int) (value TValue, found bool) {
+	tmp := *it
+	tmp.MoveTo(i)
+
+	return tmp.Get()
+}
+
+func (it *OrderedIterator[TKey, TValue]) SetAt(i int, value TValue) bool {
+	tmp := *it
+	tmp.MoveTo(i)
+
+	return tmp.Set(value)
+}
+
+func (it *OrderedIterator[TKey, TValue]) GetAtKey(key TKey) (value TValue, found bool) {
+	tmp := *it
+	if !tmp.MoveToKey(key) {
+		return
+	}
+
+	return tmp.Get()
+}
+
+func (it *OrderedIterator[TKey, TValue]) SetAtKey(key TKey, value TValue) bool {
+	tmp := *it
+	if !tmp.MoveToKey(key) {
+		return false
+	}
+
+	return tmp.Set(value)
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (it *OrderedIterator[TKey, TValue]) Index() (index int, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.index, true
+}
+
+// GetKey returns the current element's key.
+// Does not modify the state of the iterator.
+func (it *OrderedIterator[TKey, TValue]) GetKey() (key TKey, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.node.Key, true
+}
+
+// Node returns the current element's node.
+// Does not modify the state of the iterator.
+func (it *OrderedIterator[TKey, TValue]) Node() (*Node[TKey, TValue], bool) {
+	return it.node, it.IsValid()
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkipListOrderedIteratorNextPrevious(t *testing.T) {
+	tests := []struct {
+		name string
+		list *SkipList[int, string]
+		keys []int
+	}{
+		{
+			name: "empty list",
+			list: newFromKeys(),
+			keys: []int{},
+		},
+		{
+			name: "single item",
+			list: newFromKeys(1),
+			keys: []int{1},
+		},
+		{
+			name: "5 items",
+			list: newFromKeys(5, 3, 1, 4, 2),
+			keys: []int{1, 2, 3, 4, 5},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			keys := []int{}
+			it := test.list.OrderedBegin()
+			for it.Next() {
+				key, _ := it.(*OrderedIterator[int, string]).GetKey()
+				keys = append(keys, key)
+			}
+
+			assert.Equalf(t, test.keys, keys, test.name)
+			assert.Truef(t, it.IsEnd(), test.name)
+
+			keys = []int{}
+			for it.Previous() {
+				key, _ := it.(*OrderedIterator[int, string]).GetKey()
+				keys = append([]int{key}, keys...)
+			}
+
+			assert.Equalf(t, test.keys, keys, test.name)
+			assert.Truef(t, it.IsBegin(), test.name)
+		})
+	}
+}
+
+func TestSkipListOrderedIteratorGetAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     *SkipList[int, string]
+		position int
+		value    string
+		found    bool
+	}{
+		{
+			name:     "empty list",
+			list:     newFromKeys(),
+			position: 0,
+			found:    false,
+		},
+		{
+			name:     "first",
+			list:     newFromKeys(1, 3, 5),
+			position: 0,
+			value:    "1",
+			found:    true,
+		},
+		{
+			name:     "last",
+			list:     newFromKeys(1, 3, 5),
+			position: 2,
+			value:    "5",
+			found:    true,
+		},
+		{
+			name:     "out of bounds",
+			list:     newFromKeys(1, 3, 5),
+			position: 3,
+			found:    false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.list.OrderedBegin()
+
+			value, found := it.GetAt(test.position)
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+			assert.Truef(t, it.IsBegin(), test.name)
+		})
+	}
+}
+
+func TestSkipListRangeOrderedIterator(t *testing.T) {
+	tests := []struct {
+		name          string
+		list          *SkipList[int, string]
+		from          int
+		fromInclusive bool
+		to            int
+		toInclusive   bool
+		keys          []int
+	}{
+		{
+			name:          "empty list",
+			list:          newFromKeys(),
+			from:          1,
+			fromInclusive: true,
+			to:            5,
+			toInclusive:   true,
+			keys:          []int{},
+		},
+		{
+			name:          "inclusive",
+			list:          newFromKeys(1, 2, 3, 4, 5),
+			from:          2,
+			fromInclusive: true,
+			to:            4,
+			toInclusive:   true,
+			keys:          []int{2, 3, 4},
+		},
+		{
+			name:          "exclusive",
+			list:          newFromKeys(1, 2, 3, 4, 5),
+			from:          2,
+			fromInclusive: false,
+			to:            4,
+			toInclusive:   false,
+			keys:          []int{3},
+		},
+		{
+			name:          "bounds are missing keys",
+			list:          newFromKeys(1, 3, 5, 7),
+			from:          2,
+			fromInclusive: false,
+			to:            6,
+			toInclusive:   false,
+			keys:          []int{3, 5},
+		},
+		{
+			name:          "empty range",
+			list:          newFromKeys(1, 2, 3, 4, 5),
+			from:          3,
+			fromInclusive: false,
+			to:            3,
+			toInclusive:   true,
+			keys:          []int{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			it := test.list.NewRangeOrderedIterator(test.from, test.fromInclusive, test.to, test.toInclusive, -1)
+
+			keys := []int{}
+			for it.Next() {
+				key, _ := it.GetKey()
+				keys = append(keys, key)
+			}
+
+			assert.Equalf(t, test.keys, keys, test.name)
+			assert.Equalf(t, len(test.keys), it.Size(), test.name)
+
+			if len(test.keys) > 0 {
+				assert.Truef(t, it.MoveToKey(test.keys[0]), test.name)
+				assert.Truef(t, it.IsFirst(), test.name)
+			}
+
+			assert.Falsef(t, it.MoveToKey(test.from-1), test.name)
+		})
+	}
+}
+
+func TestSkipListOrderedIteratorFailsFastUnderRemoval(t *testing.T) {
+	list := newFromKeys(1, 2, 3, 4, 5, 6)
+
+	keys := []int{}
+	it := list.OrderedBegin()
+	for it.Next() {
+		node, _ := it.(*OrderedIterator[int, string]).Node()
+		keys = append(keys, node.Key)
+
+		if node.Key%2 == 0 {
+			list.Remove(node.Key)
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, keys)
+	assert.Equal(t, []int{1, 3, 4, 5, 6}, list.GetKeys())
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+
+	_, found := it.Get()
+	assert.False(t, found)
+}
+
+func TestSkipListOrderedIteratorFailsFastUnderUnrelatedInsertion(t *testing.T) {
+	list := newFromKeys(1, 2, 3)
+
+	it := list.OrderedFirst()
+
+	// Overwriting a value keeps the iterator valid
+	list.Put(3, "x")
+	assert.True(t, it.IsValid())
+	assert.NoError(t, it.(ds.FailFastIterator).Err())
+
+	// Inserting a key after the iterator's position still invalidates it
+	list.Put(10, "x")
+	assert.False(t, it.IsValid())
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.Next())
+}
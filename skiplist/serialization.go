@@ -0,0 +1,76 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"encoding/json"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Serialization implementation
+var _ ds.JSONSerializer = (*Map[string, any])(nil)
+var _ ds.JSONDeserializer = (*Map[string, any])(nil)
+var _ ds.JSONSerializer = (*Set[string])(nil)
+var _ ds.JSONDeserializer = (*Set[string])(nil)
+
+// ToJSON outputs the JSON representation of the map.
+func (m *Map[TKey, TValue]) ToJSON() ([]byte, error) {
+	elements := make(map[string]TValue)
+	for node := m.list.First(); node != nil; node = node.Next() {
+		elements[utils.ToString(node.Key)] = node.Value
+	}
+	return json.Marshal(&elements)
+}
+
+// FromJSON populates the map from the input JSON representation.
+func (m *Map[TKey, TValue]) FromJSON(data []byte) error {
+	elements := make(map[TKey]TValue)
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		m.Clear()
+		for key, value := range elements {
+			m.Put(key, value)
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (m *Map[TKey, TValue]) UnmarshalJSON(bytes []byte) error {
+	return m.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (m *Map[TKey, TValue]) MarshalJSON() ([]byte, error) {
+	return m.ToJSON()
+}
+
+// ToJSON outputs the JSON representation of the set.
+func (set *Set[T]) ToJSON() ([]byte, error) {
+	return json.Marshal(set.GetValues())
+}
+
+// FromJSON populates the set from the input JSON representation.
+func (set *Set[T]) FromJSON(data []byte) error {
+	elements := []T{}
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		set.Clear()
+		set.Add(elements...)
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (set *Set[T]) UnmarshalJSON(bytes []byte) error {
+	return set.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (set *Set[T]) MarshalJSON() ([]byte, error) {
+	return set.ToJSON()
+}
@@ -0,0 +1,386 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/sets"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Set implementation
+var _ sets.Set[string] = (*Set[string])(nil)
+
+// Assert Cloneable implementation
+var _ ds.Cloneable[*Set[string]] = (*Set[string])(nil)
+
+// Set holds elements in a skip list, ordered by the set's comparator.
+type Set[T comparable] struct {
+	list *SkipList[T, struct{}]
+}
+
+var itemExists = struct{}{}
+
+// NewSet instantiates a new skip list set with the custom comparator and adds the passed values, if any, to the set.
+func NewSet[T comparable](comparator utils.Comparator[T], values ...T) *Set[T] {
+	set := &Set[T]{list: New[T, struct{}](comparator)}
+	if len(values) > 0 {
+		set.Add(values...)
+	}
+	return set
+}
+
+// NewSetFromSlice instantiates a new skip list set from the provided slice.
+func NewSetFromSlice[T comparable](comparator utils.Comparator[T], slice []T) *Set[T] {
+	return NewSet(comparator, slice...)
+}
+
+// NewSetFromIterator instantiates a new skip list set containing the elements provided by the passed iterator.
+func NewSetFromIterator[T comparable](comparator utils.Comparator[T], begin ds.ReadForIndexIterator[int, T]) *Set[T] {
+	set := NewSet(comparator)
+
+	for begin.Next() {
+		newValue, _ := begin.Get()
+
+		set.Add(newValue)
+	}
+
+	return set
+}
+
+// NewSetFromIterators instantiates a new skip list set containing the elements provided by first, until it is equal to end.
+// end is a sentinel and not included.
+func NewSetFromIterators[T comparable](comparator utils.Comparator[T], begin ds.ReadCompForIndexIterator[int, T], end ds.CompIndexIterator[int]) *Set[T] {
+	set := NewSet(comparator)
+
+	for !begin.IsEqual(end) && begin.Next() {
+		newValue, _ := begin.Get()
+
+		set.Add(newValue)
+	}
+
+	return set
+}
+
+// Add adds the items (one or more) to the set.
+func (set *Set[T]) Add(items ...T) {
+	for _, item := range items {
+		set.list.Put(item, itemExists)
+	}
+}
+
+// Remove removes the items (one or more) from the set.
+// The comparator is not used and may be nil, the set's own comparator is used instead.
+func (set *Set[T]) Remove(_ utils.Comparator[T], items ...T) {
+	for _, item := range items {
+		set.list.Remove(item)
+	}
+}
+
+// Contains checks weather items (one or more) are present in the set.
+// All items have to be present in the set for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
+func (set *Set[T]) Contains(items ...T) bool {
+	for _, item := range items {
+		if set.list.GetNode(item) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// GetAt returns the i-th smallest item (starting at 0) in expected O(log n).
+// Second return parameter is true if i is within bounds, otherwise false.
+func (set *Set[T]) GetAt(i int) (item T, found bool) {
+	node, found := set.list.Select(i)
+	if !found {
+		return
+	}
+
+	return node.Key, true
+}
+
+// Min returns the smallest item of the set.
+// Second return parameter is false if the set is empty, otherwise true.
+func (set *Set[T]) Min() (item T, found bool) {
+	return nodeKey(set.list.First())
+}
+
+// Max returns the largest item of the set.
+// Second return parameter is false if the set is empty, otherwise true.
+func (set *Set[T]) Max() (item T, found bool) {
+	return nodeKey(set.list.Last())
+}
+
+// Floor finds the largest item smaller than or equal to the input item.
+// Second return parameter is true if such an item was found, otherwise false.
+func (set *Set[T]) Floor(item T) (foundItem T, found bool) {
+	node, _ := set.list.Floor(item)
+
+	return nodeKey(node)
+}
+
+// Ceiling finds the smallest item larger than or equal to the input item.
+// Second return parameter is true if such an item was found, otherwise false.
+func (set *Set[T]) Ceiling(item T) (foundItem T, found bool) {
+	node, _ := set.list.Ceiling(item)
+
+	return nodeKey(node)
+}
+
+// Lower finds the largest item strictly smaller than the input item.
+// Second return parameter is true if such an item was found, otherwise false.
+func (set *Set[T]) Lower(item T) (foundItem T, found bool) {
+	node, _ := set.list.Lower(item)
+
+	return nodeKey(node)
+}
+
+// Higher finds the smallest item strictly larger than the input item.
+// Second return parameter is true if such an item was found, otherwise false.
+func (set *Set[T]) Higher(item T) (foundItem T, found bool) {
+	node, _ := set.list.Higher(item)
+
+	return nodeKey(node)
+}
+
+// Rank returns the number of items in the set, which are strictly smaller than item.
+// Runs in expected O(log n).
+func (set *Set[T]) Rank(item T) int {
+	return set.list.Rank(item)
+}
+
+// CountRange returns the number of items in the set, which are larger than or equal to lo and smaller than or equal to hi.
+// Runs in expected O(log n).
+func (set *Set[T]) CountRange(lo T, hi T) int {
+	return set.list.CountRange(lo, hi)
+}
+
+// Range returns an iterator over the items between from and to, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (set *Set[T]) Range(from T, fromInclusive bool, to T, toInclusive bool) *SetIterator[T] {
+	return &SetIterator[T]{set.list.NewRangeOrderedIterator(from, fromInclusive, to, toInclusive, -1), set}
+}
+
+// Empty returns true if set does not contain any elements.
+func (set *Set[T]) IsEmpty() bool {
+	return set.list.IsEmpty()
+}
+
+// Size returns number of elements within the set.
+func (set *Set[T]) Size() int {
+	return set.list.Size()
+}
+
+// Clear clears all values in the set.
+func (set *Set[T]) Clear() {
+	set.list.Clear()
+}
+
+// Values returns all items in the set.
+func (set *Set[T]) GetValues() []T {
+	return set.list.GetKeys()
+}
+
+// String returns a string representation of container
+func (set *Set[T]) ToString() string {
+	str := "SkipListSet\n"
+	items := []string{}
+	for node := set.list.First(); node != nil; node = node.Next() {
+		items = append(items, fmt.Sprintf("%v", node.Key))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// Clone returns a copy of the set, which does not share any nodes with the original.
+func (set *Set[T]) Clone() *Set[T] {
+	return &Set[T]{list: set.list.Clone()}
+}
+
+// Intersection returns the intersection between two sets.
+// The new set consists of all elements that are both in "set" and "other".
+// Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
+func (set *Set[T]) MakeIntersectionWith(other sets.Set[T]) sets.Set[T] {
+	result := NewSet(set.list.Comparator)
+
+	// Iterate over smaller set (optimization)
+	if set.Size() <= other.Size() {
+		for node := set.list.First(); node != nil; node = node.Next() {
+			if other.Contains(node.Key) {
+				result.Add(node.Key)
+			}
+		}
+	} else {
+		for _, value := range other.GetValues() {
+			if set.Contains(value) {
+				result.Add(value)
+			}
+		}
+	}
+
+	return result
+}
+
+// Union returns the union of two sets.
+// The new set consists of all elements that are in "set" or "other" (possibly both).
+// Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
+func (set *Set[T]) MakeUnionWith(other sets.Set[T]) sets.Set[T] {
+	result := NewSet(set.list.Comparator, set.GetValues()...)
+	result.Add(other.GetValues()...)
+
+	return result
+}
+
+// Difference returns the difference between two sets.
+// The new set consists of all elements that are in "set" but not in "other".
+// Ref: https://proofwiki.org/wiki/Definition:Set_Difference
+func (set *Set[T]) MakeDifferenceWith(other sets.Set[T]) sets.Set[T] {
+	result := NewSet(set.list.Comparator)
+
+	for node := set.list.First(); node != nil; node = node.Next() {
+		if !other.Contains(node.Key) {
+			result.Add(node.Key)
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifference returns the symmetric difference between two sets.
+// The new set consists of all elements that are either in "set" or in "other", but not in both.
+// Ref: https://en.wikipedia.org/wiki/Symmetric_difference
+func (set *Set[T]) MakeSymmetricDifferenceWith(other sets.Set[T]) sets.Set[T] {
+	result := NewSet(set.list.Comparator)
+
+	for node := set.list.First(); node != nil; node = node.Next() {
+		if !other.Contains(node.Key) {
+			result.Add(node.Key)
+		}
+	}
+	for _, value := range other.GetValues() {
+		if !set.Contains(value) {
+			result.Add(value)
+		}
+	}
+
+	return result
+}
+
+// IsSubsetOf returns true if all elements of the set are contained in other.
+func (set *Set[T]) IsSubsetOf(other sets.Set[T]) bool {
+	if set.Size() > other.Size() {
+		return false
+	}
+
+	for node := set.list.First(); node != nil; node = node.Next() {
+		if !other.Contains(node.Key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSupersetOf returns true if all elements of other are contained in the set.
+func (set *Set[T]) IsSupersetOf(other sets.Set[T]) bool {
+	if set.Size() < other.Size() {
+		return false
+	}
+
+	return set.Contains(other.GetValues()...)
+}
+
+// IsDisjointWith returns true if the set and other have no elements in common.
+func (set *Set[T]) IsDisjointWith(other sets.Set[T]) bool {
+	for _, value := range other.GetValues() {
+		if set.Contains(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if the set and other contain the same elements.
+func (set *Set[T]) Equals(other sets.Set[T]) bool {
+	return set.Size() == other.Size() && set.IsSubsetOf(other)
+}
+
+// UnionInPlace adds all elements of other to the set.
+func (set *Set[T]) UnionInPlace(other sets.Set[T]) {
+	set.Add(other.GetValues()...)
+}
+
+// RetainAll removes all elements from the set, which are not contained in other.
+func (set *Set[T]) RetainAll(other sets.Set[T]) {
+	removed := []T{}
+	for node := set.list.First(); node != nil; node = node.Next() {
+		if !other.Contains(node.Key) {
+			removed = append(removed, node.Key)
+		}
+	}
+
+	set.Remove(set.list.Comparator, removed...)
+}
+
+// RemoveAll removes all elements from the set, which are contained in other.
+func (set *Set[T]) RemoveAll(other sets.Set[T]) {
+	set.Remove(set.list.Comparator, other.GetValues()...)
+}
+
+func nodeKey[T any](node *Node[T, struct{}]) (item T, found bool) {
+	if node == nil {
+		return
+	}
+
+	return node.Key, true
+}
+
+//******************************************************************//
+//                         Ordered iterator                         //
+//******************************************************************//
+
+// OrderedBegin returns an initialized iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (set *Set[T]) OrderedBegin(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return set.NewOrderedIterator(-1, set.Size())
+}
+
+// OrderedEnd returns an initialized iterator, which points to one element afrer it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (set *Set[T]) OrderedEnd(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return set.NewOrderedIterator(set.Size(), set.Size())
+}
+
+// OrderedFirst returns an initialized iterator, which points to it's first element.
+func (set *Set[T]) OrderedFirst(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return set.NewOrderedIterator(0, set.Size())
+}
+
+// OrderedLast returns an initialized iterator, which points to it's last element.
+func (set *Set[T]) OrderedLast(comparator utils.Comparator[T]) ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return set.NewOrderedIterator(set.Size()-1, set.Size())
+}
+
+// All returns a sequence over the items of the set in order.
+func (set *Set[T]) All() iter.Seq[T] {
+	return set.list.Keys()
+}
+
+// Backward returns a sequence over the items of the set in reverse order.
+func (set *Set[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range set.list.Backward() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
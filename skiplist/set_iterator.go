@@ -0,0 +1,154 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+)
+
+// Assert Iterator implementation
+var _ ds.ReadWriteOrdCompBidRandCollIterator[int, string] = (*SetIterator[string])(nil)
+
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*SetIterator[string])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*SetIterator[string])(nil)
+
+// SetIterator holding the iterator's state
+type SetIterator[T comparable] struct {
+	*OrderedIterator[T, struct{}]
+	set *Set[T]
+}
+
+// NewOrderedIterator returns a stateful iterator whose values can be fetched by an index.
+func (set *Set[T]) NewOrderedIterator(index int, size int) *SetIterator[T] {
+	return &SetIterator[T]{set.list.NewOrderedIterator(index, size), set}
+}
+
+// NOTE: The following methods need to be reimplemented because of the type assertions they contain
+
+func (it *SetIterator[T]) DistanceTo(other ds.OrderedIterator) int {
+	otherThis, ok := other.(*SetIterator[T])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.OrderedIterator.DistanceTo(otherThis.OrderedIterator)
+}
+
+func (it *SetIterator[T]) IsAfter(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*SetIterator[T])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) > 0
+}
+
+func (it *SetIterator[T]) IsBefore(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*SetIterator[T])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) < 0
+}
+
+func (it *SetIterator[T]) IsEqual(other ds.ComparableIterator) bool {
+	otherThis, ok := other.(*SetIterator[T])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) == 0
+}
+
+// CanCompare checks if other is an iterator of the same set.
+func (it *SetIterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*SetIterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if it.OrderedIterator.CanCompare(otherThis.OrderedIterator) != nil {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
+// Get returns the current item.
+func (it *SetIterator[T]) Get() (value T, found bool) {
+	return it.OrderedIterator.GetKey()
+}
+
+// GetKey returns the current item's index.
+func (it *SetIterator[T]) GetKey() (index int, found bool) {
+	return it.Index()
+}
+
+// Set replaces the current item by value and moves the iterator to value.
+func (it *SetIterator[T]) Set(value T) bool {
+	item, found := it.OrderedIterator.GetKey()
+	if !found {
+		return false
+	}
+
+	if item == value {
+		return true
+	}
+
+	if it.set.Contains(value) {
+		it.size--
+	}
+
+	it.set.list.Remove(item)
+	it.set.list.Put(value, itemExists)
+	it.guard.Sync()
+
+	if !it.OrderedIterator.MoveToKey(value) {
+		it.MoveTo(it.size)
+	}
+
+	return true
+}
+
+func (it *SetIterator[T]) GetAt(i int) (value T, found bool) {
+	tmp := it.copy()
+	tmp.MoveTo(i)
+
+	return tmp.Get()
+}
+
+func (it *SetIterator[T]) SetAt(i int, value T) bool {
+	tmp := it.copy()
+	if !tmp.MoveTo(i) || !tmp.Set(value) {
+		return false
+	}
+
+	// The set was modified through the iterator
+	it.guard.Sync()
+
+	return true
+}
+
+func (it *SetIterator[T]) GetAtKey(i int) (value T, found bool) {
+	return it.GetAt(i)
+}
+
+func (it *SetIterator[T]) SetAtKey(i int, value T) bool {
+	return it.SetAt(i, value)
+}
+
+func (it *SetIterator[T]) MoveToKey(i int) bool {
+	return it.MoveTo(i)
+}
+
+func (it *SetIterator[T]) copy() *SetIterator[T] {
+	orderedIterator := *it.OrderedIterator
+
+	return &SetIterator[T]{&orderedIterator, it.set}
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"slices"
+	"testing"
+
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkipListSetAddRemove(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      *Set[int]
+		toAdd    []int
+		toRemove []int
+		values   []int
+	}{
+		{
+			name:     "empty set",
+			set:      NewSet[int](utils.BasicComparator[int]),
+			toAdd:    []int{},
+			toRemove: []int{1},
+			values:   []int{},
+		},
+		{
+			name:     "duplicates",
+			set:      NewSet[int](utils.BasicComparator[int], 3, 1),
+			toAdd:    []int{2, 3, 1},
+			toRemove: []int{},
+			values:   []int{1, 2, 3},
+		},
+		{
+			name:     "add and remove",
+			set:      NewSet[int](utils.BasicComparator[int], 3, 1),
+			toAdd:    []int{2, 5},
+			toRemove: []int{1, 4},
+			values:   []int{2, 3, 5},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.set.Add(test.toAdd...)
+			test.set.Remove(nil, test.toRemove...)
+
+			assert.Equalf(t, test.values, test.set.GetValues(), test.name)
+			assert.Truef(t, test.set.Contains(test.values...), test.name)
+			assert.Equalf(t, len(test.values), test.set.Size(), test.name)
+		})
+	}
+}
+
+func TestSkipListSetNavigation(t *testing.T) {
+	tests := []struct {
+		name      string
+		set       *Set[int]
+		method    func(set *Set[int], item int) (int, bool)
+		item      int
+		foundItem int
+		found     bool
+	}{
+		{
+			name:   "Floor, empty set",
+			set:    NewSet[int](utils.BasicComparator[int]),
+			method: (*Set[int]).Floor,
+			item:   1,
+			found:  false,
+		},
+		{
+			name:      "Floor, missing item",
+			set:       NewSet[int](utils.BasicComparator[int], 1, 3, 5),
+			method:    (*Set[int]).Floor,
+			item:      4,
+			foundItem: 3,
+			found:     true,
+		},
+		{
+			name:      "Ceiling, missing item",
+			set:       NewSet[int](utils.BasicComparator[int], 1, 3, 5),
+			method:    (*Set[int]).Ceiling,
+			item:      4,
+			foundItem: 5,
+			found:     true,
+		},
+		{
+			name:   "Lower, not found",
+			set:    NewSet[int](utils.BasicComparator[int], 1, 3, 5),
+			method: (*Set[int]).Lower,
+			item:   1,
+			found:  false,
+		},
+		{
+			name:      "Higher, equal item",
+			set:       NewSet[int](utils.BasicComparator[int], 1, 3, 5),
+			method:    (*Set[int]).Higher,
+			item:      3,
+			foundItem: 5,
+			found:     true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			item, found := test.method(test.set, test.item)
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.foundItem, item, test.name)
+		})
+	}
+}
+
+func TestSkipListSetOperations(t *testing.T) {
+	set := NewSet[int](utils.BasicComparator[int], 1, 2, 3, 4)
+	other := NewSet[int](utils.BasicComparator[int], 3, 4, 5)
+
+	assert.Equal(t, []int{3, 4}, set.MakeIntersectionWith(other).GetValues())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, set.MakeUnionWith(other).GetValues())
+	assert.Equal(t, []int{1, 2}, set.MakeDifferenceWith(other).GetValues())
+}
+
+func TestSkipListSetIterator(t *testing.T) {
+	set := NewSet[int](utils.BasicComparator[int], 1, 3, 5, 7)
+
+	it := set.OrderedFirst(utils.BasicComparator[int])
+	value, _ := it.GetAt(2)
+	assert.Equal(t, 5, value)
+
+	assert.True(t, it.SetAt(1, 4))
+	assert.Equal(t, []int{1, 4, 5, 7}, set.GetValues())
+
+	assert.True(t, it.Set(6))
+	assert.Equal(t, []int{4, 5, 6, 7}, set.GetValues())
+
+	item, _ := it.Get()
+	index, _ := it.GetKey()
+	assert.Equal(t, 6, item)
+	assert.Equal(t, 2, index)
+
+	values := []int{}
+	rangeIt := set.Range(4, false, 7, true)
+	for rangeIt.Next() {
+		value, _ := rangeIt.Get()
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{5, 6, 7}, values)
+}
+
+func TestSkipListSetClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Set[string]
+	}{
+		{
+			name:     "empty",
+			original: NewSet[string](utils.BasicComparator[string]),
+		},
+		{
+			name:     "3 items",
+			original: NewSet[string](utils.BasicComparator[string], "foo", "bar", "baz"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			values := test.original.GetValues()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original), test.name)
+			assert.Equalf(t, values, clone.GetValues(), test.name)
+
+			clone.Add("qux")
+
+			assert.Falsef(t, clone.Equals(test.original), test.name)
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+		})
+	}
+}
+
+func TestSetSequences(t *testing.T) {
+	set := NewSet(utils.BasicComparator[string], "c", "a", "b")
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(set.All()))
+	assert.Equal(t, []string{"c", "b", "a"}, slices.Collect(set.Backward()))
+
+	items := []string{}
+	for item := range set.All() {
+		items = append(items, item)
+		if len(items) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, items)
+}
@@ -0,0 +1,532 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package skiplist implements a sorted map and a sorted set backed by an indexable skip list.
+//
+// A skip list is a linked list of sorted elements with additional express lanes, which skip over a random number of elements.
+// Searching, inserting and removing elements runs in expected O(log n).
+// Every link stores the number of elements it skips (its width), so that accessing elements by index also runs in expected O(log n).
+//
+// Nodes are never moved, but iterators also track their index, which shifts whenever other elements are inserted or removed.
+// Therefore, any structural modification other than through the iterator itself invalidates it, see ds.FailFastIterator.
+// Overwriting the value of an existing key is not a structural modification.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Skip_list
+package skiplist
+
+import (
+	"fmt"
+	"iter"
+	"math/rand"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Container implementation
+var _ ds.Container[any] = (*SkipList[string, any])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*SkipList[string, any]] = (*SkipList[string, any])(nil)
+var _ ds.Equatable[*SkipList[string, any], any] = (*SkipList[string, any])(nil)
+
+// MaxLevel is the maximum number of levels of a skip list, enough for 2^32 elements.
+const MaxLevel = 32
+
+// Node is a single element within the skip list.
+type Node[TKey any, TValue any] struct {
+	Key   TKey
+	Value TValue
+	// next holds the following node of each level the node is part of.
+	next []*Node[TKey, TValue]
+	// widths holds the number of elements the link of each level skips, including the next node.
+	widths []int
+	prev   *Node[TKey, TValue]
+}
+
+// Next returns the following node or nil if node is the last one.
+func (node *Node[TKey, TValue]) Next() *Node[TKey, TValue] {
+	return node.next[0]
+}
+
+// Previous returns the preceding node or nil if node is the first one.
+func (node *Node[TKey, TValue]) Previous() *Node[TKey, TValue] {
+	return node.prev
+}
+
+func (node *Node[TKey, TValue]) String() string {
+	return fmt.Sprintf("%v:%v", node.Key, node.Value)
+}
+
+// SkipList holds the nodes of the skip list, which are ordered by key.
+type SkipList[TKey comparable, TValue any] struct {
+	// head is a sentinel, which is part of all levels and does not hold an element.
+	head       *Node[TKey, TValue]
+	tail       *Node[TKey, TValue]
+	level      int
+	size       int
+	Comparator utils.Comparator[TKey]
+
+	modifications ds.ModificationCounter
+}
+
+// New instantiates a skip list with the custom comparator.
+func New[TKey comparable, TValue any](comparator utils.Comparator[TKey]) *SkipList[TKey, TValue] {
+	return &SkipList[TKey, TValue]{
+		head:       newNode[TKey, TValue](MaxLevel),
+		level:      1,
+		Comparator: comparator,
+	}
+}
+
+func newNode[TKey any, TValue any](level int) *Node[TKey, TValue] {
+	return &Node[TKey, TValue]{
+		next:   make([]*Node[TKey, TValue], level),
+		widths: make([]int, level),
+	}
+}
+
+// Put inserts node into the skip list or updates its value if key is already contained.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (list *SkipList[TKey, TValue]) Put(key TKey, value TValue) {
+	var update [MaxLevel]*Node[TKey, TValue]
+	var rank [MaxLevel]int
+
+	node := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		if i < list.level-1 {
+			rank[i] = rank[i+1]
+		}
+
+		for node.next[i] != nil && list.Comparator(node.next[i].Key, key) < 0 {
+			rank[i] += node.widths[i]
+			node = node.next[i]
+		}
+
+		update[i] = node
+	}
+
+	if next := node.next[0]; next != nil && list.Comparator(next.Key, key) == 0 {
+		next.Value = value
+
+		return
+	}
+
+	level := randomLevel()
+	if level > list.level {
+		for i := list.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = list.head
+			update[i].widths[i] = list.size
+		}
+
+		list.level = level
+	}
+
+	newNode := newNode[TKey, TValue](level)
+	newNode.Key = key
+	newNode.Value = value
+
+	for i := 0; i < level; i++ {
+		newNode.next[i] = update[i].next[i]
+		update[i].next[i] = newNode
+
+		newNode.widths[i] = update[i].widths[i] - (rank[0] - rank[i])
+		update[i].widths[i] = rank[0] - rank[i] + 1
+	}
+
+	for i := level; i < list.level; i++ {
+		update[i].widths[i]++
+	}
+
+	if update[0] != list.head {
+		newNode.prev = update[0]
+	}
+
+	if newNode.next[0] != nil {
+		newNode.next[0].prev = newNode
+	} else {
+		list.tail = newNode
+	}
+
+	list.size++
+	list.modifications.Modified()
+}
+
+// Get searches the node in the skip list by key and returns its value or nil if key is not found in the skip list.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (list *SkipList[TKey, TValue]) Get(key TKey) (value TValue, found bool) {
+	node := list.GetNode(key)
+	if node == nil {
+		return
+	}
+
+	return node.Value, true
+}
+
+// GetNode searches the node in the skip list by key and returns it or nil if key is not found in the skip list.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (list *SkipList[TKey, TValue]) GetNode(key TKey) *Node[TKey, TValue] {
+	node, _ := list.Ceiling(key)
+	if node == nil || list.Comparator(node.Key, key) != 0 {
+		return nil
+	}
+
+	return node
+}
+
+// Remove removes the node from the skip list by key.
+// Returns true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (list *SkipList[TKey, TValue]) Remove(key TKey) bool {
+	var update [MaxLevel]*Node[TKey, TValue]
+
+	node := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && list.Comparator(node.next[i].Key, key) < 0 {
+			node = node.next[i]
+		}
+
+		update[i] = node
+	}
+
+	node = node.next[0]
+	if node == nil || list.Comparator(node.Key, key) != 0 {
+		return false
+	}
+
+	for i := 0; i < list.level; i++ {
+		if update[i].next[i] == node {
+			update[i].widths[i] += node.widths[i] - 1
+			update[i].next[i] = node.next[i]
+		} else {
+			update[i].widths[i]--
+		}
+	}
+
+	if node.next[0] != nil {
+		node.next[0].prev = node.prev
+	} else {
+		list.tail = node.prev
+	}
+
+	for list.level > 1 && list.head.next[list.level-1] == nil {
+		list.level--
+	}
+
+	list.size--
+	list.modifications.Modified()
+
+	return true
+}
+
+// Empty returns true if the skip list does not contain any nodes.
+func (list *SkipList[TKey, TValue]) IsEmpty() bool {
+	return list.size == 0
+}
+
+// Size returns number of nodes in the skip list.
+func (list *SkipList[TKey, TValue]) Size() int {
+	return list.size
+}
+
+// GetKeys returns all keys in-order
+func (list *SkipList[TKey, TValue]) GetKeys() []TKey {
+	keys := make([]TKey, 0, list.size)
+	for node := list.First(); node != nil; node = node.Next() {
+		keys = append(keys, node.Key)
+	}
+
+	return keys
+}
+
+// GetValues returns all values in-order based on the key.
+func (list *SkipList[TKey, TValue]) GetValues() []TValue {
+	values := make([]TValue, 0, list.size)
+	for node := list.First(); node != nil; node = node.Next() {
+		values = append(values, node.Value)
+	}
+
+	return values
+}
+
+// First returns the node with the smallest key or nil if the skip list is empty.
+func (list *SkipList[TKey, TValue]) First() *Node[TKey, TValue] {
+	return list.head.next[0]
+}
+
+// Last returns the node with the largest key or nil if the skip list is empty.
+func (list *SkipList[TKey, TValue]) Last() *Node[TKey, TValue] {
+	return list.tail
+}
+
+// Floor finds the node with the largest key smaller than or equal to the input key.
+// Second return parameter is true if floor was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (list *SkipList[TKey, TValue]) Floor(key TKey) (floor *Node[TKey, TValue], found bool) {
+	return list.nodeOrNil(list.findLast(key, true))
+}
+
+// Ceiling finds the node with the smallest key larger than or equal to the input key.
+// Second return parameter is true if ceiling was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (list *SkipList[TKey, TValue]) Ceiling(key TKey) (ceiling *Node[TKey, TValue], found bool) {
+	return list.nodeOrNil(list.findLast(key, false).next[0])
+}
+
+// Lower finds the node with the largest key strictly smaller than the input key.
+// Second return parameter is true if such a node was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (list *SkipList[TKey, TValue]) Lower(key TKey) (lower *Node[TKey, TValue], found bool) {
+	return list.nodeOrNil(list.findLast(key, false))
+}
+
+// Higher finds the node with the smallest key strictly larger than the input key.
+// Second return parameter is true if such a node was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (list *SkipList[TKey, TValue]) Higher(key TKey) (higher *Node[TKey, TValue], found bool) {
+	return list.nodeOrNil(list.findLast(key, true).next[0])
+}
+
+// Rank returns the number of keys in the skip list, which are strictly smaller than key.
+// Runs in expected O(log n).
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (list *SkipList[TKey, TValue]) Rank(key TKey) int {
+	return list.countBelow(key, false)
+}
+
+// Select returns the node with the i-th smallest key (starting at 0) or nil if i is out of bounds.
+// Second return parameter is true if the node was found, otherwise false.
+// Runs in expected O(log n).
+func (list *SkipList[TKey, TValue]) Select(i int) (node *Node[TKey, TValue], found bool) {
+	if i < 0 || i >= list.size {
+		return nil, false
+	}
+
+	// The head is at position 0, the node with index i at position i+1.
+	position := 0
+	node = list.head
+
+	for level := list.level - 1; level >= 0; level-- {
+		for node.next[level] != nil && position+node.widths[level] <= i+1 {
+			position += node.widths[level]
+			node = node.next[level]
+		}
+
+		if position == i+1 {
+			return node, true
+		}
+	}
+
+	return nil, false
+}
+
+// CountRange returns the number of keys in the skip list, which are larger than or equal to lo and smaller than or equal to hi.
+// Runs in expected O(log n).
+//
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (list *SkipList[TKey, TValue]) CountRange(lo TKey, hi TKey) int {
+	from, to := list.rangeBounds(lo, true, hi, true)
+
+	return to - from
+}
+
+// Clear removes all nodes from the skip list.
+func (list *SkipList[TKey, TValue]) Clear() {
+	list.head = newNode[TKey, TValue](MaxLevel)
+	list.tail = nil
+	list.level = 1
+	list.size = 0
+	list.modifications.Modified()
+}
+
+// String returns a string representation of container
+func (list *SkipList[TKey, TValue]) ToString() string {
+	str := "SkipList\n"
+	nodes := []string{}
+	for node := list.First(); node != nil; node = node.Next() {
+		nodes = append(nodes, node.String())
+	}
+	str += strings.Join(nodes, ", ")
+	return str
+}
+
+// Clone returns a copy of the skip list with the same levels, which does not share any nodes with the original.
+func (list *SkipList[TKey, TValue]) Clone() *SkipList[TKey, TValue] {
+	clone := New[TKey, TValue](list.Comparator)
+	clone.level = list.level
+	clone.size = list.size
+	copy(clone.head.widths, list.head.widths)
+
+	// Last copied node of each level, whose link still has to be set
+	var last [MaxLevel]*Node[TKey, TValue]
+	for i := range last {
+		last[i] = clone.head
+	}
+
+	var previous *Node[TKey, TValue]
+	for node := list.First(); node != nil; node = node.Next() {
+		newNode := newNode[TKey, TValue](len(node.next))
+		newNode.Key = node.Key
+		newNode.Value = node.Value
+		newNode.prev = previous
+		copy(newNode.widths, node.widths)
+
+		for i := range newNode.next {
+			last[i].next[i] = newNode
+			last[i] = newNode
+		}
+
+		previous = newNode
+	}
+
+	clone.tail = previous
+
+	return clone
+}
+
+// Equals returns true if both skip lists hold equal key/value pairs.
+// Keys are compared with the skip list's comparator, values with valueComparator.
+func (list *SkipList[TKey, TValue]) Equals(other *SkipList[TKey, TValue], valueComparator utils.Comparator[TValue]) bool {
+	if list.size != other.size {
+		return false
+	}
+
+	for node, otherNode := list.First(), other.First(); node != nil; node, otherNode = node.Next(), otherNode.Next() {
+		if list.Comparator(node.Key, otherNode.Key) != 0 || valueComparator(node.Value, otherNode.Value) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// findLast returns the last node, whose key is smaller than (or equal to) key.
+// Returns the head if there is no such node.
+func (list *SkipList[TKey, TValue]) findLast(key TKey, inclusive bool) *Node[TKey, TValue] {
+	node := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && list.isBelow(node.next[i].Key, key, inclusive) {
+			node = node.next[i]
+		}
+	}
+
+	return node
+}
+
+// countBelow returns the number of keys, which are smaller than (or equal to) key.
+func (list *SkipList[TKey, TValue]) countBelow(key TKey, inclusive bool) int {
+	count := 0
+
+	node := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && list.isBelow(node.next[i].Key, key, inclusive) {
+			count += node.widths[i]
+			node = node.next[i]
+		}
+	}
+
+	return count
+}
+
+// rangeBounds returns the index of the first key within the range and the index after the last one.
+func (list *SkipList[TKey, TValue]) rangeBounds(from TKey, fromInclusive bool, to TKey, toInclusive bool) (start int, end int) {
+	start = list.countBelow(from, !fromInclusive)
+	end = list.countBelow(to, toInclusive)
+
+	return start, utils.Max(start, end)
+}
+
+func (list *SkipList[TKey, TValue]) isBelow(key TKey, bound TKey, inclusive bool) bool {
+	if inclusive {
+		return list.Comparator(key, bound) <= 0
+	}
+
+	return list.Comparator(key, bound) < 0
+}
+
+func (list *SkipList[TKey, TValue]) nodeOrNil(node *Node[TKey, TValue]) (*Node[TKey, TValue], bool) {
+	if node == nil || node == list.head {
+		return nil, false
+	}
+
+	return node, true
+}
+
+// randomLevel returns a level between 1 and MaxLevel, where each additional level is half as likely as the previous one.
+func randomLevel() int {
+	level := 1
+	for level < MaxLevel && rand.Int63()&1 == 1 {
+		level++
+	}
+
+	return level
+}
+
+//******************************************************************//
+//                         Ordered iterator                         //
+//******************************************************************//
+
+// OrderedBegin returns an initialized iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (list *SkipList[TKey, TValue]) OrderedBegin() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return list.NewOrderedIterator(-1, list.Size())
+}
+
+// OrderedEnd returns an initialized iterator, which points to one element afrer it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (list *SkipList[TKey, TValue]) OrderedEnd() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return list.NewOrderedIterator(list.Size(), list.Size())
+}
+
+// OrderedFirst returns an initialized iterator, which points to it's first element.
+func (list *SkipList[TKey, TValue]) OrderedFirst() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return list.NewOrderedIterator(0, list.Size())
+}
+
+// OrderedLast returns an initialized iterator, which points to it's last element.
+func (list *SkipList[TKey, TValue]) OrderedLast() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return list.NewOrderedIterator(list.Size()-1, list.Size())
+}
+
+// All returns a sequence over the key/value pairs of the skip list in key order.
+func (list *SkipList[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.Seq2(list.OrderedBegin())(yield)
+	}
+}
+
+// Backward returns a sequence over the key/value pairs of the skip list in reverse key order.
+func (list *SkipList[TKey, TValue]) Backward() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.BackwardSeq2(list.OrderedEnd())(yield)
+	}
+}
+
+// Keys returns a sequence over the keys of the skip list in order.
+func (list *SkipList[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return func(yield func(TKey) bool) {
+		for key := range list.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the skip list in key order.
+func (list *SkipList[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		ds.Seq(list.OrderedBegin())(yield)
+	}
+}
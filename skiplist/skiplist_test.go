@@ -0,0 +1,500 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplist
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFromKeys(keys ...int) *SkipList[int, string] {
+	list := New[int, string](utils.BasicComparator[int])
+	for _, key := range keys {
+		list.Put(key, utils.ToString(key))
+	}
+
+	return list
+}
+
+func TestSkipListPut(t *testing.T) {
+	tests := []struct {
+		name   string
+		list   *SkipList[int, string]
+		key    int
+		value  string
+		keys   []int
+		values []string
+	}{
+		{
+			name:   "empty list",
+			list:   newFromKeys(),
+			key:    1,
+			value:  "foo",
+			keys:   []int{1},
+			values: []string{"foo"},
+		},
+		{
+			name:   "new key in the middle",
+			list:   newFromKeys(1, 5),
+			key:    3,
+			value:  "foo",
+			keys:   []int{1, 3, 5},
+			values: []string{"1", "foo", "5"},
+		},
+		{
+			name:   "existing key",
+			list:   newFromKeys(1, 3, 5),
+			key:    3,
+			value:  "foo",
+			keys:   []int{1, 3, 5},
+			values: []string{"1", "foo", "5"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.list.Put(test.key, test.value)
+
+			assert.Equalf(t, test.keys, test.list.GetKeys(), test.name)
+			assert.Equalf(t, test.values, test.list.GetValues(), test.name)
+			assert.Equalf(t, len(test.keys), test.list.Size(), test.name)
+		})
+	}
+}
+
+func TestSkipListRemove(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    *SkipList[int, string]
+		key     int
+		removed bool
+		keys    []int
+	}{
+		{
+			name:    "empty list",
+			list:    newFromKeys(),
+			key:     1,
+			removed: false,
+			keys:    []int{},
+		},
+		{
+			name:    "first",
+			list:    newFromKeys(1, 3, 5),
+			key:     1,
+			removed: true,
+			keys:    []int{3, 5},
+		},
+		{
+			name:    "last",
+			list:    newFromKeys(1, 3, 5),
+			key:     5,
+			removed: true,
+			keys:    []int{1, 3},
+		},
+		{
+			name:    "missing key",
+			list:    newFromKeys(1, 3, 5),
+			key:     4,
+			removed: false,
+			keys:    []int{1, 3, 5},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			removed := test.list.Remove(test.key)
+
+			assert.Equalf(t, test.removed, removed, test.name)
+			assert.Equalf(t, test.keys, test.list.GetKeys(), test.name)
+			assert.Equalf(t, len(test.keys), test.list.Size(), test.name)
+		})
+	}
+}
+
+func TestSkipListNavigation(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     *SkipList[int, string]
+		method   func(list *SkipList[int, string], key int) (*Node[int, string], bool)
+		key      int
+		foundKey int
+		found    bool
+	}{
+		{
+			name:   "Floor, empty list",
+			list:   newFromKeys(),
+			method: (*SkipList[int, string]).Floor,
+			key:    1,
+			found:  false,
+		},
+		{
+			name:     "Floor, equal key",
+			list:     newFromKeys(1, 3, 5),
+			method:   (*SkipList[int, string]).Floor,
+			key:      3,
+			foundKey: 3,
+			found:    true,
+		},
+		{
+			name:     "Floor, missing key",
+			list:     newFromKeys(1, 3, 5),
+			method:   (*SkipList[int, string]).Floor,
+			key:      4,
+			foundKey: 3,
+			found:    true,
+		},
+		{
+			name:   "Floor, not found",
+			list:   newFromKeys(1, 3, 5),
+			method: (*SkipList[int, string]).Floor,
+			key:    0,
+			found:  false,
+		},
+		{
+			name:     "Ceiling, missing key",
+			list:     newFromKeys(1, 3, 5),
+			method:   (*SkipList[int, string]).Ceiling,
+			key:      4,
+			foundKey: 5,
+			found:    true,
+		},
+		{
+			name:   "Ceiling, not found",
+			list:   newFromKeys(1, 3, 5),
+			method: (*SkipList[int, string]).Ceiling,
+			key:    6,
+			found:  false,
+		},
+		{
+			name:     "Lower, equal key",
+			list:     newFromKeys(1, 3, 5),
+			method:   (*SkipList[int, string]).Lower,
+			key:      3,
+			foundKey: 1,
+			found:    true,
+		},
+		{
+			name:   "Lower, not found",
+			list:   newFromKeys(1, 3, 5),
+			method: (*SkipList[int, string]).Lower,
+			key:    1,
+			found:  false,
+		},
+		{
+			name:     "Higher, equal key",
+			list:     newFromKeys(1, 3, 5),
+			method:   (*SkipList[int, string]).Higher,
+			key:      3,
+			foundKey: 5,
+			found:    true,
+		},
+		{
+			name:   "Higher, not found",
+			list:   newFromKeys(1, 3, 5),
+			method: (*SkipList[int, string]).Higher,
+			key:    5,
+			found:  false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			node, found := test.method(test.list, test.key)
+
+			assert.Equalf(t, test.found, found, test.name)
+			if test.found {
+				assert.Equalf(t, test.foundKey, node.Key, test.name)
+			} else {
+				assert.Nilf(t, node, test.name)
+			}
+		})
+	}
+}
+
+func TestSkipListCountRange(t *testing.T) {
+	tests := []struct {
+		name  string
+		list  *SkipList[int, string]
+		lo    int
+		hi    int
+		count int
+	}{
+		{
+			name:  "empty list",
+			list:  newFromKeys(),
+			lo:    0,
+			hi:    10,
+			count: 0,
+		},
+		{
+			name:  "bounds are keys",
+			list:  newFromKeys(1, 3, 5, 7),
+			lo:    3,
+			hi:    5,
+			count: 2,
+		},
+		{
+			name:  "bounds are missing keys",
+			list:  newFromKeys(1, 3, 5, 7),
+			lo:    2,
+			hi:    8,
+			count: 3,
+		},
+		{
+			name:  "inverted bounds",
+			list:  newFromKeys(1, 3, 5, 7),
+			lo:    5,
+			hi:    3,
+			count: 0,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			count := test.list.CountRange(test.lo, test.hi)
+
+			assert.Equalf(t, test.count, count, test.name)
+		})
+	}
+}
+
+func TestSkipListOrderStatisticsAfterModification(t *testing.T) {
+	list := New[int, int](utils.BasicComparator[int])
+	random := rand.New(rand.NewSource(1))
+	keys := map[int]struct{}{}
+
+	for i := 0; i < 2000; i++ {
+		key := random.Intn(300)
+
+		if random.Intn(3) == 0 {
+			_, contained := keys[key]
+			assert.Equal(t, contained, list.Remove(key))
+			delete(keys, key)
+		} else {
+			list.Put(key, i)
+			keys[key] = struct{}{}
+		}
+	}
+
+	sortedKeys := list.GetKeys()
+	assert.Len(t, sortedKeys, len(keys))
+	assert.Equal(t, len(keys), list.Size())
+	assert.IsIncreasing(t, sortedKeys)
+
+	for i, key := range sortedKeys {
+		assert.Equal(t, i, list.Rank(key))
+
+		node, found := list.Select(i)
+		assert.True(t, found)
+		assert.Equal(t, key, node.Key)
+	}
+
+	reversedKeys := []int{}
+	for node := list.Last(); node != nil; node = node.Previous() {
+		reversedKeys = append([]int{node.Key}, reversedKeys...)
+	}
+	assert.Equal(t, sortedKeys, reversedKeys)
+
+	it := list.OrderedBegin()
+	for i := len(sortedKeys) - 1; i >= 0; i-- {
+		assert.True(t, it.MoveTo(i))
+
+		key, _ := it.GetKey()
+		assert.Equal(t, sortedKeys[i], key)
+
+		assert.True(t, it.MoveToKey(sortedKeys[i]))
+
+		index, _ := it.Index()
+		assert.Equal(t, i, index)
+	}
+}
+
+func TestSkipListClear(t *testing.T) {
+	list := newFromKeys(1, 3, 5)
+	list.Clear()
+
+	assert.True(t, list.IsEmpty())
+	assert.Nil(t, list.First())
+	assert.Nil(t, list.Last())
+
+	list.Put(2, "foo")
+
+	assert.Equal(t, []int{2}, list.GetKeys())
+}
+
+func TestSkipListClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *SkipList[int, string]
+	}{
+		{
+			name:     "empty",
+			original: newFromKeys(),
+		},
+		{
+			name:     "many items",
+			original: newFromKeys(rand.New(rand.NewSource(1)).Perm(200)...),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			keys := test.original.GetKeys()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+
+			for i, key := range keys {
+				assert.Equalf(t, i, clone.Rank(key), test.name)
+
+				node, found := clone.Select(i)
+				assert.Truef(t, found, test.name)
+				assert.Equalf(t, key, node.Key, test.name)
+			}
+
+			reversedKeys := []int{}
+			for node := clone.Last(); node != nil; node = node.Previous() {
+				reversedKeys = append([]int{node.Key}, reversedKeys...)
+			}
+			assert.Equalf(t, keys, reversedKeys, test.name)
+
+			values := test.original.GetValues()
+			for _, key := range keys {
+				clone.Put(key, "qux")
+			}
+
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+
+			clone.Put(-1, "qux")
+			clone.Remove(0)
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, keys, test.original.GetKeys(), test.name)
+			assert.Equalf(t, -1, clone.First().Key, test.name)
+		})
+	}
+}
+
+func TestSkipListEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *SkipList[int, string]
+		b     *SkipList[int, string]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     newFromKeys(),
+			b:     newFromKeys(),
+			equal: true,
+		},
+		{
+			name:  "different insertion order",
+			a:     newFromKeys(1, 2, 3),
+			b:     newFromKeys(3, 2, 1),
+			equal: true,
+		},
+		{
+			name: "different values",
+			a:    newFromKeys(1, 2),
+			b: func() *SkipList[int, string] {
+				list := newFromKeys(1, 2)
+				list.Put(2, "foo")
+
+				return list
+			}(),
+			equal: false,
+		},
+		{
+			name:  "different keys",
+			a:     newFromKeys(1, 2),
+			b:     newFromKeys(1, 3),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     newFromKeys(1),
+			b:     newFromKeys(1, 2),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[string]), test.name)
+		})
+	}
+}
+
+func TestSkipListSequences(t *testing.T) {
+	list := New[string, int](utils.BasicComparator[string])
+	list.Put("c", 3)
+	list.Put("a", 1)
+	list.Put("b", 2)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range list.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	keys = []string{}
+	values = []int{}
+	for key, value := range list.Backward() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"c", "b", "a"}, keys)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(list.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(list.Values()))
+
+	keys = []string{}
+	for key := range list.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
@@ -12,16 +12,22 @@ package arraystack
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
 	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
 	"github.com/JonasMuehlmann/datastructures.go/stacks"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
 )
 
 // Assert Stack implementation
 var _ stacks.Stack[any] = (*Stack[any])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Stack[any]] = (*Stack[any])(nil)
+var _ ds.Equatable[*Stack[any], any] = (*Stack[any])(nil)
+
 // Stack holds elements in an array-list
 type Stack[T any] struct {
 	list *arraylist.List[T]
@@ -46,6 +52,7 @@ func NewFromIterator[T any](begin ds.ReadForIterator[T]) *Stack[T] {
 		length = sizedIterator.Size()
 	}
 
+	length = utils.Max(length, 0)
 	elements := make([]T, 0, length)
 
 	for begin.Next() {
@@ -135,6 +142,16 @@ func (stack *Stack[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the stack, which does not share any elements with the original.
+func (stack *Stack[T]) Clone() *Stack[T] {
+	return &Stack[T]{list: stack.list.Clone()}
+}
+
+// Equals returns true if both stacks hold equal values in the same order.
+func (stack *Stack[T]) Equals(other *Stack[T], comparator utils.Comparator[T]) bool {
+	return stack.list.Equals(other.list, comparator)
+}
+
 // Check that the index is within bounds of the list
 func (stack *Stack[T]) withinRange(index int) bool {
 	return index >= 0 && index < stack.list.Size()
@@ -165,3 +182,62 @@ func (set *Stack[T]) First() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 func (set *Stack[T]) Last() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 	return set.NewIterator(set.list.Size()-1, set.Size())
 }
+
+//******************************************************************//
+//                         Reverse iterator                         //
+//******************************************************************//
+
+// ReverseBegin returns an initialized, reversed iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (set *Stack[T]) ReverseBegin() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(set.End())
+}
+
+// ReverseEnd returns an initialized, reversed iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (set *Stack[T]) ReverseEnd() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(set.Begin())
+}
+
+// ReverseFirst returns an initialized, reversed iterator, which points to it's first element.
+func (set *Stack[T]) ReverseFirst() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(set.Last())
+}
+
+// ReverseLast returns an initialized, reversed iterator, which points to it's last element.
+func (set *Stack[T]) ReverseLast() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
+	return ds.NewReverseIterator(set.First())
+}
+
+// All returns a sequence over the index/value pairs of the stack in iteration order.
+func (stack *Stack[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range ds.Seq(stack.Begin()) {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns a sequence over the index/value pairs of the stack in reverse iteration order.
+func (stack *Stack[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := stack.Size() - 1
+		for value := range ds.BackwardSeq(stack.End()) {
+			if !yield(i, value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// Values returns a sequence over the values of the stack in iteration order.
+func (stack *Stack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(stack.Begin())(yield)
+	}
+}
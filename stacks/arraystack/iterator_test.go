@@ -1,6 +1,7 @@
 package arraystack
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
@@ -1081,3 +1082,188 @@ func TestArrayStackIteratorSize(t *testing.T) {
 		})
 	}
 }
+
+func TestArrayStackReverseIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		container *Stack[int]
+	}{
+		{
+			name:      "Empty",
+			container: New[int](),
+		},
+		{
+			name:      "One element",
+			container: New[int](1),
+		},
+		{
+			name:      "3 elements",
+			container: New[int](1, 2, 3),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			values := []int{}
+			for it := test.container.Begin(); it.Next(); {
+				value, _ := it.Get()
+				values = append(values, value)
+			}
+
+			reversed := []int{}
+			for it := test.container.ReverseBegin(); it.Next(); {
+				value, _ := it.Get()
+				reversed = append(reversed, value)
+			}
+
+			backward := []int{}
+			for it := test.container.ReverseEnd(); it.Previous(); {
+				value, _ := it.Get()
+				backward = append(backward, value)
+			}
+
+			assert.Equalf(t, values, backward, test.name)
+
+			slices.Reverse(values)
+			assert.Equalf(t, values, reversed, test.name)
+
+			first, found := test.container.ReverseFirst().Get()
+			last, _ := test.container.ReverseLast().Get()
+			assert.Equalf(t, len(values) > 0, found, test.name)
+
+			if len(values) > 0 {
+				assert.Equalf(t, values[0], first, test.name)
+				assert.Equalf(t, values[len(values)-1], last, test.name)
+			}
+		})
+	}
+}
+
+func TestArrayStackReverseIteratorTop(t *testing.T) {
+	stack := New[int](1, 2, 3)
+	it := stack.ReverseFirst()
+
+	top, _ := stack.Peek()
+	value, _ := it.Get()
+	assert.Equal(t, top, value)
+
+	index, _ := it.Index()
+	assert.Equal(t, stack.Size()-1, index)
+
+	assert.True(t, it.Set(30))
+
+	top, _ = stack.Peek()
+	assert.Equal(t, 30, top)
+
+	// GetAt addresses the stack from the bottom, like the underlying iterator
+	value, _ = it.GetAt(0)
+	assert.Equal(t, 1, value)
+	assert.True(t, it.MoveTo(0))
+	assert.True(t, it.IsEqual(stack.ReverseLast()))
+	assert.False(t, it.Next())
+}
+
+func TestArrayStackReverseIteratorCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		stack    *Stack[int]
+		it       func(*Stack[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+		other    func(*Stack[int]) ds.ReadWriteOrdCompBidRandCollIterator[int, int]
+		distance int
+	}{
+		{
+			name:     "Top to bottom, reversed",
+			stack:    New[int](1, 2, 3),
+			it:       (*Stack[int]).ReverseFirst,
+			other:    (*Stack[int]).ReverseLast,
+			distance: -2,
+		},
+		{
+			name:     "Reversed top to forward bottom",
+			stack:    New[int](1, 2, 3),
+			it:       (*Stack[int]).ReverseFirst,
+			other:    (*Stack[int]).First,
+			distance: 2,
+		},
+		{
+			name:     "Reversed top to forward top",
+			stack:    New[int](1, 2, 3),
+			it:       (*Stack[int]).ReverseFirst,
+			other:    (*Stack[int]).Last,
+			distance: 0,
+		},
+		{
+			name:     "Reversed begin to forward end",
+			stack:    New[int](1, 2, 3),
+			it:       (*Stack[int]).ReverseBegin,
+			other:    (*Stack[int]).End,
+			distance: 0,
+		},
+		{
+			name:     "Reversed end to forward begin",
+			stack:    New[int](1, 2, 3),
+			it:       (*Stack[int]).ReverseEnd,
+			other:    (*Stack[int]).Begin,
+			distance: 0,
+		},
+		{
+			name:     "Single element",
+			stack:    New[int](1),
+			it:       (*Stack[int]).ReverseFirst,
+			other:    (*Stack[int]).First,
+			distance: 0,
+		},
+		{
+			name:     "Empty, reversed begin to forward begin",
+			stack:    New[int](),
+			it:       (*Stack[int]).ReverseBegin,
+			other:    (*Stack[int]).Begin,
+			distance: 1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			it := test.it(test.stack)
+			other := test.other(test.stack)
+
+			distance, err := ds.CheckedDistanceTo(it, other)
+			assert.NoErrorf(t, err, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+			assert.Equalf(t, -test.distance, other.DistanceTo(it), test.name)
+			assert.Equalf(t, test.distance < 0, it.IsBefore(other), test.name)
+			assert.Equalf(t, test.distance > 0, other.IsBefore(it), test.name)
+			assert.Equalf(t, test.distance == 0, other.IsEqual(it), test.name)
+		})
+	}
+
+	stack := New[int](1, 2, 3)
+	other := stack.Clone()
+	assert.ErrorIs(t, ds.CanCompare(stack.First(), other.ReverseFirst()), ds.ErrForeignIterators)
+	assert.ErrorIs(t, ds.CanCompare(stack.ReverseFirst(), other.First()), ds.ErrForeignIterators)
+}
+
+func TestArrayStackReverseIteratorFailFast(t *testing.T) {
+	stack := New[int](1, 2, 3)
+	it := stack.ReverseFirst()
+
+	stack.Pop()
+
+	// The reversed iterator pointed to the popped top, it must not read past the shrunk stack
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Next())
+
+	_, found := it.Get()
+	assert.False(t, found)
+}
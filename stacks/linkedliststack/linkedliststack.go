@@ -12,16 +12,22 @@ package linkedliststack
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
 	"github.com/JonasMuehlmann/datastructures.go/lists/singlylinkedlist"
 	"github.com/JonasMuehlmann/datastructures.go/stacks"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
 )
 
 // Assert Stack implementation
 var _ stacks.Stack[any] = (*Stack[any])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Stack[any]] = (*Stack[any])(nil)
+var _ ds.Equatable[*Stack[any], any] = (*Stack[any])(nil)
+
 // Stack holds elements in a singly-linked-list
 type Stack[T any] struct {
 	list *singlylinkedlist.List[T]
@@ -115,6 +121,16 @@ func (stack *Stack[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the stack, which does not share any elements with the original.
+func (stack *Stack[T]) Clone() *Stack[T] {
+	return &Stack[T]{list: stack.list.Clone()}
+}
+
+// Equals returns true if both stacks hold equal values in the same order.
+func (stack *Stack[T]) Equals(other *Stack[T], comparator utils.Comparator[T]) bool {
+	return stack.list.Equals(other.list, comparator)
+}
+
 // Check that the index is within bounds of the list
 func (stack *Stack[T]) withinRange(index int) bool {
 	return index >= 0 && index < stack.list.Size()
@@ -145,3 +161,23 @@ func (stack *Stack[T]) First() ds.ReadWriteOrdCompForRandCollIterator[int, T] {
 func (stack *Stack[T]) Last() ds.ReadWriteOrdCompForRandCollIterator[int, T] {
 	return stack.NewIterator(stack.Size()-1, stack.Size())
 }
+
+// All returns a sequence over the index/value pairs of the stack in iteration order.
+func (stack *Stack[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range ds.Seq(stack.Begin()) {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Values returns a sequence over the values of the stack in iteration order.
+func (stack *Stack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(stack.Begin())(yield)
+	}
+}
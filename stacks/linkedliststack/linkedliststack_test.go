@@ -6,12 +6,12 @@
 package linkedliststack
 
 import (
+	"slices"
 	"testing"
 
-	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/ds"
-
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -467,3 +467,115 @@ func BenchmarkLinkedListStackPop(b *testing.B) {
 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 	}
 }
+
+func TestLinkedListStackClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Stack[string]
+	}{
+		{
+			name:     "empty",
+			original: NewFromSlice[string]([]string{}),
+		},
+		{
+			name:     "3 items",
+			original: NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			values := test.original.GetValues()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+
+			clone.Push("qux")
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+		})
+	}
+}
+
+func TestLinkedListStackEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Stack[string]
+		b     *Stack[string]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     NewFromSlice[string]([]string{}),
+			b:     NewFromSlice[string]([]string{}),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			b:     NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			equal: true,
+		},
+		{
+			name:  "different order",
+			a:     NewFromSlice[string]([]string{"foo", "bar", "baz"}),
+			b:     NewFromSlice[string]([]string{"baz", "bar", "foo"}),
+			equal: false,
+		},
+		{
+			name:  "different values",
+			a:     NewFromSlice[string]([]string{"foo", "bar"}),
+			b:     NewFromSlice[string]([]string{"foo", "qux"}),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     NewFromSlice[string]([]string{"foo"}),
+			b:     NewFromSlice[string]([]string{"foo", "bar"}),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[string]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	stack := New[int](1, 2, 3)
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range stack.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(stack.Values()))
+
+	values = []int{}
+	for value := range stack.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
@@ -10,6 +10,8 @@
 package avltree
 
 import (
+	"iter"
+
 	"github.com/JonasMuehlmann/datastructures.go/ds"
 	"github.com/JonasMuehlmann/datastructures.go/trees"
 	"github.com/JonasMuehlmann/datastructures.go/utils"
@@ -18,11 +20,17 @@ import (
 // Assert Tree implementation
 var _ trees.Tree[string, any] = new(Tree[string, any])
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Tree[string, any]] = new(Tree[string, any])
+var _ ds.Equatable[*Tree[string, any], any] = new(Tree[string, any])
+
 // Tree holds elements of the AVL tree.
 type Tree[TKey comparable, TValue any] struct {
 	Root       *Node[TKey, TValue]    // Root node
 	Comparator utils.Comparator[TKey] // Key comparator
 	size       int                    // Total number of keys in the tree
+
+	modifications ds.ModificationCounter
 }
 
 // NewWith instantiates an AVL tree with the custom comparator.
@@ -227,10 +235,75 @@ func (t *Tree[TKey, TValue]) Ceiling(key TKey) (floor *Node[TKey, TValue], found
 	return nil, false
 }
 
+// Rank returns the number of keys in the tree, which are strictly smaller than key.
+// Runs in O(log n).
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (t *Tree[TKey, TValue]) Rank(key TKey) int {
+	rank := 0
+	n := t.Root
+
+	for n != nil {
+		if t.Comparator(key, n.Key) <= 0 {
+			n = n.Children[0]
+		} else {
+			rank += n.Children[0].Size() + 1
+			n = n.Children[1]
+		}
+	}
+
+	return rank
+}
+
+// Select returns the node with the i-th smallest key (starting at 0) or nil if i is out of bounds.
+// Second return parameter is true if the node was found, otherwise false.
+// Runs in O(log n).
+func (t *Tree[TKey, TValue]) Select(i int) (node *Node[TKey, TValue], found bool) {
+	if i < 0 || i >= t.size {
+		return nil, false
+	}
+
+	n := t.Root
+
+	for n != nil {
+		leftSize := n.Children[0].Size()
+
+		switch {
+		case i < leftSize:
+			n = n.Children[0]
+		case i == leftSize:
+			return n, true
+		default:
+			i -= leftSize + 1
+			n = n.Children[1]
+		}
+	}
+
+	return nil, false
+}
+
+// CountRange returns the number of keys in the tree, which are larger than or equal to lo and smaller than or equal to hi.
+// Runs in O(log n).
+//
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (t *Tree[TKey, TValue]) CountRange(lo TKey, hi TKey) int {
+	if t.Comparator(lo, hi) > 0 {
+		return 0
+	}
+
+	count := t.Rank(hi) - t.Rank(lo)
+	if t.GetNode(hi) != nil {
+		count++
+	}
+
+	return count
+}
+
 // Clear removes all nodes from the tree.
 func (t *Tree[TKey, TValue]) Clear() {
 	t.Root = nil
 	t.size = 0
+	t.modifications.Modified()
 }
 
 // String returns a string representation of container
@@ -244,11 +317,63 @@ func (t *Tree[TKey, TValue]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the tree with the same shape, which does not share any nodes with the original.
+func (t *Tree[TKey, TValue]) Clone() *Tree[TKey, TValue] {
+	return &Tree[TKey, TValue]{
+		Root:       cloneNode(t.Root, nil),
+		Comparator: t.Comparator,
+		size:       t.size,
+	}
+}
+
+// Equals returns true if both trees hold equal key/value pairs.
+// Keys are compared with the tree's comparator, values with valueComparator.
+func (t *Tree[TKey, TValue]) Equals(other *Tree[TKey, TValue], valueComparator utils.Comparator[TValue]) bool {
+	if t.size != other.size {
+		return false
+	}
+
+	it := t.OrderedBegin()
+	otherIt := other.OrderedBegin()
+
+	for it.Next() && otherIt.Next() {
+		key, _ := it.GetKey()
+		otherKey, _ := otherIt.GetKey()
+		value, _ := it.Get()
+		otherValue, _ := otherIt.Get()
+
+		if t.Comparator(key, otherKey) != 0 || valueComparator(value, otherValue) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func cloneNode[TKey comparable, TValue any](n *Node[TKey, TValue], parent *Node[TKey, TValue]) *Node[TKey, TValue] {
+	if n == nil {
+		return nil
+	}
+
+	clone := &Node[TKey, TValue]{
+		Key:    n.Key,
+		Value:  n.Value,
+		Parent: parent,
+		b:      n.b,
+		size:   n.size,
+	}
+	clone.Children[0] = cloneNode(n.Children[0], clone)
+	clone.Children[1] = cloneNode(n.Children[1], clone)
+
+	return clone
+}
+
 func (t *Tree[TKey, TValue]) put(key TKey, value TValue, p *Node[TKey, TValue], qp **Node[TKey, TValue]) bool {
 	q := *qp
 	if q == nil {
 		t.size++
-		*qp = &Node[TKey, TValue]{Key: key, Value: value, Parent: p}
+		t.modifications.Modified()
+		*qp = &Node[TKey, TValue]{Key: key, Value: value, Parent: p, size: 1}
 
 		return true
 	}
@@ -271,6 +396,8 @@ func (t *Tree[TKey, TValue]) put(key TKey, value TValue, p *Node[TKey, TValue],
 	var fix bool
 
 	fix = t.put(key, value, q, &q.Children[a])
+	q.updateSize()
+
 	if fix {
 		return putFix(int8(c), qp)
 	}
@@ -287,6 +414,7 @@ func (t *Tree[TKey, TValue]) remove(key TKey, qp **Node[TKey, TValue]) bool {
 	c := t.Comparator(key, q.Key)
 	if c == 0 {
 		t.size--
+		t.modifications.Modified()
 		if q.Children[1] == nil {
 			if q.Children[0] != nil {
 				q.Children[0].Parent = q.Parent
@@ -298,6 +426,8 @@ func (t *Tree[TKey, TValue]) remove(key TKey, qp **Node[TKey, TValue]) bool {
 		}
 
 		fix := removeMin(&q.Children[1], &q.Key, &q.Value)
+		q.updateSize()
+
 		if fix {
 			return removeFix(-1, qp)
 		}
@@ -313,6 +443,8 @@ func (t *Tree[TKey, TValue]) remove(key TKey, qp **Node[TKey, TValue]) bool {
 	a := (c + 1) / 2
 
 	fix := t.remove(key, &q.Children[a])
+	q.updateSize()
+
 	if fix {
 		return removeFix(int8(-c), qp)
 	}
@@ -335,6 +467,7 @@ func removeMin[TKey comparable, TValue any](qp **Node[TKey, TValue], minKey *TKe
 	}
 
 	fix := removeMin(&q.Children[0], minKey, minVal)
+	q.updateSize()
 
 	if fix {
 		return removeFix(1, qp)
@@ -446,6 +579,9 @@ func rotate[TKey comparable, TValue any](c int8, s *Node[TKey, TValue]) *Node[TK
 	r.Parent = s.Parent
 	s.Parent = r
 
+	s.updateSize()
+	r.updateSize()
+
 	return r
 }
 
@@ -517,61 +653,17 @@ func (tree *Tree[TKey, TValue]) lookup(key TKey) *Node[TKey, TValue] {
 	return nil
 }
 
-func findLowestCommonAncestor[TKey comparable, TValue any](start, node1, node2 *Node[TKey, TValue]) *Node[TKey, TValue] {
-	if start == nil {
-		return nil
-	}
-
-	if start == node1 || start == node2 {
-		return start
-	}
-
-	leftChild := findLowestCommonAncestor(start.Children[0], node1, node2)
-	rightChild := findLowestCommonAncestor(start.Children[1], node1, node2)
+// rankOf returns the number of keys in the tree, which are strictly smaller than n's key.
+func rankOf[TKey comparable, TValue any](n *Node[TKey, TValue]) int {
+	rank := n.Children[0].Size()
 
-	if leftChild != nil && rightChild != nil {
-		return start
-	}
-
-	if leftChild == nil {
-		return rightChild
-	}
-
-	return leftChild
-}
-
-func getDistanceFromLCA[TKey comparable, TValue any](comparator utils.Comparator[TKey], lca, child *Node[TKey, TValue], distance int, originalToTargetOrdering int, isOriginal bool) int {
-	if lca == child {
-		return distance
-	}
-
-	if child == lca.Children[0] || child == lca.Children[1] {
-		return distance + 1
-	}
-
-	newDistance := distance
-
-	if comparator(child.Key, lca.Key) < 0 {
-		if originalToTargetOrdering < 0 {
-			newDistance += 1
+	for ; n.Parent != nil; n = n.Parent {
+		if n == n.Parent.Children[1] {
+			rank += n.Parent.Children[0].Size() + 1
 		}
-
-		return getDistanceFromLCA(comparator, lca.Children[0], child, newDistance, originalToTargetOrdering, true)
 	}
-	if originalToTargetOrdering > 0 {
-		newDistance += 1
-	}
-
-	return getDistanceFromLCA(comparator, lca.Children[1], child, newDistance, originalToTargetOrdering, true)
 
-}
-
-func distanceBetween[TKey comparable, TValue any](comparator utils.Comparator[TKey], root, original, target *Node[TKey, TValue]) int {
-	distance := 0
-
-	lca := findLowestCommonAncestor(root, original, target)
-
-	return getDistanceFromLCA(comparator, lca, original, distance, comparator(original.Key, target.Key), true) + getDistanceFromLCA(comparator, lca, target, distance, comparator(original.Key, target.Key), false)
+	return rank
 }
 
 //******************************************************************//
@@ -603,3 +695,61 @@ func (tree *Tree[TKey, TValue]) OrderedFirst() ds.ReadWriteOrdCompBidRandCollIte
 func (tree *Tree[TKey, TValue]) OrderedLast() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
 	return tree.NewOrderedIterator(tree.Size()-1, tree.Size())
 }
+
+//******************************************************************//
+//                         Reverse iterator                         //
+//******************************************************************//
+
+// ReverseBegin returns an initialized, reversed iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (tree *Tree[TKey, TValue]) ReverseBegin() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(tree.OrderedEnd())
+}
+
+// ReverseEnd returns an initialized, reversed iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (tree *Tree[TKey, TValue]) ReverseEnd() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(tree.OrderedBegin())
+}
+
+// ReverseFirst returns an initialized, reversed iterator, which points to it's first element.
+func (tree *Tree[TKey, TValue]) ReverseFirst() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(tree.OrderedLast())
+}
+
+// ReverseLast returns an initialized, reversed iterator, which points to it's last element.
+func (tree *Tree[TKey, TValue]) ReverseLast() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(tree.OrderedFirst())
+}
+
+// All returns a sequence over the key/value pairs of the tree in key order.
+func (tree *Tree[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.Seq2(tree.OrderedBegin())(yield)
+	}
+}
+
+// Backward returns a sequence over the key/value pairs of the tree in reverse key order.
+func (tree *Tree[TKey, TValue]) Backward() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.BackwardSeq2(tree.OrderedEnd())(yield)
+	}
+}
+
+// Keys returns a sequence over the keys of the tree in order.
+func (tree *Tree[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return func(yield func(TKey) bool) {
+		for key := range tree.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the tree in key order.
+func (tree *Tree[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		ds.Seq(tree.OrderedBegin())(yield)
+	}
+}
@@ -6,11 +6,12 @@
 package avltree
 
 import (
+	"math/rand"
+	"slices"
 	"testing"
 
-	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/maps/hashmap"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -409,6 +410,219 @@ func TestAVLTreeFloor(t *testing.T) {
 	}
 }
 
+func TestAVLTreeRank(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Tree[int, string]
+		key         int
+		rank        int
+	}{
+		{
+			name:        "empty list",
+			originalMap: New[int, string](utils.BasicComparator[int]),
+			key:         1,
+			rank:        0,
+		},
+		{
+			name:        "smallest key",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			key:         1,
+			rank:        0,
+		},
+		{
+			name:        "contained key",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			key:         5,
+			rank:        2,
+		},
+		{
+			name:        "missing key",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			key:         4,
+			rank:        2,
+		},
+		{
+			name:        "key larger than all keys",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			key:         10,
+			rank:        3,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			rank := test.originalMap.Rank(test.key)
+
+			assert.Equalf(t, test.rank, rank, test.name)
+		})
+	}
+}
+
+func TestAVLTreeSelect(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Tree[int, string]
+		index       int
+		key         int
+		found       bool
+	}{
+		{
+			name:        "empty list",
+			originalMap: New[int, string](utils.BasicComparator[int]),
+			index:       0,
+			found:       false,
+		},
+		{
+			name:        "first",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			index:       0,
+			key:         1,
+			found:       true,
+		},
+		{
+			name:        "last",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			index:       2,
+			key:         5,
+			found:       true,
+		},
+		{
+			name:        "negative index",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			index:       -1,
+			found:       false,
+		},
+		{
+			name:        "index out of bounds",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz"}),
+			index:       3,
+			found:       false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			node, found := test.originalMap.Select(test.index)
+
+			assert.Equalf(t, test.found, found, test.name)
+			if test.found {
+				assert.Equalf(t, test.key, node.Key, test.name)
+			} else {
+				assert.Nilf(t, node, test.name)
+			}
+		})
+	}
+}
+
+func TestAVLTreeCountRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		originalMap *Tree[int, string]
+		lo          int
+		hi          int
+		count       int
+	}{
+		{
+			name:        "empty list",
+			originalMap: New[int, string](utils.BasicComparator[int]),
+			lo:          0,
+			hi:          10,
+			count:       0,
+		},
+		{
+			name:        "contained bounds",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz", 7: "qux"}),
+			lo:          3,
+			hi:          5,
+			count:       2,
+		},
+		{
+			name:        "missing bounds",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz", 7: "qux"}),
+			lo:          2,
+			hi:          6,
+			count:       2,
+		},
+		{
+			name:        "all keys",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz", 7: "qux"}),
+			lo:          0,
+			hi:          10,
+			count:       4,
+		},
+		{
+			name:        "inverted bounds",
+			originalMap: NewFromMap[int, string](utils.BasicComparator[int], map[int]string{1: "foo", 3: "bar", 5: "baz", 7: "qux"}),
+			lo:          5,
+			hi:          3,
+			count:       0,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			count := test.originalMap.CountRange(test.lo, test.hi)
+
+			assert.Equalf(t, test.count, count, test.name)
+		})
+	}
+}
+
+func TestAVLTreeOrderStatisticsAfterModification(t *testing.T) {
+	tree := New[int, int](utils.BasicComparator[int])
+	random := rand.New(rand.NewSource(1))
+	keys := map[int]struct{}{}
+
+	for i := 0; i < 1000; i++ {
+		key := random.Intn(200)
+
+		if random.Intn(3) == 0 {
+			tree.Remove(key)
+			delete(keys, key)
+		} else {
+			tree.Put(key, i)
+			keys[key] = struct{}{}
+		}
+	}
+
+	sortedKeys := tree.GetKeys()
+	assert.Len(t, sortedKeys, len(keys))
+	assert.Equal(t, len(keys), tree.Root.Size())
+
+	for i, key := range sortedKeys {
+		assert.Equal(t, i, tree.Rank(key))
+
+		node, found := tree.Select(i)
+		assert.True(t, found)
+		assert.Equal(t, key, node.Key)
+	}
+
+	it := tree.OrderedBegin()
+	for i := len(sortedKeys) - 1; i >= 0; i-- {
+		assert.True(t, it.MoveTo(i))
+
+		key, _ := it.GetKey()
+		assert.Equal(t, sortedKeys[i], key)
+
+		assert.True(t, it.MoveToKey(sortedKeys[i]))
+
+		index, _ := it.Index()
+		assert.Equal(t, i, index)
+	}
+}
+
 func TestAVLTreeGetKeys(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -826,3 +1040,159 @@ func TestAVLTreeNewFromIteratorsWith(t *testing.T) {
 // 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 // 	}
 // }
+
+// assertClonedNode asserts, that clone has the same keys, balance factors and shape as node, but none of its nodes.
+func assertClonedNode(t *testing.T, node *Node[string, int], clone *Node[string, int], parent *Node[string, int]) {
+	if node == nil {
+		assert.Nil(t, clone)
+
+		return
+	}
+
+	if !assert.NotNil(t, clone) {
+		return
+	}
+
+	assert.NotSame(t, node, clone)
+	assert.Same(t, parent, clone.Parent)
+	assert.Equal(t, node.Key, clone.Key)
+	assert.Equal(t, node.b, clone.b)
+
+	for i := range node.Children {
+		assertClonedNode(t, node.Children[i], clone.Children[i], clone)
+	}
+}
+
+func TestAVLTreeClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Tree[string, int]
+	}{
+		{
+			name:     "empty",
+			original: NewFromMap[string, int](utils.BasicComparator[string], map[string]int{}),
+		},
+		{
+			name:     "3 items",
+			original: NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			size := test.original.Size()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+			assertClonedNode(t, test.original.Root, clone.Root, nil)
+
+			values := test.original.GetValues()
+			for _, key := range test.original.GetKeys() {
+				clone.Put(key, -1)
+			}
+
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+
+			clone.Put("qux", 4)
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, size, test.original.Size(), test.name)
+		})
+	}
+}
+
+func TestAVLTreeEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Tree[string, int]
+		b     *Tree[string, int]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{}),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+			equal: true,
+		},
+		{
+			name:  "different values",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 3}),
+			equal: false,
+		},
+		{
+			name:  "different keys",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "qux": 2}),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1}),
+			b:     NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2}),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[int]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	tree := New[string, int](utils.BasicComparator[string])
+	tree.Put("c", 3)
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range tree.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	keys = []string{}
+	values = []int{}
+	for key, value := range tree.Backward() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"c", "b", "a"}, keys)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(tree.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(tree.Values()))
+
+	keys = []string{}
+	for key := range tree.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
@@ -18,26 +18,21 @@ type Node[TKey comparable, TValue any] struct {
 	Parent   *Node[TKey, TValue]    // Parent node
 	Children [2]*Node[TKey, TValue] // Children nodes
 	b        int8
+	size     int // Number of nodes in the subtree rooted at this node
 }
 
 // Size returns the number of elements stored in the subtree.
-// Computed dynamically on each call, i.e. the subtree is traversed to count the number of the nodes.
+// The size is maintained by the tree, so this runs in O(1).
 func (n *Node[TKey, TValue]) Size() int {
 	if n == nil {
 		return 0
 	}
 
-	size := 1
-
-	if n.Children[0] != nil {
-		size += n.Children[0].Size()
-	}
-
-	if n.Children[1] != nil {
-		size += n.Children[1].Size()
-	}
+	return n.size
+}
 
-	return size
+func (n *Node[TKey, TValue]) updateSize() {
+	n.size = 1 + n.Children[0].Size() + n.Children[1].Size()
 }
 
 func (n *Node[TKey, TValue]) String() string {
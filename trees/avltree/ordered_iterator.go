@@ -12,6 +12,12 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[string, any] = (*OrderedIterator[string, any])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[string, any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[string, any])(nil)
+
 // Ordered holding the iterator's state
 type OrderedIterator[TKey comparable, TValue any] struct {
 	tree  *Tree[TKey, TValue]
@@ -21,14 +27,16 @@ type OrderedIterator[TKey comparable, TValue any] struct {
 	key   TKey
 	value TValue
 	size  int
+	guard ds.ModificationGuard
 }
 
-//  returns a stateful iterator whose elements are key/value pairs.
+// NewOrderedIterator returns a stateful iterator whose elements are key/value pairs.
 func (tree *Tree[TKey, TValue]) NewOrderedIterator(position int, size int) *OrderedIterator[TKey, TValue] {
 	it := &OrderedIterator[TKey, TValue]{
 		tree:  tree,
 		index: 0,
 		size:  size,
+		guard: tree.modifications.Guard(),
 	}
 	it.size = utils.Min(tree.Size(), size)
 	it.size = utils.Max(tree.Size(), -1)
@@ -67,7 +75,7 @@ func (tree *Tree[TKey, TValue]) NewOrderedIterator(position int, size int) *Orde
 
 // At returns a stateful iterator whose elements are key/value pairs that is initialised at a particular node.
 func (tree *Tree[TKey, TValue]) NewOrderedteratorAt(t *Tree[TKey, TValue], key TKey) *OrderedIterator[TKey, TValue] {
-	it := &OrderedIterator[TKey, TValue]{tree: t, index: -1, size: t.Size()}
+	it := &OrderedIterator[TKey, TValue]{tree: t, index: -1, size: t.Size(), guard: t.modifications.Guard()}
 
 	it.MoveToKey(key)
 
@@ -91,10 +99,17 @@ func (it *OrderedIterator[TKey, TValue]) IsLast() bool {
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsValid() bool {
-	return it.size > 0 && !it.IsBegin() && !it.IsEnd()
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the tree was structurally modified, other than through the iterator.
+func (it *OrderedIterator[TKey, TValue]) Err() error {
+	return it.guard.Err()
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -103,7 +118,23 @@ func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bo
 	return it.DistanceTo(otherThis) == 0
 }
 
+// CanCompare checks if other is an iterator of the same tree.
+func (it *OrderedIterator[TKey, TValue]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := ds.BaseOf[TKey, TValue](other).(*OrderedIterator[TKey, TValue])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.tree != it.tree {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 func (it *OrderedIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) int {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -113,6 +144,8 @@ func (it *OrderedIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) in
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -122,6 +155,8 @@ func (it *OrderedIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsBefore(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -178,13 +213,11 @@ func (it *OrderedIterator[TKey, TValue]) Next() bool {
 }
 
 func (it *OrderedIterator[TKey, TValue]) NextN(n int) bool {
-	var found bool
-
-	for i := 0; i < n; i++ {
-		found = it.Next()
+	if n <= 0 {
+		return false
 	}
 
-	return found
+	return it.MoveTo(utils.Min(it.index+n, it.size))
 }
 
 // Prev moves the  to the previous element and returns true if there was a previous element in the container.
@@ -231,13 +264,11 @@ func (it *OrderedIterator[TKey, TValue]) Previous() bool {
 }
 
 func (it *OrderedIterator[TKey, TValue]) PreviousN(n int) bool {
-	var found bool
-
-	for i := 0; i < n; i++ {
-		found = it.Previous()
+	if n <= 0 {
+		return false
 	}
 
-	return found
+	return it.MoveTo(utils.Max(it.index-n, -1))
 }
 
 func (it *OrderedIterator[TKey, TValue]) MoveBy(n int) bool {
@@ -248,34 +279,51 @@ func (it *OrderedIterator[TKey, TValue]) MoveBy(n int) bool {
 	}
 }
 
+// MoveTo moves the iterator to the n-th element in O(log n).
 func (it *OrderedIterator[TKey, TValue]) MoveTo(n int) bool {
-	return it.MoveBy(n - it.index)
-}
+	if it.guard.Err() != nil {
+		return false
+	}
 
-// https://www.geeksforgeeks.org/find-distance-between-two-nodes-of-a-binary-tree/
-func (it *OrderedIterator[TKey, TValue]) MoveToKey(key TKey) (found bool) {
-	if it.IsValid() && it.tree.Comparator(key, it.node.Key) == 0 {
+	switch {
+	case n < 0:
+		it.index = -1
+		it.node = it.tree.Left()
 
-		return true
-	}
+		return false
+	case n >= it.size:
+		it.index = it.size
+		it.node = it.tree.Right()
 
-	targetNode := it.tree.lookup(key)
-	if targetNode == nil {
 		return false
 	}
 
-	distance := distanceBetween(it.tree.Comparator, it.tree.Root, it.node, targetNode)
-	if it.IsBegin() || it.IsEnd() {
-		// Even if the index is Begin(), the starting node is First()
-		distance += 1
+	node, found := it.tree.Select(n)
+	if !found {
+		return false
 	}
 
-	if it.tree.Comparator(key, it.node.Key) < 0 || it.IsEnd() {
-		distance = -distance
+	it.index = n
+	it.node = node
+	it.key = node.Key
+	it.value = node.Value
+
+	return true
+}
+
+// MoveToKey moves the iterator to the element with the given key in O(log n).
+func (it *OrderedIterator[TKey, TValue]) MoveToKey(key TKey) (found bool) {
+	if it.guard.Err() != nil {
+		return false
 	}
 
-	it.index += distance
+	targetNode := it.tree.lookup(key)
+	if targetNode == nil {
+		return false
+	}
 
+	it.index = rankOf(targetNode)
+	it.node = targetNode
 	it.key = targetNode.Key
 	it.value = targetNode.Value
 
@@ -312,9 +360,6 @@ func (it *OrderedIterator[TKey, TValue]) Set(value TValue) bool {
 }
 
 func (it *OrderedIterator[TKey, TValue]) GetAt(i int) (value TValue, found bool) {
-	if !it.IsValid() {
-		return
-	}
 	tmp := *it
 	tmp.MoveTo(i)
 
@@ -322,10 +367,6 @@ func (it *OrderedIterator[TKey, TValue]) GetAt(i int) (value TValue, found bool)
 }
 
 func (it *OrderedIterator[TKey, TValue]) SetAt(i int, value TValue) bool {
-	if !it.IsValid() {
-		return false
-	}
-
 	tmp := *it
 	tmp.MoveTo(i)
 
@@ -336,6 +377,7 @@ func (it *OrderedIterator[TKey, TValue]) GetAtKey(key TKey) (value TValue, found
 	return it.tree.Get(key)
 }
 
+// SetAtKey puts the key/value pair into the tree, inserting a new key invalidates the iterator.
 func (it *OrderedIterator[TKey, TValue]) SetAtKey(key TKey, value TValue) bool {
 	it.tree.Put(key, value)
 
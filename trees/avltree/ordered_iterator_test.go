@@ -1,6 +1,8 @@
 package avltree
 
 import (
+	"slices"
+	"strconv"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
@@ -1097,3 +1099,200 @@ func TestAVLTreeOrderedIteratorIsBeginEndFirstLast(t *testing.T) {
 		})
 	}
 }
+
+func TestAVLTreeReverseIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		container *Tree[string, int]
+	}{
+		{
+			name:      "Empty",
+			container: New[string, int](utils.BasicComparator[string]),
+		},
+		{
+			name:      "One element",
+			container: NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1}),
+		},
+		{
+			name:      "3 elements",
+			container: NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2, "c": 3}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			values := []int{}
+			for it := test.container.OrderedBegin(); it.Next(); {
+				value, _ := it.Get()
+				values = append(values, value)
+			}
+
+			reversed := []int{}
+			for it := test.container.ReverseBegin(); it.Next(); {
+				value, _ := it.Get()
+				reversed = append(reversed, value)
+			}
+
+			backward := []int{}
+			for it := test.container.ReverseEnd(); it.Previous(); {
+				value, _ := it.Get()
+				backward = append(backward, value)
+			}
+
+			assert.Equalf(t, values, backward, test.name)
+
+			slices.Reverse(values)
+			assert.Equalf(t, values, reversed, test.name)
+
+			first, found := test.container.ReverseFirst().Get()
+			last, _ := test.container.ReverseLast().Get()
+			assert.Equalf(t, len(values) > 0, found, test.name)
+
+			if len(values) > 0 {
+				assert.Equalf(t, values[0], first, test.name)
+				assert.Equalf(t, values[len(values)-1], last, test.name)
+			}
+		})
+	}
+}
+
+// newRotated returns a tree of the keys 1 to 7, whose ascending insertion forced several rotations.
+func newRotated() *Tree[int, string] {
+	tree := New[int, string](utils.BasicComparator[int])
+	for key := 1; key <= 7; key++ {
+		tree.Put(key, strconv.Itoa(key))
+	}
+
+	return tree
+}
+
+func TestAVLTreeReverseIteratorAfterRotations(t *testing.T) {
+	tree := newRotated()
+
+	keys := []int{}
+	for it := tree.ReverseBegin(); it.Next(); {
+		key, _ := it.GetKey()
+		keys = append(keys, key)
+	}
+
+	assert.Equal(t, []int{7, 6, 5, 4, 3, 2, 1}, keys)
+
+	// Moving onto and past the root must not skip the rotated subtrees
+	it := tree.ReverseBegin()
+	assert.True(t, it.MoveToKey(tree.Root.Key))
+	assert.True(t, it.Next())
+
+	key, _ := it.GetKey()
+	assert.Equal(t, tree.Root.Key-1, key)
+
+	index, _ := it.Index()
+	assert.Equal(t, tree.Root.Key-2, index)
+
+	value, _ := it.GetAt(6)
+	assert.Equal(t, "7", value)
+	assert.True(t, it.SetAt(0, "one"))
+
+	value, _ = tree.Get(1)
+	assert.Equal(t, "one", value)
+
+	key, _ = it.GetKey()
+	assert.Equal(t, tree.Root.Key-1, key)
+}
+
+func TestAVLTreeReverseIteratorCompare(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           int
+		reversed      bool
+		otherKey      int
+		otherReversed bool
+		distance      int
+	}{
+		{
+			name:          "Reversed keys",
+			key:           7,
+			reversed:      true,
+			otherKey:      1,
+			otherReversed: true,
+			distance:      -6,
+		},
+		{
+			name:          "Reversed key to forward key",
+			key:           5,
+			reversed:      true,
+			otherKey:      2,
+			otherReversed: false,
+			distance:      3,
+		},
+		{
+			name:          "Reversed root to forward root",
+			key:           4,
+			reversed:      true,
+			otherKey:      4,
+			otherReversed: false,
+			distance:      0,
+		},
+		{
+			name:          "Forward leaf to reversed leaf",
+			key:           1,
+			reversed:      false,
+			otherKey:      7,
+			otherReversed: true,
+			distance:      -6,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			tree := newRotated()
+			it := atKey(tree, test.reversed, test.key)
+			other := atKey(tree, test.otherReversed, test.otherKey)
+
+			distance, err := ds.CheckedDistanceTo(it, other)
+			assert.NoErrorf(t, err, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+			assert.Equalf(t, -test.distance, other.DistanceTo(it), test.name)
+			assert.Equalf(t, test.distance < 0, it.IsBefore(other), test.name)
+			assert.Equalf(t, test.distance > 0, other.IsBefore(it), test.name)
+			assert.Equalf(t, test.distance == 0, other.IsEqual(it), test.name)
+		})
+	}
+
+	tree := newRotated()
+	assert.ErrorIs(t, ds.CanCompare(tree.OrderedFirst(), newRotated().ReverseFirst()), ds.ErrForeignIterators)
+	assert.ErrorIs(t, ds.CanCompare(tree.ReverseFirst(), newRotated().OrderedFirst()), ds.ErrForeignIterators)
+}
+
+func TestAVLTreeReverseIteratorFailFast(t *testing.T) {
+	tree := newRotated()
+	it := tree.ReverseFirst()
+
+	// Removing the root rebalances the tree, the iterator's node may have moved
+	tree.Remove(tree.Root.Key)
+
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Next())
+}
+
+// atKey returns a forward or reversed iterator of tree, which points to key.
+func atKey(tree *Tree[int, string], reversed bool, key int) ds.ReadWriteOrdCompBidRandCollIterator[int, string] {
+	it := tree.OrderedBegin()
+	if reversed {
+		it = tree.ReverseBegin()
+	}
+
+	it.MoveToKey(key)
+
+	return it
+}
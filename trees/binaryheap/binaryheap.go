@@ -14,6 +14,7 @@ package binaryheap
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
@@ -25,10 +26,16 @@ import (
 // Assert Tree implementation
 var _ trees.Tree[int, any] = (*Heap[any])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Heap[any]] = (*Heap[any])(nil)
+var _ ds.Equatable[*Heap[any], any] = (*Heap[any])(nil)
+
 // Heap holds elements in an array-list
 type Heap[T any] struct {
 	list       *arraylist.List[T]
 	Comparator utils.Comparator[T]
+
+	modifications ds.ModificationCounter
 }
 
 // NewWith instantiates a new empty heap tree with the custom comparator.
@@ -82,6 +89,8 @@ func NewFromIterators[T any](comparator utils.Comparator[T], begin ds.ReadCompFo
 
 // Push adds a value onto the heap and bubbles it up accordingly.
 func (heap *Heap[T]) Push(values ...T) {
+	heap.modifications.Modified()
+
 	if len(values) == 1 {
 		heap.list.PushBack(values[0])
 		heap.bubbleUp()
@@ -107,6 +116,7 @@ func (heap *Heap[T]) Pop() (value T, ok bool) {
 	lastIndex := heap.list.Size() - 1
 	heap.list.Swap(0, lastIndex)
 	heap.list.Remove(lastIndex)
+	heap.modifications.Modified()
 	heap.bubbleDown()
 	return
 }
@@ -130,6 +140,7 @@ func (heap *Heap[T]) Size() int {
 // Clear removes all elements from the heap.
 func (heap *Heap[T]) Clear() {
 	heap.list.Clear()
+	heap.modifications.Modified()
 }
 
 // Values returns all elements in the heap.
@@ -161,6 +172,16 @@ func (heap *Heap[T]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the heap with the same comparator, which does not share any elements with the original.
+func (heap *Heap[T]) Clone() *Heap[T] {
+	return &Heap[T]{list: heap.list.Clone(), Comparator: heap.Comparator}
+}
+
+// Equals returns true if both heaps hold equal values, which are compared in the order they would be popped in.
+func (heap *Heap[T]) Equals(other *Heap[T], comparator utils.Comparator[T]) bool {
+	return heap.Size() == other.Size() && utils.SlicesEqual(heap.GetValues(), other.GetValues(), comparator)
+}
+
 // Performs the "bubble down" operation. This is to place the element that is at the root
 // of the heap in its correct place so that the heap maintains the min/max-heap order property.
 func (heap *Heap[T]) bubbleDown() {
@@ -236,3 +257,36 @@ func (heap *Heap[T]) OrderedFirst() ds.ReadWriteOrdCompBidRandCollIterator[int,
 func (heap *Heap[T]) OrderedLast() ds.ReadWriteOrdCompBidRandCollIterator[int, T] {
 	return heap.NewOrderedIterator(heap.list.Size()-1, heap.Size())
 }
+
+// All returns a sequence over the index/value pairs of the heap in iteration order.
+func (heap *Heap[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range ds.Seq(heap.OrderedBegin()) {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns a sequence over the index/value pairs of the heap in reverse iteration order.
+func (heap *Heap[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := heap.Size() - 1
+		for value := range ds.BackwardSeq(heap.OrderedEnd()) {
+			if !yield(i, value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// Values returns a sequence over the values of the heap in iteration order.
+func (heap *Heap[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ds.Seq(heap.OrderedBegin())(yield)
+	}
+}
@@ -6,12 +6,11 @@
 package binaryheap
 
 import (
+	"slices"
 	"testing"
 
-	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/ds"
-
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -397,3 +396,125 @@ func BenchmarkBinaryHeapPop(b *testing.B) {
 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 	}
 }
+
+func TestBinaryHeapClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Heap[string]
+	}{
+		{
+			name:     "empty",
+			original: New[string](utils.BasicComparator[string]),
+		},
+		{
+			name:     "3 items",
+			original: New[string](utils.BasicComparator[string], "foo", "bar", "baz"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			values := test.original.GetValues()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+
+			clone.Push("qux")
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+		})
+	}
+}
+
+func TestBinaryHeapEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Heap[string]
+		b     *Heap[string]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     New[string](utils.BasicComparator[string]),
+			b:     New[string](utils.BasicComparator[string]),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     New[string](utils.BasicComparator[string], "foo", "bar", "baz"),
+			b:     New[string](utils.BasicComparator[string], "foo", "bar", "baz"),
+			equal: true,
+		},
+		{
+			name:  "different insertion order",
+			a:     New[string](utils.BasicComparator[string], "foo", "bar", "baz"),
+			b:     New[string](utils.BasicComparator[string], "baz", "bar", "foo"),
+			equal: true,
+		},
+		{
+			name:  "different values",
+			a:     New[string](utils.BasicComparator[string], "foo", "bar"),
+			b:     New[string](utils.BasicComparator[string], "foo", "qux"),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     New[string](utils.BasicComparator[string], "foo"),
+			b:     New[string](utils.BasicComparator[string], "foo", "bar"),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[string]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[string]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	heap := New(utils.BasicComparator[int], 2, 3, 1)
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range heap.All() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	indices = []int{}
+	values = []int{}
+	for i, value := range heap.Backward() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{2, 1, 0}, indices)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(heap.Values()))
+
+	values = []int{}
+	for value := range heap.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
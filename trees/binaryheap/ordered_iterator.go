@@ -13,6 +13,12 @@ import (
 // Assert OrderedIterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[int, any] = (*OrderedIterator[any])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[any])(nil)
+
 type visitedChildren int
 
 // OrderedIterator holding the iterator's state
@@ -25,11 +31,12 @@ type OrderedIterator[T any] struct {
 	// Redundant but has better locality
 	value T
 	size  int
+	guard ds.ModificationGuard
 }
 
 // NewOrderedIterator returns a stateful iterator whose values can be fetched by an index.
 func (list *Heap[T]) NewOrderedIterator(index int, size int) *OrderedIterator[T] {
-	it := &OrderedIterator[T]{heap: list, index: index, size: size, valueDirty: true}
+	it := &OrderedIterator[T]{heap: list, index: index, size: size, valueDirty: true, guard: list.modifications.Guard()}
 	it.size = utils.Min(list.Size(), size)
 	it.size = utils.Max(list.Size(), -1)
 
@@ -37,7 +44,12 @@ func (list *Heap[T]) NewOrderedIterator(index int, size int) *OrderedIterator[T]
 }
 
 func (it *OrderedIterator[T]) IsValid() bool {
-	return it.size > 0 && !it.IsBegin() && !it.IsEnd()
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the heap was structurally modified, other than through the iterator.
+func (it *OrderedIterator[T]) Err() error {
+	return it.guard.Err()
 }
 
 func (it *OrderedIterator[T]) Get() (value T, found bool) {
@@ -140,6 +152,20 @@ func (it *OrderedIterator[T]) IsEqual(other ds.ComparableIterator) bool {
 	return it.DistanceTo(otherThis) == 0
 }
 
+// CanCompare checks if other is an iterator of the same heap.
+func (it *OrderedIterator[T]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*OrderedIterator[T])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.heap != it.heap {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 func (it *OrderedIterator[T]) Next() bool {
 	it.index = utils.Min(it.index+1, it.size)
 	it.valueDirty = true
@@ -232,7 +258,7 @@ func (it *OrderedIterator[T]) GetAtKey(i int) (value T, found bool) {
 }
 
 func (it *OrderedIterator[T]) SetAt(i int, value T) bool {
-	if it.size == 0 || !it.heap.withinRange(i) || i >= it.size {
+	if it.size == 0 || !it.heap.withinRange(i) || i >= it.size || it.guard.Err() != nil {
 		return false
 	}
 
@@ -20,6 +20,8 @@ func (heap *Heap[T]) ToJSON() ([]byte, error) {
 
 // FromJSON populates the heap from the input JSON representation.
 func (heap *Heap[T]) FromJSON(data []byte) error {
+	heap.modifications.Modified()
+
 	return heap.list.FromJSON(data)
 }
 
@@ -20,6 +20,7 @@ package btree
 import (
 	"bytes"
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
@@ -30,12 +31,18 @@ import (
 // Assert Tree implementation
 var _ trees.Tree[string, any] = (*Tree[string, any])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Tree[string, any]] = (*Tree[string, any])(nil)
+var _ ds.Equatable[*Tree[string, any], any] = (*Tree[string, any])(nil)
+
 // Tree holds elements of the B-tree
 type Tree[TKey comparable, TValue any] struct {
 	Root       *Node[TKey, TValue]    // Root node
 	Comparator utils.Comparator[TKey] // Key comparator
 	size       int                    // Total number of keys in the tree
 	m          int                    // order (maximum number of children)
+
+	modifications ds.ModificationCounter
 }
 
 // NewWith instantiates a B-tree with the order (maximum number of children) and a custom key comparator.
@@ -95,11 +102,13 @@ func (tree *Tree[TKey, TValue]) Put(key TKey, value TValue) {
 	if tree.Root == nil {
 		tree.Root = &Node[TKey, TValue]{Entries: []*Entry[TKey, TValue]{entry}, Children: []*Node[TKey, TValue]{}}
 		tree.size++
+		tree.modifications.Modified()
 		return
 	}
 
 	if tree.insert(tree.Root, entry) {
 		tree.size++
+		tree.modifications.Modified()
 	}
 }
 
@@ -128,6 +137,7 @@ func (tree *Tree[TKey, TValue]) Remove(key TKey) {
 	if found {
 		tree.delete(node, index)
 		tree.size--
+		tree.modifications.Modified()
 	}
 }
 
@@ -173,6 +183,7 @@ func (tree *Tree[TKey, TValue]) GetValues() []TValue {
 func (tree *Tree[TKey, TValue]) Clear() {
 	tree.Root = nil
 	tree.size = 0
+	tree.modifications.Modified()
 }
 
 // Height returns the height of the tree.
@@ -232,6 +243,62 @@ func (tree *Tree[TKey, TValue]) ToString() string {
 	return buffer.String()
 }
 
+// Clone returns a copy of the tree with the same order and shape, which does not share any nodes or entries with the original.
+func (tree *Tree[TKey, TValue]) Clone() *Tree[TKey, TValue] {
+	return &Tree[TKey, TValue]{
+		Root:       cloneNode(tree.Root, nil),
+		Comparator: tree.Comparator,
+		size:       tree.size,
+		m:          tree.m,
+	}
+}
+
+// Equals returns true if both trees hold equal key/value pairs, even if their orders differ.
+// Keys are compared with the tree's comparator, values with valueComparator.
+func (tree *Tree[TKey, TValue]) Equals(other *Tree[TKey, TValue], valueComparator utils.Comparator[TValue]) bool {
+	if tree.size != other.size {
+		return false
+	}
+
+	it := tree.OrderedBegin()
+	otherIt := other.OrderedBegin()
+
+	for it.Next() && otherIt.Next() {
+		key, _ := it.GetKey()
+		otherKey, _ := otherIt.GetKey()
+		value, _ := it.Get()
+		otherValue, _ := otherIt.Get()
+
+		if tree.Comparator(key, otherKey) != 0 || valueComparator(value, otherValue) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func cloneNode[TKey comparable, TValue any](node *Node[TKey, TValue], parent *Node[TKey, TValue]) *Node[TKey, TValue] {
+	if node == nil {
+		return nil
+	}
+
+	clone := &Node[TKey, TValue]{
+		Parent:   parent,
+		Entries:  make([]*Entry[TKey, TValue], len(node.Entries)),
+		Children: make([]*Node[TKey, TValue], len(node.Children)),
+	}
+
+	for i, entry := range node.Entries {
+		clone.Entries[i] = &Entry[TKey, TValue]{Key: entry.Key, Value: entry.Value}
+	}
+
+	for i, child := range node.Children {
+		clone.Children[i] = cloneNode(child, clone)
+	}
+
+	return clone
+}
+
 func (tree *Tree[TKey, TValue]) output(buffer *bytes.Buffer, node *Node[TKey, TValue], level int, isTail bool) {
 	for e := 0; e < len(node.Entries)+1; e++ {
 		if e < len(node.Children) {
@@ -702,3 +769,61 @@ func (tree *Tree[TKey, TValue]) OrderedFirst() ds.ReadWriteOrdCompBidRandCollIte
 func (tree *Tree[TKey, TValue]) OrderedLast() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
 	return tree.NewOrderedIterator(tree.Size()-1, tree.Size())
 }
+
+//******************************************************************//
+//                         Reverse iterator                         //
+//******************************************************************//
+
+// ReverseBegin returns an initialized, reversed iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (tree *Tree[TKey, TValue]) ReverseBegin() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(tree.OrderedEnd())
+}
+
+// ReverseEnd returns an initialized, reversed iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (tree *Tree[TKey, TValue]) ReverseEnd() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(tree.OrderedBegin())
+}
+
+// ReverseFirst returns an initialized, reversed iterator, which points to it's first element.
+func (tree *Tree[TKey, TValue]) ReverseFirst() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(tree.OrderedLast())
+}
+
+// ReverseLast returns an initialized, reversed iterator, which points to it's last element.
+func (tree *Tree[TKey, TValue]) ReverseLast() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(tree.OrderedFirst())
+}
+
+// All returns a sequence over the key/value pairs of the tree in key order.
+func (tree *Tree[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.Seq2(tree.OrderedBegin())(yield)
+	}
+}
+
+// Backward returns a sequence over the key/value pairs of the tree in reverse key order.
+func (tree *Tree[TKey, TValue]) Backward() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.BackwardSeq2(tree.OrderedEnd())(yield)
+	}
+}
+
+// Keys returns a sequence over the keys of the tree in order.
+func (tree *Tree[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return func(yield func(TKey) bool) {
+		for key := range tree.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the tree in key order.
+func (tree *Tree[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		ds.Seq(tree.OrderedBegin())(yield)
+	}
+}
@@ -6,11 +6,11 @@
 package btree
 
 import (
+	"slices"
 	"testing"
 
-	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
-
 	"github.com/JonasMuehlmann/datastructures.go/maps/hashmap"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
 	"github.com/JonasMuehlmann/datastructures.go/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -727,3 +727,163 @@ func TestBTreeNewFromIteratorsWith(t *testing.T) {
 // 		testCommon.RunBenchmarkWithDefualtInputSizes(b, variant.name, variant.f)
 // 	}
 // }
+
+// assertClonedNode asserts, that clone has the same keys and shape as node, but none of its nodes or entries.
+func assertClonedNode(t *testing.T, node *Node[string, int], clone *Node[string, int], parent *Node[string, int]) {
+	if node == nil {
+		assert.Nil(t, clone)
+
+		return
+	}
+
+	if !assert.NotNil(t, clone) || !assert.Len(t, clone.Entries, len(node.Entries)) || !assert.Len(t, clone.Children, len(node.Children)) {
+		return
+	}
+
+	assert.NotSame(t, node, clone)
+	assert.Same(t, parent, clone.Parent)
+
+	for i, entry := range node.Entries {
+		assert.NotSame(t, entry, clone.Entries[i])
+		assert.Equal(t, entry.Key, clone.Entries[i].Key)
+	}
+
+	for i, child := range node.Children {
+		assertClonedNode(t, child, clone.Children[i], clone)
+	}
+}
+
+func TestBTreeClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Tree[string, int]
+	}{
+		{
+			name:     "empty",
+			original: NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{}),
+		},
+		{
+			name:     "3 items",
+			original: NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			size := test.original.Size()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+			assertClonedNode(t, test.original.Root, clone.Root, nil)
+
+			// Put overwrites the value of an existing entry in place
+			values := test.original.GetValues()
+			for _, key := range test.original.GetKeys() {
+				clone.Put(key, 0)
+			}
+
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+
+			clone.Put("qux", 4)
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, size, test.original.Size(), test.name)
+		})
+	}
+}
+
+func TestBTreeEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Tree[string, int]
+		b     *Tree[string, int]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{}),
+			b:     NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{}),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+			b:     NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2, "baz": 3}),
+			equal: true,
+		},
+		{
+			name:  "different values",
+			a:     NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2}),
+			b:     NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 3}),
+			equal: false,
+		},
+		{
+			name:  "different keys",
+			a:     NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2}),
+			b:     NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{"foo": 1, "qux": 2}),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{"foo": 1}),
+			b:     NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{"foo": 1, "bar": 2}),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[int]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	tree := New[string, int](3, utils.BasicComparator[string])
+	tree.Put("c", 3)
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range tree.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	keys = []string{}
+	values = []int{}
+	for key, value := range tree.Backward() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"c", "b", "a"}, keys)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(tree.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(tree.Values()))
+
+	keys = []string{}
+	for key := range tree.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
@@ -13,6 +13,12 @@ import (
 // Assert Iterator implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[string, any] = (*OrderedIterator[string, any])(nil)
 
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[string, any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[string, any])(nil)
+
 // Ordered holding the iterator's state
 type OrderedIterator[TKey comparable, TValue any] struct {
 	tree          *Tree[TKey, TValue]
@@ -23,6 +29,7 @@ type OrderedIterator[TKey comparable, TValue any] struct {
 	size  int
 	key   TKey
 	value TValue
+	guard ds.ModificationGuard
 }
 
 //  returns a stateful iterator whose elements are key/value pairs.
@@ -32,6 +39,7 @@ func (tree *Tree[TKey, TValue]) NewOrderedIterator(position int, size int) *Orde
 		// index: 0,
 		index: -1,
 		size:  size,
+		guard: tree.modifications.Guard(),
 	}
 	it.size = utils.Min(tree.Size(), size)
 	it.size = utils.Max(tree.Size(), -1)
@@ -72,7 +80,7 @@ func (tree *Tree[TKey, TValue]) NewOrderedIterator(position int, size int) *Orde
 
 // At returns a stateful iterator whose elements are key/value pairs that is initialised at a particular node.
 func (tree *Tree[TKey, TValue]) NewOrderedteratorAt(t *Tree[TKey, TValue], key TKey) *OrderedIterator[TKey, TValue] {
-	it := &OrderedIterator[TKey, TValue]{tree: t, index: -1, size: t.Size()}
+	it := &OrderedIterator[TKey, TValue]{tree: t, index: -1, size: t.Size(), guard: t.modifications.Guard()}
 
 	it.MoveToKey(key)
 
@@ -96,10 +104,17 @@ func (it *OrderedIterator[TKey, TValue]) IsLast() bool {
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsValid() bool {
-	return it.size > 0 && !it.IsBegin() && !it.IsEnd()
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the tree was structurally modified, other than through the iterator.
+func (it *OrderedIterator[TKey, TValue]) Err() error {
+	return it.guard.Err()
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -108,7 +123,23 @@ func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bo
 	return it.DistanceTo(otherThis) == 0
 }
 
+// CanCompare checks if other is an iterator of the same tree.
+func (it *OrderedIterator[TKey, TValue]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := ds.BaseOf[TKey, TValue](other).(*OrderedIterator[TKey, TValue])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.tree != it.tree {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
 func (it *OrderedIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) int {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -118,6 +149,8 @@ func (it *OrderedIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) in
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -127,6 +160,8 @@ func (it *OrderedIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsBefore(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -144,6 +179,11 @@ func (it *OrderedIterator[TKey, TValue]) Size() int {
 // If Next() was called for the first time, then it will point the  to the first element if it exists.
 // Modifies the state of the .
 func (it *OrderedIterator[TKey, TValue]) Next() bool {
+	// The nodes may have been split or merged, so they can not be traversed anymore
+	if it.guard.Err() != nil {
+		return false
+	}
+
 	// If already at end, go to end
 	if it.index == it.size {
 		goto end
@@ -223,6 +263,10 @@ func (it *OrderedIterator[TKey, TValue]) NextN(n int) bool {
 // If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
 // Modifies the state of the .
 func (it *OrderedIterator[TKey, TValue]) Previous() bool {
+	if it.guard.Err() != nil {
+		return false
+	}
+
 	// If already at beginning, go to begin
 	if it.index == -1 {
 		goto begin
@@ -340,6 +384,10 @@ func (it *OrderedIterator[TKey, TValue]) MoveToKey(key TKey) (found bool) {
 
 	// return true
 
+	if it.guard.Err() != nil {
+		return false
+	}
+
 	cmp := it.tree.Comparator(it.key, key)
 	if cmp == 0 {
 		if it.IsEnd() {
@@ -399,6 +447,7 @@ func (it *OrderedIterator[TKey, TValue]) GetAtKey(key TKey) (value TValue, found
 	return it.tree.Get(key)
 }
 
+// SetAtKey puts the key/value pair into the tree, inserting a new key invalidates the iterator.
 func (it *OrderedIterator[TKey, TValue]) SetAtKey(key TKey, value TValue) bool {
 	it.tree.Put(key, value)
 
@@ -406,10 +455,6 @@ func (it *OrderedIterator[TKey, TValue]) SetAtKey(key TKey, value TValue) bool {
 }
 
 func (it *OrderedIterator[TKey, TValue]) GetAt(i int) (value TValue, found bool) {
-	if !it.IsValid() {
-		return
-	}
-
 	tmp := *it
 	tmp.MoveTo(i)
 
@@ -417,10 +462,6 @@ func (it *OrderedIterator[TKey, TValue]) GetAt(i int) (value TValue, found bool)
 }
 
 func (it *OrderedIterator[TKey, TValue]) SetAt(i int, value TValue) bool {
-	if !it.IsValid() {
-		return false
-	}
-
 	tmp := *it
 	tmp.MoveTo(i)
 
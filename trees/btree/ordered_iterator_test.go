@@ -1,6 +1,8 @@
 package btree
 
 import (
+	"slices"
+	"strconv"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
@@ -1097,3 +1099,208 @@ func TestBTreeOrderedIteratorIsBeginEndFirstLast(t *testing.T) {
 		})
 	}
 }
+
+func TestBTreeReverseIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		container *Tree[string, int]
+	}{
+		{
+			name:      "Empty",
+			container: New[string, int](3, utils.BasicComparator[string]),
+		},
+		{
+			name:      "One element",
+			container: NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{"a": 1}),
+		},
+		{
+			name:      "4 elements",
+			container: NewFromMap[string, int](3, utils.BasicComparator[string], map[string]int{"a": 1, "b": 2, "d": 4, "c": 3}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			values := []int{}
+			for it := test.container.OrderedBegin(); it.Next(); {
+				value, _ := it.Get()
+				values = append(values, value)
+			}
+
+			reversed := []int{}
+			for it := test.container.ReverseBegin(); it.Next(); {
+				value, _ := it.Get()
+				reversed = append(reversed, value)
+			}
+
+			backward := []int{}
+			for it := test.container.ReverseEnd(); it.Previous(); {
+				value, _ := it.Get()
+				backward = append(backward, value)
+			}
+
+			assert.Equalf(t, values, backward, test.name)
+
+			slices.Reverse(values)
+			assert.Equalf(t, values, reversed, test.name)
+
+			first, found := test.container.ReverseFirst().Get()
+			last, _ := test.container.ReverseLast().Get()
+			assert.Equalf(t, len(values) > 0, found, test.name)
+
+			if len(values) > 0 {
+				assert.Equalf(t, values[0], first, test.name)
+				assert.Equalf(t, values[len(values)-1], last, test.name)
+			}
+		})
+	}
+}
+
+// newMultiLevel returns a tree of order 3 with the keys 1 to 9, which spreads its entries over three levels of nodes.
+func newMultiLevel() *Tree[int, string] {
+	tree := New[int, string](3, utils.BasicComparator[int])
+	for key := 1; key <= 9; key++ {
+		tree.Put(key, strconv.Itoa(key))
+	}
+
+	return tree
+}
+
+func TestBTreeReverseIteratorAcrossNodes(t *testing.T) {
+	tree := newMultiLevel()
+	assert.Equal(t, 3, tree.Height())
+
+	keys := []int{}
+	for it := tree.ReverseBegin(); it.Next(); {
+		key, _ := it.GetKey()
+		keys = append(keys, key)
+	}
+
+	assert.Equal(t, []int{9, 8, 7, 6, 5, 4, 3, 2, 1}, keys)
+
+	// Moving backward from the root's first entry descends into the rightmost leaf of its left subtree
+	root := tree.Root.Entries[0].Key
+	it := tree.ReverseBegin()
+	assert.True(t, it.MoveToKey(root))
+	assert.True(t, it.Next())
+
+	key, _ := it.GetKey()
+	assert.Equal(t, root-1, key)
+
+	index, _ := it.Index()
+	assert.Equal(t, root-2, index)
+
+	// Overwriting an entry keeps the iterator valid
+	assert.True(t, it.SetAtKey(9, "nine"))
+	assert.True(t, it.SetAt(0, "one"))
+	assert.NoError(t, it.(ds.FailFastIterator).Err())
+
+	value, _ := it.GetAt(8)
+	assert.Equal(t, "nine", value)
+
+	value, _ = tree.Get(1)
+	assert.Equal(t, "one", value)
+}
+
+func TestBTreeReverseIteratorCompare(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           int
+		reversed      bool
+		otherKey      int
+		otherReversed bool
+		distance      int
+	}{
+		{
+			name:          "Reversed keys in different leaves",
+			key:           9,
+			reversed:      true,
+			otherKey:      1,
+			otherReversed: true,
+			distance:      -8,
+		},
+		{
+			name:          "Reversed inner entry to forward leaf entry",
+			key:           2,
+			reversed:      true,
+			otherKey:      1,
+			otherReversed: false,
+			distance:      1,
+		},
+		{
+			name:          "Reversed root entry to forward root entry",
+			key:           4,
+			reversed:      true,
+			otherKey:      4,
+			otherReversed: false,
+			distance:      0,
+		},
+		{
+			name:          "Forward leaf entry to reversed inner entry",
+			key:           3,
+			reversed:      false,
+			otherKey:      6,
+			otherReversed: true,
+			distance:      -3,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			tree := newMultiLevel()
+			it := atKey(tree, test.reversed, test.key)
+			other := atKey(tree, test.otherReversed, test.otherKey)
+
+			distance, err := ds.CheckedDistanceTo(it, other)
+			assert.NoErrorf(t, err, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+			assert.Equalf(t, -test.distance, other.DistanceTo(it), test.name)
+			assert.Equalf(t, test.distance < 0, it.IsBefore(other), test.name)
+			assert.Equalf(t, test.distance > 0, other.IsBefore(it), test.name)
+			assert.Equalf(t, test.distance == 0, other.IsEqual(it), test.name)
+		})
+	}
+
+	tree := newMultiLevel()
+	assert.ErrorIs(t, ds.CanCompare(tree.OrderedFirst(), newMultiLevel().ReverseFirst()), ds.ErrForeignIterators)
+	assert.ErrorIs(t, ds.CanCompare(tree.ReverseFirst(), newMultiLevel().OrderedFirst()), ds.ErrForeignIterators)
+}
+
+func TestBTreeReverseIteratorFailFast(t *testing.T) {
+	tree := newMultiLevel()
+	it := tree.ReverseFirst()
+
+	// Removing a missing key leaves the tree untouched
+	tree.Remove(10)
+
+	assert.NoError(t, it.(ds.FailFastIterator).Err())
+
+	// Removing a leaf entry merges nodes, which moves the entries the iterator points into
+	tree.Remove(1)
+
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Next())
+}
+
+// atKey returns a forward or reversed iterator of tree, which points to key.
+func atKey(tree *Tree[int, string], reversed bool, key int) ds.ReadWriteOrdCompBidRandCollIterator[int, string] {
+	it := tree.OrderedBegin()
+	if reversed {
+		it = tree.ReverseBegin()
+	}
+
+	it.MoveToKey(key)
+
+	return it
+}
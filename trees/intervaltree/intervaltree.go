@@ -0,0 +1,671 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package intervaltree implements an interval tree, which answers which of its closed intervals overlap a given interval or point.
+//
+// It is a red-black tree ordered by the intervals' lower and then upper bounds,
+// where every node is augmented with the largest upper bound within its subtree.
+// This allows skipping subtrees, which can not contain overlapping intervals.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Interval_tree#Augmented_tree
+package intervaltree
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/trees"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Tree implementation
+var _ trees.Tree[Interval[string], any] = (*Tree[string, any])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Tree[string, any]] = (*Tree[string, any])(nil)
+var _ ds.Equatable[*Tree[string, any], any] = (*Tree[string, any])(nil)
+
+const (
+	InvalidInterval = "Invalid interval, lo should be smaller than or equal to hi"
+)
+
+type color bool
+
+const (
+	black, red color = true, false
+)
+
+// Interval is the closed interval [Lo, Hi].
+type Interval[T comparable] struct {
+	Lo T
+	Hi T
+}
+
+// Tree holds elements of the interval tree
+type Tree[T comparable, TValue any] struct {
+	Root       *Node[T, TValue]
+	size       int
+	Comparator utils.Comparator[T]
+
+	modifications ds.ModificationCounter
+}
+
+// New instantiates an interval tree with the custom comparator for the intervals' bounds.
+func New[T comparable, TValue any](comparator utils.Comparator[T]) *Tree[T, TValue] {
+	return &Tree[T, TValue]{Comparator: comparator}
+}
+
+// Put inserts the interval [lo, hi] into the tree or updates its value if it is already contained.
+// Panics if lo is larger than hi.
+func (tree *Tree[T, TValue]) Put(lo T, hi T, value TValue) {
+	if tree.Comparator(lo, hi) > 0 {
+		panic(InvalidInterval)
+	}
+
+	interval := Interval[T]{Lo: lo, Hi: hi}
+	newNode := &Node[T, TValue]{Interval: interval, Value: value, color: red, max: hi}
+
+	if tree.Root == nil {
+		tree.Root = newNode
+	} else {
+		node := tree.Root
+		loop := true
+
+		for loop {
+			compare := tree.compareIntervals(interval, node.Interval)
+
+			switch {
+			case compare == 0:
+				node.Value = value
+				return
+			case compare < 0:
+				if node.Left == nil {
+					node.Left = newNode
+					loop = false
+				} else {
+					node = node.Left
+				}
+			case compare > 0:
+				if node.Right == nil {
+					node.Right = newNode
+					loop = false
+				} else {
+					node = node.Right
+				}
+			}
+		}
+
+		newNode.Parent = node
+
+		for ; node != nil; node = node.Parent {
+			if tree.Comparator(hi, node.max) > 0 {
+				node.max = hi
+			}
+		}
+	}
+
+	tree.insertCase1(newNode)
+	tree.size++
+	tree.modifications.Modified()
+}
+
+// Get searches the interval [lo, hi] in the tree and returns its value or nil if it is not found in the tree.
+// Second return parameter is true if the interval was found, otherwise false.
+func (tree *Tree[T, TValue]) Get(lo T, hi T) (value TValue, found bool) {
+	node := tree.lookup(Interval[T]{Lo: lo, Hi: hi})
+	if node == nil {
+		return
+	}
+
+	return node.Value, true
+}
+
+// Remove removes the interval [lo, hi] from the tree.
+func (tree *Tree[T, TValue]) Remove(lo T, hi T) {
+	var child *Node[T, TValue]
+
+	node := tree.lookup(Interval[T]{Lo: lo, Hi: hi})
+	if node == nil {
+		return
+	}
+
+	if node.Left != nil && node.Right != nil {
+		pred := node.Left.maximumNode()
+		node.Interval = pred.Interval
+		node.Value = pred.Value
+		node = pred
+	}
+
+	if node.Right == nil {
+		child = node.Left
+	} else {
+		child = node.Right
+	}
+
+	if node.color == black {
+		node.color = nodeColor(child)
+		tree.deleteCase1(node)
+	}
+
+	tree.replaceNode(node, child)
+
+	if node.Parent == nil && child != nil {
+		child.color = black
+	}
+
+	for parent := node.Parent; parent != nil; parent = parent.Parent {
+		tree.updateMax(parent)
+	}
+
+	tree.size--
+	tree.modifications.Modified()
+}
+
+// Overlapping returns an iterator over all intervals overlapping [lo, hi] ordered by their bounds, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+//
+// The k intervals starting within [lo, hi] all overlap it, so they are walked in order from the first of them in O(log n + k).
+// Intervals starting before lo are found by skipping subtrees whose intervals all end before lo,
+// which can cost up to O(log n) for each one that overlaps.
+func (tree *Tree[T, TValue]) Overlapping(lo T, hi T) *OrderedIterator[T, TValue] {
+	nodes := []*Node[T, TValue]{}
+	tree.collectStartingBefore(tree.Root, lo, &nodes)
+
+	for node := tree.lowerBound(lo); node != nil && tree.Comparator(node.Interval.Lo, hi) <= 0; node = node.next() {
+		nodes = append(nodes, node)
+	}
+
+	return tree.NewOrderedIterator(nodes, -1)
+}
+
+// Containing returns an iterator over all intervals containing point ordered by their bounds, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+//
+// Only the intervals starting at point are walked in order, finding each of the others can cost up to O(log n).
+func (tree *Tree[T, TValue]) Containing(point T) *OrderedIterator[T, TValue] {
+	return tree.Overlapping(point, point)
+}
+
+// AnyOverlap returns any interval overlapping [lo, hi] and its value in O(log n).
+// Third return parameter is true if such an interval was found, otherwise false.
+func (tree *Tree[T, TValue]) AnyOverlap(lo T, hi T) (interval Interval[T], value TValue, found bool) {
+	node := tree.Root
+
+	for node != nil && !tree.overlaps(node.Interval, lo, hi) {
+		if node.Left != nil && tree.Comparator(node.Left.max, lo) >= 0 {
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+
+	if node == nil {
+		return
+	}
+
+	return node.Interval, node.Value, true
+}
+
+// Empty returns true if tree does not contain any nodes
+func (tree *Tree[T, TValue]) IsEmpty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of nodes in the tree.
+func (tree *Tree[T, TValue]) Size() int {
+	return tree.size
+}
+
+// GetIntervals returns all intervals in-order.
+func (tree *Tree[T, TValue]) GetIntervals() []Interval[T] {
+	intervals := make([]Interval[T], 0, tree.size)
+
+	it := tree.OrderedBegin()
+	for it.Next() {
+		interval, _ := it.GetKey()
+		intervals = append(intervals, interval)
+	}
+
+	return intervals
+}
+
+// GetValues returns all values in-order based on the intervals.
+func (tree *Tree[T, TValue]) GetValues() []TValue {
+	values := make([]TValue, 0, tree.size)
+
+	it := tree.OrderedBegin()
+	for it.Next() {
+		value, _ := it.Get()
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// Clear removes all nodes from the tree.
+func (tree *Tree[T, TValue]) Clear() {
+	tree.Root = nil
+	tree.size = 0
+	tree.modifications.Modified()
+}
+
+// String returns a string representation of container
+func (tree *Tree[T, TValue]) ToString() string {
+	str := "IntervalTree\n"
+
+	it := tree.OrderedBegin()
+	for it.Next() {
+		interval, _ := it.GetKey()
+		value, _ := it.Get()
+
+		str += fmt.Sprintf("[%v, %v]:%v\n", interval.Lo, interval.Hi, value)
+	}
+
+	return str
+}
+
+// Clone returns a copy of the tree with the same shape, which does not share any nodes with the original.
+func (tree *Tree[T, TValue]) Clone() *Tree[T, TValue] {
+	return &Tree[T, TValue]{
+		Root:       cloneNode(tree.Root, nil),
+		size:       tree.size,
+		Comparator: tree.Comparator,
+	}
+}
+
+// Equals returns true if both trees hold equal interval/value pairs.
+// Intervals are compared with the tree's comparator, values with valueComparator.
+func (tree *Tree[T, TValue]) Equals(other *Tree[T, TValue], valueComparator utils.Comparator[TValue]) bool {
+	if tree.size != other.size {
+		return false
+	}
+
+	nodes := make([]*Node[T, TValue], 0, tree.size)
+	otherNodes := make([]*Node[T, TValue], 0, other.size)
+	tree.collectAll(tree.Root, &nodes)
+	other.collectAll(other.Root, &otherNodes)
+
+	for i, node := range nodes {
+		if tree.compareIntervals(node.Interval, otherNodes[i].Interval) != 0 || valueComparator(node.Value, otherNodes[i].Value) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func cloneNode[T comparable, TValue any](node *Node[T, TValue], parent *Node[T, TValue]) *Node[T, TValue] {
+	if node == nil {
+		return nil
+	}
+
+	clone := &Node[T, TValue]{
+		Interval: node.Interval,
+		Value:    node.Value,
+		color:    node.color,
+		Parent:   parent,
+		max:      node.max,
+	}
+	clone.Left = cloneNode(node.Left, clone)
+	clone.Right = cloneNode(node.Right, clone)
+
+	return clone
+}
+
+// collectStartingBefore collects the intervals starting before lo, which reach lo, in order.
+func (tree *Tree[T, TValue]) collectStartingBefore(node *Node[T, TValue], lo T, nodes *[]*Node[T, TValue]) {
+	// No interval within the subtree reaches lo
+	if node == nil || tree.Comparator(node.max, lo) < 0 {
+		return
+	}
+
+	tree.collectStartingBefore(node.Left, lo, nodes)
+
+	// This interval and all intervals of the right subtree start at or after lo
+	if tree.Comparator(node.Interval.Lo, lo) >= 0 {
+		return
+	}
+
+	if tree.Comparator(node.Interval.Hi, lo) >= 0 {
+		*nodes = append(*nodes, node)
+	}
+
+	tree.collectStartingBefore(node.Right, lo, nodes)
+}
+
+// lowerBound returns the first node whose interval starts at or after lo or nil if there is none.
+func (tree *Tree[T, TValue]) lowerBound(lo T) *Node[T, TValue] {
+	var found *Node[T, TValue]
+
+	for node := tree.Root; node != nil; {
+		if tree.Comparator(node.Interval.Lo, lo) >= 0 {
+			found = node
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+
+	return found
+}
+
+func (tree *Tree[T, TValue]) collectAll(node *Node[T, TValue], nodes *[]*Node[T, TValue]) {
+	if node == nil {
+		return
+	}
+
+	tree.collectAll(node.Left, nodes)
+	*nodes = append(*nodes, node)
+	tree.collectAll(node.Right, nodes)
+}
+
+func (tree *Tree[T, TValue]) overlaps(interval Interval[T], lo T, hi T) bool {
+	return tree.Comparator(interval.Lo, hi) <= 0 && tree.Comparator(lo, interval.Hi) <= 0
+}
+
+// compareIntervals orders intervals by their lower and then their upper bounds.
+func (tree *Tree[T, TValue]) compareIntervals(a Interval[T], b Interval[T]) int {
+	if compare := tree.Comparator(a.Lo, b.Lo); compare != 0 {
+		return compare
+	}
+
+	return tree.Comparator(a.Hi, b.Hi)
+}
+
+func (tree *Tree[T, TValue]) lookup(interval Interval[T]) *Node[T, TValue] {
+	node := tree.Root
+
+	for node != nil {
+		compare := tree.compareIntervals(interval, node.Interval)
+
+		switch {
+		case compare == 0:
+			return node
+		case compare < 0:
+			node = node.Left
+		case compare > 0:
+			node = node.Right
+		}
+	}
+
+	return nil
+}
+
+// updateMax recomputes the largest upper bound of the subtree rooted at node from its children.
+func (tree *Tree[T, TValue]) updateMax(node *Node[T, TValue]) {
+	node.max = node.Interval.Hi
+
+	if node.Left != nil && tree.Comparator(node.Left.max, node.max) > 0 {
+		node.max = node.Left.max
+	}
+
+	if node.Right != nil && tree.Comparator(node.Right.max, node.max) > 0 {
+		node.max = node.Right.max
+	}
+}
+
+func (tree *Tree[T, TValue]) rotateLeft(node *Node[T, TValue]) {
+	right := node.Right
+	tree.replaceNode(node, right)
+	node.Right = right.Left
+
+	if right.Left != nil {
+		right.Left.Parent = node
+	}
+
+	right.Left = node
+	node.Parent = right
+
+	tree.updateMax(node)
+	tree.updateMax(right)
+}
+
+func (tree *Tree[T, TValue]) rotateRight(node *Node[T, TValue]) {
+	left := node.Left
+	tree.replaceNode(node, left)
+	node.Left = left.Right
+
+	if left.Right != nil {
+		left.Right.Parent = node
+	}
+
+	left.Right = node
+	node.Parent = left
+
+	tree.updateMax(node)
+	tree.updateMax(left)
+}
+
+func (tree *Tree[T, TValue]) replaceNode(old *Node[T, TValue], new *Node[T, TValue]) {
+	if old.Parent == nil {
+		tree.Root = new
+	} else {
+		if old == old.Parent.Left {
+			old.Parent.Left = new
+		} else {
+			old.Parent.Right = new
+		}
+	}
+
+	if new != nil {
+		new.Parent = old.Parent
+	}
+}
+
+func (tree *Tree[T, TValue]) insertCase1(node *Node[T, TValue]) {
+	if node.Parent == nil {
+		node.color = black
+	} else {
+		tree.insertCase2(node)
+	}
+}
+
+func (tree *Tree[T, TValue]) insertCase2(node *Node[T, TValue]) {
+	if nodeColor(node.Parent) == black {
+		return
+	}
+
+	tree.insertCase3(node)
+}
+
+func (tree *Tree[T, TValue]) insertCase3(node *Node[T, TValue]) {
+	uncle := node.uncle()
+
+	if nodeColor(uncle) == red {
+		node.Parent.color = black
+		uncle.color = black
+		node.grandparent().color = red
+		tree.insertCase1(node.grandparent())
+	} else {
+		tree.insertCase4(node)
+	}
+}
+
+func (tree *Tree[T, TValue]) insertCase4(node *Node[T, TValue]) {
+	grandparent := node.grandparent()
+
+	if node == node.Parent.Right && node.Parent == grandparent.Left {
+		tree.rotateLeft(node.Parent)
+		node = node.Left
+	} else if node == node.Parent.Left && node.Parent == grandparent.Right {
+		tree.rotateRight(node.Parent)
+		node = node.Right
+	}
+	tree.insertCase5(node)
+}
+
+func (tree *Tree[T, TValue]) insertCase5(node *Node[T, TValue]) {
+	node.Parent.color = black
+	grandparent := node.grandparent()
+	grandparent.color = red
+
+	if node == node.Parent.Left && node.Parent == grandparent.Left {
+		tree.rotateRight(grandparent)
+	} else if node == node.Parent.Right && node.Parent == grandparent.Right {
+		tree.rotateLeft(grandparent)
+	}
+}
+
+func (tree *Tree[T, TValue]) deleteCase1(node *Node[T, TValue]) {
+	if node.Parent == nil {
+		return
+	}
+
+	tree.deleteCase2(node)
+}
+
+func (tree *Tree[T, TValue]) deleteCase2(node *Node[T, TValue]) {
+	sibling := node.sibling()
+	if nodeColor(sibling) == red {
+		node.Parent.color = red
+		sibling.color = black
+		if node == node.Parent.Left {
+			tree.rotateLeft(node.Parent)
+		} else {
+			tree.rotateRight(node.Parent)
+		}
+	}
+
+	tree.deleteCase3(node)
+}
+
+func (tree *Tree[T, TValue]) deleteCase3(node *Node[T, TValue]) {
+	sibling := node.sibling()
+
+	if nodeColor(node.Parent) == black &&
+		nodeColor(sibling) == black &&
+		nodeColor(sibling.Left) == black &&
+		nodeColor(sibling.Right) == black {
+		sibling.color = red
+		tree.deleteCase1(node.Parent)
+	} else {
+		tree.deleteCase4(node)
+	}
+}
+
+func (tree *Tree[T, TValue]) deleteCase4(node *Node[T, TValue]) {
+	sibling := node.sibling()
+
+	if nodeColor(node.Parent) == red &&
+		nodeColor(sibling) == black &&
+		nodeColor(sibling.Left) == black &&
+		nodeColor(sibling.Right) == black {
+		sibling.color = red
+		node.Parent.color = black
+	} else {
+		tree.deleteCase5(node)
+	}
+}
+
+func (tree *Tree[T, TValue]) deleteCase5(node *Node[T, TValue]) {
+	sibling := node.sibling()
+
+	if node == node.Parent.Left &&
+		nodeColor(sibling) == black &&
+		nodeColor(sibling.Left) == red &&
+		nodeColor(sibling.Right) == black {
+		sibling.color = red
+		sibling.Left.color = black
+		tree.rotateRight(sibling)
+	} else if node == node.Parent.Right &&
+		nodeColor(sibling) == black &&
+		nodeColor(sibling.Right) == red &&
+		nodeColor(sibling.Left) == black {
+		sibling.color = red
+		sibling.Right.color = black
+		tree.rotateLeft(sibling)
+	}
+
+	tree.deleteCase6(node)
+}
+
+func (tree *Tree[T, TValue]) deleteCase6(node *Node[T, TValue]) {
+	sibling := node.sibling()
+	sibling.color = nodeColor(node.Parent)
+	node.Parent.color = black
+
+	if node == node.Parent.Left && nodeColor(sibling.Right) == red {
+		sibling.Right.color = black
+		tree.rotateLeft(node.Parent)
+	} else if nodeColor(sibling.Left) == red {
+		sibling.Left.color = black
+		tree.rotateRight(node.Parent)
+	}
+}
+
+func nodeColor[T comparable, TValue any](node *Node[T, TValue]) color {
+	if node == nil {
+		return black
+	}
+
+	return node.color
+}
+
+//******************************************************************//
+//                             Iterator                             //
+//******************************************************************//
+
+// OrderedBegin returns an initialized iterator over all intervals, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (tree *Tree[T, TValue]) OrderedBegin() ds.ReadWriteOrdCompBidRandCollIterator[Interval[T], TValue] {
+	return tree.newFullIterator(-1)
+}
+
+// OrderedEnd returns an initialized iterator over all intervals, which points to one element afrer it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (tree *Tree[T, TValue]) OrderedEnd() ds.ReadWriteOrdCompBidRandCollIterator[Interval[T], TValue] {
+	return tree.newFullIterator(tree.Size())
+}
+
+// OrderedFirst returns an initialized iterator over all intervals, which points to it's first element.
+func (tree *Tree[T, TValue]) OrderedFirst() ds.ReadWriteOrdCompBidRandCollIterator[Interval[T], TValue] {
+	return tree.newFullIterator(0)
+}
+
+// OrderedLast returns an initialized iterator over all intervals, which points to it's last element.
+func (tree *Tree[T, TValue]) OrderedLast() ds.ReadWriteOrdCompBidRandCollIterator[Interval[T], TValue] {
+	return tree.newFullIterator(tree.Size() - 1)
+}
+
+func (tree *Tree[T, TValue]) newFullIterator(position int) *OrderedIterator[T, TValue] {
+	nodes := make([]*Node[T, TValue], 0, tree.size)
+	tree.collectAll(tree.Root, &nodes)
+
+	return tree.NewOrderedIterator(nodes, position)
+}
+
+// All returns a sequence over the key/value pairs of the tree in key order.
+func (tree *Tree[T, TValue]) All() iter.Seq2[Interval[T], TValue] {
+	return func(yield func(Interval[T], TValue) bool) {
+		ds.Seq2(tree.OrderedBegin())(yield)
+	}
+}
+
+// Backward returns a sequence over the key/value pairs of the tree in reverse key order.
+func (tree *Tree[T, TValue]) Backward() iter.Seq2[Interval[T], TValue] {
+	return func(yield func(Interval[T], TValue) bool) {
+		ds.BackwardSeq2(tree.OrderedEnd())(yield)
+	}
+}
+
+// Keys returns a sequence over the keys of the tree in order.
+func (tree *Tree[T, TValue]) Keys() iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		for key := range tree.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the tree in key order.
+func (tree *Tree[T, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		ds.Seq(tree.OrderedBegin())(yield)
+	}
+}
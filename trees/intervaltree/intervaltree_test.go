@@ -0,0 +1,503 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package intervaltree
+
+import (
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFromIntervals(intervals ...Interval[int]) *Tree[int, int] {
+	tree := New[int, int](utils.BasicComparator[int])
+	for i, interval := range intervals {
+		tree.Put(interval.Lo, interval.Hi, i)
+	}
+
+	return tree
+}
+
+func collectIntervals(it *OrderedIterator[int, int]) []Interval[int] {
+	intervals := []Interval[int]{}
+	for it.Next() {
+		interval, _ := it.GetKey()
+		intervals = append(intervals, interval)
+	}
+
+	return intervals
+}
+
+// checkInvariants verifies the red-black properties and the maintained upper bounds and returns the black height.
+func checkInvariants(t *testing.T, node *Node[int, int]) int {
+	if node == nil {
+		return 1
+	}
+
+	if node.color == red {
+		assert.Equal(t, black, nodeColor(node.Left))
+		assert.Equal(t, black, nodeColor(node.Right))
+	}
+
+	max := node.Interval.Hi
+	if node.Left != nil && node.Left.max > max {
+		max = node.Left.max
+	}
+	if node.Right != nil && node.Right.max > max {
+		max = node.Right.max
+	}
+	assert.Equal(t, max, node.Max())
+
+	leftHeight := checkInvariants(t, node.Left)
+	rightHeight := checkInvariants(t, node.Right)
+	assert.Equal(t, leftHeight, rightHeight)
+
+	if node.color == black {
+		return leftHeight + 1
+	}
+
+	return leftHeight
+}
+
+func TestIntervalTreePut(t *testing.T) {
+	tests := []struct {
+		name      string
+		tree      *Tree[int, int]
+		interval  Interval[int]
+		value     int
+		intervals []Interval[int]
+	}{
+		{
+			name:      "empty tree",
+			tree:      newFromIntervals(),
+			interval:  Interval[int]{1, 2},
+			value:     10,
+			intervals: []Interval[int]{{1, 2}},
+		},
+		{
+			name:      "same lower bound",
+			tree:      newFromIntervals(Interval[int]{1, 5}),
+			interval:  Interval[int]{1, 2},
+			value:     10,
+			intervals: []Interval[int]{{1, 2}, {1, 5}},
+		},
+		{
+			name:      "point interval",
+			tree:      newFromIntervals(Interval[int]{1, 5}),
+			interval:  Interval[int]{3, 3},
+			value:     10,
+			intervals: []Interval[int]{{1, 5}, {3, 3}},
+		},
+		{
+			name:      "existing interval",
+			tree:      newFromIntervals(Interval[int]{1, 5}, Interval[int]{2, 3}),
+			interval:  Interval[int]{1, 5},
+			value:     10,
+			intervals: []Interval[int]{{1, 5}, {2, 3}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.tree.Put(test.interval.Lo, test.interval.Hi, test.value)
+
+			value, found := test.tree.Get(test.interval.Lo, test.interval.Hi)
+
+			assert.Truef(t, found, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+			assert.Equalf(t, test.intervals, test.tree.GetIntervals(), test.name)
+			assert.Equalf(t, len(test.intervals), test.tree.Size(), test.name)
+		})
+	}
+}
+
+func TestIntervalTreePutInvalidInterval(t *testing.T) {
+	tree := newFromIntervals()
+
+	assert.PanicsWithValue(t, InvalidInterval, func() { tree.Put(2, 1, 0) })
+}
+
+func TestIntervalTreeRemove(t *testing.T) {
+	tests := []struct {
+		name      string
+		tree      *Tree[int, int]
+		interval  Interval[int]
+		intervals []Interval[int]
+	}{
+		{
+			name:      "empty tree",
+			tree:      newFromIntervals(),
+			interval:  Interval[int]{1, 2},
+			intervals: []Interval[int]{},
+		},
+		{
+			name:      "missing interval",
+			tree:      newFromIntervals(Interval[int]{1, 5}),
+			interval:  Interval[int]{1, 2},
+			intervals: []Interval[int]{{1, 5}},
+		},
+		{
+			name:      "interval with largest upper bound",
+			tree:      newFromIntervals(Interval[int]{5, 6}, Interval[int]{1, 20}, Interval[int]{8, 9}),
+			interval:  Interval[int]{1, 20},
+			intervals: []Interval[int]{{5, 6}, {8, 9}},
+		},
+		{
+			name:      "inner node",
+			tree:      newFromIntervals(Interval[int]{5, 6}, Interval[int]{1, 20}, Interval[int]{8, 9}),
+			interval:  Interval[int]{5, 6},
+			intervals: []Interval[int]{{1, 20}, {8, 9}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.tree.Remove(test.interval.Lo, test.interval.Hi)
+
+			_, found := test.tree.Get(test.interval.Lo, test.interval.Hi)
+
+			assert.Falsef(t, found, test.name)
+			assert.Equalf(t, test.intervals, test.tree.GetIntervals(), test.name)
+			assert.Equalf(t, len(test.intervals), test.tree.Size(), test.name)
+			checkInvariants(t, test.tree.Root)
+		})
+	}
+}
+
+func TestIntervalTreeOverlapping(t *testing.T) {
+	tree := newFromIntervals(
+		Interval[int]{15, 20},
+		Interval[int]{10, 30},
+		Interval[int]{17, 19},
+		Interval[int]{5, 20},
+		Interval[int]{12, 15},
+		Interval[int]{30, 40},
+	)
+
+	tests := []struct {
+		name      string
+		lo        int
+		hi        int
+		intervals []Interval[int]
+	}{
+		{
+			name:      "no overlap",
+			lo:        41,
+			hi:        50,
+			intervals: []Interval[int]{},
+		},
+		{
+			name:      "touching bounds",
+			lo:        40,
+			hi:        45,
+			intervals: []Interval[int]{{30, 40}},
+		},
+		{
+			name:      "several overlaps",
+			lo:        14,
+			hi:        16,
+			intervals: []Interval[int]{{5, 20}, {10, 30}, {12, 15}, {15, 20}},
+		},
+		{
+			name:      "starting before and within",
+			lo:        16,
+			hi:        17,
+			intervals: []Interval[int]{{5, 20}, {10, 30}, {15, 20}, {17, 19}},
+		},
+		{
+			name:      "enclosing all",
+			lo:        0,
+			hi:        100,
+			intervals: []Interval[int]{{5, 20}, {10, 30}, {12, 15}, {15, 20}, {17, 19}, {30, 40}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.intervals, collectIntervals(tree.Overlapping(test.lo, test.hi)), test.name)
+
+			interval, _, found := tree.AnyOverlap(test.lo, test.hi)
+			assert.Equalf(t, len(test.intervals) > 0, found, test.name)
+			if found {
+				assert.Containsf(t, test.intervals, interval, test.name)
+			}
+		})
+	}
+}
+
+func TestIntervalTreeContaining(t *testing.T) {
+	tree := newFromIntervals(Interval[int]{1, 3}, Interval[int]{2, 8}, Interval[int]{5, 5}, Interval[int]{9, 12})
+
+	tests := []struct {
+		name      string
+		point     int
+		intervals []Interval[int]
+	}{
+		{
+			name:      "none",
+			point:     0,
+			intervals: []Interval[int]{},
+		},
+		{
+			name:      "point interval",
+			point:     5,
+			intervals: []Interval[int]{{2, 8}, {5, 5}},
+		},
+		{
+			name:      "bounds",
+			point:     3,
+			intervals: []Interval[int]{{1, 3}, {2, 8}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.intervals, collectIntervals(tree.Containing(test.point)), test.name)
+		})
+	}
+}
+
+func TestIntervalTreeIterator(t *testing.T) {
+	tree := newFromIntervals(Interval[int]{1, 3}, Interval[int]{2, 8}, Interval[int]{5, 5}, Interval[int]{9, 12})
+
+	it := tree.Overlapping(3, 9)
+	assert.Equal(t, 4, it.Size())
+
+	intervals := []Interval[int]{}
+	it.MoveTo(it.Size())
+	for it.Previous() {
+		interval, _ := it.GetKey()
+		intervals = append(intervals, interval)
+	}
+	assert.Equal(t, []Interval[int]{{9, 12}, {5, 5}, {2, 8}, {1, 3}}, intervals)
+
+	assert.True(t, it.MoveToKey(Interval[int]{5, 5}))
+	index, _ := it.Index()
+	assert.Equal(t, 2, index)
+	assert.False(t, it.MoveToKey(Interval[int]{5, 6}))
+
+	value, found := it.GetAt(1)
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+
+	assert.True(t, it.SetAtKey(Interval[int]{9, 12}, 10))
+	value, _ = tree.Get(9, 12)
+	assert.Equal(t, 10, value)
+}
+
+func TestIntervalTreeRandomModification(t *testing.T) {
+	tree := New[int, int](utils.BasicComparator[int])
+	random := rand.New(rand.NewSource(1))
+	elements := map[Interval[int]]int{}
+
+	for i := 0; i < 3000; i++ {
+		lo := random.Intn(100)
+		interval := Interval[int]{Lo: lo, Hi: lo + random.Intn(20)}
+
+		if random.Intn(3) == 0 {
+			tree.Remove(interval.Lo, interval.Hi)
+			delete(elements, interval)
+		} else {
+			tree.Put(interval.Lo, interval.Hi, i)
+			elements[interval] = i
+		}
+	}
+
+	checkInvariants(t, tree.Root)
+	assert.Equal(t, len(elements), tree.Size())
+
+	for lo := -5; lo < 125; lo += 7 {
+		for _, hi := range []int{lo, lo + 3, lo + 30} {
+			expected := []Interval[int]{}
+			for interval := range elements {
+				if interval.Lo <= hi && lo <= interval.Hi {
+					expected = append(expected, interval)
+				}
+			}
+
+			sort.Slice(expected, func(i, j int) bool {
+				return tree.compareIntervals(expected[i], expected[j]) < 0
+			})
+
+			assert.Equal(t, expected, collectIntervals(tree.Overlapping(lo, hi)))
+
+			_, _, found := tree.AnyOverlap(lo, hi)
+			assert.Equal(t, len(expected) > 0, found)
+		}
+	}
+}
+
+// assertClonedNode asserts, that clone has the same intervals, colors, upper bounds and shape as node, but none of its nodes.
+func assertClonedNode(t *testing.T, node *Node[int, int], clone *Node[int, int], parent *Node[int, int]) {
+	if node == nil {
+		assert.Nil(t, clone)
+
+		return
+	}
+
+	if !assert.NotNil(t, clone) {
+		return
+	}
+
+	assert.NotSame(t, node, clone)
+	assert.Same(t, parent, clone.Parent)
+	assert.Equal(t, node.Interval, clone.Interval)
+	assert.Equal(t, node.color, clone.color)
+	assert.Equal(t, node.max, clone.max)
+
+	assertClonedNode(t, node.Left, clone.Left, clone)
+	assertClonedNode(t, node.Right, clone.Right, clone)
+}
+
+func TestIntervalTreeClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Tree[int, int]
+	}{
+		{
+			name:     "empty",
+			original: newFromIntervals(),
+		},
+		{
+			name:     "3 items",
+			original: newFromIntervals(Interval[int]{5, 6}, Interval[int]{1, 20}, Interval[int]{8, 9}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			intervals := test.original.GetIntervals()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+			assertClonedNode(t, test.original.Root, clone.Root, nil)
+			checkInvariants(t, clone.Root)
+
+			values := test.original.GetValues()
+			for _, interval := range intervals {
+				clone.Put(interval.Lo, interval.Hi, -1)
+			}
+
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+
+			clone.Put(25, 30, 3)
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, intervals, test.original.GetIntervals(), test.name)
+			checkInvariants(t, clone.Root)
+		})
+	}
+}
+
+func TestIntervalTreeEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Tree[int, int]
+		b     *Tree[int, int]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     newFromIntervals(),
+			b:     newFromIntervals(),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     newFromIntervals(Interval[int]{1, 3}, Interval[int]{2, 8}),
+			b:     newFromIntervals(Interval[int]{1, 3}, Interval[int]{2, 8}),
+			equal: true,
+		},
+		{
+			name:  "different values",
+			a:     newFromIntervals(Interval[int]{1, 3}, Interval[int]{2, 8}),
+			b:     newFromIntervals(Interval[int]{2, 8}, Interval[int]{1, 3}),
+			equal: false,
+		},
+		{
+			name:  "different intervals",
+			a:     newFromIntervals(Interval[int]{1, 3}, Interval[int]{2, 8}),
+			b:     newFromIntervals(Interval[int]{1, 3}, Interval[int]{2, 9}),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     newFromIntervals(Interval[int]{1, 3}),
+			b:     newFromIntervals(Interval[int]{1, 3}, Interval[int]{2, 8}),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[int]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	tree := newFromIntervals(Interval[int]{5, 8}, Interval[int]{1, 3}, Interval[int]{1, 2})
+
+	keys := []Interval[int]{}
+	values := []int{}
+	for key, value := range tree.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []Interval[int]{{1, 2}, {1, 3}, {5, 8}}, keys)
+	assert.Equal(t, []int{2, 1, 0}, values)
+
+	keys = []Interval[int]{}
+	for key := range tree.Backward() {
+		keys = append(keys, key)
+	}
+
+	assert.Equal(t, []Interval[int]{{5, 8}, {1, 3}, {1, 2}}, keys)
+	assert.Equal(t, []Interval[int]{{1, 2}, {1, 3}, {5, 8}}, slices.Collect(tree.Keys()))
+	assert.Equal(t, []int{2, 1, 0}, slices.Collect(tree.Values()))
+
+	values = []int{}
+	for value := range tree.Values() {
+		values = append(values, value)
+		if len(values) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{2, 1}, values)
+}
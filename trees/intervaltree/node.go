@@ -0,0 +1,86 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package intervaltree
+
+import (
+	"fmt"
+)
+
+// Node is a single element within the tree
+type Node[T comparable, TValue any] struct {
+	Interval Interval[T]
+	Value    TValue
+	color    color
+	Left     *Node[T, TValue]
+	Right    *Node[T, TValue]
+	Parent   *Node[T, TValue]
+	// Largest upper bound of the intervals in the subtree rooted at this node
+	max T
+}
+
+// Max returns the largest upper bound of the intervals stored in the subtree.
+// The bound is maintained by the tree, so this runs in O(1).
+func (node *Node[T, TValue]) Max() T {
+	return node.max
+}
+
+func (node *Node[T, TValue]) String() string {
+	return fmt.Sprintf("[%v, %v]", node.Interval.Lo, node.Interval.Hi)
+}
+
+func (node *Node[T, TValue]) grandparent() *Node[T, TValue] {
+	if node != nil && node.Parent != nil {
+		return node.Parent.Parent
+	}
+	return nil
+}
+
+func (node *Node[T, TValue]) uncle() *Node[T, TValue] {
+	if node == nil || node.Parent == nil || node.Parent.Parent == nil {
+		return nil
+	}
+	return node.Parent.sibling()
+}
+
+func (node *Node[T, TValue]) sibling() *Node[T, TValue] {
+	if node == nil || node.Parent == nil {
+		return nil
+	}
+	if node == node.Parent.Left {
+		return node.Parent.Right
+	}
+	return node.Parent.Left
+}
+
+func (node *Node[T, TValue]) minimumNode() *Node[T, TValue] {
+	if node == nil {
+		return nil
+	}
+	for node.Left != nil {
+		node = node.Left
+	}
+	return node
+}
+
+func (node *Node[T, TValue]) maximumNode() *Node[T, TValue] {
+	if node == nil {
+		return nil
+	}
+	for node.Right != nil {
+		node = node.Right
+	}
+	return node
+}
+
+// next returns the in-order successor of node or nil if it is the last one.
+func (node *Node[T, TValue]) next() *Node[T, TValue] {
+	if node.Right != nil {
+		return node.Right.minimumNode()
+	}
+	for node.Parent != nil && node == node.Parent.Right {
+		node = node.Parent
+	}
+	return node.Parent
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package intervaltree
+
+import (
+	"sort"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Iterator implementation
+var _ ds.ReadWriteOrdCompBidRandCollIterator[Interval[int], any] = (*OrderedIterator[int, any])(nil)
+
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[int, any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[int, any])(nil)
+
+// OrderedIterator holding the iterator's state
+//
+// The iterator holds a snapshot of the nodes matched by a query,
+// inserting or removing intervals invalidates it, which is reported by Err.
+type OrderedIterator[T comparable, TValue any] struct {
+	tree *Tree[T, TValue]
+	// Matched nodes ordered by their intervals
+	nodes []*Node[T, TValue]
+	index int
+	guard ds.ModificationGuard
+}
+
+// NewOrderedIterator returns a stateful iterator whose elements are interval/value pairs of the given nodes.
+// The nodes must be ordered by their intervals.
+func (tree *Tree[T, TValue]) NewOrderedIterator(nodes []*Node[T, TValue], position int) *OrderedIterator[T, TValue] {
+	it := &OrderedIterator[T, TValue]{tree: tree, nodes: nodes, guard: tree.modifications.Guard()}
+	it.MoveTo(position)
+
+	return it
+}
+
+func (it *OrderedIterator[T, TValue]) IsBegin() bool {
+	return it.index <= -1
+}
+
+func (it *OrderedIterator[T, TValue]) IsEnd() bool {
+	return len(it.nodes) == 0 || it.index >= len(it.nodes)
+}
+
+func (it *OrderedIterator[T, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *OrderedIterator[T, TValue]) IsLast() bool {
+	return it.index == len(it.nodes)-1
+}
+
+func (it *OrderedIterator[T, TValue]) IsValid() bool {
+	return len(it.nodes) > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the tree was structurally modified after the iterator was created.
+func (it *OrderedIterator[T, TValue]) Err() error {
+	return it.guard.Err()
+}
+
+func (it *OrderedIterator[T, TValue]) IsEqual(other ds.ComparableIterator) bool {
+	otherThis, ok := other.(*OrderedIterator[T, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) == 0
+}
+
+// CanCompare checks if other is an iterator of the same tree.
+func (it *OrderedIterator[T, TValue]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*OrderedIterator[T, TValue])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.tree != it.tree {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
+func (it *OrderedIterator[T, TValue]) DistanceTo(other ds.OrderedIterator) int {
+	otherThis, ok := other.(*OrderedIterator[T, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.index - otherThis.index
+}
+
+func (it *OrderedIterator[T, TValue]) IsAfter(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*OrderedIterator[T, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) > 0
+}
+
+func (it *OrderedIterator[T, TValue]) IsBefore(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*OrderedIterator[T, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) < 0
+}
+
+func (it *OrderedIterator[T, TValue]) Size() int {
+	return len(it.nodes)
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+func (it *OrderedIterator[T, TValue]) Next() bool {
+	return it.MoveTo(utils.Min(it.index+1, len(it.nodes)))
+}
+
+func (it *OrderedIterator[T, TValue]) NextN(n int) bool {
+	if n <= 0 {
+		return false
+	}
+
+	return it.MoveTo(utils.Min(it.index+n, len(it.nodes)))
+}
+
+// Previous moves the iterator to the previous element and returns true if there was a previous element in the container.
+func (it *OrderedIterator[T, TValue]) Previous() bool {
+	return it.MoveTo(utils.Max(it.index-1, -1))
+}
+
+func (it *OrderedIterator[T, TValue]) PreviousN(n int) bool {
+	if n <= 0 {
+		return false
+	}
+
+	return it.MoveTo(utils.Max(it.index-n, -1))
+}
+
+func (it *OrderedIterator[T, TValue]) MoveBy(n int) bool {
+	if n > 0 {
+		return it.NextN(n)
+	} else if n < 0 {
+		return it.PreviousN(-n)
+	}
+
+	return it.IsValid()
+}
+
+func (it *OrderedIterator[T, TValue]) MoveTo(n int) bool {
+	switch {
+	case n < 0:
+		it.index = -1
+
+		return false
+	case n >= len(it.nodes):
+		it.index = len(it.nodes)
+
+		return false
+	}
+
+	it.index = n
+
+	return it.IsValid()
+}
+
+// MoveToKey moves the iterator to the element with the given interval in O(log k), where k is the number of elements.
+func (it *OrderedIterator[T, TValue]) MoveToKey(key Interval[T]) (found bool) {
+	if it.guard.Err() != nil {
+		return false
+	}
+
+	i := sort.Search(len(it.nodes), func(i int) bool {
+		return it.tree.compareIntervals(it.nodes[i].Interval, key) >= 0
+	})
+
+	if i == len(it.nodes) || it.tree.compareIntervals(it.nodes[i].Interval, key) != 0 {
+		return false
+	}
+
+	it.index = i
+
+	return true
+}
+
+func (it *OrderedIterator[T, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.nodes[it.index].Value, true
+}
+
+// Set updates the value of the current interval within the tree.
+func (it *OrderedIterator[T, TValue]) Set(value TValue) bool {
+	if !it.IsValid() {
+		return false
+	}
+
+	it.nodes[it.index].Value = value
+
+	return true
+}
+
+func (it *OrderedIterator[T, TValue]) GetAt(i int) (value TValue, found bool) {
+	tmp := *it
+	tmp.MoveTo(i)
+
+	return tmp.Get()
+}
+
+func (it *OrderedIterator[T, TValue]) SetAt(i int, value TValue) bool {
+	tmp := *it
+	tmp.MoveTo(i)
+
+	return tmp.Set(value)
+}
+
+func (it *OrderedIterator[T, TValue]) GetAtKey(key Interval[T]) (value TValue, found bool) {
+	tmp := *it
+	if !tmp.MoveToKey(key) {
+		return
+	}
+
+	return tmp.Get()
+}
+
+func (it *OrderedIterator[T, TValue]) SetAtKey(key Interval[T], value TValue) bool {
+	tmp := *it
+	if !tmp.MoveToKey(key) {
+		return false
+	}
+
+	return tmp.Set(value)
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (it *OrderedIterator[T, TValue]) Index() (index int, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.index, true
+}
+
+// GetKey returns the current element's interval.
+// Does not modify the state of the iterator.
+func (it *OrderedIterator[T, TValue]) GetKey() (key Interval[T], found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.nodes[it.index].Interval, true
+}
@@ -24,22 +24,22 @@ type Node[TKey any, TValue any] struct {
 	Left   *Node[TKey, TValue]
 	Right  *Node[TKey, TValue]
 	Parent *Node[TKey, TValue]
+	// Number of nodes in the subtree rooted at this node
+	size int
 }
 
 // Size returns the number of elements stored in the subtree.
-// Computed dynamically on each call, i.e. the subtree is traversed to count the number of the nodes.
+// The size is maintained by the tree, so this runs in O(1).
 func (node *Node[TKey, TValue]) Size() int {
 	if node == nil {
 		return 0
 	}
-	size := 1
-	if node.Left != nil {
-		size += node.Left.Size()
-	}
-	if node.Right != nil {
-		size += node.Right.Size()
-	}
-	return size
+
+	return node.size
+}
+
+func (node *Node[TKey, TValue]) updateSize() {
+	node.size = 1 + node.Left.Size() + node.Right.Size()
 }
 
 func (node *Node[TKey, TValue]) String() string {
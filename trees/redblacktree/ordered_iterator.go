@@ -13,62 +13,94 @@ import (
 // Assert  implementation
 var _ ds.ReadWriteOrdCompBidRandCollIterator[string, any] = (*OrderedIterator[string, any])(nil)
 
+// Assert ErasableIterator and KeyedInsertableIterator implementation
+var _ ds.ErasableIterator = (*OrderedIterator[string, any])(nil)
+var _ ds.KeyedInsertableIterator[string, any] = (*OrderedIterator[string, any])(nil)
+
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[string, any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[string, any])(nil)
+
 // Ordered holding the iterator's state
 type OrderedIterator[TKey comparable, TValue any] struct {
 	tree  *Tree[TKey, TValue]
 	node  *Node[TKey, TValue]
 	index int
+	// Restricts the iterator to the nodes within keyRange, nil if unrestricted
+	keyRange *Range[TKey]
+	first    *Node[TKey, TValue]
+	last     *Node[TKey, TValue]
 	// Redundant but has better locality
 	key   TKey
 	value TValue
 	size  int
+	guard ds.ModificationGuard
 }
 
-//  returns a stateful iterator whose elements are key/value pairs.
+// NewOrderedIterator returns a stateful iterator whose elements are key/value pairs.
 func (tree *Tree[TKey, TValue]) NewOrderedIterator(position int, size int) *OrderedIterator[TKey, TValue] {
 	it := &OrderedIterator[TKey, TValue]{
 		tree:  tree,
 		index: 0,
 		size:  size,
+		guard: tree.modifications.Guard(),
 	}
 	it.size = utils.Min(tree.Size(), size)
 	it.size = utils.Max(tree.Size(), -1)
 
-	if tree.size == 0 {
-		return it
-	}
+	it.initialize(position)
 
-	switch position {
-	case -1:
-		it.node = tree.Left()
-		it.index = -1
-	case 0:
-		it.node = tree.Left()
-		it.index = 0
-		it.key = it.node.Key
-		it.value = it.node.Value
-	case tree.size:
-		it.node = tree.Right()
-		it.index = tree.size
-	case tree.size - 1:
-		it.node = tree.Right()
-		it.index = tree.size - 1
-		it.key = it.node.Key
-		it.value = it.node.Value
+	return it
+}
+
+// NewRangeOrderedIterator returns a stateful iterator, which only iterates the key/value pairs within keyRange.
+func (tree *Tree[TKey, TValue]) NewRangeOrderedIterator(keyRange Range[TKey], position int) *OrderedIterator[TKey, TValue] {
+	it := &OrderedIterator[TKey, TValue]{
+		tree:     tree,
+		index:    0,
+		keyRange: &keyRange,
+		first:    tree.RangeFirst(keyRange),
+		last:     tree.RangeLast(keyRange),
+		size:     tree.RangeSize(keyRange),
+		guard:    tree.modifications.Guard(),
 	}
 
-	if !it.IsValid() {
-		return it
+	it.initialize(position)
+
+	return it
+}
+
+func (it *OrderedIterator[TKey, TValue]) initialize(position int) {
+	if it.size == 0 {
+		it.index = utils.Min(position, 0)
+
+		return
 	}
 
 	it.MoveTo(position)
+}
 
-	return it
+func (it *OrderedIterator[TKey, TValue]) firstNode() *Node[TKey, TValue] {
+	if it.keyRange != nil {
+		return it.first
+	}
+
+	return it.tree.Left()
+}
+
+func (it *OrderedIterator[TKey, TValue]) lastNode() *Node[TKey, TValue] {
+	if it.keyRange != nil {
+		return it.last
+	}
+
+	return it.tree.Right()
 }
 
 // At returns a stateful iterator whose elements are key/value pairs that is initialised at a particular node.
 func (tree *Tree[TKey, TValue]) NewOrderedteratorAt(t *Tree[TKey, TValue], key TKey) *OrderedIterator[TKey, TValue] {
-	it := &OrderedIterator[TKey, TValue]{tree: t, index: -1, size: t.Size()}
+	it := &OrderedIterator[TKey, TValue]{tree: t, index: -1, size: t.Size(), guard: t.modifications.Guard()}
 
 	it.MoveToKey(key)
 
@@ -92,10 +124,17 @@ func (it *OrderedIterator[TKey, TValue]) IsLast() bool {
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsValid() bool {
-	return it.size > 0 && !it.IsBegin() && !it.IsEnd()
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the tree was structurally modified, other than through the iterator.
+func (it *OrderedIterator[TKey, TValue]) Err() error {
+	return it.guard.Err()
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -104,16 +143,37 @@ func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bo
 	return it.DistanceTo(otherThis) == 0
 }
 
+// CanCompare checks if other is an iterator of the same tree and key range.
+// Iterators over different key ranges are views of different containers, even if they share a tree.
+func (it *OrderedIterator[TKey, TValue]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := ds.BaseOf[TKey, TValue](other).(*OrderedIterator[TKey, TValue])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.tree != it.tree || !it.tree.equalRanges(it.keyRange, otherThis.keyRange) {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
+// DistanceTo compares the positions of both iterators in the whole tree, so that iterators over different key ranges
+// agree on the elements they share.
 func (it *OrderedIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) int {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
 	}
 
-	return it.index - otherThis.index
+	return it.offset() + it.index - otherThis.offset() - otherThis.index
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -123,6 +183,8 @@ func (it *OrderedIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool
 }
 
 func (it *OrderedIterator[TKey, TValue]) IsBefore(other ds.OrderedIterator) bool {
+	other = ds.BaseOf[TKey, TValue](other)
+
 	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
 	if !ok {
 		panic(ds.CanOnlyCompareEqualIteratorTypes)
@@ -147,7 +209,7 @@ func (it *OrderedIterator[TKey, TValue]) Next() bool {
 	}
 
 	if it.IsFirst() {
-		it.node = it.tree.Left()
+		it.node = it.firstNode()
 
 		it.key = it.node.Key
 		it.value = it.node.Value
@@ -179,13 +241,11 @@ func (it *OrderedIterator[TKey, TValue]) Next() bool {
 }
 
 func (it *OrderedIterator[TKey, TValue]) NextN(n int) bool {
-	var found bool
-
-	for i := 0; i < n; i++ {
-		found = it.Next()
+	if n <= 0 {
+		return false
 	}
 
-	return found
+	return it.MoveTo(utils.Min(it.index+n, it.size))
 }
 
 // Prev moves the  to the previous element and returns true if there was a previous element in the container.
@@ -199,7 +259,7 @@ func (it *OrderedIterator[TKey, TValue]) Previous() bool {
 	}
 
 	if it.IsLast() {
-		it.node = it.tree.Right()
+		it.node = it.lastNode()
 
 		it.key = it.node.Key
 		it.value = it.node.Value
@@ -232,13 +292,11 @@ func (it *OrderedIterator[TKey, TValue]) Previous() bool {
 }
 
 func (it *OrderedIterator[TKey, TValue]) PreviousN(n int) bool {
-	var found bool
-
-	for i := 0; i < n; i++ {
-		found = it.Previous()
+	if n <= 0 {
+		return false
 	}
 
-	return found
+	return it.MoveTo(utils.Max(it.index-n, -1))
 }
 
 func (it *OrderedIterator[TKey, TValue]) MoveBy(n int) bool {
@@ -251,40 +309,66 @@ func (it *OrderedIterator[TKey, TValue]) MoveBy(n int) bool {
 	return it.IsValid()
 }
 
+// MoveTo moves the iterator to the n-th element in O(log n).
 func (it *OrderedIterator[TKey, TValue]) MoveTo(n int) bool {
-	return it.MoveBy(n - it.index)
-}
+	if it.guard.Err() != nil {
+		return false
+	}
 
-// https://www.geeksforgeeks.org/find-distance-between-two-nodes-of-a-binary-tree/
-func (it *OrderedIterator[TKey, TValue]) MoveToKey(key TKey) (found bool) {
-	if it.IsValid() && it.tree.Comparator(key, it.node.Key) == 0 {
+	switch {
+	case n < 0:
+		it.index = -1
+		it.node = it.firstNode()
 
-		return true
-	}
+		return false
+	case n >= it.size:
+		it.index = it.size
+		it.node = it.lastNode()
 
-	targetNode := it.tree.lookup(key)
-	if targetNode == nil {
 		return false
 	}
 
-	distance := distanceBetween(it.tree.Comparator, it.tree.Root, it.node, targetNode)
-	if it.IsBegin() || it.IsEnd() {
-		// Even if the index is Begin(), the starting node is First()
-		distance += 1
+	node, found := it.tree.Select(it.offset() + n)
+	if !found {
+		return false
 	}
 
-	if it.tree.Comparator(key, it.node.Key) < 0 || it.IsEnd() {
-		distance = -distance
+	it.index = n
+	it.node = node
+	it.key = node.Key
+	it.value = node.Value
+
+	return true
+}
+
+// MoveToKey moves the iterator to the element with the given key in O(log n).
+func (it *OrderedIterator[TKey, TValue]) MoveToKey(key TKey) (found bool) {
+	if it.guard.Err() != nil || it.keyRange != nil && !it.tree.InRange(*it.keyRange, key) {
+		return false
 	}
 
-	it.index += distance
+	targetNode := it.tree.lookup(key)
+	if targetNode == nil {
+		return false
+	}
 
+	it.index = rankOf(targetNode) - it.offset()
+	it.node = targetNode
 	it.key = targetNode.Key
 	it.value = targetNode.Value
 
 	return true
 }
 
+// offset returns the number of keys in the tree, which come before the iterator's first element.
+func (it *OrderedIterator[TKey, TValue]) offset() int {
+	if it.keyRange == nil || it.first == nil {
+		return 0
+	}
+
+	return rankOf(it.first)
+}
+
 // Value returns the current element's value.
 // Does not modify the state of the .
 func (it *OrderedIterator[TKey, TValue]) Get() (value TValue, found bool) {
@@ -307,10 +391,6 @@ func (it *OrderedIterator[TKey, TValue]) Set(value TValue) bool {
 }
 
 func (it *OrderedIterator[TKey, TValue]) GetAt(i int) (value TValue, found bool) {
-	if !it.IsValid() {
-		return
-	}
-
 	tmp := *it
 	tmp.MoveTo(i)
 
@@ -319,26 +399,122 @@ func (it *OrderedIterator[TKey, TValue]) GetAt(i int) (value TValue, found bool)
 }
 
 func (it *OrderedIterator[TKey, TValue]) SetAt(i int, value TValue) bool {
-	if !it.IsValid() {
-		return false
-	}
-
 	tmp := *it
 	tmp.MoveTo(i)
 
 	return tmp.Set(value)
 }
 
+// GetAtKey returns the value of key, if it is within the iterator's range.
 func (it *OrderedIterator[TKey, TValue]) GetAtKey(key TKey) (value TValue, found bool) {
+	if it.keyRange != nil && !it.tree.InRange(*it.keyRange, key) {
+		return
+	}
+
 	return it.tree.Get(key)
 }
 
+// SetAtKey puts the key/value pair into the tree.
+// Returns false if key is outside of the iterator's range.
+// Inserting a new key is a structural modification, which the iterator can not follow, so it is invalidated.
 func (it *OrderedIterator[TKey, TValue]) SetAtKey(key TKey, value TValue) bool {
+	if it.keyRange != nil && !it.tree.InRange(*it.keyRange, key) {
+		return false
+	}
+
+	it.tree.Put(key, value)
+
+	return true
+}
+
+// Remove removes the current element from the tree in O(log n) and moves the iterator to the next element.
+func (it *OrderedIterator[TKey, TValue]) Remove() bool {
+	if !it.IsValid() {
+		return false
+	}
+
+	it.tree.Remove(it.node.Key)
+	it.update(it.index)
+
+	return true
+}
+
+// InsertBefore inserts the key/value pair before the current element or at the end in O(log n).
+// Returns false if key is not between the keys of the previous and the current element, or outside of the iterator's range.
+func (it *OrderedIterator[TKey, TValue]) InsertBefore(key TKey, value TValue) bool {
+	var previous *Node[TKey, TValue]
+
+	switch {
+	case it.IsValid():
+		if it.tree.Comparator(key, it.node.Key) >= 0 {
+			return false
+		}
+
+		previous, _ = it.tree.Lower(it.node.Key)
+	case it.index == it.size:
+		previous = it.lastNode()
+	default:
+		return false
+	}
+
+	if previous != nil && it.tree.Comparator(key, previous.Key) <= 0 {
+		return false
+	}
+
+	return it.insert(key, value, it.index+1)
+}
+
+// InsertAfter inserts the key/value pair after the current element or at the start in O(log n).
+// Returns false if key is not between the keys of the current and the next element, or outside of the iterator's range.
+func (it *OrderedIterator[TKey, TValue]) InsertAfter(key TKey, value TValue) bool {
+	var next *Node[TKey, TValue]
+
+	switch {
+	case it.IsValid():
+		if it.tree.Comparator(key, it.node.Key) <= 0 {
+			return false
+		}
+
+		next, _ = it.tree.Higher(it.node.Key)
+	case it.IsBegin():
+		next = it.firstNode()
+	default:
+		return false
+	}
+
+	if next != nil && it.tree.Comparator(key, next.Key) >= 0 {
+		return false
+	}
+
+	return it.insert(key, value, it.index)
+}
+
+func (it *OrderedIterator[TKey, TValue]) insert(key TKey, value TValue, index int) bool {
+	if it.keyRange != nil && !it.tree.InRange(*it.keyRange, key) {
+		return false
+	}
+
 	it.tree.Put(key, value)
+	it.update(index)
 
 	return true
 }
 
+// update refreshes the iterator's bounds after the tree was modified through it and moves it to index.
+func (it *OrderedIterator[TKey, TValue]) update(index int) {
+	it.guard.Sync()
+
+	if it.keyRange != nil {
+		it.first = it.tree.RangeFirst(*it.keyRange)
+		it.last = it.tree.RangeLast(*it.keyRange)
+		it.size = it.tree.RangeSize(*it.keyRange)
+	} else {
+		it.size = it.tree.Size()
+	}
+
+	it.MoveTo(index)
+}
+
 // Key returns the current element's key.
 // Does not modify the state of the .
 func (it *OrderedIterator[TKey, TValue]) Index() (key int, found bool) {
@@ -1,6 +1,7 @@
 package redblacktree
 
 import (
+	"slices"
 	"testing"
 
 	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
@@ -1097,3 +1098,616 @@ func TestRedBlackTreeOrderedIteratorIsBeginEndFirstLast(t *testing.T) {
 		})
 	}
 }
+
+func TestRedBlackTreeOrderedIteratorRemove(t *testing.T) {
+	tests := []struct {
+		name     string
+		position int
+		removed  bool
+		keys     []string
+		key      string
+		found    bool
+	}{
+		{
+			name:     "begin",
+			position: -1,
+			removed:  false,
+			keys:     []string{"a", "b", "c"},
+		},
+		{
+			name:     "first",
+			position: 0,
+			removed:  true,
+			keys:     []string{"b", "c"},
+			key:      "b",
+			found:    true,
+		},
+		{
+			name:     "middle",
+			position: 1,
+			removed:  true,
+			keys:     []string{"a", "c"},
+			key:      "c",
+			found:    true,
+		},
+		{
+			name:     "last",
+			position: 2,
+			removed:  true,
+			keys:     []string{"a", "b"},
+			found:    false,
+		},
+		{
+			name:     "end",
+			position: 3,
+			removed:  false,
+			keys:     []string{"a", "b", "c"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			tree := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2, "c": 3})
+			it := tree.NewOrderedIterator(test.position, tree.Size())
+
+			assert.Equalf(t, test.removed, it.Remove(), test.name)
+			assert.Equalf(t, test.keys, tree.GetKeys(), test.name)
+			assert.Equalf(t, len(test.keys), it.Size(), test.name)
+
+			key, found := it.GetKey()
+			assert.Equalf(t, test.key, key, test.name)
+			assert.Equalf(t, test.found, found, test.name)
+		})
+	}
+}
+
+func TestRedBlackTreeOrderedIteratorRemoveWhileIterating(t *testing.T) {
+	tree := New[int, int](utils.BasicComparator[int])
+	for i := 0; i < 100; i++ {
+		tree.Put(i, i)
+	}
+
+	it := tree.NewOrderedIterator(-1, tree.Size())
+
+	for it.Next(); it.IsValid(); {
+		key, _ := it.GetKey()
+		if key%3 != 0 {
+			it.Remove()
+		} else {
+			it.Next()
+		}
+	}
+
+	assert.True(t, it.IsEnd())
+	assert.Equal(t, 34, tree.Size())
+
+	for i, key := range tree.GetKeys() {
+		assert.Equal(t, i*3, key)
+	}
+}
+
+func TestRedBlackTreeOrderedIteratorRandomAccessFromSentinels(t *testing.T) {
+	tree := New[int, int](utils.BasicComparator[int])
+	for i := 0; i < 3; i++ {
+		tree.Put(i, i*10)
+	}
+
+	for _, it := range []*OrderedIterator[int, int]{tree.NewOrderedIterator(-1, tree.Size()), tree.NewOrderedIterator(tree.Size(), tree.Size())} {
+		value, found := it.GetAt(1)
+		assert.True(t, found)
+		assert.Equal(t, 10, value)
+
+		assert.True(t, it.SetAt(2, 25))
+		assert.False(t, it.IsValid())
+	}
+
+	value, _ := tree.Get(2)
+	assert.Equal(t, 25, value)
+}
+
+func TestRedBlackTreeOrderedIteratorInsertBefore(t *testing.T) {
+	tests := []struct {
+		name     string
+		position int
+		key      string
+		inserted bool
+		keys     []string
+	}{
+		{
+			name:     "begin",
+			position: -1,
+			key:      "0",
+			inserted: false,
+			keys:     []string{"b", "d"},
+		},
+		{
+			name:     "first",
+			position: 0,
+			key:      "a",
+			inserted: true,
+			keys:     []string{"a", "b", "d"},
+		},
+		{
+			name:     "between",
+			position: 1,
+			key:      "c",
+			inserted: true,
+			keys:     []string{"b", "c", "d"},
+		},
+		{
+			name:     "before previous",
+			position: 1,
+			key:      "a",
+			inserted: false,
+			keys:     []string{"b", "d"},
+		},
+		{
+			name:     "after current",
+			position: 0,
+			key:      "c",
+			inserted: false,
+			keys:     []string{"b", "d"},
+		},
+		{
+			name:     "existing",
+			position: 1,
+			key:      "d",
+			inserted: false,
+			keys:     []string{"b", "d"},
+		},
+		{
+			name:     "end",
+			position: 2,
+			key:      "e",
+			inserted: true,
+			keys:     []string{"b", "d", "e"},
+		},
+		{
+			name:     "end, before last",
+			position: 2,
+			key:      "c",
+			inserted: false,
+			keys:     []string{"b", "d"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			tree := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"b": 2, "d": 4})
+			it := tree.NewOrderedIterator(test.position, tree.Size())
+			keyBefore, foundBefore := it.GetKey()
+			indexBefore := it.index
+
+			assert.Equalf(t, test.inserted, it.InsertBefore(test.key, 0), test.name)
+			assert.Equalf(t, test.keys, tree.GetKeys(), test.name)
+			assert.Equalf(t, len(test.keys), it.Size(), test.name)
+
+			key, found := it.GetKey()
+			assert.Equalf(t, keyBefore, key, test.name)
+			assert.Equalf(t, foundBefore, found, test.name)
+
+			if test.inserted {
+				assert.Equalf(t, indexBefore+1, it.index, test.name)
+			}
+		})
+	}
+}
+
+func TestRedBlackTreeOrderedIteratorInsertAfter(t *testing.T) {
+	tests := []struct {
+		name     string
+		position int
+		key      string
+		inserted bool
+		keys     []string
+	}{
+		{
+			name:     "begin",
+			position: -1,
+			key:      "a",
+			inserted: true,
+			keys:     []string{"a", "b", "d"},
+		},
+		{
+			name:     "begin, after first",
+			position: -1,
+			key:      "c",
+			inserted: false,
+			keys:     []string{"b", "d"},
+		},
+		{
+			name:     "between",
+			position: 0,
+			key:      "c",
+			inserted: true,
+			keys:     []string{"b", "c", "d"},
+		},
+		{
+			name:     "after next",
+			position: 0,
+			key:      "e",
+			inserted: false,
+			keys:     []string{"b", "d"},
+		},
+		{
+			name:     "last",
+			position: 1,
+			key:      "e",
+			inserted: true,
+			keys:     []string{"b", "d", "e"},
+		},
+		{
+			name:     "end",
+			position: 2,
+			key:      "e",
+			inserted: false,
+			keys:     []string{"b", "d"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			tree := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"b": 2, "d": 4})
+			it := tree.NewOrderedIterator(test.position, tree.Size())
+			keyBefore, foundBefore := it.GetKey()
+			indexBefore := it.index
+
+			assert.Equalf(t, test.inserted, it.InsertAfter(test.key, 0), test.name)
+			assert.Equalf(t, test.keys, tree.GetKeys(), test.name)
+			assert.Equalf(t, len(test.keys), it.Size(), test.name)
+			assert.Equalf(t, indexBefore, it.index, test.name)
+
+			key, found := it.GetKey()
+			assert.Equalf(t, keyBefore, key, test.name)
+			assert.Equalf(t, foundBefore, found, test.name)
+		})
+	}
+}
+
+func TestRedBlackTreeOrderedIteratorInsertEmpty(t *testing.T) {
+	tree := New[string, int](utils.BasicComparator[string])
+
+	it := tree.OrderedBegin().(*OrderedIterator[string, int])
+	assert.True(t, it.InsertAfter("a", 1))
+	assert.True(t, it.IsBegin())
+	assert.True(t, it.Next())
+
+	tree = New[string, int](utils.BasicComparator[string])
+
+	it = tree.OrderedEnd().(*OrderedIterator[string, int])
+	assert.True(t, it.InsertBefore("a", 1))
+	assert.True(t, it.IsEnd())
+	assert.True(t, it.Previous())
+
+	key, _ := it.GetKey()
+	assert.Equal(t, "a", key)
+}
+
+func TestRedBlackTreeRangeOrderedIteratorMutation(t *testing.T) {
+	tree := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2, "d": 4, "f": 6})
+	it := tree.NewRangeOrderedIterator(NewRange("b", true, "e", true), 0)
+
+	assert.True(t, it.Remove())
+	assert.Equal(t, 1, it.Size())
+
+	key, _ := it.GetKey()
+	assert.Equal(t, "d", key)
+
+	// Keys outside of the range can not be inserted
+	assert.False(t, it.InsertBefore("a", 0))
+	assert.False(t, it.InsertAfter("f", 0))
+
+	assert.True(t, it.InsertBefore("c", 3))
+	assert.True(t, it.InsertAfter("e", 5))
+	assert.Equal(t, 3, it.Size())
+
+	key, _ = it.GetKey()
+	assert.Equal(t, "d", key)
+
+	index, _ := it.Index()
+	assert.Equal(t, 1, index)
+
+	assert.Equal(t, []string{"a", "c", "d", "e", "f"}, tree.GetKeys())
+
+	// Keys outside of the range are hidden and can not be set
+	_, found := it.GetAtKey("a")
+	assert.False(t, found)
+	assert.False(t, it.SetAtKey("f", 7))
+	assert.False(t, it.SetAtKey("g", 7))
+
+	value, found := it.GetAtKey("c")
+	assert.True(t, found)
+	assert.Equal(t, 3, value)
+	assert.True(t, it.SetAtKey("c", 8))
+
+	value, _ = tree.Get("f")
+	assert.Equal(t, 6, value)
+	value, _ = tree.Get("c")
+	assert.Equal(t, 8, value)
+	assert.Equal(t, []string{"a", "c", "d", "e", "f"}, tree.GetKeys())
+}
+
+func TestRedBlackTreeOrderedIteratorFailFast(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Tree[string, int])
+		err    error
+	}{
+		{
+			name:   "Put new key",
+			modify: func(tree *Tree[string, int]) { tree.Put("d", 4) },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "Put existing key",
+			modify: func(tree *Tree[string, int]) { tree.Put("a", 5) },
+			err:    nil,
+		},
+		{
+			name:   "Remove",
+			modify: func(tree *Tree[string, int]) { tree.Remove("a") },
+			err:    ds.ErrConcurrentModification,
+		},
+		{
+			name:   "Remove missing key",
+			modify: func(tree *Tree[string, int]) { tree.Remove("e") },
+			err:    nil,
+		},
+		{
+			name:   "Clear",
+			modify: func(tree *Tree[string, int]) { tree.Clear() },
+			err:    ds.ErrConcurrentModification,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			tree := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2, "c": 3})
+			it := tree.OrderedFirst().(*OrderedIterator[string, int])
+
+			test.modify(tree)
+
+			assert.Equalf(t, test.err, it.Err(), test.name)
+			assert.Equalf(t, test.err == nil, it.IsValid(), test.name)
+			assert.Equalf(t, test.err == nil, it.MoveToKey("b"), test.name)
+
+			_, found := it.Get()
+			assert.Equalf(t, test.err == nil, found, test.name)
+		})
+	}
+}
+
+func TestRedBlackTreeOrderedIteratorFailFastOwnModifications(t *testing.T) {
+	tree := NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "c": 3, "e": 5})
+	it := tree.OrderedFirst().(*OrderedIterator[string, int])
+
+	assert.True(t, it.InsertAfter("b", 2))
+	assert.True(t, it.Remove())
+	assert.NoError(t, it.Err())
+
+	key, found := it.GetKey()
+	assert.True(t, found)
+	assert.Equal(t, "b", key)
+
+	tree.Put("d", 4)
+	assert.ErrorIs(t, it.Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.Next())
+	assert.False(t, it.Remove())
+}
+
+func TestRedBlackTreeReverseIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		container *Tree[string, int]
+	}{
+		{
+			name:      "Empty",
+			container: New[string, int](utils.BasicComparator[string]),
+		},
+		{
+			name:      "One element",
+			container: NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1}),
+		},
+		{
+			name:      "3 elements",
+			container: NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2, "c": 3}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			values := []int{}
+			for it := test.container.OrderedBegin(); it.Next(); {
+				value, _ := it.Get()
+				values = append(values, value)
+			}
+
+			reversed := []int{}
+			for it := test.container.ReverseBegin(); it.Next(); {
+				value, _ := it.Get()
+				reversed = append(reversed, value)
+			}
+
+			backward := []int{}
+			for it := test.container.ReverseEnd(); it.Previous(); {
+				value, _ := it.Get()
+				backward = append(backward, value)
+			}
+
+			assert.Equalf(t, values, backward, test.name)
+
+			slices.Reverse(values)
+			assert.Equalf(t, values, reversed, test.name)
+
+			first, found := test.container.ReverseFirst().Get()
+			last, _ := test.container.ReverseLast().Get()
+			assert.Equalf(t, len(values) > 0, found, test.name)
+
+			if len(values) > 0 {
+				assert.Equalf(t, values[0], first, test.name)
+				assert.Equalf(t, values[len(values)-1], last, test.name)
+			}
+		})
+	}
+}
+
+// newRangeIterator returns a forward or reversed iterator over the keys c to e of tree, which points to key.
+func newRangeIterator(tree *Tree[string, int], reversed bool, key string) ds.ReadWriteOrdCompBidRandCollIterator[string, int] {
+	var it ds.ReadWriteOrdCompBidRandCollIterator[string, int] = tree.NewRangeOrderedIterator(NewRange("b", false, "e", true), -1)
+	if reversed {
+		it = ds.NewReverseIterator(it)
+	}
+
+	it.MoveToKey(key)
+
+	return it
+}
+
+// newAlphabetTree returns a tree of the keys a to f.
+func newAlphabetTree() *Tree[string, int] {
+	return NewFromMap[string, int](utils.BasicComparator[string], map[string]int{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5, "f": 6})
+}
+
+func TestRedBlackTreeReverseRangeIterator(t *testing.T) {
+	tree := newAlphabetTree()
+	keyRange := NewRange("b", false, "e", true)
+
+	keys := []string{}
+	for it := ds.NewReverseIterator[string, int](tree.NewRangeOrderedIterator(keyRange, tree.RangeSize(keyRange))); it.Next(); {
+		key, _ := it.GetKey()
+		keys = append(keys, key)
+	}
+
+	// b is excluded from the range, so the reversed iterator stops before it
+	assert.Equal(t, []string{"e", "d", "c"}, keys)
+
+	it := newRangeIterator(tree, true, "e")
+
+	index, _ := it.Index()
+	assert.Equal(t, 2, index)
+
+	assert.False(t, it.MoveToKey("b"))
+	assert.False(t, it.MoveToKey("f"))
+
+	value, found := it.GetAt(0)
+	assert.True(t, found)
+	assert.Equal(t, 3, value)
+
+	assert.True(t, it.MoveTo(0))
+	assert.False(t, it.Next())
+	assert.True(t, it.IsEnd())
+}
+
+func TestRedBlackTreeReverseIteratorCompare(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		reversed      bool
+		otherKey      string
+		otherReversed bool
+		distance      int
+	}{
+		{
+			name:          "Reversed keys",
+			key:           "e",
+			reversed:      true,
+			otherKey:      "c",
+			otherReversed: true,
+			distance:      -2,
+		},
+		{
+			name:          "Reversed key to forward key",
+			key:           "e",
+			reversed:      true,
+			otherKey:      "c",
+			otherReversed: false,
+			distance:      2,
+		},
+		{
+			name:          "Reversed key to same forward key",
+			key:           "d",
+			reversed:      true,
+			otherKey:      "d",
+			otherReversed: false,
+			distance:      0,
+		},
+		{
+			name:          "Forward key to reversed key",
+			key:           "c",
+			reversed:      false,
+			otherKey:      "d",
+			otherReversed: true,
+			distance:      -1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			tree := newAlphabetTree()
+			it := newRangeIterator(tree, test.reversed, test.key)
+			other := newRangeIterator(tree, test.otherReversed, test.otherKey)
+
+			distance, err := ds.CheckedDistanceTo(it, other)
+			assert.NoErrorf(t, err, test.name)
+			assert.Equalf(t, test.distance, distance, test.name)
+			assert.Equalf(t, -test.distance, other.DistanceTo(it), test.name)
+			assert.Equalf(t, test.distance < 0, it.IsBefore(other), test.name)
+			assert.Equalf(t, test.distance > 0, other.IsBefore(it), test.name)
+			assert.Equalf(t, test.distance == 0, other.IsEqual(it), test.name)
+		})
+	}
+
+	// Iterators over different ranges are not comparable, even if they point to the same key
+	tree := newAlphabetTree()
+	assert.ErrorIs(t, ds.CanCompare(newRangeIterator(tree, true, "d"), tree.ReverseFirst()), ds.ErrForeignIterators)
+	assert.ErrorIs(t, ds.CanCompare(tree.OrderedFirst(), newRangeIterator(tree, true, "d")), ds.ErrForeignIterators)
+}
+
+func TestRedBlackTreeReverseIteratorFailFast(t *testing.T) {
+	tree := newAlphabetTree()
+	it := newRangeIterator(tree, true, "d")
+
+	tree.Put("d", 40)
+
+	assert.NoError(t, it.(ds.FailFastIterator).Err())
+
+	value, _ := it.Get()
+	assert.Equal(t, 40, value)
+
+	// Inserting a key outside of the range still restructures the tree
+	tree.Put("g", 7)
+
+	assert.ErrorIs(t, it.(ds.FailFastIterator).Err(), ds.ErrConcurrentModification)
+	assert.False(t, it.IsValid())
+	assert.False(t, it.Next())
+}
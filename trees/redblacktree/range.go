@@ -0,0 +1,155 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package redblacktree
+
+// Range describes a contiguous range of keys.
+// Each end of the range is either unbounded, inclusive or exclusive.
+type Range[TKey any] struct {
+	From          TKey
+	To            TKey
+	HasFrom       bool
+	HasTo         bool
+	FromInclusive bool
+	ToInclusive   bool
+}
+
+// NewRange instantiates a range from from to to.
+func NewRange[TKey any](from TKey, fromInclusive bool, to TKey, toInclusive bool) Range[TKey] {
+	return Range[TKey]{From: from, FromInclusive: fromInclusive, HasFrom: true, To: to, ToInclusive: toInclusive, HasTo: true}
+}
+
+// NewHeadRange instantiates a range of all keys up to to.
+func NewHeadRange[TKey any](to TKey, toInclusive bool) Range[TKey] {
+	return Range[TKey]{To: to, ToInclusive: toInclusive, HasTo: true}
+}
+
+// NewTailRange instantiates a range of all keys starting at from.
+func NewTailRange[TKey any](from TKey, fromInclusive bool) Range[TKey] {
+	return Range[TKey]{From: from, FromInclusive: fromInclusive, HasFrom: true}
+}
+
+// IsBelowRange checks if key is smaller than all keys in r.
+func (tree *Tree[TKey, TValue]) IsBelowRange(r Range[TKey], key TKey) bool {
+	if !r.HasFrom {
+		return false
+	}
+
+	compare := tree.Comparator(key, r.From)
+
+	return compare < 0 || (compare == 0 && !r.FromInclusive)
+}
+
+// IsAboveRange checks if key is larger than all keys in r.
+func (tree *Tree[TKey, TValue]) IsAboveRange(r Range[TKey], key TKey) bool {
+	if !r.HasTo {
+		return false
+	}
+
+	compare := tree.Comparator(key, r.To)
+
+	return compare > 0 || (compare == 0 && !r.ToInclusive)
+}
+
+// InRange checks if key is contained in r.
+func (tree *Tree[TKey, TValue]) InRange(r Range[TKey], key TKey) bool {
+	return !tree.IsBelowRange(r, key) && !tree.IsAboveRange(r, key)
+}
+
+// IntersectRanges returns the range of keys contained in both r and other.
+func (tree *Tree[TKey, TValue]) IntersectRanges(r Range[TKey], other Range[TKey]) Range[TKey] {
+	intersection := r
+
+	if other.HasFrom {
+		if !r.HasFrom {
+			intersection.From, intersection.FromInclusive, intersection.HasFrom = other.From, other.FromInclusive, true
+		} else if compare := tree.Comparator(other.From, r.From); compare > 0 {
+			intersection.From, intersection.FromInclusive = other.From, other.FromInclusive
+		} else if compare == 0 {
+			intersection.FromInclusive = r.FromInclusive && other.FromInclusive
+		}
+	}
+
+	if other.HasTo {
+		if !r.HasTo {
+			intersection.To, intersection.ToInclusive, intersection.HasTo = other.To, other.ToInclusive, true
+		} else if compare := tree.Comparator(other.To, r.To); compare < 0 {
+			intersection.To, intersection.ToInclusive = other.To, other.ToInclusive
+		} else if compare == 0 {
+			intersection.ToInclusive = r.ToInclusive && other.ToInclusive
+		}
+	}
+
+	return intersection
+}
+
+// equalRanges checks if r and other describe the same range, nil describing the unrestricted range.
+func (tree *Tree[TKey, TValue]) equalRanges(r *Range[TKey], other *Range[TKey]) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+
+	if r.HasFrom != other.HasFrom || r.HasTo != other.HasTo {
+		return false
+	}
+
+	if r.HasFrom && (r.FromInclusive != other.FromInclusive || tree.Comparator(r.From, other.From) != 0) {
+		return false
+	}
+
+	return !r.HasTo || r.ToInclusive == other.ToInclusive && tree.Comparator(r.To, other.To) == 0
+}
+
+// RangeFirst returns the smallest node contained in r or nil if r contains no nodes.
+func (tree *Tree[TKey, TValue]) RangeFirst(r Range[TKey]) *Node[TKey, TValue] {
+	var node *Node[TKey, TValue]
+
+	switch {
+	case !r.HasFrom:
+		node = tree.Left()
+	case r.FromInclusive:
+		node, _ = tree.Ceiling(r.From)
+	default:
+		node, _ = tree.Higher(r.From)
+	}
+
+	if node == nil || tree.IsAboveRange(r, node.Key) {
+		return nil
+	}
+
+	return node
+}
+
+// RangeLast returns the largest node contained in r or nil if r contains no nodes.
+func (tree *Tree[TKey, TValue]) RangeLast(r Range[TKey]) *Node[TKey, TValue] {
+	var node *Node[TKey, TValue]
+
+	switch {
+	case !r.HasTo:
+		node = tree.Right()
+	case r.ToInclusive:
+		node, _ = tree.Floor(r.To)
+	default:
+		node, _ = tree.Lower(r.To)
+	}
+
+	if node == nil || tree.IsBelowRange(r, node.Key) {
+		return nil
+	}
+
+	return node
+}
+
+// RangeSize returns the number of nodes contained in r.
+// Runs in O(log n).
+func (tree *Tree[TKey, TValue]) RangeSize(r Range[TKey]) int {
+	first := tree.RangeFirst(r)
+	last := tree.RangeLast(r)
+
+	if first == nil || last == nil {
+		return 0
+	}
+
+	return rankOf(last) - rankOf(first) + 1
+}
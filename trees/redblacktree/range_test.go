@@ -0,0 +1,183 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package redblacktree
+
+import (
+	"testing"
+
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedBlackTreeRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []int
+		keyRange Range[int]
+		inRange  []int
+	}{
+		{
+			name:     "empty tree",
+			keys:     []int{},
+			keyRange: NewRange(1, true, 5, true),
+			inRange:  []int{},
+		},
+		{
+			name:     "unbounded",
+			keys:     []int{1, 2, 3},
+			keyRange: Range[int]{},
+			inRange:  []int{1, 2, 3},
+		},
+		{
+			name:     "inclusive",
+			keys:     []int{1, 2, 3, 4, 5},
+			keyRange: NewRange(2, true, 4, true),
+			inRange:  []int{2, 3, 4},
+		},
+		{
+			name:     "exclusive",
+			keys:     []int{1, 2, 3, 4, 5},
+			keyRange: NewRange(2, false, 4, false),
+			inRange:  []int{3},
+		},
+		{
+			name:     "bounds between keys",
+			keys:     []int{0, 2, 4, 6, 8},
+			keyRange: NewRange(1, false, 7, false),
+			inRange:  []int{2, 4, 6},
+		},
+		{
+			name:     "head",
+			keys:     []int{1, 2, 3, 4, 5},
+			keyRange: NewHeadRange(3, false),
+			inRange:  []int{1, 2},
+		},
+		{
+			name:     "tail",
+			keys:     []int{1, 2, 3, 4, 5},
+			keyRange: NewTailRange(3, true),
+			inRange:  []int{3, 4, 5},
+		},
+		{
+			name:     "inverted bounds",
+			keys:     []int{1, 2, 3, 4, 5},
+			keyRange: NewRange(4, true, 2, true),
+			inRange:  []int{},
+		},
+		{
+			name:     "no keys in range",
+			keys:     []int{1, 2, 4, 5},
+			keyRange: NewRange(2, false, 4, false),
+			inRange:  []int{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			tree := New[int, int](utils.BasicComparator[int])
+			for _, key := range test.keys {
+				tree.Put(key, key)
+			}
+
+			assert.Equalf(t, len(test.inRange), tree.RangeSize(test.keyRange), test.name)
+
+			if len(test.inRange) == 0 {
+				assert.Nilf(t, tree.RangeFirst(test.keyRange), test.name)
+				assert.Nilf(t, tree.RangeLast(test.keyRange), test.name)
+			} else {
+				assert.Equalf(t, test.inRange[0], tree.RangeFirst(test.keyRange).Key, test.name)
+				assert.Equalf(t, test.inRange[len(test.inRange)-1], tree.RangeLast(test.keyRange).Key, test.name)
+			}
+
+			keys := []int{}
+			it := tree.NewRangeOrderedIterator(test.keyRange, -1)
+			for it.Next() {
+				key, _ := it.GetKey()
+				keys = append(keys, key)
+			}
+
+			assert.Equalf(t, test.inRange, keys, test.name)
+
+			keys = []int{}
+			it = tree.NewRangeOrderedIterator(test.keyRange, len(test.inRange))
+			for it.Previous() {
+				key, _ := it.GetKey()
+				keys = append([]int{key}, keys...)
+			}
+
+			assert.Equalf(t, test.inRange, keys, test.name)
+
+			for _, key := range test.keys {
+				assert.Equalf(t, tree.InRange(test.keyRange, key), it.MoveToKey(key), test.name)
+			}
+		})
+	}
+}
+
+func TestRedBlackTreeIntersectRanges(t *testing.T) {
+	tests := []struct {
+		name         string
+		first        Range[int]
+		second       Range[int]
+		intersection Range[int]
+	}{
+		{
+			name:         "unbounded",
+			first:        Range[int]{},
+			second:       Range[int]{},
+			intersection: Range[int]{},
+		},
+		{
+			name:         "one unbounded",
+			first:        Range[int]{},
+			second:       NewRange(1, true, 5, false),
+			intersection: NewRange(1, true, 5, false),
+		},
+		{
+			name:         "nested",
+			first:        NewRange(1, true, 10, true),
+			second:       NewRange(3, false, 5, false),
+			intersection: NewRange(3, false, 5, false),
+		},
+		{
+			name:         "overlapping",
+			first:        NewRange(1, true, 5, true),
+			second:       NewRange(3, true, 10, true),
+			intersection: NewRange(3, true, 5, true),
+		},
+		{
+			name:         "equal bounds, mixed inclusiveness",
+			first:        NewRange(1, true, 5, false),
+			second:       NewRange(1, false, 5, true),
+			intersection: NewRange(1, false, 5, false),
+		},
+		{
+			name:         "head and tail",
+			first:        NewHeadRange(5, true),
+			second:       NewTailRange(2, false),
+			intersection: NewRange(2, false, 5, true),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			tree := New[int, int](utils.BasicComparator[int])
+
+			assert.Equalf(t, test.intersection, tree.IntersectRanges(test.first, test.second), test.name)
+			assert.Equalf(t, test.intersection, tree.IntersectRanges(test.second, test.first), test.name)
+		})
+	}
+}
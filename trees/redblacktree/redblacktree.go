@@ -13,6 +13,8 @@
 package redblacktree
 
 import (
+	"iter"
+
 	"github.com/JonasMuehlmann/datastructures.go/ds"
 	"github.com/JonasMuehlmann/datastructures.go/trees"
 	"github.com/JonasMuehlmann/datastructures.go/utils"
@@ -21,6 +23,10 @@ import (
 // Assert Tree implementation
 var _ trees.Tree[string, any] = (*Tree[string, any])(nil)
 
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Tree[string, any]] = (*Tree[string, any])(nil)
+var _ ds.Equatable[*Tree[string, any], any] = (*Tree[string, any])(nil)
+
 type color bool
 
 const (
@@ -32,6 +38,8 @@ type Tree[TKey comparable, TValue any] struct {
 	Root       *Node[TKey, TValue]
 	size       int
 	Comparator utils.Comparator[TKey]
+
+	modifications ds.ModificationCounter
 }
 
 // NewWith instantiates a red-black tree with the custom comparator.
@@ -87,7 +95,7 @@ func (tree *Tree[TKey, TValue]) Put(key TKey, value TValue) {
 	if tree.Root == nil {
 		// Assert key is of comparator's type for initial tree
 		tree.Comparator(key, key)
-		tree.Root = &Node[TKey, TValue]{Key: key, Value: value, color: red}
+		tree.Root = &Node[TKey, TValue]{Key: key, Value: value, color: red, size: 1}
 		insertedNode = tree.Root
 	} else {
 		node := tree.Root
@@ -103,7 +111,7 @@ func (tree *Tree[TKey, TValue]) Put(key TKey, value TValue) {
 				return
 			case compare < 0:
 				if node.Left == nil {
-					node.Left = &Node[TKey, TValue]{Key: key, Value: value, color: red}
+					node.Left = &Node[TKey, TValue]{Key: key, Value: value, color: red, size: 1}
 					insertedNode = node.Left
 					loop = false
 				} else {
@@ -111,7 +119,7 @@ func (tree *Tree[TKey, TValue]) Put(key TKey, value TValue) {
 				}
 			case compare > 0:
 				if node.Right == nil {
-					node.Right = &Node[TKey, TValue]{Key: key, Value: value, color: red}
+					node.Right = &Node[TKey, TValue]{Key: key, Value: value, color: red, size: 1}
 					insertedNode = node.Right
 					loop = false
 				} else {
@@ -121,10 +129,15 @@ func (tree *Tree[TKey, TValue]) Put(key TKey, value TValue) {
 		}
 
 		insertedNode.Parent = node
+
+		for ; node != nil; node = node.Parent {
+			node.size++
+		}
 	}
 
 	tree.insertCase1(insertedNode)
 	tree.size++
+	tree.modifications.Modified()
 }
 
 // Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
@@ -176,9 +189,14 @@ func (tree *Tree[TKey, TValue]) Remove(key TKey) {
 		if node.Parent == nil && child != nil {
 			child.color = black
 		}
+
+		for parent := node.Parent; parent != nil; parent = parent.Parent {
+			parent.size--
+		}
 	}
 
 	tree.size--
+	tree.modifications.Modified()
 }
 
 // Empty returns true if tree does not contain any nodes
@@ -311,10 +329,108 @@ func (tree *Tree[TKey, TValue]) Ceiling(key TKey) (ceiling *Node[TKey, TValue],
 	return nil, false
 }
 
+// Lower finds the lower node of the input key, return the lower node or nil if no lower node is found.
+// Second return parameter is true if a lower node was found, otherwise false.
+//
+// Lower node is defined as the largest node that is strictly smaller than the given node.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[TKey, TValue]) Lower(key TKey) (lower *Node[TKey, TValue], found bool) {
+	node := tree.Root
+
+	for node != nil {
+		if tree.Comparator(key, node.Key) > 0 {
+			lower, found = node, true
+			node = node.Right
+		} else {
+			node = node.Left
+		}
+	}
+
+	return lower, found
+}
+
+// Higher finds the higher node of the input key, return the higher node or nil if no higher node is found.
+// Second return parameter is true if a higher node was found, otherwise false.
+//
+// Higher node is defined as the smallest node that is strictly larger than the given node.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[TKey, TValue]) Higher(key TKey) (higher *Node[TKey, TValue], found bool) {
+	node := tree.Root
+
+	for node != nil {
+		if tree.Comparator(key, node.Key) < 0 {
+			higher, found = node, true
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+
+	return higher, found
+}
+
+// Rank returns the number of keys in the tree, which are strictly smaller than key.
+// Runs in O(log n).
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[TKey, TValue]) Rank(key TKey) int {
+	rank := 0
+	node := tree.Root
+
+	for node != nil {
+		if tree.Comparator(key, node.Key) <= 0 {
+			node = node.Left
+		} else {
+			rank += node.Left.Size() + 1
+			node = node.Right
+		}
+	}
+
+	return rank
+}
+
+// Select returns the node with the i-th smallest key (starting at 0) or nil if i is out of bounds.
+// Second return parameter is true if the node was found, otherwise false.
+// Runs in O(log n).
+func (tree *Tree[TKey, TValue]) Select(i int) (node *Node[TKey, TValue], found bool) {
+	if i < 0 || i >= tree.size {
+		return nil, false
+	}
+
+	node = tree.Root
+
+	for node != nil {
+		leftSize := node.Left.Size()
+
+		switch {
+		case i < leftSize:
+			node = node.Left
+		case i == leftSize:
+			return node, true
+		default:
+			i -= leftSize + 1
+			node = node.Right
+		}
+	}
+
+	return nil, false
+}
+
+// CountRange returns the number of keys in the tree, which are larger than or equal to lo and smaller than or equal to hi.
+// Runs in O(log n).
+//
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[TKey, TValue]) CountRange(lo TKey, hi TKey) int {
+	return tree.RangeSize(NewRange(lo, true, hi, true))
+}
+
 // Clear removes all nodes from the tree.
 func (tree *Tree[TKey, TValue]) Clear() {
 	tree.Root = nil
 	tree.size = 0
+	tree.modifications.Modified()
 }
 
 // String returns a string representation of container
@@ -328,6 +444,57 @@ func (tree *Tree[TKey, TValue]) ToString() string {
 	return str
 }
 
+// Clone returns a copy of the tree with the same shape, which does not share any nodes with the original.
+func (tree *Tree[TKey, TValue]) Clone() *Tree[TKey, TValue] {
+	return &Tree[TKey, TValue]{
+		Root:       cloneNode(tree.Root, nil),
+		size:       tree.size,
+		Comparator: tree.Comparator,
+	}
+}
+
+// Equals returns true if both trees hold equal key/value pairs.
+// Keys are compared with the tree's comparator, values with valueComparator.
+func (tree *Tree[TKey, TValue]) Equals(other *Tree[TKey, TValue], valueComparator utils.Comparator[TValue]) bool {
+	if tree.size != other.size {
+		return false
+	}
+
+	it := tree.OrderedBegin()
+	otherIt := other.OrderedBegin()
+
+	for it.Next() && otherIt.Next() {
+		key, _ := it.GetKey()
+		otherKey, _ := otherIt.GetKey()
+		value, _ := it.Get()
+		otherValue, _ := otherIt.Get()
+
+		if tree.Comparator(key, otherKey) != 0 || valueComparator(value, otherValue) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func cloneNode[TKey comparable, TValue any](node *Node[TKey, TValue], parent *Node[TKey, TValue]) *Node[TKey, TValue] {
+	if node == nil {
+		return nil
+	}
+
+	clone := &Node[TKey, TValue]{
+		Key:    node.Key,
+		Value:  node.Value,
+		color:  node.color,
+		Parent: parent,
+		size:   node.size,
+	}
+	clone.Left = cloneNode(node.Left, clone)
+	clone.Right = cloneNode(node.Right, clone)
+
+	return clone
+}
+
 func output[TKey comparable, TValue any](node *Node[TKey, TValue], prefix string, isTail bool, str *string) {
 	if node.Right != nil {
 		newPrefix := prefix
@@ -383,61 +550,17 @@ func (tree *Tree[TKey, TValue]) lookup(key TKey) *Node[TKey, TValue] {
 	return nil
 }
 
-func findLowestCommonAncestor[TKey comparable, TValue any](start, node1, node2 *Node[TKey, TValue]) *Node[TKey, TValue] {
-	if start == nil {
-		return nil
-	}
-
-	if start == node1 || start == node2 {
-		return start
-	}
+// rankOf returns the number of keys in the tree, which are strictly smaller than node's key.
+func rankOf[TKey comparable, TValue any](node *Node[TKey, TValue]) int {
+	rank := node.Left.Size()
 
-	leftChild := findLowestCommonAncestor(start.Left, node1, node2)
-	rightChild := findLowestCommonAncestor(start.Right, node1, node2)
-
-	if leftChild != nil && rightChild != nil {
-		return start
-	}
-
-	if leftChild == nil {
-		return rightChild
-	}
-
-	return leftChild
-}
-
-func getDistanceFromLCA[TKey comparable, TValue any](comparator utils.Comparator[TKey], lca, child *Node[TKey, TValue], distance int, originalToTargetOrdering int, isOriginal bool) int {
-	if lca == child {
-		return distance
-	}
-
-	if child == lca.Left || child == lca.Right {
-		return distance + 1
-	}
-
-	newDistance := distance
-
-	if comparator(child.Key, lca.Key) < 0 {
-		if originalToTargetOrdering < 0 {
-			newDistance += 1
+	for ; node.Parent != nil; node = node.Parent {
+		if node == node.Parent.Right {
+			rank += node.Parent.Left.Size() + 1
 		}
-
-		return getDistanceFromLCA(comparator, lca.Left, child, newDistance, originalToTargetOrdering, true)
-	}
-	if originalToTargetOrdering > 0 {
-		newDistance += 1
 	}
 
-	return getDistanceFromLCA(comparator, lca.Right, child, newDistance, originalToTargetOrdering, true)
-
-}
-
-func distanceBetween[TKey comparable, TValue any](comparator utils.Comparator[TKey], root, original, target *Node[TKey, TValue]) int {
-	distance := 0
-
-	lca := findLowestCommonAncestor(root, original, target)
-
-	return getDistanceFromLCA(comparator, lca, original, distance, comparator(original.Key, target.Key), true) + getDistanceFromLCA(comparator, lca, target, distance, comparator(original.Key, target.Key), false)
+	return rank
 }
 
 func (tree *Tree[TKey, TValue]) rotateLeft(node *Node[TKey, TValue]) {
@@ -451,6 +574,9 @@ func (tree *Tree[TKey, TValue]) rotateLeft(node *Node[TKey, TValue]) {
 
 	right.Left = node
 	node.Parent = right
+
+	node.updateSize()
+	right.updateSize()
 }
 
 func (tree *Tree[TKey, TValue]) rotateRight(node *Node[TKey, TValue]) {
@@ -464,6 +590,9 @@ func (tree *Tree[TKey, TValue]) rotateRight(node *Node[TKey, TValue]) {
 
 	left.Right = node
 	node.Parent = left
+
+	node.updateSize()
+	left.updateSize()
 }
 
 func (tree *Tree[TKey, TValue]) replaceNode(old *Node[TKey, TValue], new *Node[TKey, TValue]) {
@@ -657,3 +786,61 @@ func (tree *Tree[TKey, TValue]) OrderedFirst() ds.ReadWriteOrdCompBidRandCollIte
 func (tree *Tree[TKey, TValue]) OrderedLast() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
 	return tree.NewOrderedIterator(tree.Size()-1, tree.Size())
 }
+
+//******************************************************************//
+//                         Reverse iterator                         //
+//******************************************************************//
+
+// ReverseBegin returns an initialized, reversed iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (tree *Tree[TKey, TValue]) ReverseBegin() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(tree.OrderedEnd())
+}
+
+// ReverseEnd returns an initialized, reversed iterator, which points to one element after it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (tree *Tree[TKey, TValue]) ReverseEnd() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(tree.OrderedBegin())
+}
+
+// ReverseFirst returns an initialized, reversed iterator, which points to it's first element.
+func (tree *Tree[TKey, TValue]) ReverseFirst() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(tree.OrderedLast())
+}
+
+// ReverseLast returns an initialized, reversed iterator, which points to it's last element.
+func (tree *Tree[TKey, TValue]) ReverseLast() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return ds.NewReverseIterator(tree.OrderedFirst())
+}
+
+// All returns a sequence over the key/value pairs of the tree in key order.
+func (tree *Tree[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.Seq2(tree.OrderedBegin())(yield)
+	}
+}
+
+// Backward returns a sequence over the key/value pairs of the tree in reverse key order.
+func (tree *Tree[TKey, TValue]) Backward() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.BackwardSeq2(tree.OrderedEnd())(yield)
+	}
+}
+
+// Keys returns a sequence over the keys of the tree in order.
+func (tree *Tree[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return func(yield func(TKey) bool) {
+		for key := range tree.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the tree in key order.
+func (tree *Tree[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		ds.Seq(tree.OrderedBegin())(yield)
+	}
+}
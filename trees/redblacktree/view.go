@@ -0,0 +1,141 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package redblacktree
+
+// View is a live view of the nodes of a tree, whose keys lie within a range.
+// It implements the range logic of the views of tree backed maps, which only add the map specific modifications.
+type View[TKey comparable, TValue any] struct {
+	tree     *Tree[TKey, TValue]
+	keyRange Range[TKey]
+}
+
+// NewView returns a live view of the nodes of the tree, whose keys lie within keyRange.
+func (tree *Tree[TKey, TValue]) NewView(keyRange Range[TKey]) View[TKey, TValue] {
+	return View[TKey, TValue]{tree: tree, keyRange: keyRange}
+}
+
+// SubView returns a live view of the nodes, whose keys lie within keyRange and the view's range.
+func (v View[TKey, TValue]) SubView(keyRange Range[TKey]) View[TKey, TValue] {
+	return View[TKey, TValue]{tree: v.tree, keyRange: v.tree.IntersectRanges(v.keyRange, keyRange)}
+}
+
+// Contains checks if key lies within the view's range.
+func (v View[TKey, TValue]) Contains(key TKey) bool {
+	return v.tree.InRange(v.keyRange, key)
+}
+
+// ContainsAll checks if all keys lie within the view's range.
+func (v View[TKey, TValue]) ContainsAll(keys []TKey) bool {
+	for _, key := range keys {
+		if !v.Contains(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsEmpty returns true if no node of the tree lies within the view's range.
+func (v View[TKey, TValue]) IsEmpty() bool {
+	return v.First() == nil
+}
+
+// Size returns the number of nodes within the view's range in O(log n).
+func (v View[TKey, TValue]) Size() int {
+	return v.tree.RangeSize(v.keyRange)
+}
+
+// GetKeys returns the keys within the view's range in-order.
+func (v View[TKey, TValue]) GetKeys() []TKey {
+	keys := make([]TKey, 0)
+
+	it := v.NewOrderedIterator(-1)
+	for it.Next() {
+		key, _ := it.GetKey()
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// GetValues returns the values of the nodes within the view's range in-order based on the key.
+func (v View[TKey, TValue]) GetValues() []TValue {
+	values := make([]TValue, 0)
+
+	it := v.NewOrderedIterator(-1)
+	for it.Next() {
+		value, _ := it.Get()
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// First returns the smallest node within the view's range or nil if there is none.
+func (v View[TKey, TValue]) First() *Node[TKey, TValue] {
+	return v.tree.RangeFirst(v.keyRange)
+}
+
+// Last returns the largest node within the view's range or nil if there is none.
+func (v View[TKey, TValue]) Last() *Node[TKey, TValue] {
+	return v.tree.RangeLast(v.keyRange)
+}
+
+// Lower returns the largest node within the view's range, whose key is strictly smaller than key, or nil if there is none.
+func (v View[TKey, TValue]) Lower(key TKey) *Node[TKey, TValue] {
+	node, _ := v.tree.Lower(key)
+
+	return v.clampBelow(node)
+}
+
+// Higher returns the smallest node within the view's range, whose key is strictly larger than key, or nil if there is none.
+func (v View[TKey, TValue]) Higher(key TKey) *Node[TKey, TValue] {
+	node, _ := v.tree.Higher(key)
+
+	return v.clampAbove(node)
+}
+
+// Floor returns the largest node within the view's range, whose key is smaller than or equal to key, or nil if there is none.
+func (v View[TKey, TValue]) Floor(key TKey) *Node[TKey, TValue] {
+	node, _ := v.tree.Floor(key)
+
+	return v.clampBelow(node)
+}
+
+// Ceiling returns the smallest node within the view's range, whose key is larger than or equal to key, or nil if there is none.
+func (v View[TKey, TValue]) Ceiling(key TKey) *Node[TKey, TValue] {
+	node, _ := v.tree.Ceiling(key)
+
+	return v.clampAbove(node)
+}
+
+// NewOrderedIterator returns a stateful iterator over the nodes within the view's range.
+func (v View[TKey, TValue]) NewOrderedIterator(position int) *OrderedIterator[TKey, TValue] {
+	return v.tree.NewRangeOrderedIterator(v.keyRange, position)
+}
+
+// clampBelow maps a node, which is smaller than some key, to the largest node of the view, which is smaller than that key.
+func (v View[TKey, TValue]) clampBelow(node *Node[TKey, TValue]) *Node[TKey, TValue] {
+	switch {
+	case node == nil || v.tree.IsBelowRange(v.keyRange, node.Key):
+		return nil
+	case v.tree.IsAboveRange(v.keyRange, node.Key):
+		return v.Last()
+	default:
+		return node
+	}
+}
+
+// clampAbove maps a node, which is larger than some key, to the smallest node of the view, which is larger than that key.
+func (v View[TKey, TValue]) clampAbove(node *Node[TKey, TValue]) *Node[TKey, TValue] {
+	switch {
+	case node == nil || v.tree.IsAboveRange(v.keyRange, node.Key):
+		return nil
+	case v.tree.IsBelowRange(v.keyRange, node.Key):
+		return v.First()
+	default:
+		return node
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package redblacktree
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedBlackTreeView(t *testing.T) {
+	tree := NewFromMap[int, string](utils.BasicComparator[int], map[int]string{0: "a", 2: "b", 4: "c", 6: "d", 8: "e"})
+	view := tree.NewView(NewRange(1, true, 7, false))
+
+	assert.Equal(t, []int{2, 4, 6}, view.GetKeys())
+	assert.Equal(t, []string{"b", "c", "d"}, view.GetValues())
+	assert.Equal(t, 3, view.Size())
+	assert.False(t, view.IsEmpty())
+	assert.True(t, view.ContainsAll([]int{1, 3, 6}))
+	assert.False(t, view.ContainsAll([]int{1, 7}))
+
+	// Neighbours outside of the range are clamped to the range
+	assert.Equal(t, 6, view.Lower(10).Key)
+	assert.Equal(t, 2, view.Higher(-5).Key)
+	assert.Equal(t, 4, view.Floor(5).Key)
+	assert.Equal(t, 4, view.Ceiling(3).Key)
+	assert.Nil(t, view.Lower(2))
+	assert.Nil(t, view.Higher(6))
+
+	subView := view.SubView(NewTailRange(4, false))
+	assert.Equal(t, []int{6}, subView.GetKeys())
+	assert.Equal(t, 6, subView.First().Key)
+	assert.Equal(t, 6, subView.Last().Key)
+
+	tree.Put(5, "x")
+	assert.Equal(t, []int{5, 6}, subView.GetKeys())
+
+	assert.True(t, view.SubView(NewRange(6, false, 7, false)).IsEmpty())
+}
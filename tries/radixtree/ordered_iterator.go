@@ -0,0 +1,436 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixtree
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/tries"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Iterator implementation
+var _ ds.ReadWriteOrdCompBidRandCollIterator[string, any] = (*OrderedIterator[string, any])(nil)
+
+// Assert FailFastIterator implementation
+var _ ds.FailFastIterator = (*OrderedIterator[string, any])(nil)
+
+// Assert CheckedIterator implementation
+var _ ds.CheckedIterator = (*OrderedIterator[string, any])(nil)
+
+// OrderedIterator holding the iterator's state
+//
+// Inserting or removing keys invalidates the iterator, because nodes may be split or merged, which is reported by Err.
+type OrderedIterator[TKey tries.Key, TValue any] struct {
+	tree *Tree[TKey, TValue]
+	// Root of the subtree the iterator is restricted to, nil if it is empty
+	root  *node[TValue]
+	node  *node[TValue]
+	index int
+	size  int
+	// Redundant but has better locality
+	key   TKey
+	guard ds.ModificationGuard
+}
+
+// NewOrderedIterator returns a stateful iterator whose elements are key/value pairs.
+func (tree *Tree[TKey, TValue]) NewOrderedIterator(position int) *OrderedIterator[TKey, TValue] {
+	return tree.newIterator(tree.root, position)
+}
+
+// NewPrefixIterator returns a stateful iterator, which only iterates the key/value pairs whose keys start with prefix.
+func (tree *Tree[TKey, TValue]) NewPrefixIterator(prefix TKey, position int) *OrderedIterator[TKey, TValue] {
+	return tree.newIterator(tree.lookupPrefix(string(prefix)), position)
+}
+
+func (tree *Tree[TKey, TValue]) newIterator(root *node[TValue], position int) *OrderedIterator[TKey, TValue] {
+	it := &OrderedIterator[TKey, TValue]{tree: tree, root: root, guard: tree.modifications.Guard()}
+	if root != nil {
+		it.size = root.size
+	}
+
+	it.MoveTo(position)
+
+	return it
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsBegin() bool {
+	return it.index <= -1
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsEnd() bool {
+	return it.size == 0 || it.index >= it.size
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsLast() bool {
+	return it.index == it.size-1
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsValid() bool {
+	return it.size > 0 && !it.IsBegin() && !it.IsEnd() && it.guard.Err() == nil
+}
+
+// Err returns ds.ErrConcurrentModification if the tree was structurally modified after the iterator was created.
+func (it *OrderedIterator[TKey, TValue]) Err() error {
+	return it.guard.Err()
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsEqual(other ds.ComparableIterator) bool {
+	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) == 0
+}
+
+// CanCompare checks if other is an iterator of the same tree.
+func (it *OrderedIterator[TKey, TValue]) CanCompare(other ds.Iterator) error {
+	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
+	if !ok {
+		return ds.NewIteratorError(it, other, ds.ErrIncompatibleIterators)
+	}
+
+	if otherThis.tree != it.tree {
+		return ds.NewIteratorError(it, other, ds.ErrForeignIterators)
+	}
+
+	return nil
+}
+
+func (it *OrderedIterator[TKey, TValue]) DistanceTo(other ds.OrderedIterator) int {
+	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.index - otherThis.index
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsAfter(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) > 0
+}
+
+func (it *OrderedIterator[TKey, TValue]) IsBefore(other ds.OrderedIterator) bool {
+	otherThis, ok := other.(*OrderedIterator[TKey, TValue])
+	if !ok {
+		panic(ds.CanOnlyCompareEqualIteratorTypes)
+	}
+
+	return it.DistanceTo(otherThis) < 0
+}
+
+func (it *OrderedIterator[TKey, TValue]) Size() int {
+	return it.size
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+func (it *OrderedIterator[TKey, TValue]) Next() bool {
+	if it.guard.Err() != nil {
+		return false
+	}
+
+	if it.IsLast() || it.IsEnd() {
+		it.index = it.size
+
+		return false
+	}
+
+	if it.IsBegin() {
+		return it.MoveTo(0)
+	}
+
+	it.index++
+	it.setNode(it.successor(it.node))
+
+	return true
+}
+
+func (it *OrderedIterator[TKey, TValue]) NextN(n int) bool {
+	if n <= 0 {
+		return false
+	}
+
+	return it.MoveTo(utils.Min(it.index+n, it.size))
+}
+
+// Previous moves the iterator to the previous element and returns true if there was a previous element in the container.
+func (it *OrderedIterator[TKey, TValue]) Previous() bool {
+	if it.guard.Err() != nil {
+		return false
+	}
+
+	if it.IsFirst() || it.IsBegin() {
+		it.index = -1
+
+		return false
+	}
+
+	if it.IsEnd() {
+		return it.MoveTo(it.size - 1)
+	}
+
+	it.index--
+	it.setNode(it.predecessor(it.node))
+
+	return true
+}
+
+func (it *OrderedIterator[TKey, TValue]) PreviousN(n int) bool {
+	if n <= 0 {
+		return false
+	}
+
+	return it.MoveTo(utils.Max(it.index-n, -1))
+}
+
+func (it *OrderedIterator[TKey, TValue]) MoveBy(n int) bool {
+	if n > 0 {
+		return it.NextN(n)
+	} else if n < 0 {
+		return it.PreviousN(-n)
+	}
+
+	return it.IsValid()
+}
+
+// MoveTo moves the iterator to the n-th element in O(k * d), where k is the length of the key and d the number of distinct bytes.
+func (it *OrderedIterator[TKey, TValue]) MoveTo(n int) bool {
+	if it.guard.Err() != nil {
+		return false
+	}
+
+	switch {
+	case n < 0:
+		it.index = -1
+		it.node = nil
+
+		return false
+	case n >= it.size:
+		it.index = it.size
+		it.node = nil
+
+		return false
+	}
+
+	it.index = n
+	it.setNode(it.selectNode(n))
+
+	return true
+}
+
+// MoveToKey moves the iterator to the element with the given key.
+func (it *OrderedIterator[TKey, TValue]) MoveToKey(key TKey) (found bool) {
+	if it.guard.Err() != nil {
+		return false
+	}
+
+	n := it.tree.lookup(string(key))
+	if n == nil || !n.hasValue || !it.contains(n) {
+		return false
+	}
+
+	it.index = it.rankOf(n)
+	it.setNode(n)
+
+	return true
+}
+
+func (it *OrderedIterator[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.node.value, true
+}
+
+func (it *OrderedIterator[TKey, TValue]) Set(value TValue) bool {
+	if !it.IsValid() {
+		return false
+	}
+
+	it.node.value = value
+
+	return true
+}
+
+func (it *OrderedIterator[TKey, TValue]) GetAt(i int) (value TValue, found bool) {
+	tmp := *it
+	tmp.MoveTo(i)
+
+	return tmp.Get()
+}
+
+func (it *OrderedIterator[TKey, TValue]) SetAt(i int, value TValue) bool {
+	tmp := *it
+	tmp.MoveTo(i)
+
+	return tmp.Set(value)
+}
+
+func (it *OrderedIterator[TKey, TValue]) GetAtKey(key TKey) (value TValue, found bool) {
+	tmp := *it
+	if !tmp.MoveToKey(key) {
+		return
+	}
+
+	return tmp.Get()
+}
+
+func (it *OrderedIterator[TKey, TValue]) SetAtKey(key TKey, value TValue) bool {
+	tmp := *it
+	if !tmp.MoveToKey(key) {
+		return false
+	}
+
+	return tmp.Set(value)
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (it *OrderedIterator[TKey, TValue]) Index() (index int, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.index, true
+}
+
+// GetKey returns the current element's key.
+// Does not modify the state of the iterator.
+func (it *OrderedIterator[TKey, TValue]) GetKey() (key TKey, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.key, true
+}
+
+func (it *OrderedIterator[TKey, TValue]) setNode(n *node[TValue]) {
+	it.node = n
+	it.key = TKey(n.key())
+}
+
+// successor returns the node holding the next value in-order.
+func (it *OrderedIterator[TKey, TValue]) successor(n *node[TValue]) *node[TValue] {
+	if len(n.children) > 0 {
+		return firstValued(n.children[0])
+	}
+
+	for n != it.root {
+		parent := n.parent
+
+		i, _ := parent.childIndex(n.prefix[0])
+		if i+1 < len(parent.children) {
+			return firstValued(parent.children[i+1])
+		}
+
+		n = parent
+	}
+
+	return nil
+}
+
+// predecessor returns the node holding the previous value in-order.
+func (it *OrderedIterator[TKey, TValue]) predecessor(n *node[TValue]) *node[TValue] {
+	for n != it.root {
+		parent := n.parent
+
+		i, _ := parent.childIndex(n.prefix[0])
+		if i > 0 {
+			return lastValued(parent.children[i-1])
+		}
+
+		if parent.hasValue {
+			return parent
+		}
+
+		n = parent
+	}
+
+	return nil
+}
+
+// selectNode returns the node holding the i-th value of the iterator's subtree.
+func (it *OrderedIterator[TKey, TValue]) selectNode(i int) *node[TValue] {
+	n := it.root
+
+	for {
+		if n.hasValue {
+			if i == 0 {
+				return n
+			}
+
+			i--
+		}
+
+		for _, child := range n.children {
+			if i < child.size {
+				n = child
+
+				break
+			}
+
+			i -= child.size
+		}
+	}
+}
+
+// rankOf returns the number of values of the iterator's subtree, which come before n.
+func (it *OrderedIterator[TKey, TValue]) rankOf(n *node[TValue]) int {
+	rank := 0
+
+	for ; n != it.root; n = n.parent {
+		parent := n.parent
+		if parent.hasValue {
+			rank++
+		}
+
+		i, _ := parent.childIndex(n.prefix[0])
+		for _, sibling := range parent.children[:i] {
+			rank += sibling.size
+		}
+	}
+
+	return rank
+}
+
+// contains returns true if n is part of the iterator's subtree.
+func (it *OrderedIterator[TKey, TValue]) contains(n *node[TValue]) bool {
+	for ; n != nil; n = n.parent {
+		if n == it.root {
+			return true
+		}
+	}
+
+	return false
+}
+
+// firstValued returns the first node holding a value in the subtree rooted at n.
+func firstValued[TValue any](n *node[TValue]) *node[TValue] {
+	for !n.hasValue {
+		n = n.children[0]
+	}
+
+	return n
+}
+
+// lastValued returns the last node holding a value in the subtree rooted at n.
+func lastValued[TValue any](n *node[TValue]) *node[TValue] {
+	for len(n.children) > 0 {
+		n = n.children[len(n.children)-1]
+	}
+
+	return n
+}
@@ -0,0 +1,548 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package radixtree implements a compressed trie (radix tree) keyed by strings or byte slices.
+//
+// Every edge of the tree is labeled with a sequence of bytes and nodes with a single child are merged with it,
+// so that the tree holds at most 2n nodes for n keys.
+// Looking up, inserting and removing a key runs in O(k), where k is the length of the key.
+//
+// Keys are ordered byte-wise (lexicographically), the empty key is a valid key.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Radix_tree
+package radixtree
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/maps"
+	"github.com/JonasMuehlmann/datastructures.go/tries"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Trie implementation
+var _ tries.Trie[string, any] = (*Tree[string, any])(nil)
+var _ tries.Trie[[]byte, any] = (*Tree[[]byte, any])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*Tree[string, any]] = (*Tree[string, any])(nil)
+var _ ds.Equatable[*Tree[string, any], any] = (*Tree[string, any])(nil)
+
+// Tree holds the root node of the radix tree.
+type Tree[TKey tries.Key, TValue any] struct {
+	root *node[TValue]
+
+	modifications ds.ModificationCounter
+}
+
+type node[TValue any] struct {
+	// prefix is the label of the edge from the parent to the node.
+	prefix string
+	// children are ordered by the first byte of their prefix.
+	children []*node[TValue]
+	parent   *node[TValue]
+	value    TValue
+	hasValue bool
+	// Number of values in the subtree rooted at this node
+	size int
+}
+
+// New instantiates an empty radix tree.
+func New[TKey tries.Key, TValue any]() *Tree[TKey, TValue] {
+	return &Tree[TKey, TValue]{root: &node[TValue]{}}
+}
+
+// NewFromMap instantiates a new radix tree containing the provided map.
+func NewFromMap[TKey tries.Key, TValue any](map_ map[string]TValue) *Tree[TKey, TValue] {
+	tree := New[TKey, TValue]()
+
+	for k, v := range map_ {
+		tree.Put(TKey(k), v)
+	}
+
+	return tree
+}
+
+// NewFromIterator instantiates a new radix tree containing the elements provided by the passed iterator.
+func NewFromIterator[TKey tries.Key, TValue any](begin ds.ReadForIndexIterator[TKey, TValue]) *Tree[TKey, TValue] {
+	tree := New[TKey, TValue]()
+
+	for begin.Next() {
+		newKey, _ := begin.GetKey()
+		newValue, _ := begin.Get()
+
+		tree.Put(newKey, newValue)
+	}
+
+	return tree
+}
+
+// NewFromIterators instantiates a new radix tree containing the elements provided by first, until it is equal to end.
+// end is a sentinel and not included.
+func NewFromIterators[TKey tries.Key, TValue any](begin ds.ReadCompForIndexIterator[TKey, TValue], end ds.CompIndexIterator[TKey]) *Tree[TKey, TValue] {
+	tree := New[TKey, TValue]()
+
+	for !begin.IsEqual(end) && begin.Next() {
+		newKey, _ := begin.GetKey()
+		newValue, _ := begin.Get()
+
+		tree.Put(newKey, newValue)
+	}
+
+	return tree
+}
+
+// MergeWith inserts all elements of other into the tree.
+// If a key is contained in both maps, the tree is left unchanged and false is returned.
+func (tree *Tree[TKey, TValue]) MergeWith(other *maps.Map[TKey, TValue]) bool {
+	keys := (*other).GetKeys()
+
+	for _, key := range keys {
+		if _, found := tree.Get(key); found {
+			return false
+		}
+	}
+
+	for _, key := range keys {
+		value, _ := (*other).Get(key)
+		tree.Put(key, value)
+	}
+
+	return true
+}
+
+// MergeWithSafe inserts all elements of other into the tree.
+// If a key is contained in both maps, other's value is used if overwriteOriginal is true, otherwise the original value is kept.
+func (tree *Tree[TKey, TValue]) MergeWithSafe(other *maps.Map[TKey, TValue], overwriteOriginal bool) {
+	for _, key := range (*other).GetKeys() {
+		if _, found := tree.Get(key); found && !overwriteOriginal {
+			continue
+		}
+
+		value, _ := (*other).Get(key)
+		tree.Put(key, value)
+	}
+}
+
+// Put inserts key-value pair into the tree or updates the value if key is already contained.
+func (tree *Tree[TKey, TValue]) Put(key TKey, value TValue) {
+	n := tree.root
+	remainder := string(key)
+
+	for len(remainder) > 0 {
+		i, found := n.childIndex(remainder[0])
+		if !found {
+			child := &node[TValue]{prefix: remainder, parent: n}
+			n.children = append(n.children, nil)
+			copy(n.children[i+1:], n.children[i:])
+			n.children[i] = child
+
+			n = child
+
+			break
+		}
+
+		child := n.children[i]
+
+		common := commonPrefixLength(remainder, child.prefix)
+		if common < len(child.prefix) {
+			// Split the edge, so that the common prefix ends at a node
+			middle := &node[TValue]{
+				prefix:   child.prefix[:common],
+				children: []*node[TValue]{child},
+				parent:   n,
+				size:     child.size,
+			}
+
+			child.prefix = child.prefix[common:]
+			child.parent = middle
+			n.children[i] = middle
+
+			child = middle
+		}
+
+		remainder = remainder[common:]
+		n = child
+	}
+
+	if !n.hasValue {
+		for ancestor := n; ancestor != nil; ancestor = ancestor.parent {
+			ancestor.size++
+		}
+
+		tree.modifications.Modified()
+	}
+
+	n.value = value
+	n.hasValue = true
+}
+
+// Get searches the element in the tree by key and returns its value or nil if key is not found in tree.
+// Second return parameter is true if key was found, otherwise false.
+func (tree *Tree[TKey, TValue]) Get(key TKey) (value TValue, found bool) {
+	n := tree.lookup(string(key))
+	if n == nil || !n.hasValue {
+		return
+	}
+
+	return n.value, true
+}
+
+// Remove removes the element from the tree by key.
+// The comparator is not used and may be nil.
+func (tree *Tree[TKey, TValue]) Remove(comparator utils.Comparator[TKey], key TKey) {
+	n := tree.lookup(string(key))
+	if n == nil || !n.hasValue {
+		return
+	}
+
+	var zero TValue
+	n.value = zero
+	n.hasValue = false
+
+	for ancestor := n; ancestor != nil; ancestor = ancestor.parent {
+		ancestor.size--
+	}
+
+	tree.compact(n)
+	tree.modifications.Modified()
+}
+
+// LongestPrefixOf returns the longest key in the tree, which is a prefix of key (or key itself), and its value.
+// Third return parameter is true if such a key was found, otherwise false.
+func (tree *Tree[TKey, TValue]) LongestPrefixOf(key TKey) (prefix TKey, value TValue, found bool) {
+	remainder := string(key)
+	consumed := 0
+	longest := -1
+
+	n := tree.root
+	for {
+		if n.hasValue {
+			longest = consumed
+			value = n.value
+		}
+
+		if len(remainder) == 0 {
+			break
+		}
+
+		i, ok := n.childIndex(remainder[0])
+		if !ok || !strings.HasPrefix(remainder, n.children[i].prefix) {
+			break
+		}
+
+		n = n.children[i]
+		consumed += len(n.prefix)
+		remainder = remainder[len(n.prefix):]
+	}
+
+	if longest == -1 {
+		return
+	}
+
+	return TKey(string(key)[:longest]), value, true
+}
+
+// WalkPrefix returns an iterator over the elements whose keys start with prefix, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (tree *Tree[TKey, TValue]) WalkPrefix(prefix TKey) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return tree.NewPrefixIterator(prefix, -1)
+}
+
+// KeysWithPrefix returns all keys starting with prefix in-order.
+func (tree *Tree[TKey, TValue]) KeysWithPrefix(prefix TKey) []TKey {
+	it := tree.NewPrefixIterator(prefix, -1)
+
+	keys := make([]TKey, 0, it.Size())
+	for it.Next() {
+		key, _ := it.GetKey()
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// CountPrefix returns the number of keys starting with prefix in O(k), where k is the length of prefix.
+func (tree *Tree[TKey, TValue]) CountPrefix(prefix TKey) int {
+	n := tree.lookupPrefix(string(prefix))
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+// Empty returns true if tree does not contain any elements.
+func (tree *Tree[TKey, TValue]) IsEmpty() bool {
+	return tree.root.size == 0
+}
+
+// Size returns number of elements in the tree.
+func (tree *Tree[TKey, TValue]) Size() int {
+	return tree.root.size
+}
+
+// GetKeys returns all keys in-order.
+func (tree *Tree[TKey, TValue]) GetKeys() []TKey {
+	keys := make([]TKey, 0, tree.Size())
+
+	it := tree.OrderedBegin()
+	for it.Next() {
+		key, _ := it.GetKey()
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// GetValues returns all values in-order based on the key.
+func (tree *Tree[TKey, TValue]) GetValues() []TValue {
+	values := make([]TValue, 0, tree.Size())
+
+	it := tree.OrderedBegin()
+	for it.Next() {
+		value, _ := it.Get()
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// Clear removes all elements from the tree.
+func (tree *Tree[TKey, TValue]) Clear() {
+	tree.root = &node[TValue]{}
+	tree.modifications.Modified()
+}
+
+// String returns a string representation of container
+func (tree *Tree[TKey, TValue]) ToString() string {
+	str := "RadixTree\nmap["
+	it := tree.OrderedBegin()
+	for it.Next() {
+		key, _ := it.GetKey()
+		value, _ := it.Get()
+
+		str += fmt.Sprintf("%v:%v ", string(key), value)
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// Clone returns a copy of the tree with the same shape, which does not share any nodes with the original.
+func (tree *Tree[TKey, TValue]) Clone() *Tree[TKey, TValue] {
+	return &Tree[TKey, TValue]{root: tree.root.clone(nil)}
+}
+
+// Equals returns true if both trees hold equal key/value pairs.
+// Keys are compared byte-wise, values with valueComparator.
+func (tree *Tree[TKey, TValue]) Equals(other *Tree[TKey, TValue], valueComparator utils.Comparator[TValue]) bool {
+	if tree.Size() != other.Size() {
+		return false
+	}
+
+	it := tree.OrderedBegin()
+	otherIt := other.OrderedBegin()
+
+	for it.Next() && otherIt.Next() {
+		key, _ := it.GetKey()
+		otherKey, _ := otherIt.GetKey()
+		value, _ := it.Get()
+		otherValue, _ := otherIt.Get()
+
+		if string(key) != string(otherKey) || valueComparator(value, otherValue) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lookup returns the node whose key is equal to key or nil if there is no such node.
+// The node does not necessarily hold a value.
+func (tree *Tree[TKey, TValue]) lookup(key string) *node[TValue] {
+	n := tree.root
+
+	for len(key) > 0 {
+		i, found := n.childIndex(key[0])
+		if !found || !strings.HasPrefix(key, n.children[i].prefix) {
+			return nil
+		}
+
+		n = n.children[i]
+		key = key[len(n.prefix):]
+	}
+
+	return n
+}
+
+// lookupPrefix returns the root of the subtree containing exactly the keys starting with prefix or nil if there are no such keys.
+func (tree *Tree[TKey, TValue]) lookupPrefix(prefix string) *node[TValue] {
+	n := tree.root
+
+	for len(prefix) > 0 {
+		i, found := n.childIndex(prefix[0])
+		if !found {
+			return nil
+		}
+
+		child := n.children[i]
+
+		switch {
+		case strings.HasPrefix(prefix, child.prefix):
+			prefix = prefix[len(child.prefix):]
+			n = child
+		case strings.HasPrefix(child.prefix, prefix):
+			return child
+		default:
+			return nil
+		}
+	}
+
+	if n.size == 0 {
+		return nil
+	}
+
+	return n
+}
+
+// compact removes n if it is a leaf without value and merges it with its only child if it has exactly one.
+func (tree *Tree[TKey, TValue]) compact(n *node[TValue]) {
+	if n == tree.root || n.hasValue {
+		return
+	}
+
+	parent := n.parent
+	i, _ := parent.childIndex(n.prefix[0])
+
+	switch len(n.children) {
+	case 0:
+		parent.children = append(parent.children[:i], parent.children[i+1:]...)
+
+		tree.compact(parent)
+	case 1:
+		child := n.children[0]
+		child.prefix = n.prefix + child.prefix
+		child.parent = parent
+
+		parent.children[i] = child
+	}
+}
+
+// key returns the key of n, which is the concatenation of the prefixes from the root to n.
+func (n *node[TValue]) key() string {
+	prefixes := []string{}
+	for ; n != nil; n = n.parent {
+		prefixes = append(prefixes, n.prefix)
+	}
+
+	var builder strings.Builder
+	for i := len(prefixes) - 1; i >= 0; i-- {
+		builder.WriteString(prefixes[i])
+	}
+
+	return builder.String()
+}
+
+// clone returns a copy of the subtree rooted at n, which is attached to parent.
+func (n *node[TValue]) clone(parent *node[TValue]) *node[TValue] {
+	clone := &node[TValue]{
+		prefix:   n.prefix,
+		children: make([]*node[TValue], len(n.children)),
+		parent:   parent,
+		value:    n.value,
+		hasValue: n.hasValue,
+		size:     n.size,
+	}
+
+	for i, child := range n.children {
+		clone.children[i] = child.clone(clone)
+	}
+
+	return clone
+}
+
+// childIndex returns the index of the child whose prefix starts with b or the index to insert such a child at.
+// Second return parameter is true if the child was found, otherwise false.
+func (n *node[TValue]) childIndex(b byte) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].prefix[0] >= b
+	})
+
+	return i, i < len(n.children) && n.children[i].prefix[0] == b
+}
+
+func commonPrefixLength(a string, b string) int {
+	length := utils.Min(len(a), len(b))
+
+	for i := 0; i < length; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+
+	return length
+}
+
+//******************************************************************//
+//                         Ordered iterator                         //
+//******************************************************************//
+
+// OrderedBegin returns an initialized iterator, which points to one element before it's first.
+// Unless Next() is called, the iterator is in an invalid state.
+func (tree *Tree[TKey, TValue]) OrderedBegin() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return tree.NewOrderedIterator(-1)
+}
+
+// OrderedEnd returns an initialized iterator, which points to one element afrer it's last.
+// Unless Previous() is called, the iterator is in an invalid state.
+func (tree *Tree[TKey, TValue]) OrderedEnd() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return tree.NewOrderedIterator(tree.Size())
+}
+
+// OrderedFirst returns an initialized iterator, which points to it's first element.
+func (tree *Tree[TKey, TValue]) OrderedFirst() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return tree.NewOrderedIterator(0)
+}
+
+// OrderedLast returns an initialized iterator, which points to it's last element.
+func (tree *Tree[TKey, TValue]) OrderedLast() ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue] {
+	return tree.NewOrderedIterator(tree.Size() - 1)
+}
+
+// All returns a sequence over the key/value pairs of the tree in key order.
+func (tree *Tree[TKey, TValue]) All() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.Seq2(tree.OrderedBegin())(yield)
+	}
+}
+
+// Backward returns a sequence over the key/value pairs of the tree in reverse key order.
+func (tree *Tree[TKey, TValue]) Backward() iter.Seq2[TKey, TValue] {
+	return func(yield func(TKey, TValue) bool) {
+		ds.BackwardSeq2(tree.OrderedEnd())(yield)
+	}
+}
+
+// Keys returns a sequence over the keys of the tree in order.
+func (tree *Tree[TKey, TValue]) Keys() iter.Seq[TKey] {
+	return func(yield func(TKey) bool) {
+		for key := range tree.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the values of the tree in key order.
+func (tree *Tree[TKey, TValue]) Values() iter.Seq[TValue] {
+	return func(yield func(TValue) bool) {
+		ds.Seq(tree.OrderedBegin())(yield)
+	}
+}
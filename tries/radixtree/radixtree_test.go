@@ -0,0 +1,612 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixtree
+
+import (
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/maps"
+	"github.com/JonasMuehlmann/datastructures.go/maps/hashmap"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFromKeys(keys ...string) *Tree[string, int] {
+	tree := New[string, int]()
+	for i, key := range keys {
+		tree.Put(key, i)
+	}
+
+	return tree
+}
+
+func TestRadixTreePut(t *testing.T) {
+	tests := []struct {
+		name  string
+		tree  *Tree[string, int]
+		key   string
+		value int
+		keys  []string
+	}{
+		{
+			name:  "empty tree",
+			tree:  newFromKeys(),
+			key:   "foo",
+			value: 10,
+			keys:  []string{"foo"},
+		},
+		{
+			name:  "empty key",
+			tree:  newFromKeys("foo"),
+			key:   "",
+			value: 10,
+			keys:  []string{"", "foo"},
+		},
+		{
+			name:  "split edge",
+			tree:  newFromKeys("foobar"),
+			key:   "foobaz",
+			value: 10,
+			keys:  []string{"foobar", "foobaz"},
+		},
+		{
+			name:  "prefix of existing key",
+			tree:  newFromKeys("foobar"),
+			key:   "foo",
+			value: 10,
+			keys:  []string{"foo", "foobar"},
+		},
+		{
+			name:  "extension of existing key",
+			tree:  newFromKeys("foo"),
+			key:   "foobar",
+			value: 10,
+			keys:  []string{"foo", "foobar"},
+		},
+		{
+			name:  "existing key",
+			tree:  newFromKeys("foo", "bar"),
+			key:   "foo",
+			value: 10,
+			keys:  []string{"bar", "foo"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.tree.Put(test.key, test.value)
+
+			value, found := test.tree.Get(test.key)
+
+			assert.Truef(t, found, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+			assert.Equalf(t, test.keys, test.tree.GetKeys(), test.name)
+			assert.Equalf(t, len(test.keys), test.tree.Size(), test.name)
+		})
+	}
+}
+
+func TestRadixTreeGet(t *testing.T) {
+	tests := []struct {
+		name  string
+		tree  *Tree[string, int]
+		key   string
+		value int
+		found bool
+	}{
+		{
+			name:  "empty tree",
+			tree:  newFromKeys(),
+			key:   "foo",
+			found: false,
+		},
+		{
+			name:  "inner node without value",
+			tree:  newFromKeys("foobar", "foobaz"),
+			key:   "fooba",
+			found: false,
+		},
+		{
+			name:  "middle of an edge",
+			tree:  newFromKeys("foobar"),
+			key:   "foo",
+			found: false,
+		},
+		{
+			name:  "found",
+			tree:  newFromKeys("foobar", "foobaz"),
+			key:   "foobaz",
+			value: 1,
+			found: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			value, found := test.tree.Get(test.key)
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+		})
+	}
+}
+
+func TestRadixTreeRemove(t *testing.T) {
+	tests := []struct {
+		name  string
+		tree  *Tree[string, int]
+		key   string
+		keys  []string
+		nodes int
+	}{
+		{
+			name:  "empty tree",
+			tree:  newFromKeys(),
+			key:   "foo",
+			keys:  []string{},
+			nodes: 1,
+		},
+		{
+			name:  "leaf, merges parent",
+			tree:  newFromKeys("foobar", "foobaz"),
+			key:   "foobar",
+			keys:  []string{"foobaz"},
+			nodes: 2,
+		},
+		{
+			name:  "inner node, merges with child",
+			tree:  newFromKeys("foo", "foobar"),
+			key:   "foo",
+			keys:  []string{"foobar"},
+			nodes: 2,
+		},
+		{
+			name:  "inner node with several children",
+			tree:  newFromKeys("foo", "foobar", "foobaz"),
+			key:   "foo",
+			keys:  []string{"foobar", "foobaz"},
+			nodes: 4,
+		},
+		{
+			name:  "missing key",
+			tree:  newFromKeys("foobar"),
+			key:   "foo",
+			keys:  []string{"foobar"},
+			nodes: 2,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			test.tree.Remove(nil, test.key)
+
+			assert.Equalf(t, test.keys, test.tree.GetKeys(), test.name)
+			assert.Equalf(t, len(test.keys), test.tree.Size(), test.name)
+			assert.Equalf(t, test.nodes, countNodes(test.tree.root), test.name)
+		})
+	}
+}
+
+func countNodes(n *node[int]) int {
+	count := 1
+	for _, child := range n.children {
+		count += countNodes(child)
+	}
+
+	return count
+}
+
+func TestRadixTreeLongestPrefixOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		tree   *Tree[string, int]
+		key    string
+		prefix string
+		value  int
+		found  bool
+	}{
+		{
+			name:  "empty tree",
+			tree:  newFromKeys(),
+			key:   "foo",
+			found: false,
+		},
+		{
+			name:   "key itself",
+			tree:   newFromKeys("f", "foo", "foobar"),
+			key:    "foo",
+			prefix: "foo",
+			value:  1,
+			found:  true,
+		},
+		{
+			name:   "longer key",
+			tree:   newFromKeys("f", "foo", "foobar"),
+			key:    "foobaz",
+			prefix: "foo",
+			value:  1,
+			found:  true,
+		},
+		{
+			name:   "empty key",
+			tree:   newFromKeys("", "foo"),
+			key:    "bar",
+			prefix: "",
+			value:  0,
+			found:  true,
+		},
+		{
+			name:  "not found",
+			tree:  newFromKeys("foo", "foobar"),
+			key:   "fo",
+			found: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			prefix, value, found := test.tree.LongestPrefixOf(test.key)
+
+			assert.Equalf(t, test.found, found, test.name)
+			assert.Equalf(t, test.prefix, prefix, test.name)
+			assert.Equalf(t, test.value, value, test.name)
+		})
+	}
+}
+
+func TestRadixTreeKeysWithPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		tree   *Tree[string, int]
+		prefix string
+		keys   []string
+	}{
+		{
+			name:   "empty tree",
+			tree:   newFromKeys(),
+			prefix: "foo",
+			keys:   []string{},
+		},
+		{
+			name:   "empty prefix",
+			tree:   newFromKeys("foo", "bar", "baz"),
+			prefix: "",
+			keys:   []string{"bar", "baz", "foo"},
+		},
+		{
+			name:   "prefix ends at a node",
+			tree:   newFromKeys("foo", "bar", "baz", "ba"),
+			prefix: "ba",
+			keys:   []string{"ba", "bar", "baz"},
+		},
+		{
+			name:   "prefix ends in the middle of an edge",
+			tree:   newFromKeys("foobar", "foobaz", "bar"),
+			prefix: "foob",
+			keys:   []string{"foobar", "foobaz"},
+		},
+		{
+			name:   "no matches",
+			tree:   newFromKeys("foobar", "foobaz", "bar"),
+			prefix: "fooc",
+			keys:   []string{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.keys, test.tree.KeysWithPrefix(test.prefix), test.name)
+			assert.Equalf(t, len(test.keys), test.tree.CountPrefix(test.prefix), test.name)
+		})
+	}
+}
+
+func TestRadixTreeWalkPrefix(t *testing.T) {
+	tree := newFromKeys("romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus")
+
+	it := tree.WalkPrefix("rub")
+
+	keys := []string{}
+	for it.Next() {
+		key, _ := it.GetKey()
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []string{"rubens", "ruber", "rubicon", "rubicundus"}, keys)
+
+	keys = []string{}
+	for it.Previous() {
+		key, _ := it.GetKey()
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []string{"rubicundus", "rubicon", "ruber", "rubens"}, keys)
+
+	assert.True(t, it.MoveToKey("rubicon"))
+	index, _ := it.Index()
+	assert.Equal(t, 2, index)
+	assert.False(t, it.MoveToKey("romane"))
+
+	value, found := it.GetAt(1)
+	assert.True(t, found)
+	assert.Equal(t, 4, value)
+
+	assert.True(t, it.SetAtKey("ruber", 10))
+	value, _ = tree.Get("ruber")
+	assert.Equal(t, 10, value)
+}
+
+func TestRadixTreeByteSliceKeys(t *testing.T) {
+	tree := New[[]byte, int]()
+	tree.Put([]byte("foobar"), 1)
+	tree.Put([]byte("foo"), 2)
+
+	value, found := tree.Get([]byte("foo"))
+	assert.True(t, found)
+	assert.Equal(t, 2, value)
+
+	prefix, _, found := tree.LongestPrefixOf([]byte("foobaz"))
+	assert.True(t, found)
+	assert.Equal(t, []byte("foo"), prefix)
+
+	assert.Equal(t, [][]byte{[]byte("foo"), []byte("foobar")}, tree.KeysWithPrefix([]byte("fo")))
+}
+
+func TestRadixTreeRandomModification(t *testing.T) {
+	tree := New[string, int]()
+	random := rand.New(rand.NewSource(1))
+	elements := map[string]int{}
+	alphabet := "abc"
+
+	for i := 0; i < 3000; i++ {
+		key := make([]byte, random.Intn(6))
+		for j := range key {
+			key[j] = alphabet[random.Intn(len(alphabet))]
+		}
+
+		if random.Intn(3) == 0 {
+			tree.Remove(nil, string(key))
+			delete(elements, string(key))
+		} else {
+			tree.Put(string(key), i)
+			elements[string(key)] = i
+		}
+	}
+
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	assert.Equal(t, keys, tree.GetKeys())
+	assert.Equal(t, len(keys), tree.Size())
+
+	for _, key := range keys {
+		value, found := tree.Get(key)
+		assert.True(t, found)
+		assert.Equal(t, elements[key], value)
+	}
+
+	it := tree.OrderedEnd()
+	for i := len(keys) - 1; it.Previous(); i-- {
+		key, _ := it.GetKey()
+		assert.Equal(t, keys[i], key)
+	}
+
+	for i, key := range keys {
+		assert.True(t, it.MoveToKey(key))
+
+		index, _ := it.Index()
+		assert.Equal(t, i, index)
+	}
+}
+
+func TestRadixTreeMergeWith(t *testing.T) {
+	tree := newFromKeys("foo", "bar")
+
+	other := hashmap.New[string, int]()
+	other.Put("baz", 5)
+
+	var otherMap maps.Map[string, int] = other
+
+	assert.True(t, tree.MergeWith(&otherMap))
+	assert.Equal(t, []string{"bar", "baz", "foo"}, tree.GetKeys())
+	assert.False(t, tree.MergeWith(&otherMap))
+}
+
+func TestRadixTreeSerialization(t *testing.T) {
+	tree := newFromKeys("foo", "foobar", "bar")
+
+	json, err := tree.ToJSON()
+	assert.NoError(t, err)
+
+	deserialized := New[string, int]()
+	assert.NoError(t, deserialized.FromJSON(json))
+
+	assert.Equal(t, tree.GetKeys(), deserialized.GetKeys())
+	assert.Equal(t, tree.GetValues(), deserialized.GetValues())
+}
+
+// assertClonedNode asserts, that clone has the same edges and values as n, but none of its nodes.
+func assertClonedNode(t *testing.T, n *node[int], clone *node[int], parent *node[int]) {
+	if n == nil {
+		assert.Nil(t, clone)
+
+		return
+	}
+
+	if !assert.NotNil(t, clone) || !assert.Len(t, clone.children, len(n.children)) {
+		return
+	}
+
+	assert.NotSame(t, n, clone)
+	assert.Same(t, parent, clone.parent)
+	assert.Equal(t, n.prefix, clone.prefix)
+	assert.Equal(t, n.hasValue, clone.hasValue)
+	assert.Equal(t, n.size, clone.size)
+
+	for i, child := range n.children {
+		assertClonedNode(t, child, clone.children[i], clone)
+	}
+}
+
+func TestRadixTreeClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Tree[string, int]
+	}{
+		{
+			name:     "empty",
+			original: newFromKeys(),
+		},
+		{
+			name:     "shared prefixes",
+			original: newFromKeys("team", "test", "toast", ""),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			keys := test.original.GetKeys()
+			clone := test.original.Clone()
+
+			assert.Truef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+			assertClonedNode(t, test.original.root, clone.root, nil)
+
+			values := test.original.GetValues()
+			for _, key := range keys {
+				clone.Put(key, -1)
+			}
+
+			assert.Equalf(t, values, test.original.GetValues(), test.name)
+
+			clone.Put("tea", 10)
+
+			assert.Falsef(t, clone.Equals(test.original, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, keys, test.original.GetKeys(), test.name)
+			assert.Equalf(t, len(keys)+1, clone.Size(), test.name)
+		})
+	}
+}
+
+func TestRadixTreeEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *Tree[string, int]
+		b     *Tree[string, int]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     newFromKeys(),
+			b:     newFromKeys(),
+			equal: true,
+		},
+		{
+			name:  "equal",
+			a:     newFromKeys("team", "test", "toast"),
+			b:     newFromKeys("team", "test", "toast"),
+			equal: true,
+		},
+		{
+			name:  "different values",
+			a:     newFromKeys("team", "test"),
+			b:     newFromKeys("test", "team"),
+			equal: false,
+		},
+		{
+			name:  "different keys",
+			a:     newFromKeys("team", "test"),
+			b:     newFromKeys("team", "tent"),
+			equal: false,
+		},
+		{
+			name:  "different size",
+			a:     newFromKeys("team"),
+			b:     newFromKeys("team", "test"),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, utils.BasicComparator[int]), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, utils.BasicComparator[int]), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	tree := New[string, int]()
+	tree.Put("c", 3)
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range tree.All() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	keys = []string{}
+	values = []int{}
+	for key, value := range tree.Backward() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []string{"c", "b", "a"}, keys)
+	assert.Equal(t, []int{3, 2, 1}, values)
+
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(tree.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(tree.Values()))
+
+	keys = []string{}
+	for key := range tree.All() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixtree
+
+import (
+	"encoding/json"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+)
+
+// Assert Serialization implementation
+var _ ds.JSONSerializer = (*Tree[string, any])(nil)
+var _ ds.JSONDeserializer = (*Tree[string, any])(nil)
+
+// ToJSON outputs the JSON representation of the tree.
+func (tree *Tree[TKey, TValue]) ToJSON() ([]byte, error) {
+	elements := make(map[string]TValue)
+	it := tree.OrderedBegin()
+	for it.Next() {
+		key, _ := it.GetKey()
+		value, _ := it.Get()
+		elements[string(key)] = value
+	}
+	return json.Marshal(&elements)
+}
+
+// FromJSON populates the tree from the input JSON representation.
+func (tree *Tree[TKey, TValue]) FromJSON(data []byte) error {
+	elements := make(map[string]TValue)
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		tree.Clear()
+		for key, value := range elements {
+			tree.Put(TKey(key), value)
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (tree *Tree[TKey, TValue]) UnmarshalJSON(bytes []byte) error {
+	return tree.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (tree *Tree[TKey, TValue]) MarshalJSON() ([]byte, error) {
+	return tree.ToJSON()
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tries provides an abstract Trie interface.
+//
+// A trie is a search tree, which stores keys by their characters, so that all keys sharing a prefix share a subtree.
+// This makes looking up keys by prefix as cheap as looking up a single key.
+//
+// Reference: https://en.wikipedia.org/wiki/Trie
+package tries
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/maps"
+)
+
+// Key is the constraint for the keys of a trie.
+// Keys are compared and ordered byte-wise.
+type Key interface {
+	~string | ~[]byte
+}
+
+// Trie interface that all tries implement (extends the Map interface).
+type Trie[TKey Key, TValue any] interface {
+	// LongestPrefixOf returns the longest key, which is a prefix of key, and its value.
+	LongestPrefixOf(key TKey) (prefix TKey, value TValue, found bool)
+	// WalkPrefix returns an ordered iterator over the elements, whose keys start with prefix.
+	WalkPrefix(prefix TKey) ds.ReadWriteOrdCompBidRandCollIterator[TKey, TValue]
+	// KeysWithPrefix returns all keys starting with prefix in-order.
+	KeysWithPrefix(prefix TKey) []TKey
+	// CountPrefix returns the number of keys starting with prefix.
+	CountPrefix(prefix TKey) int
+
+	maps.Map[TKey, TValue]
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unionfind
+
+import (
+	"encoding/json"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+)
+
+// Assert Serialization implementation
+var _ ds.JSONSerializer = (*UnionFind[string])(nil)
+var _ ds.JSONDeserializer = (*UnionFind[string])(nil)
+
+// ToJSON outputs the JSON representation of the sets as a list of their elements.
+func (unionFind *UnionFind[T]) ToJSON() ([]byte, error) {
+	components := [][]T{}
+	for _, component := range unionFind.Components() {
+		components = append(components, component.GetValues())
+	}
+
+	return json.Marshal(components)
+}
+
+// FromJSON populates the sets from the input JSON representation.
+func (unionFind *UnionFind[T]) FromJSON(data []byte) error {
+	components := [][]T{}
+	err := json.Unmarshal(data, &components)
+	if err == nil {
+		unionFind.Clear()
+
+		for _, component := range components {
+			unionFind.Add(component...)
+
+			for i := 1; i < len(component); i++ {
+				unionFind.Union(component[0], component[i])
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (unionFind *UnionFind[T]) UnmarshalJSON(bytes []byte) error {
+	return unionFind.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (unionFind *UnionFind[T]) MarshalJSON() ([]byte, error) {
+	return unionFind.ToJSON()
+}
@@ -0,0 +1,293 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package unionfind implements a disjoint-set forest.
+//
+// Every set is represented by a tree, whose root is the set's representative.
+// Union attaches the root of the smaller tree to the root of the larger one and Find compresses the path it walks,
+// so that all operations run in amortized O(α(n)), where α is the inverse Ackermann function.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Disjoint-set_data_structure
+package unionfind
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/sets/hashset"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// Assert Container implementation
+var _ ds.Container[string] = (*UnionFind[string])(nil)
+
+// Assert Cloneable and Equatable implementation
+var _ ds.Cloneable[*UnionFind[string]] = (*UnionFind[string])(nil)
+var _ ds.Equatable[*UnionFind[string], string] = (*UnionFind[string])(nil)
+
+// UnionFind holds the elements and their sets
+type UnionFind[T comparable] struct {
+	parents map[T]T
+	// Number of elements in the set, only maintained for representatives
+	sizes   map[T]int
+	numSets int
+}
+
+// New instantiates a new union-find structure and adds the passed values, if any, as singleton sets.
+func New[T comparable](values ...T) *UnionFind[T] {
+	unionFind := &UnionFind[T]{parents: make(map[T]T), sizes: make(map[T]int)}
+	unionFind.Add(values...)
+
+	return unionFind
+}
+
+// NewFromSlice instantiates a new union-find structure holding the values of slice as singleton sets.
+func NewFromSlice[T comparable](slice []T) *UnionFind[T] {
+	return New(slice...)
+}
+
+// NewFromIterator instantiates a new union-find structure holding the elements provided by the passed iterator as singleton sets.
+func NewFromIterator[T comparable](begin ds.ReadForIterator[T]) *UnionFind[T] {
+	unionFind := New[T]()
+
+	for begin.Next() {
+		value, _ := begin.Get()
+		unionFind.Add(value)
+	}
+
+	return unionFind
+}
+
+// Add adds every passed value, which is not yet contained, as a singleton set.
+func (unionFind *UnionFind[T]) Add(values ...T) {
+	for _, value := range values {
+		if _, found := unionFind.parents[value]; found {
+			continue
+		}
+
+		unionFind.parents[value] = value
+		unionFind.sizes[value] = 1
+		unionFind.numSets++
+	}
+}
+
+// Contains returns true if all passed values are contained.
+func (unionFind *UnionFind[T]) Contains(values ...T) bool {
+	for _, value := range values {
+		if _, found := unionFind.parents[value]; !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Find returns the representative of the set containing value.
+// Second return parameter is true if value is contained, otherwise false.
+func (unionFind *UnionFind[T]) Find(value T) (representative T, found bool) {
+	representative, found = unionFind.parents[value]
+	if !found {
+		return
+	}
+
+	for parent := unionFind.parents[representative]; parent != representative; parent = unionFind.parents[representative] {
+		representative = parent
+	}
+
+	// Path compression
+	for value != representative {
+		next := unionFind.parents[value]
+		unionFind.parents[value] = representative
+		value = next
+	}
+
+	return representative, true
+}
+
+// Union merges the sets containing a and b, values which are not yet contained are added first.
+// Returns true if a and b were in different sets, otherwise false.
+func (unionFind *UnionFind[T]) Union(a T, b T) bool {
+	unionFind.Add(a, b)
+
+	rootA, _ := unionFind.Find(a)
+	rootB, _ := unionFind.Find(b)
+
+	if rootA == rootB {
+		return false
+	}
+
+	// Union by size
+	if unionFind.sizes[rootA] < unionFind.sizes[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+
+	unionFind.parents[rootB] = rootA
+	unionFind.sizes[rootA] += unionFind.sizes[rootB]
+	delete(unionFind.sizes, rootB)
+	unionFind.numSets--
+
+	return true
+}
+
+// Connected returns true if a and b are contained in the same set.
+func (unionFind *UnionFind[T]) Connected(a T, b T) bool {
+	rootA, foundA := unionFind.Find(a)
+	rootB, foundB := unionFind.Find(b)
+
+	return foundA && foundB && rootA == rootB
+}
+
+// SetSize returns the number of elements in the set containing value or 0 if value is not contained.
+func (unionFind *UnionFind[T]) SetSize(value T) int {
+	root, found := unionFind.Find(value)
+	if !found {
+		return 0
+	}
+
+	return unionFind.sizes[root]
+}
+
+// NumSets returns the number of disjoint sets.
+func (unionFind *UnionFind[T]) NumSets() int {
+	return unionFind.numSets
+}
+
+// Component returns the elements of the set containing value.
+// Second return parameter is true if value is contained, otherwise false.
+func (unionFind *UnionFind[T]) Component(value T) (component *hashset.Set[T], found bool) {
+	root, found := unionFind.Find(value)
+	if !found {
+		return
+	}
+
+	component = hashset.New[T]()
+	for element := range unionFind.parents {
+		if elementRoot, _ := unionFind.Find(element); elementRoot == root {
+			component.Add(element)
+		}
+	}
+
+	return component, true
+}
+
+// Components returns the elements of all sets.
+func (unionFind *UnionFind[T]) Components() []*hashset.Set[T] {
+	components := make(map[T]*hashset.Set[T], unionFind.numSets)
+
+	for element := range unionFind.parents {
+		root, _ := unionFind.Find(element)
+
+		component, found := components[root]
+		if !found {
+			component = hashset.New[T]()
+			components[root] = component
+		}
+
+		component.Add(element)
+	}
+
+	result := make([]*hashset.Set[T], 0, len(components))
+	for _, component := range components {
+		result = append(result, component)
+	}
+
+	return result
+}
+
+// IsEmpty returns true if no elements are contained.
+func (unionFind *UnionFind[T]) IsEmpty() bool {
+	return len(unionFind.parents) == 0
+}
+
+// Size returns the number of elements in all sets.
+func (unionFind *UnionFind[T]) Size() int {
+	return len(unionFind.parents)
+}
+
+// Clear removes all elements.
+func (unionFind *UnionFind[T]) Clear() {
+	unionFind.parents = make(map[T]T)
+	unionFind.sizes = make(map[T]int)
+	unionFind.numSets = 0
+}
+
+// GetValues returns the elements of all sets.
+func (unionFind *UnionFind[T]) GetValues() []T {
+	values := make([]T, 0, len(unionFind.parents))
+	for value := range unionFind.parents {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// ToString returns a string representation of container
+func (unionFind *UnionFind[T]) ToString() string {
+	str := "UnionFind\n"
+	components := []string{}
+	for _, component := range unionFind.Components() {
+		items := []string{}
+		for _, item := range component.GetValues() {
+			items = append(items, fmt.Sprintf("%v", item))
+		}
+
+		components = append(components, "{"+strings.Join(items, ", ")+"}")
+	}
+	str += strings.Join(components, ", ")
+
+	return str
+}
+
+// Clone returns a copy of the structure with the same sets, which does not share its underlying maps with the original.
+func (unionFind *UnionFind[T]) Clone() *UnionFind[T] {
+	clone := &UnionFind[T]{
+		parents: make(map[T]T, len(unionFind.parents)),
+		sizes:   make(map[T]int, len(unionFind.sizes)),
+		numSets: unionFind.numSets,
+	}
+
+	for value, parent := range unionFind.parents {
+		clone.parents[value] = parent
+	}
+
+	for root, size := range unionFind.sizes {
+		clone.sizes[root] = size
+	}
+
+	return clone
+}
+
+// Equals returns true if both structures hold the same elements partitioned into the same sets.
+// The representatives of the sets may differ.
+// Elements are hashed, so the comparator is not used and may be nil.
+func (unionFind *UnionFind[T]) Equals(other *UnionFind[T], _ utils.Comparator[T]) bool {
+	if unionFind.Size() != other.Size() || unionFind.numSets != other.numSets {
+		return false
+	}
+
+	// With the same number of sets, the partitions are equal if every set is contained in a set of other
+	for value := range unionFind.parents {
+		root, _ := unionFind.Find(value)
+		if !other.Connected(value, root) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// All returns a sequence over the elements of all sets in no particular order.
+func (unionFind *UnionFind[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range unionFind.parents {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
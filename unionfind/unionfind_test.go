@@ -0,0 +1,303 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unionfind
+
+import (
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	"github.com/JonasMuehlmann/datastructures.go/queues/priorityqueue"
+	testCommon "github.com/JonasMuehlmann/datastructures.go/tests"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFromPairs(pairs ...[2]string) *UnionFind[string] {
+	unionFind := New[string]()
+	for _, pair := range pairs {
+		unionFind.Union(pair[0], pair[1])
+	}
+
+	return unionFind
+}
+
+// sortedComponents returns the components as sorted slices in a deterministic order.
+func sortedComponents(unionFind *UnionFind[string]) [][]string {
+	components := [][]string{}
+	for _, component := range unionFind.Components() {
+		values := component.GetValues()
+		sort.Strings(values)
+		components = append(components, values)
+	}
+
+	sort.Slice(components, func(i, j int) bool { return components[i][0] < components[j][0] })
+
+	return components
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name      string
+		unionFind *UnionFind[string]
+		values    []string
+	}{
+		{
+			name:      "empty",
+			unionFind: New[string](),
+			values:    []string{},
+		},
+		{
+			name:      "from values",
+			unionFind: New("foo", "bar", "foo"),
+			values:    []string{"foo", "bar"},
+		},
+		{
+			name:      "from slice",
+			unionFind: NewFromSlice([]string{"foo", "bar"}),
+			values:    []string{"foo", "bar"},
+		},
+		{
+			name:      "from iterator",
+			unionFind: NewFromIterator[string](arraylist.New("foo", "bar").Begin()),
+			values:    []string{"foo", "bar"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.ElementsMatchf(t, test.values, test.unionFind.GetValues(), test.name)
+			assert.Equalf(t, len(test.values), test.unionFind.NumSets(), test.name)
+			assert.Equalf(t, len(test.values), test.unionFind.Size(), test.name)
+		})
+	}
+}
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name       string
+		unionFind  *UnionFind[string]
+		a          string
+		b          string
+		merged     bool
+		components [][]string
+	}{
+		{
+			name:       "missing elements",
+			unionFind:  New[string](),
+			a:          "a",
+			b:          "b",
+			merged:     true,
+			components: [][]string{{"a", "b"}},
+		},
+		{
+			name:       "same element",
+			unionFind:  New("a"),
+			a:          "a",
+			b:          "a",
+			merged:     false,
+			components: [][]string{{"a"}},
+		},
+		{
+			name:       "already connected",
+			unionFind:  newFromPairs([2]string{"a", "b"}, [2]string{"b", "c"}),
+			a:          "a",
+			b:          "c",
+			merged:     false,
+			components: [][]string{{"a", "b", "c"}},
+		},
+		{
+			name:       "merge sets",
+			unionFind:  newFromPairs([2]string{"a", "b"}, [2]string{"c", "d"}, [2]string{"e", "f"}),
+			a:          "b",
+			b:          "d",
+			merged:     true,
+			components: [][]string{{"a", "b", "c", "d"}, {"e", "f"}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.merged, test.unionFind.Union(test.a, test.b), test.name)
+			assert.Truef(t, test.unionFind.Connected(test.a, test.b), test.name)
+			assert.Equalf(t, test.components, sortedComponents(test.unionFind), test.name)
+			assert.Equalf(t, len(test.components), test.unionFind.NumSets(), test.name)
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	unionFind := newFromPairs([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"d", "e"})
+
+	rootA, found := unionFind.Find("a")
+	assert.True(t, found)
+	rootC, _ := unionFind.Find("c")
+	assert.Equal(t, rootA, rootC)
+
+	_, found = unionFind.Find("x")
+	assert.False(t, found)
+
+	assert.True(t, unionFind.Connected("a", "c"))
+	assert.False(t, unionFind.Connected("a", "d"))
+	assert.False(t, unionFind.Connected("a", "x"))
+
+	assert.Equal(t, 3, unionFind.SetSize("b"))
+	assert.Equal(t, 2, unionFind.SetSize("e"))
+	assert.Equal(t, 0, unionFind.SetSize("x"))
+
+	component, found := unionFind.Component("e")
+	assert.True(t, found)
+	assert.ElementsMatch(t, []string{"d", "e"}, component.GetValues())
+
+	_, found = unionFind.Component("x")
+	assert.False(t, found)
+}
+
+func TestClear(t *testing.T) {
+	unionFind := newFromPairs([2]string{"a", "b"})
+	unionFind.Clear()
+
+	assert.True(t, unionFind.IsEmpty())
+	assert.Equal(t, 0, unionFind.NumSets())
+	assert.False(t, unionFind.Contains("a"))
+}
+
+func TestKruskal(t *testing.T) {
+	type edge struct {
+		from   string
+		to     string
+		weight int
+	}
+
+	edges := priorityqueue.New(func(a, b edge) int { return a.weight - b.weight },
+		edge{"a", "b", 4},
+		edge{"a", "c", 1},
+		edge{"b", "c", 2},
+		edge{"b", "d", 5},
+		edge{"c", "d", 8},
+		edge{"d", "e", 3},
+	)
+
+	unionFind := New("a", "b", "c", "d", "e")
+	total := 0
+
+	for edge, ok := edges.Dequeue(); ok; edge, ok = edges.Dequeue() {
+		if unionFind.Union(edge.from, edge.to) {
+			total += edge.weight
+		}
+	}
+
+	assert.Equal(t, 11, total)
+	assert.Equal(t, 1, unionFind.NumSets())
+}
+
+func TestSerialization(t *testing.T) {
+	unionFind := newFromPairs([2]string{"a", "b"}, [2]string{"c", "d"}, [2]string{"b", "e"})
+	unionFind.Add("f")
+
+	json, err := unionFind.ToJSON()
+	assert.NoError(t, err)
+
+	deserialized := New[string]()
+	assert.NoError(t, deserialized.FromJSON(json))
+
+	assert.Equal(t, sortedComponents(unionFind), sortedComponents(deserialized))
+	assert.Equal(t, unionFind.NumSets(), deserialized.NumSets())
+	assert.Equal(t, 3, deserialized.SetSize("e"))
+}
+
+func TestClone(t *testing.T) {
+	unionFind := newFromPairs([2]string{"a", "b"}, [2]string{"c", "d"}, [2]string{"b", "c"}, [2]string{"e", "e"})
+	clone := unionFind.Clone()
+
+	assert.True(t, clone.Equals(unionFind, nil))
+
+	// Modifying the clone does not modify the original
+	clone.Union("a", "e")
+	assert.False(t, clone.Equals(unionFind, nil))
+	assert.False(t, unionFind.Connected("a", "e"))
+	assert.Equal(t, 2, unionFind.NumSets())
+	assert.Equal(t, 4, unionFind.SetSize("d"))
+	assert.Equal(t, 5, clone.SetSize("d"))
+}
+
+func TestEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *UnionFind[string]
+		b     *UnionFind[string]
+		equal bool
+	}{
+		{
+			name:  "both empty",
+			a:     newFromPairs(),
+			b:     newFromPairs(),
+			equal: true,
+		},
+		{
+			name:  "different representatives",
+			a:     newFromPairs([2]string{"a", "b"}, [2]string{"b", "c"}),
+			b:     newFromPairs([2]string{"c", "b"}, [2]string{"a", "c"}),
+			equal: true,
+		},
+		{
+			name:  "different elements",
+			a:     newFromPairs([2]string{"a", "b"}),
+			b:     newFromPairs([2]string{"a", "c"}),
+			equal: false,
+		},
+		{
+			name:  "different partition",
+			a:     newFromPairs([2]string{"a", "b"}, [2]string{"c", "d"}),
+			b:     newFromPairs([2]string{"a", "c"}, [2]string{"b", "d"}),
+			equal: false,
+		},
+		{
+			name:  "different number of sets",
+			a:     newFromPairs([2]string{"a", "b"}, [2]string{"c", "d"}),
+			b:     newFromPairs([2]string{"a", "b"}, [2]string{"c", "c"}, [2]string{"d", "d"}),
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			assert.Equalf(t, test.equal, test.a.Equals(test.b, nil), test.name)
+			assert.Equalf(t, test.equal, test.b.Equals(test.a, nil), test.name)
+		})
+	}
+}
+
+func TestSequences(t *testing.T) {
+	unionFind := New("c", "a", "b")
+	unionFind.Union("a", "b")
+
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, slices.Collect(unionFind.All()))
+
+	items := []string{}
+	for item := range unionFind.All() {
+		items = append(items, item)
+		if len(items) == 2 {
+			break
+		}
+	}
+
+	assert.Len(t, items, 2)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+// SlicesEqual returns true if a and b have the same length and all elements at the same index are equal according to comparator.
+func SlicesEqual[T any](a []T, b []T, comparator Comparator[T]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if comparator(a[i], b[i]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MapsEqual returns true if a and b have the same keys and the values of all keys are equal according to comparator.
+func MapsEqual[TKey comparable, TValue any](a map[TKey]TValue, b map[TKey]TValue, comparator Comparator[TValue]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for key, value := range a {
+		otherValue, found := b[key]
+		if !found || comparator(value, otherValue) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
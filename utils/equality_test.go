@@ -0,0 +1,48 @@
+// Copyright (c) 2022, Jonas Muehlmann. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"testing"
+)
+
+func TestSlicesEqual(t *testing.T) {
+	tests := []struct {
+		a        []int
+		b        []int
+		expected bool
+	}{
+		{nil, []int{}, true},
+		{[]int{1, 2}, []int{1, 2}, true},
+		{[]int{1, 2}, []int{2, 1}, false},
+		{[]int{1, 2}, []int{1, 2, 3}, false},
+	}
+
+	for _, test := range tests {
+		if actualValue := SlicesEqual(test.a, test.b, BasicComparator[int]); actualValue != test.expected {
+			t.Errorf("Got %v expected %v for %v and %v", actualValue, test.expected, test.a, test.b)
+		}
+	}
+}
+
+func TestMapsEqual(t *testing.T) {
+	tests := []struct {
+		a        map[string]int
+		b        map[string]int
+		expected bool
+	}{
+		{nil, map[string]int{}, true},
+		{map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1}, true},
+		{map[string]int{"a": 1}, map[string]int{"a": 2}, false},
+		{map[string]int{"a": 1}, map[string]int{"b": 1}, false},
+		{map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}, false},
+	}
+
+	for _, test := range tests {
+		if actualValue := MapsEqual(test.a, test.b, BasicComparator[int]); actualValue != test.expected {
+			t.Errorf("Got %v expected %v for %v and %v", actualValue, test.expected, test.a, test.b)
+		}
+	}
+}